@@ -5,12 +5,18 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"time"
 
 	"github.com/joho/godotenv"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 
 	"chat/chat_server/internal/app"
+	"chat/chat_server/internal/config"
+	"chat/chat_server/internal/metric"
 	desc "chat/chat_server/pkg/chat_v1"
 )
 
@@ -22,6 +28,11 @@ func main() {
 		log.Fatalf("Error loading .env file: %v", err)
 	}
 
+	err = metric.Init(context.Background())
+	if err != nil {
+		log.Fatalf("failed to init metrics: %v", err)
+	}
+
 	serviceProvider := app.NewServiceProvider()
 
 	dbClient := serviceProvider.GetDbClient(context.Background())
@@ -31,6 +42,28 @@ func main() {
 		}
 	}()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go serviceProvider.GetReadBuffer(ctx).Run(ctx)
+	go serviceProvider.GetPresenceTracker().Run(ctx, config.NewPresenceConfig().SweepInterval)
+	go serviceProvider.GetChatService(ctx).PublishPresenceChanges(ctx)
+	go serviceProvider.GetScheduler(ctx).Run(ctx)
+	go serviceProvider.GetReaper(ctx).Run(ctx)
+
+	metricsHost := os.Getenv("METRICS_HOST")
+	metricsPort := os.Getenv("METRICS_PORT")
+	if metricsHost == "" {
+		metricsHost = "localhost"
+	}
+	if metricsPort == "" {
+		metricsPort = "2113"
+	}
+	go func() {
+		if err := runPrometheusServer(metricsHost + ":" + metricsPort); err != nil {
+			log.Fatalf("failed to run Prometheus server: %v", err)
+		}
+	}()
+
 	chatHandler := serviceProvider.GetChatHandler(context.Background())
 	authInterceptor := serviceProvider.GetAuthInterceptor()
 
@@ -41,6 +74,7 @@ func main() {
 
 	grpcSrv := grpc.NewServer(
 		grpc.UnaryInterceptor(authInterceptor.Unary()),
+		grpc.StreamInterceptor(authInterceptor.Stream()),
 	)
 	reflection.Register(grpcSrv)
 	desc.RegisterChatV1Server(grpcSrv, chatHandler)
@@ -49,3 +83,21 @@ func main() {
 		log.Fatalf("serve error: %v", err)
 	}
 }
+
+func runPrometheusServer(addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	prometheusServer := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+		ReadTimeout:       30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+		IdleTimeout:       120 * time.Second,
+	}
+
+	log.Printf("Chat Prometheus server listening on %s", addr)
+
+	return prometheusServer.ListenAndServe()
+}
@@ -0,0 +1,117 @@
+package chat_v1
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"chat/chat_server/internal/converter"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+const downloadChunkSize = 64 << 10
+
+// UploadAttachment receives a file as a stream of chunks after an info frame
+// and returns the stored attachment once the client closes the stream.
+func (h *ChatV1Handler) UploadAttachment(stream desc.ChatV1_UploadAttachmentServer) error {
+	ctx := stream.Context()
+
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	info := first.GetInfo()
+	if info == nil {
+		return status.Errorf(codes.InvalidArgument, "first frame must carry the attachment info")
+	}
+
+	attachment, err := h.chatService.UploadAttachment(ctx, &model.AttachmentUpload{
+		ChatID:   info.GetChatId(),
+		Username: username,
+		Filename: info.GetFilename(),
+	}, &uploadReader{stream: stream})
+	if err != nil {
+		return toStatusError("failed to upload attachment", err)
+	}
+
+	return stream.SendAndClose(converter.ToAttachmentFromModel(attachment))
+}
+
+// DownloadAttachment streams an attachment back: its metadata first, then
+// its content in chunks.
+func (h *ChatV1Handler) DownloadAttachment(req *desc.DownloadAttachmentRequest, stream desc.ChatV1_DownloadAttachmentServer) error {
+	ctx := stream.Context()
+
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	attachment, content, err := h.chatService.OpenAttachment(ctx, username, req.GetAttachmentId(), int(req.GetThumbnailSize()))
+	if err != nil {
+		return toStatusError("failed to download attachment", err)
+	}
+	defer func() { _ = content.Close() }()
+
+	err = stream.Send(&desc.DownloadAttachmentResponse{Payload: &desc.DownloadAttachmentResponse_Attachment{
+		Attachment: converter.ToAttachmentFromModel(attachment),
+	}})
+	if err != nil {
+		return fmt.Errorf("failed to send attachment: %w", err)
+	}
+
+	buf := make([]byte, downloadChunkSize)
+	for {
+		n, err := content.Read(buf)
+		if n > 0 {
+			sendErr := stream.Send(&desc.DownloadAttachmentResponse{Payload: &desc.DownloadAttachmentResponse_Chunk{
+				Chunk: buf[:n],
+			}})
+			if sendErr != nil {
+				return fmt.Errorf("failed to send attachment chunk: %w", sendErr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read attachment: %w", err)
+		}
+	}
+}
+
+// uploadReader exposes the chunks of an upload stream as an io.Reader.
+type uploadReader struct {
+	stream desc.ChatV1_UploadAttachmentServer
+	buf    []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		chunk, ok := req.GetPayload().(*desc.UploadAttachmentRequest_Chunk)
+		if !ok {
+			return 0, fmt.Errorf("%w: expected an attachment chunk", service.ErrInvalidArgument)
+		}
+		r.buf = chunk.Chunk
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
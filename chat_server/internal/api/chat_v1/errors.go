@@ -0,0 +1,62 @@
+package chat_v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"chat/chat_server/internal/hub"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/service"
+)
+
+// toStatusError prefixes a service error with msg and maps known service
+// errors to the matching gRPC status code.
+func toStatusError(msg string, err error) error {
+	code := codes.Unknown
+	switch {
+	case errors.Is(err, service.ErrChatNotFound):
+		code = codes.NotFound
+	case errors.Is(err, service.ErrNotChatMember):
+		code = codes.PermissionDenied
+	case errors.Is(err, service.ErrMemberNotFound), errors.Is(err, service.ErrMessageNotFound),
+		errors.Is(err, service.ErrAttachmentNotFound), errors.Is(err, service.ErrScheduledMessageNotFound):
+		code = codes.NotFound
+	case errors.Is(err, service.ErrInvalidArgument), errors.Is(err, service.ErrAttachmentTooLarge):
+		code = codes.InvalidArgument
+	case errors.Is(err, service.ErrChatMemberLimit), errors.Is(err, service.ErrOwnerCannotLeave),
+		errors.Is(err, service.ErrPinLimit):
+		code = codes.FailedPrecondition
+	case errors.Is(err, service.ErrForbidden):
+		code = codes.PermissionDenied
+	case errors.Is(err, service.ErrRateLimited):
+		code = codes.ResourceExhausted
+	}
+
+	return status.Errorf(code, "%s: %v", msg, err)
+}
+
+// subscriptionClosedError reports why the hub dropped a chat subscription.
+func subscriptionClosedError(err error) error {
+	code := codes.ResourceExhausted
+	switch {
+	case errors.Is(err, hub.ErrRemovedFromChat):
+		code = codes.PermissionDenied
+	case errors.Is(err, hub.ErrChatClosed):
+		code = codes.NotFound
+	}
+
+	return status.Errorf(code, "chat stream closed: %v", err)
+}
+
+// callerUsername returns the authenticated caller put into the context by the auth interceptor.
+func callerUsername(ctx context.Context) (string, error) {
+	username, ok := interceptor.UsernameFromContext(ctx)
+	if !ok {
+		return "", status.Errorf(codes.Unauthenticated, "caller identity is required")
+	}
+
+	return username, nil
+}
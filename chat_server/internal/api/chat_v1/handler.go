@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"chat/chat_server/internal/converter"
@@ -28,28 +30,520 @@ func (h *ChatV1Handler) Register(server *grpc.Server) {
 }
 
 func (h *ChatV1Handler) Create(ctx context.Context, req *desc.CreateRequest) (*desc.CreateResponse, error) {
-	id, err := h.chatService.Create(ctx, converter.ToChatCreateFromDesc(req))
+	username, err := callerUsername(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create chat: %w", err)
+		return nil, err
+	}
+
+	id, err := h.chatService.Create(ctx, converter.ToChatCreateFromDesc(req, username))
+	if err != nil {
+		return nil, toStatusError("failed to create chat", err)
 	}
 
 	return &desc.CreateResponse{Id: id}, nil
 }
 
 func (h *ChatV1Handler) Delete(ctx context.Context, req *desc.DeleteRequest) (*emptypb.Empty, error) {
-	err := h.chatService.Delete(ctx, req.GetId())
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.Delete(ctx, req.GetId(), username)
+	if err != nil {
+		return nil, toStatusError("failed to delete chat", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*desc.SendMessageResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := converter.ToMessageFromDesc(req)
+	if msg.From != "" && msg.From != username {
+		return nil, status.Errorf(codes.PermissionDenied, "failed to send message: cannot send as %s", msg.From)
+	}
+	msg.From = username
+
+	stored, err := h.chatService.SendMessage(ctx, msg)
+	if err != nil {
+		return nil, toStatusError("failed to send message", err)
+	}
+
+	return &desc.SendMessageResponse{Id: stored.ID, Seq: stored.Seq}, nil
+}
+
+func (h *ChatV1Handler) ConnectChat(req *desc.ConnectChatRequest, stream desc.ChatV1_ConnectChatServer) error {
+	ctx := stream.Context()
+
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	sub, err := h.chatService.ConnectChat(ctx, req.GetChatId(), username)
+	if err != nil {
+		return toStatusError("failed to connect to chat", err)
+	}
+	defer sub.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Done():
+			return subscriptionClosedError(sub.Err())
+		case event := <-sub.Events():
+			if isOwnNotification(event, username) {
+				continue
+			}
+			if err := stream.Send(converter.ToChatEventFromModel(event)); err != nil {
+				return fmt.Errorf("failed to send chat event: %w", err)
+			}
+		case event := <-sub.Ephemeral():
+			if isOwnNotification(event, username) || isExpired(event) {
+				continue
+			}
+			if err := stream.Send(converter.ToChatEventFromModel(event)); err != nil {
+				return fmt.Errorf("failed to send chat event: %w", err)
+			}
+		}
+	}
+}
+
+func (h *ChatV1Handler) ListMessages(ctx context.Context, req *desc.ListMessagesRequest) (*desc.ListMessagesResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.chatService.ListMessages(ctx, username, converter.ToMessageListQueryFromDesc(req))
+	if err != nil {
+		return nil, toStatusError("failed to list messages", err)
+	}
+
+	return converter.ToListMessagesResponseFromModel(page), nil
+}
+
+// ListMentions returns the messages that mention the caller.
+func (h *ChatV1Handler) ListMentions(ctx context.Context, req *desc.ListMentionsRequest) (*desc.ListMentionsResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.chatService.ListMentions(ctx, converter.ToMentionQueryFromDesc(req, username))
+	if err != nil {
+		return nil, toStatusError("failed to list mentions", err)
+	}
+
+	return converter.ToListMentionsResponseFromModel(page), nil
+}
+
+// ListMessageRange returns messages by sequence number so that clients can
+// fill the gaps they detect in the seq of received messages.
+func (h *ChatV1Handler) ListMessageRange(ctx context.Context, req *desc.ListMessageRangeRequest) (*desc.ListMessageRangeResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := h.chatService.ListMessageRange(ctx, username, converter.ToMessageRangeQueryFromDesc(req))
+	if err != nil {
+		return nil, toStatusError("failed to list messages", err)
+	}
+
+	return converter.ToListMessageRangeResponseFromModel(msgs), nil
+}
+
+// Sync returns what changed in the caller's chats since a previous Sync.
+func (h *ChatV1Handler) Sync(ctx context.Context, req *desc.SyncRequest) (*desc.SyncResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := converter.ToSyncTokenFromDesc(req.GetSinceToken())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to sync: %v", err)
+	}
+
+	res, err := h.chatService.Sync(ctx, username, token, int(req.GetLimit()))
+	if err != nil {
+		return nil, toStatusError("failed to sync", err)
+	}
+
+	return converter.ToSyncResponseFromModel(res), nil
+}
+
+func (h *ChatV1Handler) ListChats(ctx context.Context, req *desc.ListChatsRequest) (*desc.ListChatsResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := converter.ToChatListQueryFromDesc(req, username)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to list chats: %v", err)
+	}
+
+	page, err := h.chatService.ListChats(ctx, query)
+	if err != nil {
+		return nil, toStatusError("failed to list chats", err)
+	}
+
+	return converter.ToListChatsResponseFromModel(page), nil
+}
+
+func (h *ChatV1Handler) AddMembers(ctx context.Context, req *desc.AddMembersRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to delete chat: %w", err)
+		return nil, err
+	}
+
+	err = h.chatService.AddMembers(ctx, req.GetChatId(), username, req.GetUsernames())
+	if err != nil {
+		return nil, toStatusError("failed to add members", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
 
-func (h *ChatV1Handler) SendMessage(ctx context.Context, req *desc.SendMessageRequest) (*emptypb.Empty, error) {
-	err := h.chatService.SendMessage(ctx, converter.ToMessageFromDesc(req))
+func (h *ChatV1Handler) RemoveMember(ctx context.Context, req *desc.RemoveMemberRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to send message: %w", err)
+		return nil, err
+	}
+
+	err = h.chatService.RemoveMember(ctx, req.GetChatId(), username, req.GetUsername())
+	if err != nil {
+		return nil, toStatusError("failed to remove member", err)
 	}
 
 	return &emptypb.Empty{}, nil
 }
+
+func (h *ChatV1Handler) LeaveChat(ctx context.Context, req *desc.LeaveChatRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.LeaveChat(ctx, req.GetChatId(), username)
+	if err != nil {
+		return nil, toStatusError("failed to leave chat", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) SetMemberRole(ctx context.Context, req *desc.SetMemberRoleRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.SetMemberRole(ctx, req.GetChatId(), username, req.GetUsername(), converter.ToRoleFromDesc(req.GetRole()))
+	if err != nil {
+		return nil, toStatusError("failed to set member role", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) GetChat(ctx context.Context, req *desc.GetChatRequest) (*desc.ChatDetails, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := h.chatService.GetChat(ctx, req.GetChatId(), username)
+	if err != nil {
+		return nil, toStatusError("failed to get chat", err)
+	}
+
+	return converter.ToChatDetailsFromModel(chat), nil
+}
+
+// GetOrCreateDirectChat returns the one-to-one chat of the caller with another
+// user, creating it on first use.
+func (h *ChatV1Handler) GetOrCreateDirectChat(ctx context.Context, req *desc.GetOrCreateDirectChatRequest) (*desc.GetOrCreateDirectChatResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	chat, created, err := h.chatService.GetOrCreateDirectChat(ctx, username, req.GetUsername())
+	if err != nil {
+		return nil, toStatusError("failed to get direct chat", err)
+	}
+
+	return &desc.GetOrCreateDirectChatResponse{
+		Chat:    converter.ToChatDetailsFromModel(chat),
+		Created: created,
+	}, nil
+}
+
+func (h *ChatV1Handler) UpdateChat(ctx context.Context, req *desc.UpdateChatRequest) (*desc.ChatDetails, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update, err := converter.ToChatUpdateFromDesc(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update chat: %v", err)
+	}
+
+	chat, err := h.chatService.UpdateChat(ctx, username, update)
+	if err != nil {
+		return nil, toStatusError("failed to update chat", err)
+	}
+
+	return converter.ToChatDetailsFromModel(chat), nil
+}
+
+func (h *ChatV1Handler) EditMessage(ctx context.Context, req *desc.EditMessageRequest) (*desc.Message, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msg, err := h.chatService.EditMessage(ctx, username, req.GetMessageId(), req.GetText())
+	if err != nil {
+		return nil, toStatusError("failed to edit message", err)
+	}
+
+	return converter.ToMessageFromModel(msg), nil
+}
+
+func (h *ChatV1Handler) DeleteMessage(ctx context.Context, req *desc.DeleteMessageRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.DeleteMessage(ctx, username, req.GetMessageId())
+	if err != nil {
+		return nil, toStatusError("failed to delete message", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) ScheduleMessage(ctx context.Context, req *desc.ScheduleMessageRequest) (*desc.ScheduledMessage, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.GetSendAt() == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to schedule message: send_at is required")
+	}
+
+	msg, err := h.chatService.ScheduleMessage(ctx, converter.ToScheduledMessageFromDesc(req, username))
+	if err != nil {
+		return nil, toStatusError("failed to schedule message", err)
+	}
+
+	return converter.ToScheduledMessageFromModel(msg), nil
+}
+
+func (h *ChatV1Handler) ListScheduledMessages(ctx context.Context, req *desc.ListScheduledMessagesRequest) (*desc.ListScheduledMessagesResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := h.chatService.ListScheduledMessages(ctx, username, req.GetChatId())
+	if err != nil {
+		return nil, toStatusError("failed to list scheduled messages", err)
+	}
+
+	return converter.ToListScheduledMessagesResponseFromModel(msgs), nil
+}
+
+func (h *ChatV1Handler) CancelScheduledMessage(ctx context.Context, req *desc.CancelScheduledMessageRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.CancelScheduledMessage(ctx, username, req.GetId())
+	if err != nil {
+		return nil, toStatusError("failed to cancel scheduled message", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) ListThread(ctx context.Context, req *desc.ListThreadRequest) (*desc.ListThreadResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.chatService.ListThread(ctx, username, converter.ToThreadQueryFromDesc(req))
+	if err != nil {
+		return nil, toStatusError("failed to list thread", err)
+	}
+
+	return converter.ToListThreadResponseFromModel(page), nil
+}
+
+func (h *ChatV1Handler) AddReaction(ctx context.Context, req *desc.AddReactionRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.AddReaction(ctx, username, req.GetMessageId(), req.GetEmoji())
+	if err != nil {
+		return nil, toStatusError("failed to add reaction", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) RemoveReaction(ctx context.Context, req *desc.RemoveReactionRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.RemoveReaction(ctx, username, req.GetMessageId(), req.GetEmoji())
+	if err != nil {
+		return nil, toStatusError("failed to remove reaction", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) PinMessage(ctx context.Context, req *desc.PinMessageRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.PinMessage(ctx, username, req.GetMessageId())
+	if err != nil {
+		return nil, toStatusError("failed to pin message", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) UnpinMessage(ctx context.Context, req *desc.UnpinMessageRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.UnpinMessage(ctx, username, req.GetMessageId())
+	if err != nil {
+		return nil, toStatusError("failed to unpin message", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) ListPinned(ctx context.Context, req *desc.ListPinnedRequest) (*desc.ListPinnedResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pins, err := h.chatService.ListPinned(ctx, username, req.GetChatId())
+	if err != nil {
+		return nil, toStatusError("failed to list pinned messages", err)
+	}
+
+	return converter.ToListPinnedResponseFromModel(pins), nil
+}
+
+func (h *ChatV1Handler) MarkRead(ctx context.Context, req *desc.MarkReadRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.MarkRead(ctx, username, req.GetChatId(), req.GetMessageId())
+	if err != nil {
+		return nil, toStatusError("failed to mark chat as read", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) GetReadState(ctx context.Context, req *desc.GetReadStateRequest) (*desc.GetReadStateResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cursors, err := h.chatService.GetReadState(ctx, username, req.GetChatId())
+	if err != nil {
+		return nil, toStatusError("failed to get read state", err)
+	}
+
+	return converter.ToGetReadStateResponseFromModel(cursors), nil
+}
+
+func (h *ChatV1Handler) SetTyping(ctx context.Context, req *desc.SetTypingRequest) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.chatService.SendTyping(ctx, req.GetChatId(), username)
+	if err != nil {
+		return nil, toStatusError("failed to set typing", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) Heartbeat(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.chatService.Heartbeat(ctx, username); err != nil {
+		return nil, toStatusError("failed to record heartbeat", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *ChatV1Handler) GetPresence(ctx context.Context, req *desc.GetPresenceRequest) (*desc.GetPresenceResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	presences, err := h.chatService.GetPresence(ctx, username, req.GetUsernames())
+	if err != nil {
+		return nil, toStatusError("failed to get presence", err)
+	}
+
+	return converter.ToGetPresenceResponseFromModel(presences), nil
+}
+
+func (h *ChatV1Handler) SearchMessages(ctx context.Context, req *desc.SearchMessagesRequest) (*desc.SearchMessagesResponse, error) {
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.chatService.SearchMessages(ctx, converter.ToMessageSearchQueryFromDesc(req, username))
+	if err != nil {
+		return nil, toStatusError("failed to search messages", err)
+	}
+
+	return converter.ToSearchMessagesResponseFromModel(page), nil
+}
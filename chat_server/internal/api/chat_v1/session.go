@@ -0,0 +1,171 @@
+package chat_v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"chat/chat_server/internal/converter"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+// Chat runs a bidirectional chat session. The client joins a chat with its
+// first frame and then posts messages, typing notifications and delivery acks,
+// while the server streams chat events back over the same connection.
+func (h *ChatV1Handler) Chat(stream desc.ChatV1_ChatServer) error {
+	ctx := stream.Context()
+
+	username, err := callerUsername(ctx)
+	if err != nil {
+		return err
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		return err
+	}
+
+	join := first.GetJoin()
+	if join == nil {
+		return status.Errorf(codes.InvalidArgument, "first frame must join a chat")
+	}
+
+	sub, err := h.chatService.ConnectChat(ctx, join.GetChatId(), username)
+	if err != nil {
+		return toStatusError("failed to join chat", err)
+	}
+	defer sub.Close()
+
+	requests := make(chan *desc.ChatRequest)
+	recvErr := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				recvErr <- err
+				return
+			}
+
+			select {
+			case requests <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-recvErr:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case <-sub.Done():
+			return subscriptionClosedError(sub.Err())
+		case event := <-sub.Events():
+			if isOwnNotification(event, username) {
+				continue
+			}
+			if err := stream.Send(converter.ToChatEventFromModel(event)); err != nil {
+				return fmt.Errorf("failed to send chat event: %w", err)
+			}
+		case event := <-sub.Ephemeral():
+			if isOwnNotification(event, username) || isExpired(event) {
+				continue
+			}
+			if err := stream.Send(converter.ToChatEventFromModel(event)); err != nil {
+				return fmt.Errorf("failed to send chat event: %w", err)
+			}
+		case req := <-requests:
+			reply := h.handleChatRequest(ctx, sub.ChatID, username, req)
+			if reply == nil {
+				continue
+			}
+			if err := stream.Send(reply); err != nil {
+				return fmt.Errorf("failed to send chat event: %w", err)
+			}
+		}
+	}
+}
+
+// handleChatRequest applies a single client frame and returns the reply to
+// send back to this client, if any. Errors are reported as ErrorEvent so that
+// one rejected frame does not tear down the whole session.
+func (h *ChatV1Handler) handleChatRequest(ctx context.Context, chatID int64, username string, req *desc.ChatRequest) *desc.ChatEvent {
+	switch r := req.GetRequest().(type) {
+	case *desc.ChatRequest_Message:
+		msg, err := h.chatService.SendMessage(ctx, &model.Message{
+			ChatID:    chatID,
+			From:      username,
+			Text:      r.Message.GetText(),
+			Timestamp: time.Now(),
+			ReplyTo:   r.Message.GetReplyToMessageId(),
+			TTL:       time.Duration(r.Message.GetTtlSeconds()) * time.Second,
+
+			ClientMessageID: r.Message.GetClientMessageId(),
+		})
+		if err != nil {
+			return errorEvent(r.Message.GetRef(), err)
+		}
+		return &desc.ChatEvent{Event: &desc.ChatEvent_Sent{Sent: &desc.MessageSentEvent{
+			Ref:       r.Message.GetRef(),
+			MessageId: msg.ID,
+			Seq:       msg.Seq,
+		}}}
+	case *desc.ChatRequest_Typing:
+		// Typing frames are fire and forget: throttled ones are dropped silently.
+		err := h.chatService.SendTyping(ctx, chatID, username)
+		if err != nil && !errors.Is(err, service.ErrRateLimited) {
+			return errorEvent("", err)
+		}
+	case *desc.ChatRequest_Ack:
+		if err := h.chatService.AckMessage(ctx, chatID, r.Ack.GetMessageId(), username); err != nil {
+			return errorEvent("", err)
+		}
+	case *desc.ChatRequest_Join:
+		return errorEvent("", fmt.Errorf("session has already joined chat %d", chatID))
+	default:
+		return errorEvent("", fmt.Errorf("unknown request"))
+	}
+
+	return nil
+}
+
+// isOwnNotification reports whether the event is a typing, delivery or
+// presence notification produced by the session's own user.
+func isOwnNotification(event *model.ChatEvent, username string) bool {
+	switch {
+	case event.Typing != nil:
+		return event.Typing.Username == username
+	case event.Delivery != nil:
+		return event.Delivery.Username == username
+	case event.Presence != nil:
+		return event.Presence.Username == username
+	}
+	return false
+}
+
+// isExpired reports whether an ephemeral event is no longer worth delivering.
+func isExpired(event *model.ChatEvent) bool {
+	return event.Typing != nil && time.Now().After(event.Typing.ExpiresAt)
+}
+
+func errorEvent(ref string, err error) *desc.ChatEvent {
+	return &desc.ChatEvent{Event: &desc.ChatEvent_Error{Error: &desc.ErrorEvent{
+		Ref:     ref,
+		Message: err.Error(),
+	}}}
+}
@@ -0,0 +1,231 @@
+package tests
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+type uploadStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	frames []*desc.UploadAttachmentRequest
+	result *desc.Attachment
+}
+
+func (s *uploadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadStream) Recv() (*desc.UploadAttachmentRequest, error) {
+	if len(s.frames) == 0 {
+		return nil, io.EOF
+	}
+	frame := s.frames[0]
+	s.frames = s.frames[1:]
+	return frame, nil
+}
+
+func (s *uploadStream) SendAndClose(attachment *desc.Attachment) error {
+	s.result = attachment
+	return nil
+}
+
+type downloadStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*desc.DownloadAttachmentResponse
+}
+
+func (s *downloadStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadStream) Send(res *desc.DownloadAttachmentResponse) error {
+	s.sent = append(s.sent, res)
+	return nil
+}
+
+func infoFrame(chatID int64, filename string) *desc.UploadAttachmentRequest {
+	return &desc.UploadAttachmentRequest{Payload: &desc.UploadAttachmentRequest_Info{
+		Info: &desc.AttachmentInfo{ChatId: chatID, Filename: filename},
+	}}
+}
+
+func chunkFrame(chunk string) *desc.UploadAttachmentRequest {
+	return &desc.UploadAttachmentRequest{Payload: &desc.UploadAttachmentRequest_Chunk{Chunk: []byte(chunk)}}
+}
+
+func TestUploadAttachment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		ts     = time.Unix(0, 0).UTC()
+		upload = &model.AttachmentUpload{ChatID: 7, Username: "a", Filename: "notes.txt"}
+		stored = &model.Attachment{ID: 3, ChatID: 7, UploadedBy: "a", Filename: "notes.txt", MimeType: "text/plain; charset=utf-8", Size: 11, SHA256: "abc", CreatedAt: ts}
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UploadAttachmentMock.Set(func(_ context.Context, got *model.AttachmentUpload, r io.Reader) (*model.Attachment, error) {
+			require.Equal(t, upload, got)
+			content, err := io.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, "hello world", string(content))
+			return stored, nil
+		})
+
+		stream := &uploadStream{ctx: ctx, frames: []*desc.UploadAttachmentRequest{
+			infoFrame(7, "notes.txt"), chunkFrame("hello "), chunkFrame("world"),
+		}}
+		require.NoError(t, api.NewChatV1Handler(svc).UploadAttachment(stream))
+		require.Equal(t, int64(3), stream.result.GetId())
+		require.Equal(t, int64(11), stream.result.GetSize())
+		require.Equal(t, "text/plain; charset=utf-8", stream.result.GetMimeType())
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UploadAttachmentMock.Set(func(_ context.Context, _ *model.AttachmentUpload, r io.Reader) (*model.Attachment, error) {
+			_, _ = io.Copy(io.Discard, r)
+			return nil, fmt.Errorf("%w: max 4 bytes", service.ErrAttachmentTooLarge)
+		})
+
+		stream := &uploadStream{ctx: ctx, frames: []*desc.UploadAttachmentRequest{infoFrame(7, "notes.txt"), chunkFrame("hello")}}
+		err := api.NewChatV1Handler(svc).UploadAttachment(stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("first frame must carry info", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &uploadStream{ctx: ctx, frames: []*desc.UploadAttachmentRequest{chunkFrame("hello")}}
+		err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).UploadAttachment(stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("info frame after the first", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UploadAttachmentMock.Set(func(_ context.Context, _ *model.AttachmentUpload, r io.Reader) (*model.Attachment, error) {
+			_, err := io.ReadAll(r)
+			return nil, fmt.Errorf("failed to store attachment: %w", err)
+		})
+
+		stream := &uploadStream{ctx: ctx, frames: []*desc.UploadAttachmentRequest{
+			infoFrame(7, "notes.txt"), chunkFrame("hello"), infoFrame(7, "notes.txt"),
+		}}
+		err := api.NewChatV1Handler(svc).UploadAttachment(stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &uploadStream{ctx: context.Background()}
+		err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).UploadAttachment(stream)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestDownloadAttachment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx        = interceptor.ContextWithUsername(context.Background(), "a")
+		mc         = minimock.NewController(t)
+		req        = &desc.DownloadAttachmentRequest{AttachmentId: 3}
+		attachment = &model.Attachment{ID: 3, ChatID: 7, MessageID: 9, Filename: "notes.txt", Size: 11}
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.OpenAttachmentMock.Expect(ctx, "a", int64(3), 0).Return(attachment, io.NopCloser(bytes.NewBufferString("hello world")), nil)
+
+		stream := &downloadStream{ctx: ctx}
+		require.NoError(t, api.NewChatV1Handler(svc).DownloadAttachment(req, stream))
+		require.Equal(t, "notes.txt", stream.sent[0].GetAttachment().GetFilename())
+
+		var content []byte
+		for _, res := range stream.sent[1:] {
+			content = append(content, res.GetChunk()...)
+		}
+		require.Equal(t, "hello world", string(content))
+	})
+
+	t.Run("thumbnail", func(t *testing.T) {
+		t.Parallel()
+
+		image := &model.Attachment{
+			ID: 3, ChatID: 7, MessageID: 9, Filename: "cat.png", MimeType: "image/png", Size: 2048, Width: 640, Height: 480,
+			Thumbnails: []*model.Thumbnail{{Width: 160, Height: 120, MimeType: "image/png", Size: 512}},
+		}
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.OpenAttachmentMock.Expect(ctx, "a", int64(3), 160).Return(image, io.NopCloser(bytes.NewBufferString("thumb")), nil)
+
+		stream := &downloadStream{ctx: ctx}
+		require.NoError(t, api.NewChatV1Handler(svc).DownloadAttachment(&desc.DownloadAttachmentRequest{AttachmentId: 3, ThumbnailSize: 160}, stream))
+
+		meta := stream.sent[0].GetAttachment()
+		require.Equal(t, int32(640), meta.GetWidth())
+		require.Equal(t, int32(480), meta.GetHeight())
+		require.Len(t, meta.GetThumbnails(), 1)
+		require.Equal(t, int32(160), meta.GetThumbnails()[0].GetWidth())
+		require.Equal(t, "thumb", string(stream.sent[1].GetChunk()))
+	})
+
+	t.Run("no such thumbnail", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.OpenAttachmentMock.Expect(ctx, "a", int64(3), 99).Return(nil, nil, service.ErrAttachmentNotFound)
+
+		err := api.NewChatV1Handler(svc).DownloadAttachment(&desc.DownloadAttachmentRequest{AttachmentId: 3, ThumbnailSize: 99}, &downloadStream{ctx: ctx})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("not a member", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.OpenAttachmentMock.Expect(ctx, "a", int64(3), 0).Return(nil, nil, service.ErrNotChatMember)
+
+		err := api.NewChatV1Handler(svc).DownloadAttachment(req, &downloadStream{ctx: ctx})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.OpenAttachmentMock.Expect(ctx, "a", int64(3), 0).Return(nil, nil, service.ErrAttachmentNotFound)
+
+		err := api.NewChatV1Handler(svc).DownloadAttachment(req, &downloadStream{ctx: ctx})
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,96 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestUpdateChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = interceptor.ContextWithUsername(context.Background(), "a")
+		mc   = minimock.NewController(t)
+		chat = &model.Chat{
+			ID:        4,
+			Info:      &model.ChatInfo{Title: "new", Type: model.ChatTypeGroup},
+			Members:   []*model.ChatMember{{Username: "a", Role: model.RoleOwner}},
+			CreatedAt: time.Unix(0, 0).UTC(),
+		}
+	)
+
+	t.Run("only masked fields are updated", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UpdateChatMock.Expect(ctx, "a", &model.ChatUpdate{ID: 4, Title: proto.String("new")}).Return(chat, nil)
+
+		got, err := api.NewChatV1Handler(svc).UpdateChat(ctx, &desc.UpdateChatRequest{
+			ChatId:      4,
+			Title:       "new",
+			Description: "ignored",
+			UpdateMask:  &fieldmaskpb.FieldMask{Paths: []string{"title"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, "new", got.GetTitle())
+		require.Equal(t, desc.Role_ROLE_OWNER, got.GetMembers()[0].GetRole())
+	})
+
+	t.Run("empty mask updates every field", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UpdateChatMock.Expect(ctx, "a", &model.ChatUpdate{
+			ID:          4,
+			Title:       proto.String("new"),
+			Description: proto.String(""),
+			AvatarURL:   proto.String(""),
+		}).Return(chat, nil)
+
+		_, err := api.NewChatV1Handler(svc).UpdateChat(ctx, &desc.UpdateChatRequest{ChatId: 4, Title: "new"})
+		require.NoError(t, err)
+	})
+
+	t.Run("message ttl", func(t *testing.T) {
+		t.Parallel()
+
+		ttl := time.Hour
+		withTTL := *chat
+		withTTL.MessageTTL = ttl
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UpdateChatMock.Expect(ctx, "a", &model.ChatUpdate{ID: 4, MessageTTL: &ttl}).Return(&withTTL, nil)
+
+		got, err := api.NewChatV1Handler(svc).UpdateChat(ctx, &desc.UpdateChatRequest{
+			ChatId:            4,
+			MessageTtlSeconds: 3600,
+			UpdateMask:        &fieldmaskpb.FieldMask{Paths: []string{"message_ttl_seconds"}},
+		})
+		require.NoError(t, err)
+		require.Equal(t, int64(3600), got.GetMessageTtlSeconds())
+	})
+
+	t.Run("unknown mask path", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).UpdateChat(ctx, &desc.UpdateChatRequest{
+			ChatId:     4,
+			UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"type"}},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
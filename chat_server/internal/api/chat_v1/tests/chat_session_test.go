@@ -0,0 +1,125 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/hub"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+type chatStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv chan *desc.ChatRequest
+	sent chan *desc.ChatEvent
+}
+
+func newChatStream(ctx context.Context) *chatStream {
+	return &chatStream{
+		ctx:  ctx,
+		recv: make(chan *desc.ChatRequest, 4),
+		sent: make(chan *desc.ChatEvent, 4),
+	}
+}
+
+func (s *chatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *chatStream) Send(event *desc.ChatEvent) error {
+	s.sent <- event
+	return nil
+}
+
+func (s *chatStream) Recv() (*desc.ChatRequest, error) {
+	req, ok := <-s.recv
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func TestChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc     = minimock.NewController(t)
+		chatID = int64(3)
+		join   = &desc.ChatRequest{Request: &desc.ChatRequest_Join{Join: &desc.JoinChat{ChatId: chatID}}}
+	)
+
+	t.Run("session", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := interceptor.ContextWithUsername(context.Background(), "alice")
+		h := hub.New(4)
+		stream := newChatStream(ctx)
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ConnectChatMock.Expect(ctx, chatID, "alice").Return(h.Subscribe(chatID, "alice"), nil)
+		svc.SendMessageMock.Set(func(_ context.Context, msg *model.Message) (*model.Message, error) {
+			if msg.Text == "" {
+				return nil, fmt.Errorf("message text cannot be empty")
+			}
+			require.Equal(t, "alice", msg.From)
+			return &model.Message{ID: 42, ChatID: chatID, Seq: 9, From: msg.From, Text: msg.Text}, nil
+		})
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- api.NewChatV1Handler(svc).Chat(stream)
+		}()
+
+		stream.recv <- join
+		stream.recv <- &desc.ChatRequest{Request: &desc.ChatRequest_Message{Message: &desc.PostMessage{Ref: "r1", Text: "hi"}}}
+		sent := <-stream.sent
+		require.Equal(t, "r1", sent.GetSent().GetRef())
+		require.Equal(t, int64(42), sent.GetSent().GetMessageId())
+		require.Equal(t, int64(9), sent.GetSent().GetSeq())
+
+		stream.recv <- &desc.ChatRequest{Request: &desc.ChatRequest_Message{Message: &desc.PostMessage{Ref: "r2"}}}
+		rejected := <-stream.sent
+		require.Equal(t, "r2", rejected.GetError().GetRef())
+		require.Contains(t, rejected.GetError().GetMessage(), "cannot be empty")
+
+		h.PublishEphemeral(&model.ChatEvent{ChatID: chatID, Typing: &model.TypingEvent{Username: "carol", ExpiresAt: time.Now().Add(-time.Second)}})
+		h.PublishEphemeral(&model.ChatEvent{ChatID: chatID, Typing: &model.TypingEvent{Username: "bob", ExpiresAt: time.Now().Add(time.Minute)}})
+		require.Equal(t, "bob", (<-stream.sent).GetTyping().GetUsername())
+
+		close(stream.recv)
+		require.NoError(t, <-errCh)
+	})
+
+	t.Run("first frame must join", func(t *testing.T) {
+		t.Parallel()
+
+		stream := newChatStream(interceptor.ContextWithUsername(context.Background(), "alice"))
+		stream.recv <- &desc.ChatRequest{Request: &desc.ChatRequest_Typing{Typing: &desc.Typing{}}}
+
+		err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).Chat(stream)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		stream := newChatStream(context.Background())
+
+		err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).Chat(stream)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
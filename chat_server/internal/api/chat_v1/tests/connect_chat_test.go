@@ -0,0 +1,146 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/hub"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+type connectChatStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *desc.ChatEvent
+}
+
+func (s *connectChatStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *connectChatStream) Send(event *desc.ChatEvent) error {
+	s.sent <- event
+	return nil
+}
+
+func TestConnectChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mc       = minimock.NewController(t)
+		username = "a"
+		req      = &desc.ConnectChatRequest{ChatId: 5}
+		svcErr   = fmt.Errorf("svc error")
+	)
+
+	t.Run("delivers events until the client disconnects", func(t *testing.T) {
+		t.Parallel()
+
+		h := hub.New(4)
+		sub := h.Subscribe(req.GetChatId(), username)
+		ctx, cancel := context.WithCancel(interceptor.ContextWithUsername(context.Background(), username))
+		stream := &connectChatStream{ctx: ctx, sent: make(chan *desc.ChatEvent, 1)}
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ConnectChatMock.Expect(ctx, req.GetChatId(), username).Return(sub, nil)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- api.NewChatV1Handler(svc).ConnectChat(req, stream)
+		}()
+
+		h.Publish(&model.ChatEvent{
+			ChatID:  req.GetChatId(),
+			Message: &model.Message{ID: 1, ChatID: req.GetChatId(), From: "b", Text: "hi", Timestamp: time.Unix(0, 0).UTC()},
+		})
+
+		got := <-stream.sent
+		require.Equal(t, "hi", got.GetMessage().GetText())
+		require.Equal(t, int64(1), got.GetMessage().GetId())
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Equal(t, 0, h.Subscribers(req.GetChatId()))
+	})
+
+	t.Run("skips the caller's own notifications", func(t *testing.T) {
+		t.Parallel()
+
+		h := hub.New(4)
+		sub := h.Subscribe(req.GetChatId(), username)
+		ctx, cancel := context.WithCancel(interceptor.ContextWithUsername(context.Background(), username))
+		stream := &connectChatStream{ctx: ctx, sent: make(chan *desc.ChatEvent, 4)}
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ConnectChatMock.Expect(ctx, req.GetChatId(), username).Return(sub, nil)
+
+		errCh := make(chan error, 1)
+		go func() {
+			errCh <- api.NewChatV1Handler(svc).ConnectChat(req, stream)
+		}()
+
+		expiresAt := time.Now().Add(time.Minute)
+		h.Publish(&model.ChatEvent{ChatID: req.GetChatId(), Delivery: &model.DeliveryEvent{MessageID: 1, Username: username}})
+		h.PublishEphemeral(&model.ChatEvent{ChatID: req.GetChatId(), Typing: &model.TypingEvent{Username: username, ExpiresAt: expiresAt}})
+		h.PublishEphemeral(&model.ChatEvent{ChatID: req.GetChatId(), Presence: &model.Presence{Username: username, Status: model.PresenceOnline}})
+		h.PublishEphemeral(&model.ChatEvent{ChatID: req.GetChatId(), Typing: &model.TypingEvent{Username: "b", ExpiresAt: expiresAt}})
+
+		got := <-stream.sent
+		require.Equal(t, "b", got.GetTyping().GetUsername())
+
+		cancel()
+		require.NoError(t, <-errCh)
+		require.Empty(t, stream.sent)
+	})
+
+	t.Run("slow subscriber is disconnected", func(t *testing.T) {
+		t.Parallel()
+
+		h := hub.New(1)
+		sub := h.Subscribe(req.GetChatId(), username)
+		for i := 0; i < 2; i++ {
+			h.Publish(&model.ChatEvent{ChatID: req.GetChatId(), Message: &model.Message{ChatID: req.GetChatId()}})
+		}
+		require.ErrorIs(t, sub.Err(), hub.ErrSlowSubscriber)
+
+		stream := &connectChatStream{ctx: interceptor.ContextWithUsername(context.Background(), username), sent: make(chan *desc.ChatEvent, 2)}
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ConnectChatMock.Return(sub, nil)
+
+		err := api.NewChatV1Handler(svc).ConnectChat(req, stream)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := interceptor.ContextWithUsername(context.Background(), username)
+		stream := &connectChatStream{ctx: ctx}
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ConnectChatMock.Expect(ctx, req.GetChatId(), username).Return(nil, svcErr)
+
+		err := api.NewChatV1Handler(svc).ConnectChat(req, stream)
+		require.ErrorContains(t, err, svcErr.Error())
+		require.ErrorContains(t, err, "failed to connect to chat")
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		stream := &connectChatStream{ctx: context.Background()}
+		err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).ConnectChat(req, stream)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
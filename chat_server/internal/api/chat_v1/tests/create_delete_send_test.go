@@ -9,12 +9,15 @@ import (
 	"github.com/stretchr/testify/require"
 
 	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
 	"chat/chat_server/internal/model"
 	"chat/chat_server/internal/service"
 	serviceMocks "chat/chat_server/internal/service/mocks"
 	desc "chat/chat_server/pkg/chat_v1"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -26,13 +29,17 @@ func TestCreate(t *testing.T) {
 		req *desc.CreateRequest
 	}
 	var (
-		ctx            = context.Background()
+		ctx            = interceptor.ContextWithUsername(context.Background(), "a")
 		mc             = minimock.NewController(t)
 		id       int64 = 77
-		req            = &desc.CreateRequest{Usernames: []string{"a", "b"}}
-		modelReq       = &model.ChatCreate{Usernames: []string{"a", "b"}}
-		res            = &desc.CreateResponse{Id: id}
-		svcErr         = fmt.Errorf("svc error")
+		req            = &desc.CreateRequest{Usernames: []string{"a", "b"}, Title: "team", Type: desc.ChatType_CHAT_TYPE_CHANNEL}
+		modelReq       = &model.ChatCreate{
+			Owner:     "a",
+			Usernames: []string{"a", "b"},
+			Info:      &model.ChatInfo{Title: "team", Type: model.ChatTypeChannel},
+		}
+		res    = &desc.CreateResponse{Id: id}
+		svcErr = fmt.Errorf("svc error")
 	)
 
 	tests := []struct {
@@ -53,6 +60,22 @@ func TestCreate(t *testing.T) {
 				return m
 			},
 		},
+		{
+			name: "with client request id",
+			args: args{ctx: ctx, req: &desc.CreateRequest{Usernames: []string{"b"}, ClientRequestId: "req-1"}},
+			want: res,
+			err:  nil,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.CreateMock.Expect(ctx, &model.ChatCreate{
+					Owner:           "a",
+					Usernames:       []string{"b"},
+					Info:            &model.ChatInfo{Type: model.ChatTypeGroup},
+					ClientRequestID: "req-1",
+				}).Return(id, nil)
+				return m
+			},
+		},
 		{
 			name: "error",
 			args: args{ctx: ctx, req: req},
@@ -90,17 +113,18 @@ func TestDelete(t *testing.T) {
 		req *desc.DeleteRequest
 	}
 	var (
-		ctx    = context.Background()
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
 		mc     = minimock.NewController(t)
 		req    = &desc.DeleteRequest{Id: 10}
 		svcErr = fmt.Errorf("svc error")
 	)
 
 	tests := []struct {
-		name    string
-		args    args
-		wantErr error
-		mockFn  func(mc *minimock.Controller) service.ChatService
+		name     string
+		args     args
+		wantErr  error
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
 	}{
 		{
 			name:    "success",
@@ -108,7 +132,7 @@ func TestDelete(t *testing.T) {
 			wantErr: nil,
 			mockFn: func(mc *minimock.Controller) service.ChatService {
 				m := serviceMocks.NewChatServiceMock(mc)
-				m.DeleteMock.Expect(ctx, req.GetId()).Return(nil)
+				m.DeleteMock.Expect(ctx, req.GetId(), "a").Return(nil)
 				return m
 			},
 		},
@@ -118,7 +142,18 @@ func TestDelete(t *testing.T) {
 			wantErr: svcErr,
 			mockFn: func(mc *minimock.Controller) service.ChatService {
 				m := serviceMocks.NewChatServiceMock(mc)
-				m.DeleteMock.Expect(ctx, req.GetId()).Return(svcErr)
+				m.DeleteMock.Expect(ctx, req.GetId(), "a").Return(svcErr)
+				return m
+			},
+		},
+		{
+			name:     "not the owner",
+			args:     args{ctx: ctx, req: req},
+			wantErr:  service.ErrForbidden,
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.DeleteMock.Expect(ctx, req.GetId(), "a").Return(fmt.Errorf("failed to delete chat: %w", service.ErrForbidden))
 				return m
 			},
 		},
@@ -133,6 +168,9 @@ func TestDelete(t *testing.T) {
 			if tt.wantErr != nil {
 				require.ErrorContains(t, err, tt.wantErr.Error())
 				require.ErrorContains(t, err, "failed to delete chat")
+				if tt.wantCode != codes.OK {
+					require.Equal(t, tt.wantCode, status.Code(err))
+				}
 			} else {
 				require.NoError(t, err)
 			}
@@ -147,26 +185,60 @@ func TestSendMessage(t *testing.T) {
 		req *desc.SendMessageRequest
 	}
 	var (
-		ctx      = context.Background()
-		mc       = minimock.NewController(t)
-		req      = &desc.SendMessageRequest{From: "a", Text: "hi", Timestamp: timestamppb.New(time.Unix(0, 0).UTC())}
-		modelMsg = &model.Message{From: "a", Text: "hi", Timestamp: time.Unix(0, 0).UTC()}
-		svcErr   = fmt.Errorf("svc error")
+		ctx       = interceptor.ContextWithUsername(context.Background(), "a")
+		mc        = minimock.NewController(t)
+		callerCtx = interceptor.ContextWithUsername(context.Background(), "b")
+		req       = &desc.SendMessageRequest{ChatId: 7, From: "a", Text: "hi", Timestamp: timestamppb.New(time.Unix(0, 0).UTC())}
+		modelMsg  = &model.Message{ChatID: 7, From: "a", Text: "hi", Timestamp: time.Unix(0, 0).UTC()}
+		svcErr    = fmt.Errorf("svc error")
 	)
 
 	tests := []struct {
-		name    string
-		args    args
-		wantErr error
-		mockFn  func(mc *minimock.Controller) service.ChatService
+		name     string
+		args     args
+		want     *desc.SendMessageResponse
+		wantErr  error
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
 	}{
 		{
 			name:    "success",
 			args:    args{ctx: ctx, req: req},
+			want:    &desc.SendMessageResponse{Id: 1, Seq: 3},
+			wantErr: nil,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SendMessageMock.Expect(ctx, modelMsg).Return(&model.Message{ID: 1, Seq: 3}, nil)
+				return m
+			},
+		},
+		{
+			name: "retry with client message id",
+			args: args{ctx: ctx, req: &desc.SendMessageRequest{
+				ChatId: 7, From: "a", Text: "hi", Timestamp: timestamppb.New(time.Unix(0, 0).UTC()), ClientMessageId: "m-1",
+			}},
+			want:    &desc.SendMessageResponse{Id: 5},
+			wantErr: nil,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SendMessageMock.Expect(ctx, &model.Message{
+					ChatID: 7, From: "a", Text: "hi", Timestamp: time.Unix(0, 0).UTC(), ClientMessageID: "m-1",
+				}).Return(&model.Message{ID: 5, ClientMessageID: "m-1"}, nil)
+				return m
+			},
+		},
+		{
+			name: "with ttl",
+			args: args{ctx: ctx, req: &desc.SendMessageRequest{
+				ChatId: 7, From: "a", Text: "hi", Timestamp: timestamppb.New(time.Unix(0, 0).UTC()), TtlSeconds: 30,
+			}},
+			want:    &desc.SendMessageResponse{Id: 6, Seq: 4},
 			wantErr: nil,
 			mockFn: func(mc *minimock.Controller) service.ChatService {
 				m := serviceMocks.NewChatServiceMock(mc)
-				m.SendMessageMock.Expect(ctx, modelMsg).Return(nil)
+				m.SendMessageMock.Expect(ctx, &model.Message{
+					ChatID: 7, From: "a", Text: "hi", Timestamp: time.Unix(0, 0).UTC(), TTL: 30 * time.Second,
+				}).Return(&model.Message{ID: 6, Seq: 4, ExpiresAt: time.Unix(30, 0)}, nil)
 				return m
 			},
 		},
@@ -176,10 +248,52 @@ func TestSendMessage(t *testing.T) {
 			wantErr: svcErr,
 			mockFn: func(mc *minimock.Controller) service.ChatService {
 				m := serviceMocks.NewChatServiceMock(mc)
-				m.SendMessageMock.Expect(ctx, modelMsg).Return(svcErr)
+				m.SendMessageMock.Expect(ctx, modelMsg).Return(nil, svcErr)
+				return m
+			},
+		},
+		{
+			name:     "chat not found",
+			args:     args{ctx: ctx, req: req},
+			wantErr:  service.ErrChatNotFound,
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SendMessageMock.Expect(ctx, modelMsg).Return(nil, service.ErrChatNotFound)
+				return m
+			},
+		},
+		{
+			name:     "invalid text",
+			args:     args{ctx: ctx, req: req},
+			wantErr:  service.ErrInvalidArgument,
+			wantCode: codes.InvalidArgument,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SendMessageMock.Expect(ctx, modelMsg).Return(nil, fmt.Errorf("%w: message text cannot be empty", service.ErrInvalidArgument))
 				return m
 			},
 		},
+		{
+			name:     "sender is not a member",
+			args:     args{ctx: ctx, req: req},
+			wantErr:  service.ErrNotChatMember,
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SendMessageMock.Expect(ctx, modelMsg).Return(nil, fmt.Errorf("%w: a", service.ErrNotChatMember))
+				return m
+			},
+		},
+		{
+			name:     "sender differs from caller",
+			args:     args{ctx: callerCtx, req: req},
+			wantErr:  fmt.Errorf("cannot send as a"),
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -187,13 +301,24 @@ func TestSendMessage(t *testing.T) {
 			t.Parallel()
 			svc := tt.mockFn(mc)
 			h := api.NewChatV1Handler(svc)
-			_, err := h.SendMessage(tt.args.ctx, tt.args.req)
+			got, err := h.SendMessage(tt.args.ctx, tt.args.req)
 			if tt.wantErr != nil {
 				require.ErrorContains(t, err, tt.wantErr.Error())
 				require.ErrorContains(t, err, "failed to send message")
+				if tt.wantCode != codes.OK {
+					require.Equal(t, tt.wantCode, status.Code(err))
+				}
 			} else {
 				require.NoError(t, err)
+				require.Equal(t, tt.want, got)
 			}
 		})
 	}
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+		h := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc))
+		_, err := h.SendMessage(context.Background(), req)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
 }
@@ -0,0 +1,92 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestGetOrCreateDirectChat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = interceptor.ContextWithUsername(context.Background(), "a")
+		mc   = minimock.NewController(t)
+		req  = &desc.GetOrCreateDirectChatRequest{Username: "b"}
+		chat = &model.Chat{
+			ID:   9,
+			Info: &model.ChatInfo{Type: model.ChatTypeDirect},
+			Members: []*model.ChatMember{
+				{Username: "a", Role: model.RoleOwner},
+				{Username: "b", Role: model.RoleMember},
+			},
+			CreatedAt: time.Unix(0, 0).UTC(),
+		}
+	)
+
+	t.Run("created", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.GetOrCreateDirectChatMock.Expect(ctx, "a", "b").Return(chat, true, nil)
+
+		got, err := api.NewChatV1Handler(svc).GetOrCreateDirectChat(ctx, req)
+		require.NoError(t, err)
+		require.True(t, got.GetCreated())
+		require.Equal(t, int64(9), got.GetChat().GetId())
+		require.Equal(t, desc.ChatType_CHAT_TYPE_DIRECT, got.GetChat().GetType())
+		require.Len(t, got.GetChat().GetMembers(), 2)
+	})
+
+	t.Run("existing", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.GetOrCreateDirectChatMock.Expect(ctx, "a", "b").Return(chat, false, nil)
+
+		got, err := api.NewChatV1Handler(svc).GetOrCreateDirectChat(ctx, req)
+		require.NoError(t, err)
+		require.False(t, got.GetCreated())
+		require.Equal(t, int64(9), got.GetChat().GetId())
+	})
+
+	t.Run("error", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.GetOrCreateDirectChatMock.Expect(ctx, "a", "a").Return(nil, false, fmt.Errorf("cannot start a direct chat with yourself"))
+
+		_, err := api.NewChatV1Handler(svc).GetOrCreateDirectChat(ctx, &desc.GetOrCreateDirectChatRequest{Username: "a"})
+		require.ErrorContains(t, err, "failed to get direct chat")
+	})
+
+	t.Run("chat vanished", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.GetOrCreateDirectChatMock.Expect(ctx, "a", "b").Return(nil, false, service.ErrChatNotFound)
+
+		_, err := api.NewChatV1Handler(svc).GetOrCreateDirectChat(ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).GetOrCreateDirectChat(context.Background(), req)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
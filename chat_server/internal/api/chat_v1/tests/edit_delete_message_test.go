@@ -0,0 +1,165 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestEditMessage(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.EditMessageRequest
+	}
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		ts     = time.Unix(0, 0).UTC()
+		edited = time.Unix(60, 0).UTC()
+		req    = &desc.EditMessageRequest{MessageId: 5, Text: "fixed"}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.Message
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: &desc.Message{Id: 5, ChatId: 7, From: "a", Text: "fixed", Timestamp: timestamppb.New(ts), EditedAt: timestamppb.New(edited)},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.EditMessageMock.Expect(ctx, "a", int64(5), "fixed").Return(&model.Message{ID: 5, ChatID: 7, From: "a", Text: "fixed", Timestamp: ts, EditedAt: edited}, nil)
+				return m
+			},
+		},
+		{
+			name:     "not the author",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.EditMessageMock.Expect(ctx, "a", int64(5), "fixed").Return(nil, fmt.Errorf("failed to edit message: %w", service.ErrForbidden))
+				return m
+			},
+		},
+		{
+			name:     "message not found",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.EditMessageMock.Expect(ctx, "a", int64(5), "fixed").Return(nil, service.ErrMessageNotFound)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.EditMessage(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDeleteMessage(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.DeleteMessageRequest
+	}
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		req = &desc.DeleteMessageRequest{MessageId: 5}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.DeleteMessageMock.Expect(ctx, "a", int64(5)).Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "neither author nor admin",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.DeleteMessageMock.Expect(ctx, "a", int64(5)).Return(fmt.Errorf("failed to delete message: %w", service.ErrForbidden))
+				return m
+			},
+		},
+		{
+			name:     "already deleted",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.DeleteMessageMock.Expect(ctx, "a", int64(5)).Return(service.ErrMessageNotFound)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			_, err := h.DeleteMessage(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
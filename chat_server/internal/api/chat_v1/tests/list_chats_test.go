@@ -0,0 +1,73 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestListChats(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		active = time.Date(2024, 1, 2, 3, 4, 5, 6000, time.UTC)
+		cursor = &model.ChatCursor{LastActivityAt: active, ChatID: 9}
+		page   = &model.ChatPage{
+			Chats: []*model.ChatSummary{
+				{ID: 9, Info: &model.ChatInfo{Title: "team", Type: model.ChatTypeGroup}, LastActivityAt: active, UnreadCount: 3, LastMessage: &model.Message{ID: 1, ChatID: 9, From: "b", Text: "hi"}},
+			},
+			Next: cursor,
+		}
+	)
+
+	t.Run("page token round trip", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ListChatsMock.When(ctx, &model.ChatListQuery{Username: "a", Limit: 1}).Then(page, nil)
+		svc.ListChatsMock.When(ctx, &model.ChatListQuery{Username: "a", Limit: 1, After: cursor}).Then(&model.ChatPage{}, nil)
+		h := api.NewChatV1Handler(svc)
+
+		first, err := h.ListChats(ctx, &desc.ListChatsRequest{Limit: 1})
+		require.NoError(t, err)
+		require.Len(t, first.GetChats(), 1)
+		require.Equal(t, int64(3), first.GetChats()[0].GetUnreadCount())
+		require.Equal(t, "team", first.GetChats()[0].GetTitle())
+		require.Equal(t, "hi", first.GetChats()[0].GetLastMessage().GetText())
+		require.NotEmpty(t, first.GetNextPageToken())
+
+		second, err := h.ListChats(ctx, &desc.ListChatsRequest{Limit: 1, PageToken: first.GetNextPageToken()})
+		require.NoError(t, err)
+		require.Empty(t, second.GetChats())
+		require.Empty(t, second.GetNextPageToken())
+	})
+
+	t.Run("invalid page token", func(t *testing.T) {
+		t.Parallel()
+
+		h := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc))
+		_, err := h.ListChats(ctx, &desc.ListChatsRequest{PageToken: "not a token"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		h := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc))
+		_, err := h.ListChats(context.Background(), &desc.ListChatsRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,113 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestListMentions(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.ListMentionsRequest
+	}
+	var (
+		ctx   = interceptor.ContextWithUsername(context.Background(), "a")
+		mc    = minimock.NewController(t)
+		ts    = time.Unix(0, 0).UTC()
+		req   = &desc.ListMentionsRequest{Cursor: 30, Limit: 1}
+		query = &model.MentionQuery{Username: "a", Cursor: 30, Limit: 1}
+		page  = &model.MessagePage{
+			Messages: []*model.Message{{
+				ID: 21, ChatID: 7, From: "b", Text: "ping @a", Timestamp: ts,
+				Mentions: []*model.Mention{{Username: "a", Offset: 5, Length: 2}},
+			}},
+			NextCursor: 21,
+		}
+		res = &desc.ListMentionsResponse{
+			Messages: []*desc.Message{{
+				Id: 21, ChatId: 7, From: "b", Text: "ping @a", Timestamp: timestamppb.New(ts),
+				Mentions: []*desc.Mention{{Username: "a", Offset: 5, Length: 2}},
+			}},
+			NextCursor: 21,
+		}
+		svcErr = fmt.Errorf("svc error")
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.ListMentionsResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMentionsMock.Expect(ctx, query).Return(page, nil)
+				return m
+			},
+		},
+		{
+			name: "no mentions",
+			args: args{ctx: ctx, req: req},
+			want: &desc.ListMentionsResponse{Messages: []*desc.Message{}},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMentionsMock.Expect(ctx, query).Return(&model.MessagePage{}, nil)
+				return m
+			},
+		},
+		{
+			name:     "error",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.Unknown,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMentionsMock.Expect(ctx, query).Return(nil, svcErr)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ListMentions(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
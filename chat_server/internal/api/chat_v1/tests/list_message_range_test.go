@@ -0,0 +1,105 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestListMessageRange(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.ListMessageRangeRequest
+	}
+	var (
+		ctx   = interceptor.ContextWithUsername(context.Background(), "a")
+		mc    = minimock.NewController(t)
+		ts    = time.Unix(0, 0).UTC()
+		req   = &desc.ListMessageRangeRequest{ChatId: 7, FromSeq: 4, ToSeq: 5}
+		query = &model.MessageRangeQuery{ChatID: 7, FromSeq: 4, ToSeq: 5}
+		msgs  = []*model.Message{
+			{ID: 40, ChatID: 7, Seq: 4, From: "b", Text: "one", Timestamp: ts},
+			{ID: 42, ChatID: 7, Seq: 5, From: "a", Text: "two", Timestamp: ts, ReplyTo: 40},
+		}
+		res = &desc.ListMessageRangeResponse{Messages: []*desc.Message{
+			{Id: 40, ChatId: 7, Seq: 4, From: "b", Text: "one", Timestamp: timestamppb.New(ts)},
+			{Id: 42, ChatId: 7, Seq: 5, From: "a", Text: "two", Timestamp: timestamppb.New(ts), ReplyToMessageId: 40},
+		}}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.ListMessageRangeResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMessageRangeMock.Expect(ctx, "a", query).Return(msgs, nil)
+				return m
+			},
+		},
+		{
+			name: "empty range",
+			args: args{ctx: ctx, req: req},
+			want: &desc.ListMessageRangeResponse{Messages: []*desc.Message{}},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMessageRangeMock.Expect(ctx, "a", query).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMessageRangeMock.Expect(ctx, "a", query).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ListMessageRange(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
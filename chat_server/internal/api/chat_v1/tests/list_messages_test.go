@@ -0,0 +1,101 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestListMessages(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.ListMessagesRequest
+	}
+	var (
+		ctx   = interceptor.ContextWithUsername(context.Background(), "a")
+		mc    = minimock.NewController(t)
+		ts    = time.Unix(0, 0).UTC()
+		req   = &desc.ListMessagesRequest{ChatId: 7, Cursor: 100, Limit: 2, Direction: desc.Direction_DIRECTION_FORWARD}
+		query = &model.MessageListQuery{ChatID: 7, Cursor: 100, Limit: 2, Direction: model.DirectionForward}
+		page  = &model.MessagePage{
+			Messages: []*model.Message{
+				{ID: 101, ChatID: 7, From: "a", Text: "one", Timestamp: ts, Reactions: []*model.Reaction{{Emoji: "👍", Count: 2, Reacted: true}}},
+				{ID: 102, ChatID: 7, From: "b", Text: "two", Timestamp: ts, ExpiresAt: ts.Add(time.Minute)},
+			},
+			NextCursor: 102,
+		}
+		res = &desc.ListMessagesResponse{
+			Messages: []*desc.Message{
+				{Id: 101, ChatId: 7, From: "a", Text: "one", Timestamp: timestamppb.New(ts), Reactions: []*desc.Reaction{{Emoji: "👍", Count: 2, Reacted: true}}},
+				{Id: 102, ChatId: 7, From: "b", Text: "two", Timestamp: timestamppb.New(ts), ExpiresAt: timestamppb.New(ts.Add(time.Minute))},
+			},
+			NextCursor: 102,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.ListMessagesResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMessagesMock.Expect(ctx, "a", query).Return(page, nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListMessagesMock.Expect(ctx, "a", query).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ListMessages(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestListThread(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.ListThreadRequest
+	}
+	var (
+		ctx   = interceptor.ContextWithUsername(context.Background(), "a")
+		mc    = minimock.NewController(t)
+		ts    = time.Unix(0, 0).UTC()
+		reply = time.Unix(60, 0).UTC()
+		req   = &desc.ListThreadRequest{MessageId: 10, Limit: 1}
+		query = &model.ThreadQuery{MessageID: 10, Limit: 1}
+		page  = &model.ThreadPage{
+			Root:       &model.Message{ID: 10, ChatID: 7, From: "a", Text: "root", Timestamp: ts, ReplyCount: 2, LastReplyAt: reply},
+			Replies:    []*model.Message{{ID: 11, ChatID: 7, From: "b", Text: "one", Timestamp: ts, ReplyTo: 10}},
+			NextCursor: 11,
+		}
+		res = &desc.ListThreadResponse{
+			Root:       &desc.Message{Id: 10, ChatId: 7, From: "a", Text: "root", Timestamp: timestamppb.New(ts), ReplyCount: 2, LastReplyAt: timestamppb.New(reply)},
+			Replies:    []*desc.Message{{Id: 11, ChatId: 7, From: "b", Text: "one", Timestamp: timestamppb.New(ts), ReplyToMessageId: 10}},
+			NextCursor: 11,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.ListThreadResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListThreadMock.Expect(ctx, "a", query).Return(page, nil)
+				return m
+			},
+		},
+		{
+			name:     "message not found",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListThreadMock.Expect(ctx, "a", query).Return(nil, service.ErrMessageNotFound)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListThreadMock.Expect(ctx, "a", query).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ListThread(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
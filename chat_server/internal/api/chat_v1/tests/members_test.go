@@ -0,0 +1,137 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestMembers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "alice")
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name     string
+		call     func(h *api.ChatV1Handler) error
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "add members",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.AddMembers(ctx, &desc.AddMembersRequest{ChatId: 1, Usernames: []string{"bob"}})
+				return err
+			},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.AddMembersMock.Expect(ctx, 1, "alice", []string{"bob"}).Return(nil)
+				return m
+			},
+		},
+		{
+			name: "add members over the limit",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.AddMembers(ctx, &desc.AddMembersRequest{ChatId: 1, Usernames: []string{"bob"}})
+				return err
+			},
+			wantCode: codes.FailedPrecondition,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.AddMembersMock.Expect(ctx, 1, "alice", []string{"bob"}).
+					Return(fmt.Errorf("failed to add members: %w", service.ErrChatMemberLimit))
+				return m
+			},
+		},
+		{
+			name: "remove missing member",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.RemoveMember(ctx, &desc.RemoveMemberRequest{ChatId: 1, Username: "bob"})
+				return err
+			},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.RemoveMemberMock.Expect(ctx, 1, "alice", "bob").
+					Return(fmt.Errorf("failed to remove member: %w", service.ErrMemberNotFound))
+				return m
+			},
+		},
+		{
+			name: "leave chat",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.LeaveChat(ctx, &desc.LeaveChatRequest{ChatId: 1})
+				return err
+			},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.LeaveChatMock.Expect(ctx, 1, "alice").Return(nil)
+				return m
+			},
+		},
+		{
+			name: "set member role",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.SetMemberRole(ctx, &desc.SetMemberRoleRequest{ChatId: 1, Username: "bob", Role: desc.Role_ROLE_ADMIN})
+				return err
+			},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SetMemberRoleMock.Expect(ctx, 1, "alice", "bob", model.RoleAdmin).Return(nil)
+				return m
+			},
+		},
+		{
+			name: "set member role as admin",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.SetMemberRole(ctx, &desc.SetMemberRoleRequest{ChatId: 1, Username: "bob"})
+				return err
+			},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SetMemberRoleMock.Expect(ctx, 1, "alice", "bob", model.RoleMember).
+					Return(fmt.Errorf("failed to set member role: %w", service.ErrForbidden))
+				return m
+			},
+		},
+		{
+			name: "leave chat unauthenticated",
+			call: func(h *api.ChatV1Handler) error {
+				_, err := h.LeaveChat(context.Background(), &desc.LeaveChatRequest{ChatId: 1})
+				return err
+			},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := tt.call(api.NewChatV1Handler(tt.mockFn(mc)))
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
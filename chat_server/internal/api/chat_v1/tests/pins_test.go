@@ -0,0 +1,206 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestPinMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+	)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.PinMessageMock.Expect(ctx, "a", int64(9)).Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "limit reached",
+			ctx:      ctx,
+			wantCode: codes.FailedPrecondition,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.PinMessageMock.Expect(ctx, "a", int64(9)).Return(fmt.Errorf("failed to pin message: %w", service.ErrPinLimit))
+				return m
+			},
+		},
+		{
+			name:     "not an admin",
+			ctx:      ctx,
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.PinMessageMock.Expect(ctx, "a", int64(9)).Return(service.ErrForbidden)
+				return m
+			},
+		},
+		{
+			name:     "message not found",
+			ctx:      ctx,
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.PinMessageMock.Expect(ctx, "a", int64(9)).Return(service.ErrMessageNotFound)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			_, err := h.PinMessage(tt.ctx, &desc.PinMessageRequest{MessageId: 9})
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestUnpinMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UnpinMessageMock.Expect(ctx, "a", int64(9)).Return(nil)
+
+		_, err := api.NewChatV1Handler(svc).UnpinMessage(ctx, &desc.UnpinMessageRequest{MessageId: 9})
+		require.NoError(t, err)
+	})
+
+	t.Run("not an admin", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.UnpinMessageMock.Expect(ctx, "a", int64(9)).Return(service.ErrForbidden)
+
+		_, err := api.NewChatV1Handler(svc).UnpinMessage(ctx, &desc.UnpinMessageRequest{MessageId: 9})
+		require.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestListPinned(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx  = interceptor.ContextWithUsername(context.Background(), "a")
+		mc   = minimock.NewController(t)
+		ts   = time.Unix(0, 0).UTC()
+		req  = &desc.ListPinnedRequest{ChatId: 7}
+		pins = []*model.PinnedMessage{{
+			Message:  &model.Message{ID: 9, ChatID: 7, From: "b", Text: "rules", Timestamp: ts},
+			PinnedBy: "a",
+			PinnedAt: ts,
+		}}
+		res = &desc.ListPinnedResponse{Pins: []*desc.PinnedMessage{{
+			Message:  &desc.Message{Id: 9, ChatId: 7, From: "b", Text: "rules", Timestamp: timestamppb.New(ts)},
+			PinnedBy: "a",
+			PinnedAt: timestamppb.New(ts),
+		}}}
+	)
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		want     *desc.ListPinnedResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			ctx:  ctx,
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListPinnedMock.Expect(ctx, "a", int64(7)).Return(pins, nil)
+				return m
+			},
+		},
+		{
+			name: "nothing pinned",
+			ctx:  ctx,
+			want: &desc.ListPinnedResponse{Pins: []*desc.PinnedMessage{}},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListPinnedMock.Expect(ctx, "a", int64(7)).Return(nil, nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			ctx:      ctx,
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ListPinnedMock.Expect(ctx, "a", int64(7)).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ListPinned(tt.ctx, req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestGetPresence(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.GetPresenceRequest
+	}
+	var (
+		ctx       = interceptor.ContextWithUsername(context.Background(), "a")
+		mc        = minimock.NewController(t)
+		seen      = time.Unix(60, 0).UTC()
+		usernames = []string{"b", "c"}
+		req       = &desc.GetPresenceRequest{Usernames: usernames}
+		presences = []*model.Presence{
+			{Username: "b", Status: model.PresenceAway, LastSeen: seen},
+			{Username: "c", Status: model.PresenceOffline},
+		}
+		res = &desc.GetPresenceResponse{Presences: []*desc.Presence{
+			{Username: "b", Status: desc.PresenceStatus_PRESENCE_STATUS_AWAY, LastSeenAt: timestamppb.New(seen)},
+			{Username: "c", Status: desc.PresenceStatus_PRESENCE_STATUS_OFFLINE},
+		}}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.GetPresenceResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.GetPresenceMock.Expect(ctx, "a", usernames).Return(presences, nil)
+				return m
+			},
+		},
+		{
+			name:     "error",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.InvalidArgument,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.GetPresenceMock.Expect(ctx, "a", usernames).Return(nil, fmt.Errorf("%w: too many usernames (max 100)", service.ErrInvalidArgument))
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.GetPresence(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.HeartbeatMock.Expect(ctx, "a").Return(nil)
+
+		_, err := api.NewChatV1Handler(svc).Heartbeat(ctx, &emptypb.Empty{})
+		require.NoError(t, err)
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).Heartbeat(context.Background(), &emptypb.Empty{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
@@ -0,0 +1,142 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestAddReaction(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.AddReactionRequest
+	}
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		req = &desc.AddReactionRequest{MessageId: 5, Emoji: "👍"}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.AddReactionMock.Expect(ctx, "a", int64(5), "👍").Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "message not found",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.AddReactionMock.Expect(ctx, "a", int64(5), "👍").Return(fmt.Errorf("%w: 5", service.ErrMessageNotFound))
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.AddReactionMock.Expect(ctx, "a", int64(5), "👍").Return(service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			_, err := h.AddReaction(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestRemoveReaction(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.RemoveReactionRequest
+	}
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		req = &desc.RemoveReactionRequest{MessageId: 5, Emoji: "👍"}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.RemoveReactionMock.Expect(ctx, "a", int64(5), "👍").Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "message not found",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.RemoveReactionMock.Expect(ctx, "a", int64(5), "👍").Return(service.ErrMessageNotFound)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			_, err := h.RemoveReaction(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestMarkRead(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.MarkReadRequest
+	}
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		req = &desc.MarkReadRequest{ChatId: 7, MessageId: 42}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.MarkReadMock.Expect(ctx, "a", int64(7), int64(42)).Return(nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.MarkReadMock.Expect(ctx, "a", int64(7), int64(42)).Return(service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			_, err := h.MarkRead(tt.args.ctx, tt.args.req)
+			require.Equal(t, tt.wantCode, status.Code(err))
+		})
+	}
+}
+
+func TestGetReadState(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.GetReadStateRequest
+	}
+	var (
+		ctx     = interceptor.ContextWithUsername(context.Background(), "a")
+		mc      = minimock.NewController(t)
+		req     = &desc.GetReadStateRequest{ChatId: 7}
+		cursors = []*model.ReadCursor{
+			{ChatID: 7, Username: "a", MessageID: 42},
+			{ChatID: 7, Username: "b", MessageID: 0},
+		}
+		res = &desc.GetReadStateResponse{Cursors: []*desc.ReadCursor{
+			{Username: "a", LastReadMessageId: 42},
+			{Username: "b"},
+		}}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.GetReadStateResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.GetReadStateMock.Expect(ctx, "a", int64(7)).Return(cursors, nil)
+				return m
+			},
+		},
+		{
+			name:     "chat not found",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.NotFound,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.GetReadStateMock.Expect(ctx, "a", int64(7)).Return(nil, service.ErrChatNotFound)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.GetReadState(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package tests
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestScheduleMessage(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.ScheduleMessageRequest
+	}
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		sendAt = time.Unix(3600, 0).UTC()
+		ts     = time.Unix(0, 0).UTC()
+		req    = &desc.ScheduleMessageRequest{ChatId: 7, Text: "later", SendAt: timestamppb.New(sendAt)}
+		msg    = &model.ScheduledMessage{ChatID: 7, From: "a", Text: "later", SendAt: sendAt}
+		stored = &model.ScheduledMessage{ID: 4, ChatID: 7, From: "a", Text: "later", SendAt: sendAt, CreatedAt: ts}
+		res    = &desc.ScheduledMessage{
+			Id: 4, ChatId: 7, From: "a", Text: "later", SendAt: timestamppb.New(sendAt), CreatedAt: timestamppb.New(ts),
+		}
+		svcErr = fmt.Errorf("send time must be in the future")
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.ScheduledMessage
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ScheduleMessageMock.Expect(ctx, msg).Return(stored, nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ScheduleMessageMock.Expect(ctx, msg).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "error",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.Unknown,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.ScheduleMessageMock.Expect(ctx, msg).Return(nil, svcErr)
+				return m
+			},
+		},
+		{
+			name:     "missing send time",
+			args:     args{ctx: ctx, req: &desc.ScheduleMessageRequest{ChatId: 7, Text: "later"}},
+			wantCode: codes.InvalidArgument,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.ScheduleMessage(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestListScheduledMessages(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		sendAt = time.Unix(3600, 0).UTC()
+		ts     = time.Unix(0, 0).UTC()
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ListScheduledMessagesMock.Expect(ctx, "a", int64(0)).Return([]*model.ScheduledMessage{
+			{ID: 4, ChatID: 7, From: "a", Text: "later", SendAt: sendAt, CreatedAt: ts},
+		}, nil)
+
+		got, err := api.NewChatV1Handler(svc).ListScheduledMessages(ctx, &desc.ListScheduledMessagesRequest{})
+		require.NoError(t, err)
+		require.Equal(t, &desc.ListScheduledMessagesResponse{Messages: []*desc.ScheduledMessage{{
+			Id: 4, ChatId: 7, From: "a", Text: "later", SendAt: timestamppb.New(sendAt), CreatedAt: timestamppb.New(ts),
+		}}}, got)
+	})
+
+	t.Run("nothing pending", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.ListScheduledMessagesMock.Expect(ctx, "a", int64(7)).Return(nil, nil)
+
+		got, err := api.NewChatV1Handler(svc).ListScheduledMessages(ctx, &desc.ListScheduledMessagesRequest{ChatId: 7})
+		require.NoError(t, err)
+		require.Empty(t, got.GetMessages())
+	})
+
+	t.Run("unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := api.NewChatV1Handler(serviceMocks.NewChatServiceMock(mc)).ListScheduledMessages(context.Background(), &desc.ListScheduledMessagesRequest{})
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestCancelScheduledMessage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		req = &desc.CancelScheduledMessageRequest{Id: 4}
+	)
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.CancelScheduledMessageMock.Expect(ctx, "a", int64(4)).Return(nil)
+
+		_, err := api.NewChatV1Handler(svc).CancelScheduledMessage(ctx, req)
+		require.NoError(t, err)
+	})
+
+	t.Run("already sent", func(t *testing.T) {
+		t.Parallel()
+
+		svc := serviceMocks.NewChatServiceMock(mc)
+		svc.CancelScheduledMessageMock.Expect(ctx, "a", int64(4)).Return(fmt.Errorf("%w: 4", service.ErrScheduledMessageNotFound))
+
+		_, err := api.NewChatV1Handler(svc).CancelScheduledMessage(ctx, req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,102 @@
+package tests
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func TestSearchMessages(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.SearchMessagesRequest
+	}
+	var (
+		ctx    = interceptor.ContextWithUsername(context.Background(), "a")
+		mc     = minimock.NewController(t)
+		ts     = time.Unix(0, 0).UTC()
+		before = time.Unix(3600, 0).UTC()
+		req    = &desc.SearchMessagesRequest{Query: "release date", ChatId: 7, Before: timestamppb.New(before), Limit: 1}
+		query  = &model.MessageSearchQuery{Username: "a", Query: "release date", ChatID: 7, Before: before, Limit: 1}
+		page   = &model.SearchPage{
+			Results: []*model.SearchResult{{
+				Message: &model.Message{ID: 12, ChatID: 7, From: "b", Text: "the release date is <friday>", Timestamp: ts},
+				Snippet: "the <b>release</b> <b>date</b> is &lt;friday&gt;",
+			}},
+			NextCursor: 12,
+		}
+		res = &desc.SearchMessagesResponse{
+			Results: []*desc.SearchResult{{
+				Message: &desc.Message{Id: 12, ChatId: 7, From: "b", Text: "the release date is <friday>", Timestamp: timestamppb.New(ts)},
+				Snippet: "the <b>release</b> <b>date</b> is &lt;friday&gt;",
+			}},
+			NextCursor: 12,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.SearchMessagesResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: res,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SearchMessagesMock.Expect(ctx, query).Return(page, nil)
+				return m
+			},
+		},
+		{
+			name:     "not a member of the chat",
+			args:     args{ctx: ctx, req: req},
+			wantCode: codes.PermissionDenied,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SearchMessagesMock.Expect(ctx, query).Return(nil, service.ErrNotChatMember)
+				return m
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.SearchMessages(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	serviceMocks "chat/chat_server/internal/service/mocks"
+	desc "chat/chat_server/pkg/chat_v1"
+)
+
+func syncToken(raw string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func TestSync(t *testing.T) {
+	t.Parallel()
+	type args struct {
+		ctx context.Context
+		req *desc.SyncRequest
+	}
+	var (
+		ctx = interceptor.ContextWithUsername(context.Background(), "a")
+		mc  = minimock.NewController(t)
+		ts  = time.Unix(0, 0).UTC()
+		req = &desc.SyncRequest{SinceToken: syncToken("100.0.0"), Limit: 2}
+		res = &model.SyncResult{
+			Messages: []*model.Message{{ID: 11, ChatID: 7, Seq: 3, From: "b", Text: "hi", Timestamp: ts}},
+			Deleted:  []*model.Change{{ChatID: 7, Kind: model.ChangeMessageDeleted, MessageID: 10, Seq: 4, Username: "b"}},
+			MemberChanges: []*model.Change{
+				{ChatID: 7, Kind: model.ChangeMemberAdded, Username: "c", Role: model.RoleMember},
+				{ChatID: 8, Kind: model.ChangeChatDeleted, Username: "a"},
+			},
+			ReadCursors: []*model.ReadCursor{{ChatID: 7, Username: "b", MessageID: 11}},
+			Next:        &model.SyncToken{From: 100, To: 140, After: 55},
+			HasMore:     true,
+		}
+		want = &desc.SyncResponse{
+			Messages:        []*desc.Message{{Id: 11, ChatId: 7, Seq: 3, From: "b", Text: "hi", Timestamp: timestamppb.New(ts)}},
+			DeletedMessages: []*desc.MessageDeletedEvent{{ChatId: 7, MessageId: 10, Seq: 4, DeletedBy: "b"}},
+			MembershipChanges: []*desc.MembershipChange{
+				{ChatId: 7, Username: "c", Kind: desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_ADDED, Role: desc.Role_ROLE_MEMBER},
+				{ChatId: 8, Username: "a", Kind: desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_CHAT_DELETED},
+			},
+			ReadStates: []*desc.ReadEvent{{ChatId: 7, Username: "b", MessageId: 11}},
+			NextToken:  syncToken("100.140.55"),
+			HasMore:    true,
+		}
+	)
+
+	tests := []struct {
+		name     string
+		args     args
+		want     *desc.SyncResponse
+		wantCode codes.Code
+		mockFn   func(mc *minimock.Controller) service.ChatService
+	}{
+		{
+			name: "success",
+			args: args{ctx: ctx, req: req},
+			want: want,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SyncMock.Expect(ctx, "a", &model.SyncToken{From: 100}, 2).Return(res, nil)
+				return m
+			},
+		},
+		{
+			name: "first sync",
+			args: args{ctx: ctx, req: &desc.SyncRequest{}},
+			want: &desc.SyncResponse{Messages: []*desc.Message{}, NextToken: syncToken("140.0.0")},
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				m := serviceMocks.NewChatServiceMock(mc)
+				m.SyncMock.Expect(ctx, "a", nil, 0).Return(&model.SyncResult{Next: &model.SyncToken{From: 140}}, nil)
+				return m
+			},
+		},
+		{
+			name:     "invalid token",
+			args:     args{ctx: ctx, req: &desc.SyncRequest{SinceToken: syncToken("100.x")}},
+			wantCode: codes.InvalidArgument,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+		{
+			name:     "unauthenticated",
+			args:     args{ctx: context.Background(), req: req},
+			wantCode: codes.Unauthenticated,
+			mockFn: func(mc *minimock.Controller) service.ChatService {
+				return serviceMocks.NewChatServiceMock(mc)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			h := api.NewChatV1Handler(tt.mockFn(mc))
+			got, err := h.Sync(tt.args.ctx, tt.args.req)
+			if tt.wantCode != codes.OK {
+				require.Equal(t, tt.wantCode, status.Code(err))
+				require.Nil(t, got)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -10,11 +10,22 @@ import (
 
 	"chat/auth/pkg/access_v1"
 	"chat/chat_server/internal/api/chat_v1"
+	"chat/chat_server/internal/blob"
+	localBlob "chat/chat_server/internal/blob/local"
 	"chat/chat_server/internal/config"
 	"chat/chat_server/internal/database"
+	"chat/chat_server/internal/hub"
 	"chat/chat_server/internal/interceptor"
+	"chat/chat_server/internal/presence"
+	"chat/chat_server/internal/readstate"
+	"chat/chat_server/internal/reaper"
 	"chat/chat_server/internal/repository"
+	attachmentRepository "chat/chat_server/internal/repository/attachment"
+	changeRepository "chat/chat_server/internal/repository/change"
 	chatRepository "chat/chat_server/internal/repository/chat"
+	messageRepository "chat/chat_server/internal/repository/message"
+	scheduledRepository "chat/chat_server/internal/repository/scheduled"
+	"chat/chat_server/internal/scheduler"
 	"chat/chat_server/internal/service"
 	chatService "chat/chat_server/internal/service/chat"
 	"common/database/client"
@@ -32,9 +43,39 @@ type ServiceProvider struct {
 	chatRepositoryOnce sync.Once
 	chatRepository     repository.ChatRepository
 
+	messageRepositoryOnce sync.Once
+	messageRepository     repository.MessageRepository
+
+	attachmentRepositoryOnce sync.Once
+	attachmentRepository     repository.AttachmentRepository
+
+	changeRepositoryOnce sync.Once
+	changeRepository     repository.ChangeRepository
+
+	scheduledRepositoryOnce sync.Once
+	scheduledRepository     repository.ScheduledRepository
+
+	blobStoreOnce sync.Once
+	blobStore     blob.Store
+
+	hubOnce sync.Once
+	hub     *hub.Hub
+
+	readBufferOnce sync.Once
+	readBuffer     *readstate.Buffer
+
+	presenceTrackerOnce sync.Once
+	presenceTracker     *presence.Tracker
+
 	chatServiceOnce sync.Once
 	chatService     service.ChatService
 
+	schedulerOnce sync.Once
+	scheduler     *scheduler.Scheduler
+
+	reaperOnce sync.Once
+	reaper     *reaper.Reaper
+
 	chatHandlerOnce sync.Once
 	chatHandler     *chat_v1.ChatV1Handler
 
@@ -75,13 +116,103 @@ func (s *ServiceProvider) GetChatRepository(ctx context.Context) repository.Chat
 	return s.chatRepository
 }
 
+func (s *ServiceProvider) GetMessageRepository(ctx context.Context) repository.MessageRepository {
+	s.messageRepositoryOnce.Do(func() {
+		s.messageRepository = messageRepository.NewMessageRepository(s.GetDbClient(ctx))
+	})
+	return s.messageRepository
+}
+
+func (s *ServiceProvider) GetAttachmentRepository(ctx context.Context) repository.AttachmentRepository {
+	s.attachmentRepositoryOnce.Do(func() {
+		s.attachmentRepository = attachmentRepository.NewAttachmentRepository(s.GetDbClient(ctx))
+	})
+	return s.attachmentRepository
+}
+
+func (s *ServiceProvider) GetChangeRepository(ctx context.Context) repository.ChangeRepository {
+	s.changeRepositoryOnce.Do(func() {
+		s.changeRepository = changeRepository.NewChangeRepository(s.GetDbClient(ctx))
+	})
+	return s.changeRepository
+}
+
+func (s *ServiceProvider) GetScheduledRepository(ctx context.Context) repository.ScheduledRepository {
+	s.scheduledRepositoryOnce.Do(func() {
+		s.scheduledRepository = scheduledRepository.NewScheduledRepository(s.GetDbClient(ctx))
+	})
+	return s.scheduledRepository
+}
+
+func (s *ServiceProvider) GetBlobStore() blob.Store {
+	s.blobStoreOnce.Do(func() {
+		store, err := localBlob.New(config.NewAttachmentConfig().Dir)
+		if err != nil {
+			log.Fatalf("failed to create blob store: %v", err)
+		}
+		s.blobStore = store
+	})
+	return s.blobStore
+}
+
+func (s *ServiceProvider) GetHub() *hub.Hub {
+	s.hubOnce.Do(func() {
+		s.hub = hub.New(config.NewHubConfig().SubscriberBuffer)
+	})
+	return s.hub
+}
+
+func (s *ServiceProvider) GetReadBuffer(ctx context.Context) *readstate.Buffer {
+	s.readBufferOnce.Do(func() {
+		s.readBuffer = readstate.New(s.GetChatRepository(ctx), config.NewReadStateConfig().FlushInterval)
+	})
+	return s.readBuffer
+}
+
+func (s *ServiceProvider) GetPresenceTracker() *presence.Tracker {
+	s.presenceTrackerOnce.Do(func() {
+		cfg := config.NewPresenceConfig()
+		s.presenceTracker = presence.New(cfg.AwayTimeout, cfg.OfflineTimeout)
+	})
+	return s.presenceTracker
+}
+
 func (s *ServiceProvider) GetChatService(ctx context.Context) service.ChatService {
 	s.chatServiceOnce.Do(func() {
-		s.chatService = chatService.NewChatService(s.GetChatRepository(ctx))
+		s.chatService = chatService.NewChatService(
+			s.GetChatRepository(ctx),
+			s.GetMessageRepository(ctx),
+			s.GetAttachmentRepository(ctx),
+			s.GetChangeRepository(ctx),
+			s.GetScheduledRepository(ctx),
+			s.GetTxManager(ctx),
+			s.GetHub(),
+			s.GetReadBuffer(ctx),
+			config.NewTypingConfig(),
+			s.GetPresenceTracker(),
+			s.GetBlobStore(),
+			config.NewAttachmentConfig(),
+			config.NewPinConfig(),
+		)
 	})
 	return s.chatService
 }
 
+func (s *ServiceProvider) GetScheduler(ctx context.Context) *scheduler.Scheduler {
+	s.schedulerOnce.Do(func() {
+		s.scheduler = scheduler.New(s.GetChatService(ctx), config.NewSchedulerConfig().PollInterval)
+	})
+	return s.scheduler
+}
+
+func (s *ServiceProvider) GetReaper(ctx context.Context) *reaper.Reaper {
+	s.reaperOnce.Do(func() {
+		cfg := config.NewReaperConfig()
+		s.reaper = reaper.New(s.GetChatService(ctx), cfg.Interval, cfg.BatchSize)
+	})
+	return s.reaper
+}
+
 func (s *ServiceProvider) GetChatHandler(ctx context.Context) *chat_v1.ChatV1Handler {
 	s.chatHandlerOnce.Do(func() {
 		s.chatHandler = chat_v1.NewChatV1Handler(s.GetChatService(ctx))
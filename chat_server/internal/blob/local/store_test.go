@@ -0,0 +1,88 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"chat/chat_server/internal/blob"
+)
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errors.New("connection reset")
+}
+
+func TestPutGetDelete(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	require.NoError(t, store.Put(ctx, "chats/1/abc", strings.NewReader("hello")))
+
+	r, err := store.Get(ctx, "chats/1/abc")
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "hello", string(data))
+
+	// Putting the same key again replaces the blob.
+	require.NoError(t, store.Put(ctx, "chats/1/abc", strings.NewReader("bye")))
+	r, err = store.Get(ctx, "chats/1/abc")
+	require.NoError(t, err)
+	data, err = io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	require.Equal(t, "bye", string(data))
+
+	require.NoError(t, store.Delete(ctx, "chats/1/abc"))
+	_, err = store.Get(ctx, "chats/1/abc")
+	require.ErrorIs(t, err, blob.ErrNotFound)
+
+	// Deleting a missing blob is not an error.
+	require.NoError(t, store.Delete(ctx, "chats/1/abc"))
+}
+
+func TestFailedPutLeavesNothingBehind(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := New(root)
+	require.NoError(t, err)
+
+	require.Error(t, store.Put(ctx, "chats/1/abc", failingReader{}))
+
+	_, err = store.Get(ctx, "chats/1/abc")
+	require.ErrorIs(t, err, blob.ErrNotFound)
+
+	entries, err := os.ReadDir(filepath.Join(root, "chats", "1"))
+	require.NoError(t, err)
+	require.Empty(t, entries)
+}
+
+func TestInvalidKeys(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	store, err := New(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"", "/etc/passwd", "../secret", "chats/../../secret", "Chats/1", ".hidden", "chats/1/a b"} {
+		require.Error(t, store.Put(ctx, key, strings.NewReader("x")), key)
+		_, err := store.Get(ctx, key)
+		require.Error(t, err, key)
+		require.NotErrorIs(t, err, blob.ErrNotFound, key)
+		require.Error(t, store.Delete(ctx, key), key)
+	}
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultAttachmentDir     = "data/attachments"
+	defaultAttachmentMaxSize = 25 << 20
+	defaultUploadTTL         = 24 * time.Hour
+
+	defaultThumbnailConcurrency = 2
+)
+
+type AttachmentConfig struct {
+	// Dir is the root directory of the local blob store.
+	Dir string
+	// MaxSize is the largest attachment accepted, in bytes.
+	MaxSize int64
+	// UploadTTL is how long an uploaded attachment may stay unsent before it
+	// is deleted.
+	UploadTTL time.Duration
+	// ThumbnailConcurrency is the number of images decoded for thumbnails at
+	// the same time.
+	ThumbnailConcurrency int
+}
+
+func NewAttachmentConfig() *AttachmentConfig {
+	cfg := &AttachmentConfig{
+		Dir:                  defaultAttachmentDir,
+		MaxSize:              defaultAttachmentMaxSize,
+		UploadTTL:            durationFromEnv("ATTACHMENT_UPLOAD_TTL", defaultUploadTTL),
+		ThumbnailConcurrency: defaultThumbnailConcurrency,
+	}
+
+	if v := os.Getenv("ATTACHMENT_DIR"); v != "" {
+		cfg.Dir = v
+	}
+
+	if v := os.Getenv("ATTACHMENT_MAX_SIZE"); v != "" {
+		n, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || n <= 0 {
+			log.Fatalf("ATTACHMENT_MAX_SIZE must be a positive number of bytes, got %q", v)
+		}
+		cfg.MaxSize = n
+	}
+
+	if v := os.Getenv("THUMBNAIL_CONCURRENCY"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("THUMBNAIL_CONCURRENCY must be a positive number, got %q", v)
+		}
+		cfg.ThumbnailConcurrency = n
+	}
+
+	return cfg
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+)
+
+const defaultSubscriberBuffer = 64
+
+type HubConfig struct {
+	SubscriberBuffer int
+}
+
+func NewHubConfig() *HubConfig {
+	buffer := defaultSubscriberBuffer
+	if v := os.Getenv("HUB_SUBSCRIBER_BUFFER"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("HUB_SUBSCRIBER_BUFFER must be a positive integer, got %q", v)
+		}
+		buffer = n
+	}
+
+	return &HubConfig{
+		SubscriberBuffer: buffer,
+	}
+}
@@ -0,0 +1,28 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+)
+
+const defaultPinLimit = 50
+
+type PinConfig struct {
+	// Limit is the number of messages a chat may have pinned at once.
+	Limit int
+}
+
+func NewPinConfig() *PinConfig {
+	cfg := &PinConfig{Limit: defaultPinLimit}
+
+	if v := os.Getenv("PIN_LIMIT"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("PIN_LIMIT must be a positive number, got %q", v)
+		}
+		cfg.Limit = n
+	}
+
+	return cfg
+}
@@ -0,0 +1,35 @@
+package config
+
+import (
+	"log"
+	"time"
+)
+
+const (
+	defaultPresenceAwayTimeout    = 5 * time.Minute
+	defaultPresenceOfflineTimeout = 10 * time.Minute
+	defaultPresenceSweepInterval  = 10 * time.Second
+)
+
+type PresenceConfig struct {
+	// AwayTimeout is how long a user may be idle before being shown as away.
+	AwayTimeout time.Duration
+	// OfflineTimeout is how long a user without open streams may be idle
+	// before being shown as offline.
+	OfflineTimeout time.Duration
+	SweepInterval  time.Duration
+}
+
+func NewPresenceConfig() *PresenceConfig {
+	cfg := &PresenceConfig{
+		AwayTimeout:    durationFromEnv("PRESENCE_AWAY_TIMEOUT", defaultPresenceAwayTimeout),
+		OfflineTimeout: durationFromEnv("PRESENCE_OFFLINE_TIMEOUT", defaultPresenceOfflineTimeout),
+		SweepInterval:  durationFromEnv("PRESENCE_SWEEP_INTERVAL", defaultPresenceSweepInterval),
+	}
+
+	if cfg.OfflineTimeout < cfg.AwayTimeout {
+		log.Fatalf("PRESENCE_OFFLINE_TIMEOUT must not be shorter than PRESENCE_AWAY_TIMEOUT")
+	}
+
+	return cfg
+}
@@ -0,0 +1,17 @@
+package config
+
+import (
+	"time"
+)
+
+const defaultReadStateFlushInterval = time.Second
+
+type ReadStateConfig struct {
+	FlushInterval time.Duration
+}
+
+func NewReadStateConfig() *ReadStateConfig {
+	return &ReadStateConfig{
+		FlushInterval: durationFromEnv("READ_STATE_FLUSH_INTERVAL", defaultReadStateFlushInterval),
+	}
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultReaperInterval  = 10 * time.Second
+	defaultReaperBatchSize = 500
+)
+
+type ReaperConfig struct {
+	// Interval is how often the reaper looks for expired messages. Messages
+	// may outlive their TTL by up to this long.
+	Interval time.Duration
+	// BatchSize is the number of expired messages deleted per transaction.
+	BatchSize int
+}
+
+func NewReaperConfig() *ReaperConfig {
+	cfg := &ReaperConfig{
+		Interval:  durationFromEnv("REAPER_INTERVAL", defaultReaperInterval),
+		BatchSize: defaultReaperBatchSize,
+	}
+
+	if v := os.Getenv("REAPER_BATCH_SIZE"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n <= 0 {
+			log.Fatalf("REAPER_BATCH_SIZE must be a positive number, got %q", v)
+		}
+		cfg.BatchSize = n
+	}
+
+	return cfg
+}
@@ -0,0 +1,16 @@
+package config
+
+import "time"
+
+const defaultSchedulerPollInterval = time.Second
+
+type SchedulerConfig struct {
+	// PollInterval is how often the scheduler looks for due messages.
+	PollInterval time.Duration
+}
+
+func NewSchedulerConfig() *SchedulerConfig {
+	return &SchedulerConfig{
+		PollInterval: durationFromEnv("SCHEDULER_POLL_INTERVAL", defaultSchedulerPollInterval),
+	}
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	defaultTypingTTL      = 5 * time.Second
+	defaultTypingInterval = time.Second
+)
+
+type TypingConfig struct {
+	// TTL is how long clients show a typing indicator without a refresh.
+	TTL time.Duration
+	// Interval is the minimum time between typing notifications of one user.
+	Interval time.Duration
+}
+
+func NewTypingConfig() *TypingConfig {
+	return &TypingConfig{
+		TTL:      durationFromEnv("TYPING_TTL", defaultTypingTTL),
+		Interval: durationFromEnv("TYPING_RATE_INTERVAL", defaultTypingInterval),
+	}
+}
+
+func durationFromEnv(name string, def time.Duration) time.Duration {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		log.Fatalf("%s must be a positive duration, got %q", name, v)
+	}
+	return d
+}
@@ -1,20 +1,618 @@
 package converter
 
 import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"chat/chat_server/internal/model"
 	desc "chat/chat_server/pkg/chat_v1"
 )
 
-func ToChatCreateFromDesc(req *desc.CreateRequest) *model.ChatCreate {
+func ToChatCreateFromDesc(req *desc.CreateRequest, owner string) *model.ChatCreate {
 	return &model.ChatCreate{
+		Owner:     owner,
 		Usernames: req.GetUsernames(),
+		Info: &model.ChatInfo{
+			Title:       req.GetTitle(),
+			Description: req.GetDescription(),
+			AvatarURL:   req.GetAvatarUrl(),
+			Type:        ToChatTypeFromDesc(req.GetType()),
+		},
+		ClientRequestID: req.GetClientRequestId(),
+	}
+}
+
+func ToChatTypeFromDesc(chatType desc.ChatType) model.ChatType {
+	switch chatType {
+	case desc.ChatType_CHAT_TYPE_DIRECT:
+		return model.ChatTypeDirect
+	case desc.ChatType_CHAT_TYPE_CHANNEL:
+		return model.ChatTypeChannel
+	default:
+		return model.ChatTypeGroup
+	}
+}
+
+func ToChatTypeFromModel(chatType model.ChatType) desc.ChatType {
+	switch chatType {
+	case model.ChatTypeDirect:
+		return desc.ChatType_CHAT_TYPE_DIRECT
+	case model.ChatTypeChannel:
+		return desc.ChatType_CHAT_TYPE_CHANNEL
+	default:
+		return desc.ChatType_CHAT_TYPE_GROUP
+	}
+}
+
+func ToRoleFromModel(role model.Role) desc.Role {
+	switch role {
+	case model.RoleOwner:
+		return desc.Role_ROLE_OWNER
+	case model.RoleAdmin:
+		return desc.Role_ROLE_ADMIN
+	default:
+		return desc.Role_ROLE_MEMBER
+	}
+}
+
+func ToChatDetailsFromModel(chat *model.Chat) *desc.ChatDetails {
+	members := make([]*desc.ChatMember, 0, len(chat.Members))
+	for _, m := range chat.Members {
+		members = append(members, &desc.ChatMember{
+			Username: m.Username,
+			Role:     ToRoleFromModel(m.Role),
+		})
+	}
+
+	return &desc.ChatDetails{
+		Id:          chat.ID,
+		Title:       chat.Info.Title,
+		Description: chat.Info.Description,
+		AvatarUrl:   chat.Info.AvatarURL,
+		Type:        ToChatTypeFromModel(chat.Info.Type),
+		Members:     members,
+		CreatedAt:   timestamppb.New(chat.CreatedAt),
+		UpdatedAt:   timestamppb.New(chat.UpdatedAt),
+
+		MessageTtlSeconds: int64(chat.MessageTTL / time.Second),
+	}
+}
+
+// ToChatUpdateFromDesc applies the update mask of the request. An empty mask
+// updates every mutable field except the message TTL.
+func ToChatUpdateFromDesc(req *desc.UpdateChatRequest) (*model.ChatUpdate, error) {
+	update := &model.ChatUpdate{ID: req.GetChatId()}
+
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		paths = []string{"title", "description", "avatar_url"}
+	}
+
+	for _, path := range paths {
+		switch path {
+		case "title":
+			update.Title = proto.String(req.GetTitle())
+		case "description":
+			update.Description = proto.String(req.GetDescription())
+		case "avatar_url":
+			update.AvatarURL = proto.String(req.GetAvatarUrl())
+		case "message_ttl_seconds":
+			ttl := time.Duration(req.GetMessageTtlSeconds()) * time.Second
+			update.MessageTTL = &ttl
+		default:
+			return nil, fmt.Errorf("unknown update mask path %q", path)
+		}
+	}
+
+	return update, nil
+}
+
+func ToRoleFromDesc(role desc.Role) model.Role {
+	switch role {
+	case desc.Role_ROLE_OWNER:
+		return model.RoleOwner
+	case desc.Role_ROLE_ADMIN:
+		return model.RoleAdmin
+	default:
+		return model.RoleMember
 	}
 }
 
 func ToMessageFromDesc(req *desc.SendMessageRequest) *model.Message {
 	return &model.Message{
+		ChatID:    req.GetChatId(),
 		From:      req.GetFrom(),
 		Text:      req.GetText(),
 		Timestamp: req.GetTimestamp().AsTime(),
+		ReplyTo:   req.GetReplyToMessageId(),
+		TTL:       time.Duration(req.GetTtlSeconds()) * time.Second,
+
+		AttachmentIDs:   req.GetAttachmentIds(),
+		ClientMessageID: req.GetClientMessageId(),
+	}
+}
+
+func ToScheduledMessageFromDesc(req *desc.ScheduleMessageRequest, username string) *model.ScheduledMessage {
+	return &model.ScheduledMessage{
+		ChatID: req.GetChatId(),
+		From:   username,
+		Text:   req.GetText(),
+		SendAt: req.GetSendAt().AsTime(),
+	}
+}
+
+func ToScheduledMessageFromModel(msg *model.ScheduledMessage) *desc.ScheduledMessage {
+	return &desc.ScheduledMessage{
+		Id:        msg.ID,
+		ChatId:    msg.ChatID,
+		From:      msg.From,
+		Text:      msg.Text,
+		SendAt:    timestamppb.New(msg.SendAt),
+		CreatedAt: timestamppb.New(msg.CreatedAt),
+	}
+}
+
+func ToListScheduledMessagesResponseFromModel(msgs []*model.ScheduledMessage) *desc.ListScheduledMessagesResponse {
+	res := make([]*desc.ScheduledMessage, 0, len(msgs))
+	for _, msg := range msgs {
+		res = append(res, ToScheduledMessageFromModel(msg))
+	}
+
+	return &desc.ListScheduledMessagesResponse{Messages: res}
+}
+
+func ToMessageFromModel(msg *model.Message) *desc.Message {
+	res := &desc.Message{
+		Id:               msg.ID,
+		ChatId:           msg.ChatID,
+		Seq:              msg.Seq,
+		From:             msg.From,
+		Text:             msg.Text,
+		Timestamp:        timestamppb.New(msg.Timestamp),
+		Kind:             ToMessageKindFromModel(msg.Kind),
+		Deleted:          msg.Deleted,
+		ReplyToMessageId: msg.ReplyTo,
+		ReplyCount:       msg.ReplyCount,
+	}
+	if !msg.EditedAt.IsZero() {
+		res.EditedAt = timestamppb.New(msg.EditedAt)
+	}
+	if !msg.LastReplyAt.IsZero() {
+		res.LastReplyAt = timestamppb.New(msg.LastReplyAt)
+	}
+	if !msg.ExpiresAt.IsZero() {
+		res.ExpiresAt = timestamppb.New(msg.ExpiresAt)
+	}
+	for _, attachment := range msg.Attachments {
+		res.Attachments = append(res.Attachments, ToAttachmentFromModel(attachment))
+	}
+	for _, mention := range msg.Mentions {
+		res.Mentions = append(res.Mentions, &desc.Mention{
+			Username: mention.Username,
+			Offset:   int32(mention.Offset),
+			Length:   int32(mention.Length),
+		})
+	}
+	for _, reaction := range msg.Reactions {
+		res.Reactions = append(res.Reactions, &desc.Reaction{
+			Emoji:   reaction.Emoji,
+			Count:   reaction.Count,
+			Reacted: reaction.Reacted,
+		})
+	}
+	return res
+}
+
+func ToMessageKindFromModel(kind model.MessageKind) desc.MessageKind {
+	if kind == model.MessageKindSystem {
+		return desc.MessageKind_MESSAGE_KIND_SYSTEM
+	}
+	return desc.MessageKind_MESSAGE_KIND_USER
+}
+
+func ToChatEventFromModel(event *model.ChatEvent) *desc.ChatEvent {
+	res := &desc.ChatEvent{}
+	switch {
+	case event.Message != nil:
+		res.Event = &desc.ChatEvent_Message{Message: ToMessageFromModel(event.Message)}
+	case event.Edited != nil:
+		res.Event = &desc.ChatEvent_Edited{Edited: ToMessageFromModel(event.Edited)}
+	case event.Deleted != nil:
+		res.Event = &desc.ChatEvent_Deleted{Deleted: &desc.MessageDeletedEvent{
+			ChatId:    event.ChatID,
+			MessageId: event.Deleted.MessageID,
+			Seq:       event.Deleted.Seq,
+			DeletedBy: event.Deleted.DeletedBy,
+		}}
+	case event.Reaction != nil:
+		res.Event = &desc.ChatEvent_Reaction{Reaction: &desc.ReactionEvent{
+			ChatId:    event.ChatID,
+			MessageId: event.Reaction.MessageID,
+			Username:  event.Reaction.Username,
+			Emoji:     event.Reaction.Emoji,
+			Removed:   event.Reaction.Removed,
+		}}
+	case event.Read != nil:
+		res.Event = &desc.ChatEvent_Read{Read: &desc.ReadEvent{
+			ChatId:    event.ChatID,
+			Username:  event.Read.Username,
+			MessageId: event.Read.MessageID,
+		}}
+	case event.Presence != nil:
+		res.Event = &desc.ChatEvent_Presence{Presence: ToPresenceFromModel(event.Presence)}
+	case event.Typing != nil:
+		res.Event = &desc.ChatEvent_Typing{Typing: &desc.TypingEvent{
+			ChatId:    event.ChatID,
+			Username:  event.Typing.Username,
+			ExpiresAt: timestamppb.New(event.Typing.ExpiresAt),
+		}}
+	case event.Delivery != nil:
+		res.Event = &desc.ChatEvent_Delivery{Delivery: &desc.DeliveryEvent{
+			ChatId:    event.ChatID,
+			MessageId: event.Delivery.MessageID,
+			Username:  event.Delivery.Username,
+		}}
+	}
+	return res
+}
+
+func ToMessageListQueryFromDesc(req *desc.ListMessagesRequest) *model.MessageListQuery {
+	direction := model.DirectionBackward
+	if req.GetDirection() == desc.Direction_DIRECTION_FORWARD {
+		direction = model.DirectionForward
+	}
+
+	return &model.MessageListQuery{
+		ChatID:    req.GetChatId(),
+		Cursor:    req.GetCursor(),
+		Limit:     int(req.GetLimit()),
+		Direction: direction,
+	}
+}
+
+func ToListMessagesResponseFromModel(page *model.MessagePage) *desc.ListMessagesResponse {
+	messages := make([]*desc.Message, 0, len(page.Messages))
+	for _, msg := range page.Messages {
+		messages = append(messages, ToMessageFromModel(msg))
+	}
+
+	return &desc.ListMessagesResponse{
+		Messages:   messages,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToMentionQueryFromDesc(req *desc.ListMentionsRequest, username string) *model.MentionQuery {
+	return &model.MentionQuery{
+		Username: username,
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	}
+}
+
+func ToListMentionsResponseFromModel(page *model.MessagePage) *desc.ListMentionsResponse {
+	messages := make([]*desc.Message, 0, len(page.Messages))
+	for _, msg := range page.Messages {
+		messages = append(messages, ToMessageFromModel(msg))
+	}
+
+	return &desc.ListMentionsResponse{
+		Messages:   messages,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToListPinnedResponseFromModel(pins []*model.PinnedMessage) *desc.ListPinnedResponse {
+	res := make([]*desc.PinnedMessage, 0, len(pins))
+	for _, pin := range pins {
+		res = append(res, &desc.PinnedMessage{
+			Message:  ToMessageFromModel(pin.Message),
+			PinnedBy: pin.PinnedBy,
+			PinnedAt: timestamppb.New(pin.PinnedAt),
+		})
+	}
+
+	return &desc.ListPinnedResponse{Pins: res}
+}
+
+func ToMessageRangeQueryFromDesc(req *desc.ListMessageRangeRequest) *model.MessageRangeQuery {
+	return &model.MessageRangeQuery{
+		ChatID:  req.GetChatId(),
+		FromSeq: req.GetFromSeq(),
+		ToSeq:   req.GetToSeq(),
+		Limit:   int(req.GetLimit()),
+	}
+}
+
+func ToListMessageRangeResponseFromModel(msgs []*model.Message) *desc.ListMessageRangeResponse {
+	messages := make([]*desc.Message, 0, len(msgs))
+	for _, msg := range msgs {
+		messages = append(messages, ToMessageFromModel(msg))
+	}
+
+	return &desc.ListMessageRangeResponse{Messages: messages}
+}
+
+func ToMessageSearchQueryFromDesc(req *desc.SearchMessagesRequest, username string) *model.MessageSearchQuery {
+	query := &model.MessageSearchQuery{
+		Username: username,
+		Query:    req.GetQuery(),
+		ChatID:   req.GetChatId(),
+		From:     req.GetFrom(),
+		Cursor:   req.GetCursor(),
+		Limit:    int(req.GetLimit()),
+	}
+	if req.GetBefore() != nil {
+		query.Before = req.GetBefore().AsTime()
+	}
+	if req.GetAfter() != nil {
+		query.After = req.GetAfter().AsTime()
+	}
+	return query
+}
+
+func ToSearchMessagesResponseFromModel(page *model.SearchPage) *desc.SearchMessagesResponse {
+	results := make([]*desc.SearchResult, 0, len(page.Results))
+	for _, result := range page.Results {
+		results = append(results, &desc.SearchResult{
+			Message: ToMessageFromModel(result.Message),
+			Snippet: result.Snippet,
+		})
+	}
+
+	return &desc.SearchMessagesResponse{
+		Results:    results,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToThreadQueryFromDesc(req *desc.ListThreadRequest) *model.ThreadQuery {
+	return &model.ThreadQuery{
+		MessageID: req.GetMessageId(),
+		Cursor:    req.GetCursor(),
+		Limit:     int(req.GetLimit()),
+	}
+}
+
+func ToListThreadResponseFromModel(page *model.ThreadPage) *desc.ListThreadResponse {
+	replies := make([]*desc.Message, 0, len(page.Replies))
+	for _, msg := range page.Replies {
+		replies = append(replies, ToMessageFromModel(msg))
+	}
+
+	return &desc.ListThreadResponse{
+		Root:       ToMessageFromModel(page.Root),
+		Replies:    replies,
+		NextCursor: page.NextCursor,
+	}
+}
+
+func ToChatListQueryFromDesc(req *desc.ListChatsRequest, username string) (*model.ChatListQuery, error) {
+	query := &model.ChatListQuery{
+		Username: username,
+		Limit:    int(req.GetLimit()),
+	}
+
+	if req.GetPageToken() != "" {
+		after, err := decodeChatCursor(req.GetPageToken())
+		if err != nil {
+			return nil, err
+		}
+		query.After = after
+	}
+
+	return query, nil
+}
+
+func ToListChatsResponseFromModel(page *model.ChatPage) *desc.ListChatsResponse {
+	chats := make([]*desc.ChatSummary, 0, len(page.Chats))
+	for _, chat := range page.Chats {
+		summary := &desc.ChatSummary{
+			Id:             chat.ID,
+			LastActivityAt: timestamppb.New(chat.LastActivityAt),
+			UnreadCount:    chat.UnreadCount,
+			Title:          chat.Info.Title,
+			AvatarUrl:      chat.Info.AvatarURL,
+			Type:           ToChatTypeFromModel(chat.Info.Type),
+		}
+		if chat.LastMessage != nil {
+			summary.LastMessage = ToMessageFromModel(chat.LastMessage)
+		}
+		chats = append(chats, summary)
+	}
+
+	res := &desc.ListChatsResponse{Chats: chats}
+	if page.Next != nil {
+		res.NextPageToken = encodeChatCursor(page.Next)
+	}
+	return res
+}
+
+// ToSyncTokenFromDesc decodes a sync token. The empty token decodes to nil.
+func ToSyncTokenFromDesc(token string) (*model.SyncToken, error) {
+	if token == "" {
+		return nil, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sync token")
+	}
+
+	parts := strings.Split(string(raw), ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid sync token")
+	}
+
+	values := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		v, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sync token")
+		}
+		values = append(values, v)
+	}
+
+	return &model.SyncToken{From: values[0], To: values[1], After: values[2]}, nil
+}
+
+func ToSyncResponseFromModel(res *model.SyncResult) *desc.SyncResponse {
+	out := &desc.SyncResponse{
+		Messages:  make([]*desc.Message, 0, len(res.Messages)),
+		NextToken: encodeSyncToken(res.Next),
+		HasMore:   res.HasMore,
+	}
+	for _, msg := range res.Messages {
+		out.Messages = append(out.Messages, ToMessageFromModel(msg))
+	}
+	for _, c := range res.Deleted {
+		out.DeletedMessages = append(out.DeletedMessages, &desc.MessageDeletedEvent{
+			ChatId:    c.ChatID,
+			MessageId: c.MessageID,
+			Seq:       c.Seq,
+			DeletedBy: c.Username,
+		})
+	}
+	for _, c := range res.MemberChanges {
+		change := &desc.MembershipChange{
+			ChatId:   c.ChatID,
+			Username: c.Username,
+			Kind:     ToMembershipChangeKindFromModel(c.Kind),
+		}
+		if c.Role != "" {
+			change.Role = ToRoleFromModel(c.Role)
+		}
+		out.MembershipChanges = append(out.MembershipChanges, change)
+	}
+	for _, cursor := range res.ReadCursors {
+		out.ReadStates = append(out.ReadStates, &desc.ReadEvent{
+			ChatId:    cursor.ChatID,
+			Username:  cursor.Username,
+			MessageId: cursor.MessageID,
+		})
+	}
+	return out
+}
+
+func ToMembershipChangeKindFromModel(kind model.ChangeKind) desc.MembershipChangeKind {
+	switch kind {
+	case model.ChangeMemberAdded:
+		return desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_ADDED
+	case model.ChangeMemberRemoved:
+		return desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_REMOVED
+	case model.ChangeMemberRole:
+		return desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_ROLE_CHANGED
+	case model.ChangeChatDeleted:
+		return desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_CHAT_DELETED
+	default:
+		return desc.MembershipChangeKind_MEMBERSHIP_CHANGE_KIND_UNSPECIFIED
+	}
+}
+
+func encodeSyncToken(token *model.SyncToken) string {
+	raw := fmt.Sprintf("%d.%d.%d", token.From, token.To, token.After)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// encodeChatCursor turns a chat cursor into an opaque page token.
+func encodeChatCursor(cursor *model.ChatCursor) string {
+	raw := fmt.Sprintf("%d.%d", cursor.LastActivityAt.UnixMicro(), cursor.ChatID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeChatCursor(token string) (*model.ChatCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	micros, id, ok := strings.Cut(string(raw), ".")
+	if !ok {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	lastActivity, err := strconv.ParseInt(micros, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	chatID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	return &model.ChatCursor{
+		LastActivityAt: time.UnixMicro(lastActivity).UTC(),
+		ChatID:         chatID,
+	}, nil
+}
+
+func ToGetReadStateResponseFromModel(cursors []*model.ReadCursor) *desc.GetReadStateResponse {
+	res := &desc.GetReadStateResponse{Cursors: make([]*desc.ReadCursor, 0, len(cursors))}
+	for _, c := range cursors {
+		res.Cursors = append(res.Cursors, &desc.ReadCursor{
+			Username:          c.Username,
+			LastReadMessageId: c.MessageID,
+		})
+	}
+	return res
+}
+
+func ToPresenceFromModel(p *model.Presence) *desc.Presence {
+	res := &desc.Presence{
+		Username: p.Username,
+		Status:   ToPresenceStatusFromModel(p.Status),
+	}
+	if !p.LastSeen.IsZero() {
+		res.LastSeenAt = timestamppb.New(p.LastSeen)
+	}
+	return res
+}
+
+func ToPresenceStatusFromModel(status model.PresenceStatus) desc.PresenceStatus {
+	switch status {
+	case model.PresenceOnline:
+		return desc.PresenceStatus_PRESENCE_STATUS_ONLINE
+	case model.PresenceAway:
+		return desc.PresenceStatus_PRESENCE_STATUS_AWAY
+	default:
+		return desc.PresenceStatus_PRESENCE_STATUS_OFFLINE
+	}
+}
+
+func ToGetPresenceResponseFromModel(presences []*model.Presence) *desc.GetPresenceResponse {
+	res := &desc.GetPresenceResponse{Presences: make([]*desc.Presence, 0, len(presences))}
+	for _, p := range presences {
+		res.Presences = append(res.Presences, ToPresenceFromModel(p))
+	}
+	return res
+}
+
+func ToAttachmentFromModel(attachment *model.Attachment) *desc.Attachment {
+	res := &desc.Attachment{
+		Id:        attachment.ID,
+		ChatId:    attachment.ChatID,
+		Filename:  attachment.Filename,
+		MimeType:  attachment.MimeType,
+		Size:      attachment.Size,
+		Sha256:    attachment.SHA256,
+		CreatedAt: timestamppb.New(attachment.CreatedAt),
+		Width:     int32(attachment.Width),
+		Height:    int32(attachment.Height),
+	}
+	for _, t := range attachment.Thumbnails {
+		res.Thumbnails = append(res.Thumbnails, &desc.Thumbnail{
+			Width:    int32(t.Width),
+			Height:   int32(t.Height),
+			MimeType: t.MimeType,
+			Size:     t.Size,
+		})
 	}
+	return res
 }
@@ -0,0 +1,189 @@
+package hub
+
+import (
+	"errors"
+	"sync"
+
+	"chat/chat_server/internal/model"
+)
+
+// ErrSlowSubscriber is reported by a subscription that was dropped because
+// its buffer filled up faster than the client could read it.
+var ErrSlowSubscriber = errors.New("subscriber is too slow, events were dropped")
+
+// ErrRemovedFromChat is reported by the subscriptions of a user who has left
+// or was removed from the chat.
+var ErrRemovedFromChat = errors.New("user was removed from the chat")
+
+// ErrChatClosed is reported by the subscriptions of a deleted chat.
+var ErrChatClosed = errors.New("chat was deleted")
+
+// Hub fans out chat events to the in-process subscribers of each chat.
+// Publishing never blocks: a subscriber whose buffer is full is disconnected
+// and has to reconnect and catch up from the message history.
+//
+// Ephemeral events such as typing notifications go through a separate
+// channel. They cannot be caught up on later, so they are simply dropped for
+// a subscriber that is behind instead of disconnecting it.
+type Hub struct {
+	mu         sync.RWMutex
+	chats      map[int64]map[*Subscription]struct{}
+	bufferSize int
+}
+
+func New(bufferSize int) *Hub {
+	return &Hub{
+		chats:      make(map[int64]map[*Subscription]struct{}),
+		bufferSize: bufferSize,
+	}
+}
+
+// Subscription is a single connected client of a chat.
+type Subscription struct {
+	ChatID   int64
+	Username string
+
+	hub       *Hub
+	events    chan *model.ChatEvent
+	ephemeral chan *model.ChatEvent
+	done      chan struct{}
+	closeOnce sync.Once
+	err       error
+}
+
+// Events returns the channel the subscriber reads chat events from.
+func (s *Subscription) Events() <-chan *model.ChatEvent {
+	return s.events
+}
+
+// Ephemeral returns the channel the subscriber reads ephemeral events from.
+func (s *Subscription) Ephemeral() <-chan *model.ChatEvent {
+	return s.ephemeral
+}
+
+// Done is closed once the subscription has been removed from the hub.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns the reason the hub dropped the subscription, if any.
+func (s *Subscription) Err() error {
+	<-s.done
+	return s.err
+}
+
+// Close removes the subscription from the hub. It is safe to call more than once.
+func (s *Subscription) Close() {
+	s.hub.remove(s, nil)
+}
+
+func (h *Hub) Subscribe(chatID int64, username string) *Subscription {
+	sub := &Subscription{
+		ChatID:    chatID,
+		Username:  username,
+		hub:       h,
+		events:    make(chan *model.ChatEvent, h.bufferSize),
+		ephemeral: make(chan *model.ChatEvent, h.bufferSize),
+		done:      make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.chats[chatID]
+	if !ok {
+		subs = make(map[*Subscription]struct{})
+		h.chats[chatID] = subs
+	}
+	subs[sub] = struct{}{}
+
+	return sub
+}
+
+func (h *Hub) Publish(event *model.ChatEvent) {
+	var slow []*Subscription
+
+	h.mu.RLock()
+	for sub := range h.chats[event.ChatID] {
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range slow {
+		h.remove(sub, ErrSlowSubscriber)
+	}
+}
+
+// PublishEphemeral delivers an event that is not stored anywhere. Subscribers
+// whose ephemeral buffer is full miss the event but stay connected.
+func (h *Hub) PublishEphemeral(event *model.ChatEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for sub := range h.chats[event.ChatID] {
+		select {
+		case sub.ephemeral <- event:
+		default:
+		}
+	}
+}
+
+// Disconnect drops every subscription a user holds on a chat.
+func (h *Hub) Disconnect(chatID int64, username string) {
+	var subs []*Subscription
+
+	h.mu.RLock()
+	for sub := range h.chats[chatID] {
+		if sub.Username == username {
+			subs = append(subs, sub)
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range subs {
+		h.remove(sub, ErrRemovedFromChat)
+	}
+}
+
+// CloseChat drops every subscription of a chat.
+func (h *Hub) CloseChat(chatID int64) {
+	var subs []*Subscription
+
+	h.mu.RLock()
+	for sub := range h.chats[chatID] {
+		subs = append(subs, sub)
+	}
+	h.mu.RUnlock()
+
+	for _, sub := range subs {
+		h.remove(sub, ErrChatClosed)
+	}
+}
+
+// Subscribers returns the number of subscriptions currently attached to a chat.
+func (h *Hub) Subscribers(chatID int64) int {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.chats[chatID])
+}
+
+func (h *Hub) remove(sub *Subscription, reason error) {
+	sub.closeOnce.Do(func() {
+		h.mu.Lock()
+		if subs, ok := h.chats[sub.ChatID]; ok {
+			delete(subs, sub)
+			if len(subs) == 0 {
+				delete(h.chats, sub.ChatID)
+			}
+		}
+		h.mu.Unlock()
+
+		sub.err = reason
+		close(sub.done)
+	})
+}
@@ -0,0 +1,124 @@
+package hub
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"chat/chat_server/internal/model"
+)
+
+func TestPublishDropsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	slow := h.Subscribe(1, "a")
+	fast := h.Subscribe(1, "b")
+
+	first := &model.ChatEvent{ChatID: 1}
+	second := &model.ChatEvent{ChatID: 1}
+
+	h.Publish(first)
+	require.Equal(t, first, <-fast.Events())
+
+	// The slow subscriber has not read the first event, so its buffer is full.
+	h.Publish(second)
+
+	require.ErrorIs(t, slow.Err(), ErrSlowSubscriber)
+	require.Equal(t, second, <-fast.Events())
+	require.Equal(t, 1, h.Subscribers(1))
+
+	select {
+	case <-fast.Done():
+		t.Fatal("subscriber that keeps up was dropped")
+	default:
+	}
+}
+
+func TestPublishOnlyReachesSubscribersOfTheChat(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	other := h.Subscribe(2, "a")
+
+	h.Publish(&model.ChatEvent{ChatID: 1})
+
+	require.Empty(t, other.Events())
+	require.Equal(t, 1, h.Subscribers(2))
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	sub := h.Subscribe(1, "a")
+
+	sub.Close()
+	sub.Close()
+
+	require.NoError(t, sub.Err())
+	require.Equal(t, 0, h.Subscribers(1))
+}
+
+func TestDisconnect(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	first := h.Subscribe(1, "a")
+	second := h.Subscribe(1, "a")
+	other := h.Subscribe(1, "b")
+
+	h.Disconnect(1, "a")
+
+	require.ErrorIs(t, first.Err(), ErrRemovedFromChat)
+	require.ErrorIs(t, second.Err(), ErrRemovedFromChat)
+	require.Equal(t, 1, h.Subscribers(1))
+
+	select {
+	case <-other.Done():
+		t.Fatal("subscription of another member was dropped")
+	default:
+	}
+}
+
+func TestCloseChat(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	a := h.Subscribe(1, "a")
+	b := h.Subscribe(1, "b")
+	other := h.Subscribe(2, "a")
+
+	h.CloseChat(1)
+
+	require.ErrorIs(t, a.Err(), ErrChatClosed)
+	require.ErrorIs(t, b.Err(), ErrChatClosed)
+	require.Equal(t, 0, h.Subscribers(1))
+	require.Equal(t, 1, h.Subscribers(2))
+
+	select {
+	case <-other.Done():
+		t.Fatal("subscription of another chat was dropped")
+	default:
+	}
+}
+
+func TestPublishEphemeralKeepsSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	h := New(1)
+	sub := h.Subscribe(1, "a")
+
+	first := &model.ChatEvent{ChatID: 1}
+	h.PublishEphemeral(first)
+	h.PublishEphemeral(&model.ChatEvent{ChatID: 1})
+
+	require.Equal(t, first, <-sub.Ephemeral())
+	require.Equal(t, 1, h.Subscribers(1))
+
+	select {
+	case <-sub.Done():
+		t.Fatal("subscriber was dropped for missing an ephemeral event")
+	default:
+	}
+}
@@ -29,34 +29,77 @@ func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		log.Printf("Auth interceptor: checking access for method %s", info.FullMethod)
-
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			log.Printf("Auth interceptor: no metadata in context")
-			return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+		ctx, err := a.authorize(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
 
-		authHeader, ok := md["authorization"]
-		if !ok || len(authHeader) == 0 {
-			log.Printf("Auth interceptor: no authorization header")
-			return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
+		return handler(ctx, req)
+	}
+}
+
+func (a *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
 		}
 
-		outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+		return handler(srv, &authorizedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		checkReq := &accesspb.CheckRequest{
-			EndpointAddress: info.FullMethod,
-		}
+// authorize asks the auth service whether the caller may access the method
+// and returns a context carrying the caller's username.
+func (a *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	log.Printf("Auth interceptor: checking access for method %s", method)
 
-		_, err := a.accessClient.Check(outgoingCtx, checkReq)
-		if err != nil {
-			log.Printf("Auth interceptor: access denied for method %s: %v", info.FullMethod, err)
-			return nil, status.Errorf(codes.PermissionDenied, "access denied: %v", err)
-		}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Printf("Auth interceptor: no metadata in context")
+		return nil, status.Errorf(codes.Unauthenticated, "missing metadata")
+	}
 
-		log.Printf("Auth interceptor: access granted for method %s", info.FullMethod)
+	authHeader, ok := md["authorization"]
+	if !ok || len(authHeader) == 0 {
+		log.Printf("Auth interceptor: no authorization header")
+		return nil, status.Errorf(codes.Unauthenticated, "missing authorization header")
+	}
 
-		return handler(ctx, req)
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	checkReq := &accesspb.CheckRequest{
+		EndpointAddress: method,
+	}
+
+	_, err := a.accessClient.Check(outgoingCtx, checkReq)
+	if err != nil {
+		log.Printf("Auth interceptor: access denied for method %s: %v", method, err)
+		return nil, status.Errorf(codes.PermissionDenied, "access denied: %v", err)
+	}
+
+	username, err := usernameFromAuthHeader(authHeader[0])
+	if err != nil {
+		log.Printf("Auth interceptor: failed to read caller identity for method %s: %v", method, err)
+		return nil, status.Errorf(codes.Unauthenticated, "invalid access token: %v", err)
 	}
+
+	log.Printf("Auth interceptor: access granted for method %s", method)
+
+	return ContextWithUsername(ctx, username), nil
+}
+
+// authorizedStream overrides the stream context with the one carrying the caller identity.
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
 }
@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const authPrefix = "Bearer "
+
+type usernameKey struct{}
+
+type accessClaims struct {
+	jwt.RegisteredClaims
+	Username string `json:"username"`
+}
+
+func ContextWithUsername(ctx context.Context, username string) context.Context {
+	return context.WithValue(ctx, usernameKey{}, username)
+}
+
+// UsernameFromContext returns the authenticated caller put into the context by AuthInterceptor.
+func UsernameFromContext(ctx context.Context) (string, bool) {
+	username, ok := ctx.Value(usernameKey{}).(string)
+	return username, ok && username != ""
+}
+
+// usernameFromAuthHeader reads the username claim of the access token. The token
+// signature is verified by the auth service during the access check, so it is
+// not verified again here.
+func usernameFromAuthHeader(header string) (string, error) {
+	if !strings.HasPrefix(header, authPrefix) {
+		return "", fmt.Errorf("invalid authorization header format")
+	}
+
+	claims := &accessClaims{}
+	_, _, err := jwt.NewParser().ParseUnverified(strings.TrimPrefix(header, authPrefix), claims)
+	if err != nil {
+		return "", fmt.Errorf("parse access token: %w", err)
+	}
+
+	if claims.Username == "" {
+		return "", fmt.Errorf("access token has no username")
+	}
+
+	return claims.Username, nil
+}
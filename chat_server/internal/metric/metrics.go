@@ -0,0 +1,74 @@
+package metric
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "chat_service"
+
+type Metrics struct {
+	reaperRunCounter      *prometheus.CounterVec
+	reaperDeletedCounter  prometheus.Counter
+	reaperRunTime         prometheus.Histogram
+	reaperLastSuccessTime prometheus.Gauge
+}
+
+var metrics *Metrics
+
+func Init(_ context.Context) error {
+	metrics = &Metrics{
+		reaperRunCounter: promauto.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "reaper",
+				Name:      "runs_total",
+				Help:      "Total number of expired message reaper batches",
+			},
+			[]string{"status"},
+		),
+		reaperDeletedCounter: promauto.NewCounter(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Subsystem: "reaper",
+				Name:      "messages_deleted_total",
+				Help:      "Total number of expired messages deleted by the reaper",
+			},
+		),
+		reaperRunTime: promauto.NewHistogram(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Subsystem: "reaper",
+				Name:      "run_duration_seconds",
+				Help:      "Time taken by one expired message reaper batch",
+				Buckets:   prometheus.ExponentialBuckets(0.001, 2, 16),
+			},
+		),
+		reaperLastSuccessTime: promauto.NewGauge(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Subsystem: "reaper",
+				Name:      "last_success_timestamp_seconds",
+				Help:      "Unix time of the last reaper pass that found no expired messages left",
+			},
+		),
+	}
+
+	return nil
+}
+
+func ObserveReaperRun(status string, d time.Duration) {
+	metrics.reaperRunCounter.WithLabelValues(status).Inc()
+	metrics.reaperRunTime.Observe(d.Seconds())
+}
+
+func AddReaperDeleted(n int) {
+	metrics.reaperDeletedCounter.Add(float64(n))
+}
+
+func SetReaperLastSuccess(t time.Time) {
+	metrics.reaperLastSuccessTime.Set(float64(t.Unix()))
+}
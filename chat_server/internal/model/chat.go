@@ -2,12 +2,383 @@ package model
 
 import "time"
 
+type ChatType string
+
+const (
+	ChatTypeGroup   ChatType = "group"
+	ChatTypeDirect  ChatType = "direct"
+	ChatTypeChannel ChatType = "channel"
+)
+
+type ChatInfo struct {
+	Title       string
+	Description string
+	AvatarURL   string
+	Type        ChatType
+}
+
 type ChatCreate struct {
+	Owner     string
 	Usernames []string
+	Info      *ChatInfo
+	// ClientRequestID is an optional idempotency key chosen by the client.
+	ClientRequestID string
+}
+
+type Chat struct {
+	ID      int64
+	Info    *ChatInfo
+	Members []*ChatMember
+	// MessageTTL is how long new messages of the chat are kept. Zero keeps
+	// them forever.
+	MessageTTL time.Duration
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// ChatUpdate changes the non-nil fields of a chat.
+type ChatUpdate struct {
+	ID          int64
+	Title       *string
+	Description *string
+	AvatarURL   *string
+	MessageTTL  *time.Duration
+}
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+)
+
+type ChatMember struct {
+	Username string
+	Role     Role
 }
 
+type MessageKind string
+
+const (
+	MessageKindUser   MessageKind = "user"
+	MessageKindSystem MessageKind = "system"
+)
+
 type Message struct {
+	ID     int64
+	ChatID int64
+	// Seq numbers the messages of a chat 1, 2, 3... without gaps.
+	Seq       int64
 	From      string
 	Text      string
 	Timestamp time.Time
+	Kind      MessageKind
+	EditedAt  time.Time
+	Deleted   bool
+	// ReplyTo is the id of the thread root for replies and zero otherwise.
+	ReplyTo     int64
+	ReplyCount  int64
+	LastReplyAt time.Time
+	Reactions   []*Reaction
+	// AttachmentIDs are the uploaded attachments to send with a new message.
+	AttachmentIDs []int64
+	Attachments   []*Attachment
+	// ClientMessageID is an optional idempotency key chosen by the sender.
+	ClientMessageID string
+	Mentions        []*Mention
+	// TTL asks for a new message to be deleted this long after it is sent.
+	// The chat's message TTL applies instead when it is shorter.
+	TTL time.Duration
+	// ExpiresAt is when the message will be deleted, zero for never.
+	ExpiresAt time.Time
+}
+
+// PinnedMessage is a message pinned to the top of its chat.
+type PinnedMessage struct {
+	Message  *Message
+	PinnedBy string
+	PinnedAt time.Time
+}
+
+// ScheduledMessage is a message waiting to be sent to its chat at SendAt.
+type ScheduledMessage struct {
+	ID        int64
+	ChatID    int64
+	From      string
+	Text      string
+	SendAt    time.Time
+	CreatedAt time.Time
+	// Attempts is the number of failed deliveries so far.
+	Attempts int
+}
+
+// Mention is an @username in a message text that names a member of the chat.
+// Offset and Length count characters, the @ included.
+type Mention struct {
+	Username string
+	Offset   int
+	Length   int
+}
+
+// MentionQuery pages through the messages that mention Username, newest
+// first. Cursor is the id of the last message of the previous page.
+type MentionQuery struct {
+	Username string
+	Cursor   int64
+	Limit    int
+}
+
+// Attachment is a file uploaded to a chat. MessageID is zero until the
+// attachment is sent with a message.
+type Attachment struct {
+	ID         int64
+	ChatID     int64
+	MessageID  int64
+	UploadedBy string
+	Filename   string
+	MimeType   string
+	Size       int64
+	SHA256     string
+	StorageKey string
+	CreatedAt  time.Time
+	// Width and Height are only set for images.
+	Width      int
+	Height     int
+	Thumbnails []*Thumbnail
+}
+
+// Thumbnail is a scaled down copy of an image attachment.
+type Thumbnail struct {
+	Width      int
+	Height     int
+	MimeType   string
+	Size       int64
+	StorageKey string
+}
+
+// AttachmentUpload describes a file a user is about to upload.
+type AttachmentUpload struct {
+	ChatID   int64
+	Username string
+	Filename string
+}
+
+// Reaction is the number of users who reacted to a message with one emoji.
+type Reaction struct {
+	Emoji   string
+	Count   int64
+	Reacted bool
+}
+
+// ChatEvent is a single update fanned out to the subscribers of a chat.
+// Exactly one of the payload fields is set.
+type ChatEvent struct {
+	ChatID   int64
+	Message  *Message
+	Edited   *Message
+	Deleted  *MessageDeletedEvent
+	Reaction *ReactionEvent
+	Read     *ReadEvent
+	Presence *Presence
+	Typing   *TypingEvent
+	Delivery *DeliveryEvent
+}
+
+type MessageDeletedEvent struct {
+	MessageID int64
+	Seq       int64
+	// DeletedBy is empty for messages deleted because they expired.
+	DeletedBy string
+}
+
+type ReactionEvent struct {
+	MessageID int64
+	Username  string
+	Emoji     string
+	Removed   bool
+}
+
+// ReadEvent reports that a member has read a chat up to a message.
+type ReadEvent struct {
+	Username  string
+	MessageID int64
+}
+
+// TypingEvent is ephemeral: clients hide the indicator once it expires.
+type TypingEvent struct {
+	Username  string
+	ExpiresAt time.Time
+}
+
+// DeliveryEvent reports that a message reached one of the chat members.
+type DeliveryEvent struct {
+	MessageID int64
+	Username  string
+}
+
+type Direction int
+
+const (
+	DirectionBackward Direction = iota
+	DirectionForward
+)
+
+type MessageListQuery struct {
+	ChatID    int64
+	Cursor    int64
+	Limit     int
+	Direction Direction
+}
+
+// MessageRangeQuery selects messages by sequence number, both ends inclusive.
+// A zero ToSeq means up to the latest message.
+type MessageRangeQuery struct {
+	ChatID  int64
+	FromSeq int64
+	ToSeq   int64
+	Limit   int
+}
+
+type MessagePage struct {
+	Messages   []*Message
+	NextCursor int64
+}
+
+// MessageSearchQuery is a full-text search over the chats of Username.
+// Zero values of the optional filters are ignored.
+type MessageSearchQuery struct {
+	Username string
+	Query    string
+	ChatID   int64
+	From     string
+	Before   time.Time
+	After    time.Time
+	Cursor   int64
+	Limit    int
+}
+
+type SearchResult struct {
+	Message *Message
+	Snippet string
+}
+
+type SearchPage struct {
+	Results    []*SearchResult
+	NextCursor int64
+}
+
+type ThreadQuery struct {
+	MessageID int64
+	Cursor    int64
+	Limit     int
+}
+
+type ThreadPage struct {
+	Root       *Message
+	Replies    []*Message
+	NextCursor int64
+}
+
+// ChatCursor points at the last chat of a ListChats page.
+type ChatCursor struct {
+	LastActivityAt time.Time
+	ChatID         int64
+}
+
+type ChatListQuery struct {
+	Username string
+	Limit    int
+	After    *ChatCursor
+}
+
+type ChatSummary struct {
+	ID             int64
+	Info           *ChatInfo
+	LastActivityAt time.Time
+	LastMessage    *Message
+	UnreadCount    int64
+}
+
+type ChatPage struct {
+	Chats []*ChatSummary
+	Next  *ChatCursor
+}
+
+// ReadCursor is the id of the last message a member has read in a chat.
+type ReadCursor struct {
+	ChatID    int64
+	Username  string
+	MessageID int64
+}
+
+// ChangeKind is the kind of a change recorded for delta sync.
+type ChangeKind string
+
+const (
+	ChangeMessageSent    ChangeKind = "message_sent"
+	ChangeMessageEdited  ChangeKind = "message_edited"
+	ChangeMessageDeleted ChangeKind = "message_deleted"
+	ChangeMemberAdded    ChangeKind = "member_added"
+	ChangeMemberRemoved  ChangeKind = "member_removed"
+	ChangeMemberRole     ChangeKind = "member_role"
+	ChangeChatDeleted    ChangeKind = "chat_deleted"
+	ChangeRead           ChangeKind = "read"
+)
+
+// Change is an entry of the change log. MessageID is set for message changes
+// and read changes, where it is the new read cursor. Username is the member a
+// membership or read change is about, or who deleted a message.
+type Change struct {
+	ID        int64
+	ChatID    int64
+	Kind      ChangeKind
+	MessageID int64
+	// Seq is the sequence number of the message of a message_deleted change.
+	Seq      int64
+	Username string
+	Role     Role
+}
+
+// SyncToken marks how far a client has synced. Changes written by
+// transactions with ids in [From, To) are settled; To is zero until a window
+// is split over several responses, After is the last change returned from it.
+type SyncToken struct {
+	From  int64
+	To    int64
+	After int64
+}
+
+type ChangeQuery struct {
+	Username string
+	From     int64
+	To       int64
+	After    int64
+	Limit    int
+}
+
+// SyncResult is what changed for a user since a sync token. Messages are in
+// their current state; the other changes are in the order they happened.
+type SyncResult struct {
+	Messages      []*Message
+	Deleted       []*Change
+	MemberChanges []*Change
+	ReadCursors   []*ReadCursor
+	Next          *SyncToken
+	HasMore       bool
+}
+
+type PresenceStatus string
+
+const (
+	PresenceOffline PresenceStatus = "offline"
+	PresenceOnline  PresenceStatus = "online"
+	PresenceAway    PresenceStatus = "away"
+)
+
+type Presence struct {
+	Username string
+	Status   PresenceStatus
+	// LastSeen is the time of the user's last activity, zero if unknown.
+	LastSeen time.Time
 }
@@ -0,0 +1,168 @@
+package presence
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"chat/chat_server/internal/model"
+)
+
+// user is the presence state of a single user.
+type user struct {
+	connections  int
+	lastActivity time.Time
+	status       model.PresenceStatus
+}
+
+// Tracker derives the presence of users from their open streams and their
+// activity. A user is online while active within the away timeout, away while
+// connected or active within the offline timeout, and offline otherwise.
+// State is kept in memory only.
+type Tracker struct {
+	mu       sync.Mutex
+	users    map[string]*user
+	away     time.Duration
+	offline  time.Duration
+	onChange func(*model.Presence)
+	now      func() time.Time
+}
+
+func New(away, offline time.Duration) *Tracker {
+	return &Tracker{
+		users:   make(map[string]*user),
+		away:    away,
+		offline: offline,
+		now:     time.Now,
+	}
+}
+
+// OnChange registers the function called whenever the status of a user changes.
+// It must be set before the tracker is used.
+func (t *Tracker) OnChange(fn func(*model.Presence)) {
+	t.onChange = fn
+}
+
+// Connect records a new stream of the user. The returned function must be
+// called once the stream is closed.
+func (t *Tracker) Connect(username string) func() {
+	t.update(username, func(u *user) {
+		u.connections++
+	})
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			t.mu.Lock()
+			u := t.users[username]
+			u.connections--
+			changed := t.refresh(username, u, t.now())
+			t.mu.Unlock()
+
+			t.notify(changed)
+		})
+	}
+}
+
+// Touch records activity of the user, such as a heartbeat or a sent message.
+func (t *Tracker) Touch(username string) {
+	t.update(username, func(*user) {})
+}
+
+// Get returns the presence of the given users. Unknown users are offline.
+func (t *Tracker) Get(usernames []string) []*model.Presence {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := t.now()
+	res := make([]*model.Presence, 0, len(usernames))
+	for _, username := range usernames {
+		p := &model.Presence{Username: username, Status: model.PresenceOffline}
+		if u, ok := t.users[username]; ok {
+			p.Status = t.status(u, now)
+			p.LastSeen = u.lastActivity
+		}
+		res = append(res, p)
+	}
+	return res
+}
+
+// Run moves idle users to away and offline until ctx is done.
+func (t *Tracker) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			t.sweep()
+		}
+	}
+}
+
+func (t *Tracker) sweep() {
+	t.mu.Lock()
+	now := t.now()
+	var changed []*model.Presence
+	for username, u := range t.users {
+		if p := t.refresh(username, u, now); p != nil {
+			changed = append(changed, p)
+		}
+		if u.status == model.PresenceOffline && now.Sub(u.lastActivity) >= 2*t.offline {
+			delete(t.users, username)
+		}
+	}
+	t.mu.Unlock()
+
+	for _, p := range changed {
+		t.notify(p)
+	}
+}
+
+func (t *Tracker) update(username string, fn func(u *user)) {
+	t.mu.Lock()
+	u, ok := t.users[username]
+	if !ok {
+		u = &user{status: model.PresenceOffline}
+		t.users[username] = u
+	}
+	now := t.now()
+	u.lastActivity = now
+	fn(u)
+	changed := t.refresh(username, u, now)
+	t.mu.Unlock()
+
+	t.notify(changed)
+}
+
+// refresh recomputes the status of a user and returns the new presence if it
+// has changed. It must be called with mu held.
+func (t *Tracker) refresh(username string, u *user, now time.Time) *model.Presence {
+	status := t.status(u, now)
+	if status == u.status {
+		return nil
+	}
+
+	u.status = status
+	return &model.Presence{Username: username, Status: status, LastSeen: u.lastActivity}
+}
+
+func (t *Tracker) status(u *user, now time.Time) model.PresenceStatus {
+	idle := now.Sub(u.lastActivity)
+	switch {
+	case idle < t.away:
+		return model.PresenceOnline
+	case u.connections > 0 || idle < t.offline:
+		return model.PresenceAway
+	default:
+		return model.PresenceOffline
+	}
+}
+
+func (t *Tracker) notify(p *model.Presence) {
+	if p != nil && t.onChange != nil {
+		t.onChange(p)
+	}
+}
@@ -0,0 +1,109 @@
+package presence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"chat/chat_server/internal/model"
+)
+
+const (
+	away    = time.Minute
+	offline = 5 * time.Minute
+)
+
+// newTestTracker returns a tracker with a fake clock and the list of presence
+// changes it has reported.
+func newTestTracker() (*Tracker, *time.Time, *[]model.PresenceStatus) {
+	now := time.Unix(1000, 0)
+	var changes []model.PresenceStatus
+
+	tr := New(away, offline)
+	tr.now = func() time.Time { return now }
+	tr.OnChange(func(p *model.Presence) { changes = append(changes, p.Status) })
+	return tr, &now, &changes
+}
+
+func status(tr *Tracker, username string) model.PresenceStatus {
+	return tr.Get([]string{username})[0].Status
+}
+
+func TestConnectedUserGoesAwayButNotOffline(t *testing.T) {
+	t.Parallel()
+
+	tr, now, changes := newTestTracker()
+
+	disconnect := tr.Connect("a")
+	require.Equal(t, model.PresenceOnline, status(tr, "a"))
+
+	*now = now.Add(away)
+	tr.sweep()
+	require.Equal(t, model.PresenceAway, status(tr, "a"))
+
+	*now = now.Add(offline)
+	tr.sweep()
+	require.Equal(t, model.PresenceAway, status(tr, "a"))
+
+	disconnect()
+	disconnect()
+	require.Equal(t, model.PresenceOffline, status(tr, "a"))
+
+	require.Equal(t, []model.PresenceStatus{model.PresenceOnline, model.PresenceAway, model.PresenceOffline}, *changes)
+}
+
+func TestIdleUserGoesAwayThenOffline(t *testing.T) {
+	t.Parallel()
+
+	tr, now, changes := newTestTracker()
+
+	tr.Touch("a")
+	tr.Touch("a")
+
+	*now = now.Add(away - time.Second)
+	tr.sweep()
+	require.Equal(t, model.PresenceOnline, status(tr, "a"))
+
+	*now = now.Add(time.Second)
+	tr.sweep()
+	require.Equal(t, model.PresenceAway, status(tr, "a"))
+
+	// Activity brings an away user back online.
+	tr.Touch("a")
+	require.Equal(t, model.PresenceOnline, status(tr, "a"))
+
+	*now = now.Add(offline)
+	tr.sweep()
+	require.Equal(t, model.PresenceOffline, status(tr, "a"))
+
+	require.Equal(t, []model.PresenceStatus{
+		model.PresenceOnline, model.PresenceAway, model.PresenceOnline, model.PresenceOffline,
+	}, *changes)
+}
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	tr, now, _ := newTestTracker()
+	seen := *now
+	tr.Touch("a")
+
+	require.Equal(t, []*model.Presence{
+		{Username: "a", Status: model.PresenceOnline, LastSeen: seen},
+		{Username: "b", Status: model.PresenceOffline},
+	}, tr.Get([]string{"a", "b"}))
+}
+
+func TestSweepForgetsLongOfflineUsers(t *testing.T) {
+	t.Parallel()
+
+	tr, now, _ := newTestTracker()
+	tr.Touch("a")
+
+	*now = now.Add(2 * offline)
+	tr.sweep()
+
+	require.Empty(t, tr.users)
+	require.Equal(t, model.PresenceOffline, status(tr, "a"))
+}
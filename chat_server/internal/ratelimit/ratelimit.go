@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+// Limiter allows at most one event per interval for each key. Keys that are
+// no longer limited are dropped at most once per interval, so the map only
+// holds the keys seen recently.
+type Limiter struct {
+	mu       sync.Mutex
+	last     map[string]time.Time
+	swept    time.Time
+	interval time.Duration
+	now      func() time.Time
+}
+
+func New(interval time.Duration) *Limiter {
+	return &Limiter{
+		last:     make(map[string]time.Time),
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Allow reports whether an event for key may go through now, and records it if so.
+func (l *Limiter) Allow(key string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	if last, ok := l.last[key]; ok && now.Sub(last) < l.interval {
+		return false
+	}
+
+	if now.Sub(l.swept) >= l.interval {
+		for k, last := range l.last {
+			if now.Sub(last) >= l.interval {
+				delete(l.last, k)
+			}
+		}
+		l.swept = now
+	}
+
+	l.last[key] = now
+	return true
+}
@@ -0,0 +1,77 @@
+package ratelimit
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(interval time.Duration) (*Limiter, *time.Time) {
+	now := time.Unix(0, 0)
+	l := New(interval)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestAllow(t *testing.T) {
+	t.Parallel()
+
+	l, now := newTestLimiter(time.Second)
+
+	require.True(t, l.Allow("a"))
+	require.False(t, l.Allow("a"))
+
+	*now = now.Add(999 * time.Millisecond)
+	require.False(t, l.Allow("a"))
+
+	*now = now.Add(time.Millisecond)
+	require.True(t, l.Allow("a"))
+	require.False(t, l.Allow("a"))
+}
+
+func TestAllowKeysAreIndependent(t *testing.T) {
+	t.Parallel()
+
+	l, _ := newTestLimiter(time.Second)
+
+	require.True(t, l.Allow("1:a"))
+	require.True(t, l.Allow("2:a"))
+	require.True(t, l.Allow("1:b"))
+	require.False(t, l.Allow("1:a"))
+}
+
+func TestAllowSweepsExpiredKeysOncePerInterval(t *testing.T) {
+	t.Parallel()
+
+	l, now := newTestLimiter(time.Second)
+
+	for i := 0; i < 100; i++ {
+		require.True(t, l.Allow(fmt.Sprint(i)))
+	}
+	require.Len(t, l.last, 100)
+
+	*now = now.Add(time.Second)
+	require.True(t, l.Allow("new"))
+	require.Len(t, l.last, 1)
+
+	// Keys that expire between sweeps stay until the next one.
+	*now = now.Add(500 * time.Millisecond)
+	require.True(t, l.Allow("other"))
+	*now = now.Add(400 * time.Millisecond)
+	require.True(t, l.Allow("late"))
+	require.Len(t, l.last, 3)
+
+	*now = now.Add(700 * time.Millisecond)
+	require.True(t, l.Allow("last"))
+	require.ElementsMatch(t, []string{"late", "last"}, keys(l))
+}
+
+func keys(l *Limiter) []string {
+	res := make([]string, 0, len(l.last))
+	for k := range l.last {
+		res = append(res, k)
+	}
+	return res
+}
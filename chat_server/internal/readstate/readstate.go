@@ -0,0 +1,196 @@
+package readstate
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+	"time"
+
+	"chat/chat_server/internal/model"
+)
+
+// ErrNotMember is returned by Mark when the user is not a member of the chat.
+var ErrNotMember = errors.New("not a member of the chat")
+
+// Store persists read cursors. Saving a cursor must never move it backwards.
+type Store interface {
+	// GetReadCursor returns the stored cursor of a member, or nil when the
+	// user is not a member of the chat.
+	GetReadCursor(ctx context.Context, chatID int64, username string) (*model.ReadCursor, error)
+	SaveReadCursors(ctx context.Context, cursors []*model.ReadCursor) error
+}
+
+type key struct {
+	chatID   int64
+	username string
+}
+
+type cursor struct {
+	messageID int64
+	dirty     bool
+}
+
+// Buffer coalesces read cursor updates in memory and writes them to the store
+// in batches, so that clients can mark messages as read on every scroll
+// without a database write per call. Only the furthest cursor of each member
+// is kept between flushes.
+//
+// A member's cursor is read from the store the first time it is marked after
+// a flush, which also checks that the user is a member. Until the next flush
+// marks are checked against memory only.
+type Buffer struct {
+	mu       sync.Mutex
+	cursors  map[key]*cursor
+	flushing map[key]*cursor
+	store    Store
+	interval time.Duration
+}
+
+func New(store Store, interval time.Duration) *Buffer {
+	return &Buffer{
+		cursors:  make(map[key]*cursor),
+		store:    store,
+		interval: interval,
+	}
+}
+
+// Mark records that username has read chatID up to messageID. It reports
+// whether this moved the cursor forward, compared to both the buffered and
+// the stored cursor.
+func (b *Buffer) Mark(ctx context.Context, chatID int64, username string, messageID int64) (bool, error) {
+	k := key{chatID: chatID, username: username}
+
+	b.mu.Lock()
+	if c := b.lookup(k); c != nil {
+		defer b.mu.Unlock()
+		return c.advance(messageID), nil
+	}
+	b.mu.Unlock()
+
+	stored, err := b.store.GetReadCursor(ctx, chatID, username)
+	if err != nil {
+		return false, err
+	}
+	if stored == nil {
+		return false, ErrNotMember
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.lookup(k)
+	if c == nil {
+		c = &cursor{messageID: stored.MessageID}
+		b.cursors[k] = c
+	}
+	return c.advance(messageID), nil
+}
+
+// advance moves the cursor forward to messageID and reports whether it moved.
+func (c *cursor) advance(messageID int64) bool {
+	if c.messageID >= messageID {
+		return false
+	}
+
+	c.messageID = messageID
+	c.dirty = true
+	return true
+}
+
+// lookup returns the cursor of a member known since the last flush. A cursor
+// that is being flushed is carried over, so that it is not read back from
+// the store before it is saved.
+func (b *Buffer) lookup(k key) *cursor {
+	if c, ok := b.cursors[k]; ok {
+		return c
+	}
+
+	if c, ok := b.flushing[k]; ok {
+		carried := &cursor{messageID: c.messageID}
+		b.cursors[k] = carried
+		return carried
+	}
+
+	return nil
+}
+
+// Pending returns the cursors of a chat that have not been flushed yet, by username.
+func (b *Buffer) Pending(chatID int64) map[string]int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := make(map[string]int64)
+	for _, m := range []map[key]*cursor{b.flushing, b.cursors} {
+		for k, c := range m {
+			if k.chatID == chatID && c.dirty && c.messageID > res[k.username] {
+				res[k.username] = c.messageID
+			}
+		}
+	}
+	return res
+}
+
+// Flush writes the buffered cursors to the store. Cursors that fail to save
+// are put back so the next flush retries them.
+func (b *Buffer) Flush(ctx context.Context) error {
+	b.mu.Lock()
+	batch := b.cursors
+	b.cursors = make(map[key]*cursor)
+	b.flushing = batch
+	b.mu.Unlock()
+
+	defer func() {
+		b.mu.Lock()
+		b.flushing = nil
+		b.mu.Unlock()
+	}()
+
+	cursors := make([]*model.ReadCursor, 0, len(batch))
+	for k, c := range batch {
+		if c.dirty {
+			cursors = append(cursors, &model.ReadCursor{ChatID: k.chatID, Username: k.username, MessageID: c.messageID})
+		}
+	}
+
+	if len(cursors) == 0 {
+		return nil
+	}
+
+	if err := b.store.SaveReadCursors(ctx, cursors); err != nil {
+		b.mu.Lock()
+		for _, saved := range cursors {
+			k := key{chatID: saved.ChatID, username: saved.Username}
+			c := b.lookup(k)
+			if c.messageID <= saved.MessageID {
+				c.messageID = saved.MessageID
+				c.dirty = true
+			}
+		}
+		b.mu.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// Run flushes the buffer periodically until ctx is done, then flushes it one
+// last time.
+func (b *Buffer) Run(ctx context.Context) {
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := b.Flush(context.Background()); err != nil {
+				log.Printf("failed to flush read cursors: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := b.Flush(ctx); err != nil {
+				log.Printf("failed to flush read cursors: %v", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package readstate
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"chat/chat_server/internal/model"
+)
+
+type fakeStore struct {
+	mu     sync.Mutex
+	err    error
+	reads  int
+	stored map[key]int64
+	saved  [][]*model.ReadCursor
+}
+
+// newFakeStore returns a store where a and b are members of chats 1 and 2
+// with nothing read yet.
+func newFakeStore() *fakeStore {
+	stored := make(map[key]int64)
+	for _, chatID := range []int64{1, 2} {
+		for _, username := range []string{"a", "b"} {
+			stored[key{chatID: chatID, username: username}] = 0
+		}
+	}
+	return &fakeStore{stored: stored}
+}
+
+func (s *fakeStore) GetReadCursor(_ context.Context, chatID int64, username string) (*model.ReadCursor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.reads++
+	messageID, ok := s.stored[key{chatID: chatID, username: username}]
+	if !ok {
+		return nil, nil
+	}
+	return &model.ReadCursor{ChatID: chatID, Username: username, MessageID: messageID}, nil
+}
+
+func (s *fakeStore) SaveReadCursors(_ context.Context, cursors []*model.ReadCursor) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err != nil {
+		return s.err
+	}
+	sort.Slice(cursors, func(i, j int) bool {
+		if cursors[i].ChatID != cursors[j].ChatID {
+			return cursors[i].ChatID < cursors[j].ChatID
+		}
+		return cursors[i].Username < cursors[j].Username
+	})
+	for _, c := range cursors {
+		k := key{chatID: c.ChatID, username: c.Username}
+		if c.MessageID > s.stored[k] {
+			s.stored[k] = c.MessageID
+		}
+	}
+	s.saved = append(s.saved, cursors)
+	return nil
+}
+
+func mark(t *testing.T, b *Buffer, chatID int64, username string, messageID int64) bool {
+	t.Helper()
+
+	moved, err := b.Mark(context.Background(), chatID, username, messageID)
+	require.NoError(t, err)
+	return moved
+}
+
+func TestMarkKeepsFurthestCursor(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	b := New(store, 0)
+
+	require.True(t, mark(t, b, 1, "a", 10))
+	require.False(t, mark(t, b, 1, "a", 10))
+	require.False(t, mark(t, b, 1, "a", 5))
+	require.True(t, mark(t, b, 1, "a", 12))
+	require.True(t, mark(t, b, 1, "b", 3))
+	require.True(t, mark(t, b, 2, "a", 1))
+
+	require.Equal(t, map[string]int64{"a": 12, "b": 3}, b.Pending(1))
+	require.Equal(t, map[string]int64{"a": 1}, b.Pending(2))
+	require.Empty(t, b.Pending(3))
+
+	// The stored cursor is read once per member.
+	require.Equal(t, 3, store.reads)
+}
+
+func TestMarkComparesWithStoredCursor(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	store.stored[key{chatID: 1, username: "a"}] = 20
+	b := New(store, 0)
+
+	require.False(t, mark(t, b, 1, "a", 15))
+	require.True(t, mark(t, b, 1, "a", 25))
+
+	// A flushed cursor does not go backwards either.
+	require.NoError(t, b.Flush(context.Background()))
+	require.False(t, mark(t, b, 1, "a", 22))
+	require.Empty(t, b.Pending(1))
+}
+
+func TestMarkRejectsNonMembers(t *testing.T) {
+	t.Parallel()
+
+	b := New(newFakeStore(), 0)
+
+	moved, err := b.Mark(context.Background(), 3, "a", 1)
+	require.ErrorIs(t, err, ErrNotMember)
+	require.False(t, moved)
+	require.Empty(t, b.Pending(3))
+}
+
+func TestFlushWritesOneCursorPerMember(t *testing.T) {
+	t.Parallel()
+
+	store := newFakeStore()
+	b := New(store, 0)
+
+	mark(t, b, 1, "a", 10)
+	mark(t, b, 1, "a", 11)
+	mark(t, b, 1, "b", 4)
+
+	require.NoError(t, b.Flush(context.Background()))
+	require.Equal(t, [][]*model.ReadCursor{{
+		{ChatID: 1, Username: "a", MessageID: 11},
+		{ChatID: 1, Username: "b", MessageID: 4},
+	}}, store.saved)
+	require.Empty(t, b.Pending(1))
+
+	// Nothing is written when nothing was marked since the last flush.
+	require.False(t, mark(t, b, 1, "a", 11))
+	require.NoError(t, b.Flush(context.Background()))
+	require.Len(t, store.saved, 1)
+}
+
+func TestFlushRetriesFailedCursors(t *testing.T) {
+	t.Parallel()
+
+	errSave := errors.New("connection refused")
+	store := newFakeStore()
+	store.err = errSave
+	b := New(store, 0)
+
+	mark(t, b, 1, "a", 10)
+	require.ErrorIs(t, b.Flush(context.Background()), errSave)
+	require.Equal(t, map[string]int64{"a": 10}, b.Pending(1))
+
+	// A cursor marked before the retry replaces the one put back.
+	require.False(t, mark(t, b, 1, "a", 5))
+	require.True(t, mark(t, b, 1, "a", 20))
+	store.err = nil
+	require.NoError(t, b.Flush(context.Background()))
+	require.Equal(t, [][]*model.ReadCursor{{{ChatID: 1, Username: "a", MessageID: 20}}}, store.saved)
+}
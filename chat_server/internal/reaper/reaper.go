@@ -0,0 +1,88 @@
+package reaper
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"chat/chat_server/internal/metric"
+)
+
+// Purger deletes expired messages and stale uploads in batches.
+type Purger interface {
+	// DeleteExpiredMessages deletes up to limit expired messages and
+	// returns how many were deleted.
+	DeleteExpiredMessages(ctx context.Context, limit int) (int, error)
+	// DeleteStaleUploads deletes up to limit attachments that were never
+	// sent and returns how many were deleted.
+	DeleteStaleUploads(ctx context.Context, limit int) (int, error)
+}
+
+// Reaper periodically deletes messages whose TTL has run out and uploads that
+// were never sent. Any number of replicas may run a reaper side by side.
+type Reaper struct {
+	purger    Purger
+	interval  time.Duration
+	batchSize int
+}
+
+func New(purger Purger, interval time.Duration, batchSize int) *Reaper {
+	return &Reaper{
+		purger:    purger,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run reaps right away and then on every tick until ctx is done.
+func (r *Reaper) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.reap(ctx)
+		r.reapUploads(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// reap deletes batches until no expired message is left. On failure it leaves
+// the rest for the next tick.
+func (r *Reaper) reap(ctx context.Context) {
+	for ctx.Err() == nil {
+		start := time.Now()
+		deleted, err := r.purger.DeleteExpiredMessages(ctx, r.batchSize)
+		if err != nil {
+			metric.ObserveReaperRun("error", time.Since(start))
+			log.Printf("failed to reap expired messages: %v", err)
+			return
+		}
+		metric.ObserveReaperRun("ok", time.Since(start))
+		metric.AddReaperDeleted(deleted)
+
+		if deleted == 0 {
+			metric.SetReaperLastSuccess(time.Now())
+			return
+		}
+	}
+}
+
+// reapUploads deletes batches of stale uploads until none is left.
+func (r *Reaper) reapUploads(ctx context.Context) {
+	for ctx.Err() == nil {
+		deleted, err := r.purger.DeleteStaleUploads(ctx, r.batchSize)
+		if err != nil {
+			log.Printf("failed to reap stale uploads: %v", err)
+			return
+		}
+
+		if deleted == 0 {
+			return
+		}
+	}
+}
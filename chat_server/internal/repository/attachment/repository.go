@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/repository"
+	"common/database/client"
+)
+
+const attachmentColumns = `id, chat_id, COALESCE(message_id, 0), uploaded_by, filename, mime_type, size, sha256, storage_key, created_at,
+	COALESCE(width, 0), COALESCE(height, 0), thumbnails`
+
+// thumbnailRow is a thumbnail as stored in the thumbnails JSON column.
+type thumbnailRow struct {
+	Width      int    `json:"width"`
+	Height     int    `json:"height"`
+	MimeType   string `json:"mime_type"`
+	Size       int64  `json:"size"`
+	StorageKey string `json:"storage_key"`
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+type attachmentRepository struct {
+	db client.Client
+}
+
+func NewAttachmentRepository(db client.Client) repository.AttachmentRepository {
+	return &attachmentRepository{db: db}
+}
+
+func (r *attachmentRepository) CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error) {
+	q := client.Query{
+		Name: "attachment_repository.CreateAttachment",
+		QueryRaw: `
+			INSERT INTO attachments (chat_id, uploaded_by, filename, mime_type, size, sha256, storage_key, created_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			RETURNING id, created_at`,
+	}
+
+	stored := *attachment
+	err := r.db.DB().QueryRowContext(ctx, q,
+		stored.ChatID,
+		stored.UploadedBy,
+		stored.Filename,
+		stored.MimeType,
+		stored.Size,
+		stored.SHA256,
+		stored.StorageKey,
+		time.Now(),
+	).Scan(&stored.ID, &stored.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert attachment: %w", err)
+	}
+	return &stored, nil
+}
+
+// GetAttachment returns an attachment by id, or nil if it does not exist or
+// was sent with a message that has expired.
+func (r *attachmentRepository) GetAttachment(ctx context.Context, id int64) (*model.Attachment, error) {
+	q := client.Query{
+		Name: "attachment_repository.GetAttachment",
+		QueryRaw: `
+			SELECT ` + attachmentColumns + `
+			FROM attachments
+			WHERE id = $1 AND (message_id IS NULL OR EXISTS (
+				SELECT 1 FROM messages m
+				WHERE m.id = attachments.message_id AND (m.expires_at IS NULL OR m.expires_at > now())
+			))`,
+	}
+
+	attachment, err := scanAttachment(r.db.DB().QueryRowContext(ctx, q, id))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get attachment: %w", err)
+	}
+	return attachment, nil
+}
+
+// LinkAttachments attaches the given uploads to a message. Only attachments
+// of the chat uploaded by uploader and not sent yet are linked; the number of
+// linked attachments is returned.
+func (r *attachmentRepository) LinkAttachments(ctx context.Context, messageID, chatID int64, uploader string, ids []int64) (int64, error) {
+	q := client.Query{
+		Name: "attachment_repository.LinkAttachments",
+		QueryRaw: `
+			UPDATE attachments
+			SET message_id = $1
+			WHERE id = ANY($2) AND chat_id = $3 AND uploaded_by = $4 AND message_id IS NULL`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, messageID, ids, chatID, uploader)
+	if err != nil {
+		return 0, fmt.Errorf("link attachments: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+func (r *attachmentRepository) ListAttachments(ctx context.Context, messageIDs []int64) (map[int64][]*model.Attachment, error) {
+	q := client.Query{
+		Name: "attachment_repository.ListAttachments",
+		QueryRaw: `
+			SELECT ` + attachmentColumns + `
+			FROM attachments
+			WHERE message_id = ANY($1)
+			ORDER BY id`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, messageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[int64][]*model.Attachment)
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan attachment: %w", err)
+		}
+		res[attachment.MessageID] = append(res[attachment.MessageID], attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read attachments: %w", err)
+	}
+	return res, nil
+}
+
+// SetImageInfo records the dimensions and thumbnails of an image attachment.
+func (r *attachmentRepository) SetImageInfo(ctx context.Context, id int64, width, height int, thumbnails []*model.Thumbnail) error {
+	rows := make([]thumbnailRow, 0, len(thumbnails))
+	for _, t := range thumbnails {
+		rows = append(rows, thumbnailRow{
+			Width:      t.Width,
+			Height:     t.Height,
+			MimeType:   t.MimeType,
+			Size:       t.Size,
+			StorageKey: t.StorageKey,
+		})
+	}
+
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return fmt.Errorf("encode thumbnails: %w", err)
+	}
+
+	q := client.Query{
+		Name:     "attachment_repository.SetImageInfo",
+		QueryRaw: `UPDATE attachments SET width = $2, height = $3, thumbnails = $4::jsonb WHERE id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, id, width, height, string(data)); err != nil {
+		return fmt.Errorf("update attachment: %w", err)
+	}
+	return nil
+}
+
+// DeleteMessageAttachments removes the attachments of a message and returns
+// them so that their blobs can be deleted too.
+func (r *attachmentRepository) DeleteMessageAttachments(ctx context.Context, messageID int64) ([]*model.Attachment, error) {
+	q := client.Query{
+		Name:     "attachment_repository.DeleteMessageAttachments",
+		QueryRaw: `DELETE FROM attachments WHERE message_id = $1 RETURNING ` + attachmentColumns,
+	}
+
+	return r.deleteAttachments(ctx, q, messageID)
+}
+
+// DeleteChatAttachments removes every attachment of a chat, sent or not, and
+// returns them so that their blobs can be deleted too.
+func (r *attachmentRepository) DeleteChatAttachments(ctx context.Context, chatID int64) ([]*model.Attachment, error) {
+	q := client.Query{
+		Name:     "attachment_repository.DeleteChatAttachments",
+		QueryRaw: `DELETE FROM attachments WHERE chat_id = $1 RETURNING ` + attachmentColumns,
+	}
+
+	return r.deleteAttachments(ctx, q, chatID)
+}
+
+// DeleteStaleUploads removes up to limit attachments uploaded before the given
+// time that were never sent, and returns them. Rows locked by a concurrent
+// caller are skipped.
+func (r *attachmentRepository) DeleteStaleUploads(ctx context.Context, before time.Time, limit int) ([]*model.Attachment, error) {
+	q := client.Query{
+		Name: "attachment_repository.DeleteStaleUploads",
+		QueryRaw: `
+			DELETE FROM attachments
+			WHERE id IN (
+				SELECT id FROM attachments
+				WHERE message_id IS NULL AND created_at < $1
+				ORDER BY id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + attachmentColumns,
+	}
+
+	return r.deleteAttachments(ctx, q, before, limit)
+}
+
+func (r *attachmentRepository) deleteAttachments(ctx context.Context, q client.Query, args ...interface{}) ([]*model.Attachment, error) {
+	rows, err := r.db.DB().QueryContext(ctx, q, args...)
+	if err != nil {
+		return nil, fmt.Errorf("delete attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Attachment
+	for rows.Next() {
+		attachment, err := scanAttachment(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan attachment: %w", err)
+		}
+		res = append(res, attachment)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read deleted attachments: %w", err)
+	}
+	return res, nil
+}
+
+func scanAttachment(row scanner) (*model.Attachment, error) {
+	a := &model.Attachment{}
+	var thumbnails []byte
+
+	err := row.Scan(&a.ID, &a.ChatID, &a.MessageID, &a.UploadedBy, &a.Filename, &a.MimeType, &a.Size, &a.SHA256, &a.StorageKey, &a.CreatedAt,
+		&a.Width, &a.Height, &thumbnails)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []thumbnailRow
+	if err := json.Unmarshal(thumbnails, &rows); err != nil {
+		return nil, fmt.Errorf("decode thumbnails: %w", err)
+	}
+	for _, t := range rows {
+		a.Thumbnails = append(a.Thumbnails, &model.Thumbnail{
+			Width:      t.Width,
+			Height:     t.Height,
+			MimeType:   t.MimeType,
+			Size:       t.Size,
+			StorageKey: t.StorageKey,
+		})
+	}
+	return a, nil
+}
@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"chat/chat_server/internal/model"
+)
+
+type AttachmentRepository interface {
+	CreateAttachment(ctx context.Context, attachment *model.Attachment) (*model.Attachment, error)
+	GetAttachment(ctx context.Context, id int64) (*model.Attachment, error)
+	LinkAttachments(ctx context.Context, messageID, chatID int64, uploader string, ids []int64) (int64, error)
+	ListAttachments(ctx context.Context, messageIDs []int64) (map[int64][]*model.Attachment, error)
+	SetImageInfo(ctx context.Context, id int64, width, height int, thumbnails []*model.Thumbnail) error
+	DeleteMessageAttachments(ctx context.Context, messageID int64) ([]*model.Attachment, error)
+	DeleteChatAttachments(ctx context.Context, chatID int64) ([]*model.Attachment, error)
+	DeleteStaleUploads(ctx context.Context, before time.Time, limit int) ([]*model.Attachment, error)
+}
@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/repository"
+	"common/database/client"
+)
+
+type changeRepository struct {
+	db client.Client
+}
+
+func NewChangeRepository(db client.Client) repository.ChangeRepository {
+	return &changeRepository{db: db}
+}
+
+// AddChanges appends changes to the log. It should run in the transaction
+// that makes the changes, so that the log carries that transaction's id.
+func (r *changeRepository) AddChanges(ctx context.Context, changes ...*model.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	chatIDs := make([]int64, 0, len(changes))
+	kinds := make([]string, 0, len(changes))
+	messageIDs := make([]int64, 0, len(changes))
+	seqs := make([]int64, 0, len(changes))
+	usernames := make([]string, 0, len(changes))
+	roles := make([]string, 0, len(changes))
+	for _, c := range changes {
+		chatIDs = append(chatIDs, c.ChatID)
+		kinds = append(kinds, string(c.Kind))
+		messageIDs = append(messageIDs, c.MessageID)
+		seqs = append(seqs, c.Seq)
+		usernames = append(usernames, c.Username)
+		roles = append(roles, string(c.Role))
+	}
+
+	q := client.Query{
+		Name: "change_repository.AddChanges",
+		QueryRaw: `
+			INSERT INTO chat_changes (chat_id, kind, message_id, seq, username, role)
+			SELECT chat_id, kind, NULLIF(message_id, 0), NULLIF(seq, 0), NULLIF(username, ''), NULLIF(role, '')
+			FROM unnest($1::bigint[], $2::text[], $3::bigint[], $4::bigint[], $5::text[], $6::text[])
+				WITH ORDINALITY AS v(chat_id, kind, message_id, seq, username, role, n)
+			ORDER BY n`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, chatIDs, kinds, messageIDs, seqs, usernames, roles); err != nil {
+		return fmt.Errorf("insert changes: %w", err)
+	}
+	return nil
+}
+
+// ListChanges returns up to query.Limit changes, in log order, that were made
+// by transactions with ids in [query.From, query.To) and come after
+// query.After. A user sees the changes of the chats they are a member of and
+// every change about themselves, such as being removed from a chat.
+func (r *changeRepository) ListChanges(ctx context.Context, query *model.ChangeQuery) ([]*model.Change, error) {
+	q := client.Query{
+		Name: "change_repository.ListChanges",
+		QueryRaw: `
+			SELECT id, chat_id, kind, COALESCE(message_id, 0), COALESCE(seq, 0), COALESCE(username, ''), COALESCE(role, '')
+			FROM chat_changes
+			WHERE tx_id >= $2 AND tx_id < $3 AND id > $4
+			  AND (chat_id IN (SELECT chat_id FROM chat_users WHERE username = $1) OR username = $1)
+			ORDER BY id
+			LIMIT $5`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, query.Username, query.From, query.To, query.After, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("query changes: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Change
+	for rows.Next() {
+		c := &model.Change{}
+		if err := rows.Scan(&c.ID, &c.ChatID, &c.Kind, &c.MessageID, &c.Seq, &c.Username, &c.Role); err != nil {
+			return nil, fmt.Errorf("scan change: %w", err)
+		}
+		res = append(res, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read changes: %w", err)
+	}
+	return res, nil
+}
+
+// Horizon returns the lowest id of a transaction that may still be running.
+// Every change made by a transaction with a lower id is already visible.
+func (r *changeRepository) Horizon(ctx context.Context) (int64, error) {
+	q := client.Query{
+		Name:     "change_repository.Horizon",
+		QueryRaw: `SELECT pg_snapshot_xmin(pg_current_snapshot())::text::bigint`,
+	}
+
+	var horizon int64
+	if err := r.db.DB().QueryRowContext(ctx, q).Scan(&horizon); err != nil {
+		return 0, fmt.Errorf("snapshot xmin: %w", err)
+	}
+	return horizon, nil
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"chat/chat_server/internal/model"
+)
+
+type ChangeRepository interface {
+	AddChanges(ctx context.Context, changes ...*model.Change) error
+	ListChanges(ctx context.Context, query *model.ChangeQuery) ([]*model.Change, error)
+	Horizon(ctx context.Context) (int64, error)
+}
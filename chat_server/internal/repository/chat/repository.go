@@ -2,9 +2,13 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/jackc/pgx/v4"
+
+	"chat/chat_server/internal/model"
 	"chat/chat_server/internal/repository"
 	"common/database/client"
 	"common/database/transaction"
@@ -18,31 +22,59 @@ func NewChatRepository(db client.Client) repository.ChatRepository {
 	return &chatRepository{db: db}
 }
 
-func (r *chatRepository) CreateChat(ctx context.Context, usernames []string) (int64, error) {
+// CreateChat stores a chat with its members. The owner must come first in
+// members. It returns repository.ErrDuplicateKey if the owner has already
+// created a chat with the same client request id, or if the chat is direct
+// and its two members already have a direct chat.
+func (r *chatRepository) CreateChat(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) (int64, error) {
 	var chatID int64
 
+	var low, high *string
+	if info.Type == model.ChatTypeDirect && len(members) == 2 {
+		low, high = directPair(members[0].Username, members[1].Username)
+	}
+
 	txManager := transaction.NewTransactionManager(r.db.DB())
 
 	err := txManager.ReadCommitted(ctx, func(ctx context.Context) error {
 		now := time.Now()
 
 		q1 := client.Query{
-			Name:     "chat_repository.CreateChat.InsertChat",
-			QueryRaw: `INSERT INTO chats (created_at, updated_at) VALUES ($1,$1) RETURNING id`,
+			Name: "chat_repository.CreateChat.InsertChat",
+			QueryRaw: `
+				INSERT INTO chats (title, description, avatar_url, type, created_at, updated_at, created_by, client_request_id,
+					direct_user_low, direct_user_high)
+				VALUES ($1, $2, $3, $4, $5, $5, $6, NULLIF($7, ''), $8, $9)
+				ON CONFLICT DO NOTHING
+				RETURNING id`,
 		}
 
-		if err := r.db.DB().QueryRowContext(ctx, q1, now).Scan(&chatID); err != nil {
+		err := r.db.DB().QueryRowContext(ctx, q1,
+			info.Title,
+			info.Description,
+			info.AvatarURL,
+			info.Type,
+			now,
+			members[0].Username,
+			clientRequestID,
+			low,
+			high,
+		).Scan(&chatID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return repository.ErrDuplicateKey
+			}
 			return fmt.Errorf("insert chat: %w", err)
 		}
 
-		for _, u := range usernames {
+		for _, m := range members {
 			q2 := client.Query{
 				Name:     "chat_repository.CreateChat.InsertUser",
-				QueryRaw: `INSERT INTO chat_users (chat_id, username, created_at) VALUES ($1,$2,$3)`,
+				QueryRaw: `INSERT INTO chat_users (chat_id, username, role, created_at) VALUES ($1,$2,$3,$4)`,
 			}
 
-			if _, err := r.db.DB().ExecContext(ctx, q2, chatID, u, now); err != nil {
-				return fmt.Errorf("insert user %s: %w", u, err)
+			if _, err := r.db.DB().ExecContext(ctx, q2, chatID, m.Username, m.Role, now); err != nil {
+				return fmt.Errorf("insert user %s: %w", m.Username, err)
 			}
 		}
 
@@ -72,23 +104,10 @@ func (r *chatRepository) DeleteChat(ctx context.Context, chatID int64) error {
 	return nil
 }
 
-func (r *chatRepository) SendMessage(ctx context.Context, fromUser, text string, ts time.Time) error {
-	q := client.Query{
-		Name:     "chat_repository.SendMessage",
-		QueryRaw: `INSERT INTO messages (chat_id, from_user, text, timestamp, created_at) VALUES ($1,$2,$3,$4,$5)`,
-	}
-
-	_, err := r.db.DB().ExecContext(ctx, q, 1, fromUser, text, ts, time.Now())
-	if err != nil {
-		return fmt.Errorf("insert message: %w", err)
-	}
-	return nil
-}
-
-func (r *chatRepository) GetChatUsers(ctx context.Context, chatID int64) ([]string, error) {
+func (r *chatRepository) GetChatMembers(ctx context.Context, chatID int64) ([]*model.ChatMember, error) {
 	q := client.Query{
-		Name:     "chat_repository.GetChatUsers",
-		QueryRaw: `SELECT username FROM chat_users WHERE chat_id=$1 ORDER BY created_at`,
+		Name:     "chat_repository.GetChatMembers",
+		QueryRaw: `SELECT username, role FROM chat_users WHERE chat_id=$1 ORDER BY created_at`,
 	}
 
 	rows, err := r.db.DB().QueryContext(ctx, q, chatID)
@@ -96,17 +115,63 @@ func (r *chatRepository) GetChatUsers(ctx context.Context, chatID int64) ([]stri
 		return nil, fmt.Errorf("query users: %w", err)
 	}
 	defer rows.Close()
-	var res []string
+	var res []*model.ChatMember
 	for rows.Next() {
-		var u string
-		if err := rows.Scan(&u); err != nil {
+		var m model.ChatMember
+		if err := rows.Scan(&m.Username, &m.Role); err != nil {
 			return nil, err
 		}
-		res = append(res, u)
+		res = append(res, &m)
 	}
 	return res, nil
 }
 
+// FindDirectChat returns the id of the direct chat between two users, or zero
+// if there is none.
+func (r *chatRepository) FindDirectChat(ctx context.Context, a, b string) (int64, error) {
+	q := client.Query{
+		Name:     "chat_repository.FindDirectChat",
+		QueryRaw: `SELECT id FROM chats WHERE direct_user_low=$1 AND direct_user_high=$2`,
+	}
+
+	low, high := directPair(a, b)
+
+	var chatID int64
+	if err := r.db.DB().QueryRowContext(ctx, q, *low, *high).Scan(&chatID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("find direct chat: %w", err)
+	}
+	return chatID, nil
+}
+
+// directPair orders the members of a direct chat into its unique key.
+func directPair(a, b string) (low, high *string) {
+	if b < a {
+		a, b = b, a
+	}
+	return &a, &b
+}
+
+// FindByClientRequestID returns the id of the chat a user created with the
+// given client request id, or zero if there is none.
+func (r *chatRepository) FindByClientRequestID(ctx context.Context, createdBy, clientRequestID string) (int64, error) {
+	q := client.Query{
+		Name:     "chat_repository.FindByClientRequestID",
+		QueryRaw: `SELECT id FROM chats WHERE created_by=$1 AND client_request_id=$2`,
+	}
+
+	var chatID int64
+	if err := r.db.DB().QueryRowContext(ctx, q, createdBy, clientRequestID).Scan(&chatID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("find chat by client id: %w", err)
+	}
+	return chatID, nil
+}
+
 func (r *chatRepository) ChatExists(ctx context.Context, chatID int64) (bool, error) {
 	q := client.Query{
 		Name:     "chat_repository.ChatExists",
@@ -119,3 +184,369 @@ func (r *chatRepository) ChatExists(ctx context.Context, chatID int64) (bool, er
 	}
 	return exists, nil
 }
+
+func (r *chatRepository) IsChatMember(ctx context.Context, chatID int64, username string) (bool, error) {
+	q := client.Query{
+		Name:     "chat_repository.IsChatMember",
+		QueryRaw: `SELECT EXISTS(SELECT 1 FROM chat_users WHERE chat_id=$1 AND username=$2)`,
+	}
+
+	var member bool
+	if err := r.db.DB().QueryRowContext(ctx, q, chatID, username).Scan(&member); err != nil {
+		return false, fmt.Errorf("is member: %w", err)
+	}
+	return member, nil
+}
+
+// ListChats returns the chats of a user ordered by last activity, newest first.
+// Last activity is the creation time of the latest message, or of the chat itself
+// when it has no messages yet.
+func (r *chatRepository) ListChats(ctx context.Context, query *model.ChatListQuery) ([]*model.ChatSummary, error) {
+	q := client.Query{
+		Name: "chat_repository.ListChats",
+		QueryRaw: `
+			SELECT c.id, c.title, c.avatar_url, c.type,
+			       COALESCE(m.created_at, c.created_at) AS last_activity_at,
+			       m.id, m.from_user, m.text, m.timestamp, m.kind, m.edited_at, m.deleted_at IS NOT NULL,
+			       (SELECT COUNT(*)
+			          FROM messages u
+			         WHERE u.chat_id = c.id
+			           AND u.id > cu.last_read_message_id
+			           AND u.from_user <> cu.username
+			           AND (u.expires_at IS NULL OR u.expires_at > now())) AS unread_count
+			FROM chat_users cu
+			JOIN chats c ON c.id = cu.chat_id
+			LEFT JOIN LATERAL (
+			    SELECT id, from_user, text, timestamp, kind, edited_at, deleted_at, created_at
+			    FROM messages
+			    WHERE chat_id = c.id AND (expires_at IS NULL OR expires_at > now())
+			    ORDER BY id DESC
+			    LIMIT 1
+			) m ON TRUE
+			WHERE cu.username = $1
+			  AND ($2::timestamp IS NULL OR (COALESCE(m.created_at, c.created_at), c.id) < ($2::timestamp, $3))
+			ORDER BY last_activity_at DESC, c.id DESC
+			LIMIT $4`,
+	}
+
+	var afterAt *time.Time
+	var afterID int64
+	if query.After != nil {
+		afterAt = &query.After.LastActivityAt
+		afterID = query.After.ChatID
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, query.Username, afterAt, afterID, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("query chats: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.ChatSummary
+	for rows.Next() {
+		chat := &model.ChatSummary{Info: &model.ChatInfo{}}
+		var (
+			msgID     *int64
+			msgFrom   *string
+			msgText   *string
+			msgSentAt *time.Time
+			msgKind   *string
+			msgEdited *time.Time
+			msgGone   *bool
+		)
+		err := rows.Scan(
+			&chat.ID, &chat.Info.Title, &chat.Info.AvatarURL, &chat.Info.Type, &chat.LastActivityAt,
+			&msgID, &msgFrom, &msgText, &msgSentAt, &msgKind, &msgEdited, &msgGone,
+			&chat.UnreadCount,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan chat: %w", err)
+		}
+
+		if msgID != nil {
+			chat.LastMessage = &model.Message{
+				ID:        *msgID,
+				ChatID:    chat.ID,
+				From:      *msgFrom,
+				Text:      *msgText,
+				Timestamp: *msgSentAt,
+				Kind:      model.MessageKind(*msgKind),
+				Deleted:   *msgGone,
+			}
+			if msgEdited != nil {
+				chat.LastMessage.EditedAt = *msgEdited
+			}
+		}
+		res = append(res, chat)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read chats: %w", err)
+	}
+	return res, nil
+}
+
+// LockChat locks the chat row until the end of the current transaction so that
+// concurrent membership changes of the same chat are serialized.
+func (r *chatRepository) LockChat(ctx context.Context, chatID int64) (bool, error) {
+	q := client.Query{
+		Name:     "chat_repository.LockChat",
+		QueryRaw: `SELECT id FROM chats WHERE id=$1 FOR UPDATE`,
+	}
+
+	var id int64
+	err := r.db.DB().QueryRowContext(ctx, q, chatID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("lock chat: %w", err)
+	}
+	return true, nil
+}
+
+func (r *chatRepository) AddMembers(ctx context.Context, chatID int64, usernames []string) error {
+	q := client.Query{
+		Name:     "chat_repository.AddMembers",
+		QueryRaw: `INSERT INTO chat_users (chat_id, username, role, created_at) SELECT $1, u, $3, $4 FROM unnest($2::text[]) AS u`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, chatID, usernames, model.RoleMember, time.Now()); err != nil {
+		return fmt.Errorf("insert members: %w", err)
+	}
+	return nil
+}
+
+func (r *chatRepository) RemoveMember(ctx context.Context, chatID int64, username string) (bool, error) {
+	q := client.Query{
+		Name:     "chat_repository.RemoveMember",
+		QueryRaw: `DELETE FROM chat_users WHERE chat_id=$1 AND username=$2`,
+	}
+
+	cmd, err := r.db.DB().ExecContext(ctx, q, chatID, username)
+	if err != nil {
+		return false, fmt.Errorf("delete member: %w", err)
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+func (r *chatRepository) SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) (bool, error) {
+	q := client.Query{
+		Name:     "chat_repository.SetMemberRole",
+		QueryRaw: `UPDATE chat_users SET role=$3 WHERE chat_id=$1 AND username=$2`,
+	}
+
+	cmd, err := r.db.DB().ExecContext(ctx, q, chatID, username, role)
+	if err != nil {
+		return false, fmt.Errorf("update member role: %w", err)
+	}
+	return cmd.RowsAffected() > 0, nil
+}
+
+func (r *chatRepository) GetChat(ctx context.Context, chatID int64) (*model.Chat, error) {
+	q := client.Query{
+		Name: "chat_repository.GetChat",
+		QueryRaw: `
+			SELECT id, title, description, avatar_url, type, message_ttl_seconds, created_at, updated_at
+			FROM chats
+			WHERE id = $1`,
+	}
+
+	chat := &model.Chat{Info: &model.ChatInfo{}}
+	var (
+		ttlSeconds int64
+		updatedAt  *time.Time
+	)
+	err := r.db.DB().QueryRowContext(ctx, q, chatID).Scan(
+		&chat.ID,
+		&chat.Info.Title,
+		&chat.Info.Description,
+		&chat.Info.AvatarURL,
+		&chat.Info.Type,
+		&ttlSeconds,
+		&chat.CreatedAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get chat: %w", err)
+	}
+
+	chat.MessageTTL = time.Duration(ttlSeconds) * time.Second
+
+	if updatedAt != nil {
+		chat.UpdatedAt = *updatedAt
+	}
+	return chat, nil
+}
+
+func (r *chatRepository) UpdateChat(ctx context.Context, update *model.ChatUpdate) error {
+	q := client.Query{
+		Name: "chat_repository.UpdateChat",
+		QueryRaw: `
+			UPDATE chats
+			SET title = COALESCE($2, title),
+			    description = COALESCE($3, description),
+			    avatar_url = COALESCE($4, avatar_url),
+			    message_ttl_seconds = COALESCE($5, message_ttl_seconds),
+			    updated_at = $6
+			WHERE id = $1`,
+	}
+
+	var ttlSeconds *int64
+	if update.MessageTTL != nil {
+		s := int64(*update.MessageTTL / time.Second)
+		ttlSeconds = &s
+	}
+
+	_, err := r.db.DB().ExecContext(ctx, q,
+		update.ID,
+		update.Title,
+		update.Description,
+		update.AvatarURL,
+		ttlSeconds,
+		time.Now(),
+	)
+	if err != nil {
+		return fmt.Errorf("update chat: %w", err)
+	}
+	return nil
+}
+
+func (r *chatRepository) GetReadCursors(ctx context.Context, chatID int64) ([]*model.ReadCursor, error) {
+	q := client.Query{
+		Name:     "chat_repository.GetReadCursors",
+		QueryRaw: `SELECT username, last_read_message_id FROM chat_users WHERE chat_id=$1 ORDER BY created_at`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("query read cursors: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.ReadCursor
+	for rows.Next() {
+		c := &model.ReadCursor{ChatID: chatID}
+		if err := rows.Scan(&c.Username, &c.MessageID); err != nil {
+			return nil, fmt.Errorf("scan read cursor: %w", err)
+		}
+		res = append(res, c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read read cursors: %w", err)
+	}
+	return res, nil
+}
+
+// GetReadCursor returns the stored read cursor of a member, or nil when the
+// user is not a member of the chat.
+func (r *chatRepository) GetReadCursor(ctx context.Context, chatID int64, username string) (*model.ReadCursor, error) {
+	q := client.Query{
+		Name:     "chat_repository.GetReadCursor",
+		QueryRaw: `SELECT last_read_message_id FROM chat_users WHERE chat_id=$1 AND username=$2`,
+	}
+
+	c := &model.ReadCursor{ChatID: chatID, Username: username}
+	if err := r.db.DB().QueryRowContext(ctx, q, chatID, username).Scan(&c.MessageID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get read cursor: %w", err)
+	}
+	return c, nil
+}
+
+// SaveReadCursors moves the read cursors of several members forward in one
+// statement. A cursor never goes backwards and never past the last message of
+// its chat; cursors of users who are no longer members are ignored. Cursors
+// that moved are recorded in the change log by the same statement.
+func (r *chatRepository) SaveReadCursors(ctx context.Context, cursors []*model.ReadCursor) error {
+	chatIDs := make([]int64, 0, len(cursors))
+	usernames := make([]string, 0, len(cursors))
+	messageIDs := make([]int64, 0, len(cursors))
+	for _, c := range cursors {
+		chatIDs = append(chatIDs, c.ChatID)
+		usernames = append(usernames, c.Username)
+		messageIDs = append(messageIDs, c.MessageID)
+	}
+
+	q := client.Query{
+		Name: "chat_repository.SaveReadCursors",
+		QueryRaw: `
+			WITH moved AS (
+				UPDATE chat_users cu
+				SET last_read_message_id = GREATEST(
+					cu.last_read_message_id,
+					LEAST(v.message_id, COALESCE((SELECT MAX(m.id) FROM messages m WHERE m.chat_id = cu.chat_id), 0))
+				)
+				FROM unnest($1::bigint[], $2::text[], $3::bigint[]) AS v(chat_id, username, message_id)
+				WHERE cu.chat_id = v.chat_id AND cu.username = v.username AND v.message_id > cu.last_read_message_id
+				RETURNING cu.chat_id, cu.username, cu.last_read_message_id
+			)
+			INSERT INTO chat_changes (chat_id, kind, message_id, username)
+			SELECT chat_id, $4, last_read_message_id, username FROM moved`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, chatIDs, usernames, messageIDs, model.ChangeRead); err != nil {
+		return fmt.Errorf("update read cursors: %w", err)
+	}
+	return nil
+}
+
+// ListChatIDs returns the ids of every chat the user is a member of.
+func (r *chatRepository) ListChatIDs(ctx context.Context, username string) ([]int64, error) {
+	q := client.Query{
+		Name:     "chat_repository.ListChatIDs",
+		QueryRaw: `SELECT chat_id FROM chat_users WHERE username=$1`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, username)
+	if err != nil {
+		return nil, fmt.Errorf("query chat ids: %w", err)
+	}
+	defer rows.Close()
+
+	var res []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scan chat id: %w", err)
+		}
+		res = append(res, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read chat ids: %w", err)
+	}
+	return res, nil
+}
+
+// FilterContacts returns those of usernames that share at least one chat with
+// username.
+func (r *chatRepository) FilterContacts(ctx context.Context, username string, usernames []string) ([]string, error) {
+	q := client.Query{
+		Name: "chat_repository.FilterContacts",
+		QueryRaw: `
+			SELECT DISTINCT other.username
+			FROM chat_users self
+			JOIN chat_users other ON other.chat_id = self.chat_id
+			WHERE self.username = $1 AND other.username = ANY($2)`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, username, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("query contacts: %w", err)
+	}
+	defer rows.Close()
+
+	var res []string
+	for rows.Next() {
+		var contact string
+		if err := rows.Scan(&contact); err != nil {
+			return nil, fmt.Errorf("scan contact: %w", err)
+		}
+		res = append(res, contact)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read contacts: %w", err)
+	}
+	return res, nil
+}
@@ -2,13 +2,28 @@ package repository
 
 import (
 	"context"
-	"time"
+
+	"chat/chat_server/internal/model"
 )
 
 type ChatRepository interface {
-	CreateChat(ctx context.Context, usernames []string) (int64, error)
+	CreateChat(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) (int64, error)
+	FindByClientRequestID(ctx context.Context, createdBy, clientRequestID string) (int64, error)
+	FindDirectChat(ctx context.Context, a, b string) (int64, error)
 	DeleteChat(ctx context.Context, chatID int64) error
-	SendMessage(ctx context.Context, fromUser, text string, ts time.Time) error
-	GetChatUsers(ctx context.Context, chatID int64) ([]string, error)
+	GetChatMembers(ctx context.Context, chatID int64) ([]*model.ChatMember, error)
 	ChatExists(ctx context.Context, chatID int64) (bool, error)
+	IsChatMember(ctx context.Context, chatID int64, username string) (bool, error)
+	ListChats(ctx context.Context, query *model.ChatListQuery) ([]*model.ChatSummary, error)
+	LockChat(ctx context.Context, chatID int64) (bool, error)
+	AddMembers(ctx context.Context, chatID int64, usernames []string) error
+	RemoveMember(ctx context.Context, chatID int64, username string) (bool, error)
+	SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) (bool, error)
+	GetChat(ctx context.Context, chatID int64) (*model.Chat, error)
+	UpdateChat(ctx context.Context, update *model.ChatUpdate) error
+	GetReadCursors(ctx context.Context, chatID int64) ([]*model.ReadCursor, error)
+	GetReadCursor(ctx context.Context, chatID int64, username string) (*model.ReadCursor, error)
+	SaveReadCursors(ctx context.Context, cursors []*model.ReadCursor) error
+	ListChatIDs(ctx context.Context, username string) ([]int64, error)
+	FilterContacts(ctx context.Context, username string, usernames []string) ([]string, error)
 }
@@ -0,0 +1,7 @@
+package repository
+
+import "errors"
+
+// ErrDuplicateKey is returned by inserts that carry a client idempotency key
+// which has already been stored by an earlier request.
+var ErrDuplicateKey = errors.New("idempotency key already used")
@@ -1,3 +1,7 @@
 package repository
 
 //go:generate minimock -i ChatRepository -o ./mocks -s _mock.go
+//go:generate minimock -i MessageRepository -o ./mocks -s _mock.go
+//go:generate minimock -i AttachmentRepository -o ./mocks -s _mock.go
+//go:generate minimock -i ChangeRepository -o ./mocks -s _mock.go
+//go:generate minimock -i ScheduledRepository -o ./mocks -s _mock.go
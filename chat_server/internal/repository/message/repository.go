@@ -0,0 +1,806 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/repository"
+	"common/database/client"
+)
+
+// messageColumns is the column list read by scanMessage.
+const messageColumns = `id, chat_id, from_user, text, timestamp, kind, edited_at, deleted_at IS NOT NULL,
+	COALESCE(reply_to_message_id, 0), reply_count, last_reply_at, seq, expires_at`
+
+// notExpired leaves out messages that are past their expiry but have not been
+// deleted by the reaper yet.
+const notExpired = `(expires_at IS NULL OR expires_at > now())`
+
+// escapedText is the message text with HTML special characters escaped, so
+// that the only markup in a search snippet is the highlighting.
+const escapedText = `replace(replace(replace(replace(replace(text,
+	'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'), '''', '&#39;')`
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+type messageRepository struct {
+	db client.Client
+}
+
+func NewMessageRepository(db client.Client) repository.MessageRepository {
+	return &messageRepository{db: db}
+}
+
+// SendMessage stores a new message with the next sequence number of its chat.
+// It must run in a transaction: taking the number locks the chat row until
+// commit, and rolling back returns the number. It returns
+// repository.ErrDuplicateKey if the sender has already stored a message with
+// the same client message id in the chat.
+func (r *messageRepository) SendMessage(ctx context.Context, msg *model.Message) (*model.Message, error) {
+	q1 := client.Query{
+		Name:     "message_repository.SendMessage.NextSeq",
+		QueryRaw: `UPDATE chats SET last_seq = last_seq + 1 WHERE id = $1 RETURNING last_seq, message_ttl_seconds`,
+	}
+
+	stored := *msg
+	if stored.Kind == "" {
+		stored.Kind = model.MessageKindUser
+	}
+
+	var chatTTL int64
+	if err := r.db.DB().QueryRowContext(ctx, q1, stored.ChatID).Scan(&stored.Seq, &chatTTL); err != nil {
+		return nil, fmt.Errorf("next seq of chat %d: %w", stored.ChatID, err)
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	if ttl := shorterTTL(stored.TTL, time.Duration(chatTTL)*time.Second); ttl > 0 {
+		stored.ExpiresAt = now.Add(ttl)
+		expiresAt = &stored.ExpiresAt
+	}
+
+	q2 := client.Query{
+		Name: "message_repository.SendMessage",
+		QueryRaw: `
+			INSERT INTO messages (chat_id, from_user, text, timestamp, kind, created_at, reply_to_message_id, client_message_id, seq, expires_at)
+			VALUES ($1,$2,$3,$4,$5,$6,NULLIF($7, 0),NULLIF($8, ''),$9,$10)
+			ON CONFLICT (chat_id, from_user, client_message_id) WHERE client_message_id IS NOT NULL DO NOTHING
+			RETURNING id`,
+	}
+
+	err := r.db.DB().QueryRowContext(ctx, q2,
+		stored.ChatID,
+		stored.From,
+		stored.Text,
+		stored.Timestamp,
+		stored.Kind,
+		now,
+		stored.ReplyTo,
+		stored.ClientMessageID,
+		stored.Seq,
+		expiresAt,
+	).Scan(&stored.ID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repository.ErrDuplicateKey
+		}
+		return nil, fmt.Errorf("insert message: %w", err)
+	}
+	return &stored, nil
+}
+
+// shorterTTL returns the shorter of two TTLs, where zero means no TTL.
+func shorterTTL(a, b time.Duration) time.Duration {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// LockExpired locks up to limit messages that expired at now, skipping the
+// ones another transaction holds, and returns them together with the replies
+// in their threads, which go away with them. The replies are locked too, so a
+// reply that another transaction deletes first is left out instead of being
+// reported twice.
+func (r *messageRepository) LockExpired(ctx context.Context, now time.Time, limit int) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.LockExpired",
+		QueryRaw: `
+			WITH expired AS (
+				SELECT id FROM messages
+				WHERE expires_at <= $1
+				ORDER BY expires_at, id
+				LIMIT $2
+				FOR UPDATE SKIP LOCKED
+			)
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE id IN (SELECT id FROM expired) OR reply_to_message_id IN (SELECT id FROM expired)
+			ORDER BY id
+			FOR UPDATE`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, now, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query expired messages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan expired message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read expired messages: %w", err)
+	}
+	return res, nil
+}
+
+// PurgeMessages removes messages for good, together with everything that
+// hangs off them, such as revisions, reactions, mentions and pins.
+func (r *messageRepository) PurgeMessages(ctx context.Context, ids []int64) error {
+	q := client.Query{
+		Name:     "message_repository.PurgeMessages",
+		QueryRaw: `DELETE FROM messages WHERE id = ANY($1)`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, ids); err != nil {
+		return fmt.Errorf("purge messages: %w", err)
+	}
+	return nil
+}
+
+// RecountReplies recomputes the reply count and last reply time of thread
+// roots from the replies that are left.
+func (r *messageRepository) RecountReplies(ctx context.Context, rootIDs []int64) error {
+	q := client.Query{
+		Name: "message_repository.RecountReplies",
+		QueryRaw: `
+			UPDATE messages root
+			SET reply_count = counts.reply_count, last_reply_at = counts.last_reply_at
+			FROM (
+				SELECT r.id, COUNT(m.id) AS reply_count, MAX(m.created_at) AS last_reply_at
+				FROM unnest($1::bigint[]) AS r(id)
+				LEFT JOIN messages m ON m.reply_to_message_id = r.id
+				GROUP BY r.id
+			) counts
+			WHERE root.id = counts.id`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, rootIDs); err != nil {
+		return fmt.Errorf("recount replies: %w", err)
+	}
+	return nil
+}
+
+// FindByClientMessageID returns the message a sender stored in a chat with
+// the given client message id, or nil if there is none.
+func (r *messageRepository) FindByClientMessageID(ctx context.Context, chatID int64, from, clientMessageID string) (*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.FindByClientMessageID",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE chat_id = $1 AND from_user = $2 AND client_message_id = $3 AND ` + notExpired + ``,
+	}
+
+	msg, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, chatID, from, clientMessageID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("find message by client id: %w", err)
+	}
+	return msg, nil
+}
+
+// ListMessagesBySeq returns up to limit messages of a chat, thread replies
+// included, whose sequence numbers lie in [fromSeq, toSeq], in sequence order.
+func (r *messageRepository) ListMessagesBySeq(ctx context.Context, chatID, fromSeq, toSeq int64, limit int) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.ListMessagesBySeq",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE chat_id = $1 AND seq BETWEEN $2 AND $3 AND ` + notExpired + `
+			ORDER BY seq
+			LIMIT $4`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, chatID, fromSeq, toSeq, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query messages by seq: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read messages: %w", err)
+	}
+	return res, nil
+}
+
+// ListMessages returns up to query.Limit messages of a chat strictly after the
+// cursor in the requested direction, using the (chat_id, id) index as the key.
+// Thread replies are left out; they are listed with ListReplies.
+func (r *messageRepository) ListMessages(ctx context.Context, query *model.MessageListQuery) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.ListMessages.Backward",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE chat_id = $1 AND ($2 = 0 OR id < $2) AND reply_to_message_id IS NULL AND ` + notExpired + `
+			ORDER BY id DESC
+			LIMIT $3`,
+	}
+	if query.Direction == model.DirectionForward {
+		q = client.Query{
+			Name: "message_repository.ListMessages.Forward",
+			QueryRaw: `
+				SELECT ` + messageColumns + `
+				FROM messages
+				WHERE chat_id = $1 AND id > $2 AND reply_to_message_id IS NULL AND ` + notExpired + `
+				ORDER BY id ASC
+				LIMIT $3`,
+		}
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, query.ChatID, query.Cursor, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("query messages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read messages: %w", err)
+	}
+	return res, nil
+}
+
+// GetMessage returns a message by id, or nil if it does not exist or has
+// expired.
+func (r *messageRepository) GetMessage(ctx context.Context, messageID int64) (*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.GetMessage",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE id = $1 AND ` + notExpired + ``,
+	}
+
+	msg, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, messageID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("get message: %w", err)
+	}
+	return msg, nil
+}
+
+// ListMessagesByIDs returns the messages with the given ids that exist and
+// have not expired, in id order.
+func (r *messageRepository) ListMessagesByIDs(ctx context.Context, ids []int64) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.ListMessagesByIDs",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE id = ANY($1) AND ` + notExpired + `
+			ORDER BY id`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, ids)
+	if err != nil {
+		return nil, fmt.Errorf("query messages by ids: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read messages: %w", err)
+	}
+	return res, nil
+}
+
+// LockMessage returns a message and locks it until the end of the current
+// transaction. It returns nil if the message does not exist or has expired.
+func (r *messageRepository) LockMessage(ctx context.Context, messageID int64) (*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.LockMessage",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE id = $1 AND ` + notExpired + `
+			FOR UPDATE`,
+	}
+
+	msg, err := scanMessage(r.db.DB().QueryRowContext(ctx, q, messageID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("lock message: %w", err)
+	}
+	return msg, nil
+}
+
+// EditMessage keeps the current text of a message as a revision and replaces it.
+func (r *messageRepository) EditMessage(ctx context.Context, messageID int64, text string) (*model.Message, error) {
+	now := time.Now()
+
+	q1 := client.Query{
+		Name: "message_repository.EditMessage.InsertRevision",
+		QueryRaw: `
+			INSERT INTO message_revisions (message_id, text, created_at)
+			SELECT id, text, $2 FROM messages WHERE id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q1, messageID, now); err != nil {
+		return nil, fmt.Errorf("insert revision: %w", err)
+	}
+
+	q2 := client.Query{
+		Name: "message_repository.EditMessage.UpdateMessage",
+		QueryRaw: `
+			UPDATE messages
+			SET text = $2, edited_at = $3
+			WHERE id = $1
+			RETURNING ` + messageColumns,
+	}
+
+	msg, err := scanMessage(r.db.DB().QueryRowContext(ctx, q2, messageID, text, now))
+	if err != nil {
+		return nil, fmt.Errorf("update message: %w", err)
+	}
+	return msg, nil
+}
+
+// DeleteMessage turns a message into a tombstone: its text and revisions are
+// removed, while the row stays in the history marked as deleted.
+func (r *messageRepository) DeleteMessage(ctx context.Context, messageID int64) error {
+	q1 := client.Query{
+		Name:     "message_repository.DeleteMessage.DeleteRevisions",
+		QueryRaw: `DELETE FROM message_revisions WHERE message_id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q1, messageID); err != nil {
+		return fmt.Errorf("delete revisions: %w", err)
+	}
+
+	q2 := client.Query{
+		Name:     "message_repository.DeleteMessage.UpdateMessage",
+		QueryRaw: `UPDATE messages SET text = '', deleted_at = $2 WHERE id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q2, messageID, time.Now()); err != nil {
+		return fmt.Errorf("delete message: %w", err)
+	}
+	return nil
+}
+
+// ListReplies returns up to limit replies of a thread after the cursor, oldest first.
+func (r *messageRepository) ListReplies(ctx context.Context, rootID int64, cursor int64, limit int) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.ListReplies",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE reply_to_message_id = $1 AND id > $2 AND ` + notExpired + `
+			ORDER BY id ASC
+			LIMIT $3`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, rootID, cursor, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query replies: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan reply: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read replies: %w", err)
+	}
+	return res, nil
+}
+
+// AddReply bumps the reply count and the last reply time of a thread root.
+func (r *messageRepository) AddReply(ctx context.Context, rootID int64, at time.Time) error {
+	q := client.Query{
+		Name:     "message_repository.AddReply",
+		QueryRaw: `UPDATE messages SET reply_count = reply_count + 1, last_reply_at = $2 WHERE id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, rootID, at); err != nil {
+		return fmt.Errorf("update thread root: %w", err)
+	}
+	return nil
+}
+
+// AddReaction stores a reaction and reports whether it was not there yet.
+func (r *messageRepository) AddReaction(ctx context.Context, messageID int64, username, emoji string) (bool, error) {
+	q := client.Query{
+		Name: "message_repository.AddReaction",
+		QueryRaw: `
+			INSERT INTO message_reactions (message_id, username, emoji, created_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, messageID, username, emoji, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("insert reaction: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// SetMentions replaces the users a message mentions.
+func (r *messageRepository) SetMentions(ctx context.Context, messageID, chatID int64, usernames []string) error {
+	q1 := client.Query{
+		Name:     "message_repository.SetMentions.Delete",
+		QueryRaw: `DELETE FROM message_mentions WHERE message_id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q1, messageID); err != nil {
+		return fmt.Errorf("delete mentions: %w", err)
+	}
+
+	if len(usernames) == 0 {
+		return nil
+	}
+
+	q2 := client.Query{
+		Name: "message_repository.SetMentions.Insert",
+		QueryRaw: `
+			INSERT INTO message_mentions (message_id, chat_id, username, created_at)
+			SELECT $1, $2, u, $4 FROM unnest($3::text[]) AS u
+			ON CONFLICT DO NOTHING`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q2, messageID, chatID, usernames, time.Now()); err != nil {
+		return fmt.Errorf("insert mentions: %w", err)
+	}
+	return nil
+}
+
+// ListMentionedUsers returns the users mentioned by each of the messages.
+func (r *messageRepository) ListMentionedUsers(ctx context.Context, messageIDs []int64) (map[int64][]string, error) {
+	q := client.Query{
+		Name: "message_repository.ListMentionedUsers",
+		QueryRaw: `
+			SELECT message_id, username
+			FROM message_mentions
+			WHERE message_id = ANY($1)`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, messageIDs)
+	if err != nil {
+		return nil, fmt.Errorf("query mentions: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[int64][]string)
+	for rows.Next() {
+		var (
+			messageID int64
+			username  string
+		)
+		if err := rows.Scan(&messageID, &username); err != nil {
+			return nil, fmt.Errorf("scan mention: %w", err)
+		}
+		res[messageID] = append(res[messageID], username)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read mentions: %w", err)
+	}
+	return res, nil
+}
+
+// ListMentions returns up to query.Limit messages that mention the user,
+// newest first, from the chats the user is still a member of. Deleted
+// messages are left out.
+func (r *messageRepository) ListMentions(ctx context.Context, query *model.MentionQuery) ([]*model.Message, error) {
+	q := client.Query{
+		Name: "message_repository.ListMentions",
+		QueryRaw: `
+			SELECT ` + messageColumns + `
+			FROM messages
+			WHERE id IN (
+				SELECT mm.message_id
+				FROM message_mentions mm
+				JOIN chat_users cu ON cu.chat_id = mm.chat_id AND cu.username = mm.username
+				WHERE mm.username = $1 AND ($2 = 0 OR mm.message_id < $2)
+			) AND deleted_at IS NULL AND ` + notExpired + `
+			ORDER BY id DESC
+			LIMIT $3`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, query.Username, query.Cursor, query.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("query mentions: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.Message
+	for rows.Next() {
+		msg, err := scanMessage(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read messages: %w", err)
+	}
+	return res, nil
+}
+
+// AddPin pins a message and reports whether it was not pinned before.
+func (r *messageRepository) AddPin(ctx context.Context, chatID, messageID int64, pinnedBy string) (bool, error) {
+	q := client.Query{
+		Name: "message_repository.AddPin",
+		QueryRaw: `
+			INSERT INTO pinned_messages (message_id, chat_id, pinned_by, pinned_at)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT DO NOTHING`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, messageID, chatID, pinnedBy, time.Now())
+	if err != nil {
+		return false, fmt.Errorf("insert pin: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// RemovePin unpins a message and reports whether it was pinned.
+func (r *messageRepository) RemovePin(ctx context.Context, messageID int64) (bool, error) {
+	q := client.Query{
+		Name:     "message_repository.RemovePin",
+		QueryRaw: `DELETE FROM pinned_messages WHERE message_id = $1`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, messageID)
+	if err != nil {
+		return false, fmt.Errorf("delete pin: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (r *messageRepository) CountPins(ctx context.Context, chatID int64) (int, error) {
+	q := client.Query{
+		Name:     "message_repository.CountPins",
+		QueryRaw: `SELECT COUNT(*) FROM pinned_messages WHERE chat_id = $1`,
+	}
+
+	var count int
+	if err := r.db.DB().QueryRowContext(ctx, q, chatID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("count pins: %w", err)
+	}
+	return count, nil
+}
+
+// ListPinned returns the pinned messages of a chat, most recently pinned first.
+func (r *messageRepository) ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error) {
+	q := client.Query{
+		Name: "message_repository.ListPinned",
+		QueryRaw: `
+			SELECT ` + messageColumns + `, pinned_by, pinned_at
+			FROM messages
+			JOIN (
+				SELECT message_id, pinned_by, pinned_at FROM pinned_messages WHERE chat_id = $1
+			) p ON p.message_id = id
+			WHERE ` + notExpired + `
+			ORDER BY pinned_at DESC, id DESC`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("query pins: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.PinnedMessage
+	for rows.Next() {
+		pin := &model.PinnedMessage{}
+		pin.Message, err = scanMessage(pinScanner{rows, pin})
+		if err != nil {
+			return nil, fmt.Errorf("scan pin: %w", err)
+		}
+		res = append(res, pin)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read pins: %w", err)
+	}
+	return res, nil
+}
+
+// RemoveReaction deletes a reaction and reports whether it existed.
+func (r *messageRepository) RemoveReaction(ctx context.Context, messageID int64, username, emoji string) (bool, error) {
+	q := client.Query{
+		Name:     "message_repository.RemoveReaction",
+		QueryRaw: `DELETE FROM message_reactions WHERE message_id = $1 AND username = $2 AND emoji = $3`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, messageID, username, emoji)
+	if err != nil {
+		return false, fmt.Errorf("delete reaction: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ListReactions aggregates the reactions of the given messages per emoji,
+// flagging the ones added by username.
+func (r *messageRepository) ListReactions(ctx context.Context, messageIDs []int64, username string) (map[int64][]*model.Reaction, error) {
+	q := client.Query{
+		Name: "message_repository.ListReactions",
+		QueryRaw: `
+			SELECT message_id, emoji, COUNT(*), BOOL_OR(username = $2)
+			FROM message_reactions
+			WHERE message_id = ANY($1)
+			GROUP BY message_id, emoji
+			ORDER BY message_id, MIN(created_at), emoji`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, messageIDs, username)
+	if err != nil {
+		return nil, fmt.Errorf("query reactions: %w", err)
+	}
+	defer rows.Close()
+
+	res := make(map[int64][]*model.Reaction)
+	for rows.Next() {
+		var messageID int64
+		reaction := &model.Reaction{}
+		if err := rows.Scan(&messageID, &reaction.Emoji, &reaction.Count, &reaction.Reacted); err != nil {
+			return nil, fmt.Errorf("scan reaction: %w", err)
+		}
+		res[messageID] = append(res[messageID], reaction)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read reactions: %w", err)
+	}
+	return res, nil
+}
+
+// SearchMessages finds messages matching a web search query in the chats the
+// user is a member of, newest first. Deleted and system messages are skipped.
+// Snippets are HTML: the text is escaped and matches are wrapped in <b>.
+func (r *messageRepository) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) ([]*model.SearchResult, error) {
+	q := client.Query{
+		Name: "message_repository.SearchMessages",
+		QueryRaw: `
+			SELECT ` + messageColumns + `,
+				ts_headline('simple', ` + escapedText + `, search, 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=20, MinWords=5')
+			FROM messages, websearch_to_tsquery('simple', $2) AS search
+			WHERE text_search @@ search
+			  AND chat_id IN (SELECT chat_id FROM chat_users WHERE username = $1)
+			  AND deleted_at IS NULL AND kind = 'user' AND ` + notExpired + `
+			  AND ($3 = 0 OR chat_id = $3)
+			  AND ($4 = '' OR from_user = $4)
+			  AND ($5::timestamp IS NULL OR timestamp < $5)
+			  AND ($6::timestamp IS NULL OR timestamp >= $6)
+			  AND ($7 = 0 OR id < $7)
+			ORDER BY id DESC
+			LIMIT $8`,
+	}
+
+	var before, after *time.Time
+	if !query.Before.IsZero() {
+		before = &query.Before
+	}
+	if !query.After.IsZero() {
+		after = &query.After
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q,
+		query.Username,
+		query.Query,
+		query.ChatID,
+		query.From,
+		before,
+		after,
+		query.Cursor,
+		query.Limit,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("search messages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.SearchResult
+	for rows.Next() {
+		result := &model.SearchResult{}
+		result.Message, err = scanMessage(snippetScanner{rows, &result.Snippet})
+		if err != nil {
+			return nil, fmt.Errorf("scan search result: %w", err)
+		}
+		res = append(res, result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read search results: %w", err)
+	}
+	return res, nil
+}
+
+// snippetScanner reads the trailing snippet column of a search result after
+// the message columns.
+type snippetScanner struct {
+	row     scanner
+	snippet *string
+}
+
+func (s snippetScanner) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, s.snippet)...)
+}
+
+// pinScanner reads the trailing pin columns after the message columns.
+type pinScanner struct {
+	row scanner
+	pin *model.PinnedMessage
+}
+
+func (s pinScanner) Scan(dest ...interface{}) error {
+	return s.row.Scan(append(dest, &s.pin.PinnedBy, &s.pin.PinnedAt)...)
+}
+
+func scanMessage(row scanner) (*model.Message, error) {
+	msg := &model.Message{}
+	var editedAt, lastReplyAt, expiresAt *time.Time
+
+	err := row.Scan(&msg.ID, &msg.ChatID, &msg.From, &msg.Text, &msg.Timestamp, &msg.Kind, &editedAt, &msg.Deleted,
+		&msg.ReplyTo, &msg.ReplyCount, &lastReplyAt, &msg.Seq, &expiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	if editedAt != nil {
+		msg.EditedAt = *editedAt
+	}
+	if lastReplyAt != nil {
+		msg.LastReplyAt = *lastReplyAt
+	}
+	if expiresAt != nil {
+		msg.ExpiresAt = *expiresAt
+	}
+	return msg, nil
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"chat/chat_server/internal/model"
+)
+
+type MessageRepository interface {
+	SendMessage(ctx context.Context, msg *model.Message) (*model.Message, error)
+	FindByClientMessageID(ctx context.Context, chatID int64, from, clientMessageID string) (*model.Message, error)
+	ListMessages(ctx context.Context, query *model.MessageListQuery) ([]*model.Message, error)
+	ListMessagesBySeq(ctx context.Context, chatID, fromSeq, toSeq int64, limit int) ([]*model.Message, error)
+	GetMessage(ctx context.Context, messageID int64) (*model.Message, error)
+	ListMessagesByIDs(ctx context.Context, ids []int64) ([]*model.Message, error)
+	LockMessage(ctx context.Context, messageID int64) (*model.Message, error)
+	EditMessage(ctx context.Context, messageID int64, text string) (*model.Message, error)
+	DeleteMessage(ctx context.Context, messageID int64) error
+	LockExpired(ctx context.Context, now time.Time, limit int) ([]*model.Message, error)
+	PurgeMessages(ctx context.Context, ids []int64) error
+	RecountReplies(ctx context.Context, rootIDs []int64) error
+	ListReplies(ctx context.Context, rootID int64, cursor int64, limit int) ([]*model.Message, error)
+	AddReply(ctx context.Context, rootID int64, at time.Time) error
+	AddReaction(ctx context.Context, messageID int64, username, emoji string) (bool, error)
+	RemoveReaction(ctx context.Context, messageID int64, username, emoji string) (bool, error)
+	AddPin(ctx context.Context, chatID, messageID int64, pinnedBy string) (bool, error)
+	RemovePin(ctx context.Context, messageID int64) (bool, error)
+	CountPins(ctx context.Context, chatID int64) (int, error)
+	ListPinned(ctx context.Context, chatID int64) ([]*model.PinnedMessage, error)
+	SearchMessages(ctx context.Context, query *model.MessageSearchQuery) ([]*model.SearchResult, error)
+	ListReactions(ctx context.Context, messageIDs []int64, username string) (map[int64][]*model.Reaction, error)
+	SetMentions(ctx context.Context, messageID, chatID int64, usernames []string) error
+	ListMentionedUsers(ctx context.Context, messageIDs []int64) (map[int64][]string, error)
+	ListMentions(ctx context.Context, query *model.MentionQuery) ([]*model.Message, error)
+}
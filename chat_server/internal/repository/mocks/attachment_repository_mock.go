@@ -0,0 +1,3127 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat/chat_server/internal/repository.AttachmentRepository -o attachment_repository_mock.go -n AttachmentRepositoryMock -p mocks
+
+import (
+	"chat/chat_server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// AttachmentRepositoryMock implements mm_repository.AttachmentRepository
+type AttachmentRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAttachment          func(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error)
+	funcCreateAttachmentOrigin    string
+	inspectFuncCreateAttachment   func(ctx context.Context, attachment *model.Attachment)
+	afterCreateAttachmentCounter  uint64
+	beforeCreateAttachmentCounter uint64
+	CreateAttachmentMock          mAttachmentRepositoryMockCreateAttachment
+
+	funcDeleteChatAttachments          func(ctx context.Context, chatID int64) (apa1 []*model.Attachment, err error)
+	funcDeleteChatAttachmentsOrigin    string
+	inspectFuncDeleteChatAttachments   func(ctx context.Context, chatID int64)
+	afterDeleteChatAttachmentsCounter  uint64
+	beforeDeleteChatAttachmentsCounter uint64
+	DeleteChatAttachmentsMock          mAttachmentRepositoryMockDeleteChatAttachments
+
+	funcDeleteMessageAttachments          func(ctx context.Context, messageID int64) (apa1 []*model.Attachment, err error)
+	funcDeleteMessageAttachmentsOrigin    string
+	inspectFuncDeleteMessageAttachments   func(ctx context.Context, messageID int64)
+	afterDeleteMessageAttachmentsCounter  uint64
+	beforeDeleteMessageAttachmentsCounter uint64
+	DeleteMessageAttachmentsMock          mAttachmentRepositoryMockDeleteMessageAttachments
+
+	funcDeleteStaleUploads          func(ctx context.Context, before time.Time, limit int) (apa1 []*model.Attachment, err error)
+	funcDeleteStaleUploadsOrigin    string
+	inspectFuncDeleteStaleUploads   func(ctx context.Context, before time.Time, limit int)
+	afterDeleteStaleUploadsCounter  uint64
+	beforeDeleteStaleUploadsCounter uint64
+	DeleteStaleUploadsMock          mAttachmentRepositoryMockDeleteStaleUploads
+
+	funcGetAttachment          func(ctx context.Context, id int64) (ap1 *model.Attachment, err error)
+	funcGetAttachmentOrigin    string
+	inspectFuncGetAttachment   func(ctx context.Context, id int64)
+	afterGetAttachmentCounter  uint64
+	beforeGetAttachmentCounter uint64
+	GetAttachmentMock          mAttachmentRepositoryMockGetAttachment
+
+	funcLinkAttachments          func(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64) (i1 int64, err error)
+	funcLinkAttachmentsOrigin    string
+	inspectFuncLinkAttachments   func(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64)
+	afterLinkAttachmentsCounter  uint64
+	beforeLinkAttachmentsCounter uint64
+	LinkAttachmentsMock          mAttachmentRepositoryMockLinkAttachments
+
+	funcListAttachments          func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error)
+	funcListAttachmentsOrigin    string
+	inspectFuncListAttachments   func(ctx context.Context, messageIDs []int64)
+	afterListAttachmentsCounter  uint64
+	beforeListAttachmentsCounter uint64
+	ListAttachmentsMock          mAttachmentRepositoryMockListAttachments
+
+	funcSetImageInfo          func(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail) (err error)
+	funcSetImageInfoOrigin    string
+	inspectFuncSetImageInfo   func(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail)
+	afterSetImageInfoCounter  uint64
+	beforeSetImageInfoCounter uint64
+	SetImageInfoMock          mAttachmentRepositoryMockSetImageInfo
+}
+
+// NewAttachmentRepositoryMock returns a mock for mm_repository.AttachmentRepository
+func NewAttachmentRepositoryMock(t minimock.Tester) *AttachmentRepositoryMock {
+	m := &AttachmentRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAttachmentMock = mAttachmentRepositoryMockCreateAttachment{mock: m}
+	m.CreateAttachmentMock.callArgs = []*AttachmentRepositoryMockCreateAttachmentParams{}
+
+	m.DeleteChatAttachmentsMock = mAttachmentRepositoryMockDeleteChatAttachments{mock: m}
+	m.DeleteChatAttachmentsMock.callArgs = []*AttachmentRepositoryMockDeleteChatAttachmentsParams{}
+
+	m.DeleteMessageAttachmentsMock = mAttachmentRepositoryMockDeleteMessageAttachments{mock: m}
+	m.DeleteMessageAttachmentsMock.callArgs = []*AttachmentRepositoryMockDeleteMessageAttachmentsParams{}
+
+	m.DeleteStaleUploadsMock = mAttachmentRepositoryMockDeleteStaleUploads{mock: m}
+	m.DeleteStaleUploadsMock.callArgs = []*AttachmentRepositoryMockDeleteStaleUploadsParams{}
+
+	m.GetAttachmentMock = mAttachmentRepositoryMockGetAttachment{mock: m}
+	m.GetAttachmentMock.callArgs = []*AttachmentRepositoryMockGetAttachmentParams{}
+
+	m.LinkAttachmentsMock = mAttachmentRepositoryMockLinkAttachments{mock: m}
+	m.LinkAttachmentsMock.callArgs = []*AttachmentRepositoryMockLinkAttachmentsParams{}
+
+	m.ListAttachmentsMock = mAttachmentRepositoryMockListAttachments{mock: m}
+	m.ListAttachmentsMock.callArgs = []*AttachmentRepositoryMockListAttachmentsParams{}
+
+	m.SetImageInfoMock = mAttachmentRepositoryMockSetImageInfo{mock: m}
+	m.SetImageInfoMock.callArgs = []*AttachmentRepositoryMockSetImageInfoParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAttachmentRepositoryMockCreateAttachment struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockCreateAttachmentExpectation
+	expectations       []*AttachmentRepositoryMockCreateAttachmentExpectation
+
+	callArgs []*AttachmentRepositoryMockCreateAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockCreateAttachmentExpectation specifies expectation struct of the AttachmentRepository.CreateAttachment
+type AttachmentRepositoryMockCreateAttachmentExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockCreateAttachmentParams
+	paramPtrs          *AttachmentRepositoryMockCreateAttachmentParamPtrs
+	expectationOrigins AttachmentRepositoryMockCreateAttachmentExpectationOrigins
+	results            *AttachmentRepositoryMockCreateAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockCreateAttachmentParams contains parameters of the AttachmentRepository.CreateAttachment
+type AttachmentRepositoryMockCreateAttachmentParams struct {
+	ctx        context.Context
+	attachment *model.Attachment
+}
+
+// AttachmentRepositoryMockCreateAttachmentParamPtrs contains pointers to parameters of the AttachmentRepository.CreateAttachment
+type AttachmentRepositoryMockCreateAttachmentParamPtrs struct {
+	ctx        *context.Context
+	attachment **model.Attachment
+}
+
+// AttachmentRepositoryMockCreateAttachmentResults contains results of the AttachmentRepository.CreateAttachment
+type AttachmentRepositoryMockCreateAttachmentResults struct {
+	ap1 *model.Attachment
+	err error
+}
+
+// AttachmentRepositoryMockCreateAttachmentOrigins contains origins of expectations of the AttachmentRepository.CreateAttachment
+type AttachmentRepositoryMockCreateAttachmentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originAttachment string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Optional() *mAttachmentRepositoryMockCreateAttachment {
+	mmCreateAttachment.optional = true
+	return mmCreateAttachment
+}
+
+// Expect sets up expected params for AttachmentRepository.CreateAttachment
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Expect(ctx context.Context, attachment *model.Attachment) *mAttachmentRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAttachment.defaultExpectation.params = &AttachmentRepositoryMockCreateAttachmentParams{ctx, attachment}
+	mmCreateAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAttachment.expectations {
+		if minimock.Equal(e.params, mmCreateAttachment.defaultExpectation.params) {
+			mmCreateAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.CreateAttachment
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &AttachmentRepositoryMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// ExpectAttachmentParam2 sets up expected param attachment for AttachmentRepository.CreateAttachment
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) ExpectAttachmentParam2(attachment *model.Attachment) *mAttachmentRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentRepositoryMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &AttachmentRepositoryMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.attachment = &attachment
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originAttachment = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.CreateAttachment
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Inspect(f func(ctx context.Context, attachment *model.Attachment)) *mAttachmentRepositoryMockCreateAttachment {
+	if mmCreateAttachment.mock.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.CreateAttachment")
+	}
+
+	mmCreateAttachment.mock.inspectFuncCreateAttachment = f
+
+	return mmCreateAttachment
+}
+
+// Return sets up results that will be returned by AttachmentRepository.CreateAttachment
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Return(ap1 *model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentRepositoryMockCreateAttachmentExpectation{mock: mmCreateAttachment.mock}
+	}
+	mmCreateAttachment.defaultExpectation.results = &AttachmentRepositoryMockCreateAttachmentResults{ap1, err}
+	mmCreateAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.CreateAttachment method
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Set(f func(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmCreateAttachment.defaultExpectation != nil {
+		mmCreateAttachment.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.CreateAttachment method")
+	}
+
+	if len(mmCreateAttachment.expectations) > 0 {
+		mmCreateAttachment.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.CreateAttachment method")
+	}
+
+	mmCreateAttachment.mock.funcCreateAttachment = f
+	mmCreateAttachment.mock.funcCreateAttachmentOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// When sets expectation for the AttachmentRepository.CreateAttachment which will trigger the result defined by the following
+// Then helper
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) When(ctx context.Context, attachment *model.Attachment) *AttachmentRepositoryMockCreateAttachmentExpectation {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentRepositoryMock.CreateAttachment mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockCreateAttachmentExpectation{
+		mock:               mmCreateAttachment.mock,
+		params:             &AttachmentRepositoryMockCreateAttachmentParams{ctx, attachment},
+		expectationOrigins: AttachmentRepositoryMockCreateAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAttachment.expectations = append(mmCreateAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.CreateAttachment return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockCreateAttachmentExpectation) Then(ap1 *model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockCreateAttachmentResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.CreateAttachment should be invoked
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Times(n uint64) *mAttachmentRepositoryMockCreateAttachment {
+	if n == 0 {
+		mmCreateAttachment.mock.t.Fatalf("Times of AttachmentRepositoryMock.CreateAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAttachment.expectedInvocations, n)
+	mmCreateAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment
+}
+
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) invocationsDone() bool {
+	if len(mmCreateAttachment.expectations) == 0 && mmCreateAttachment.defaultExpectation == nil && mmCreateAttachment.mock.funcCreateAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.mock.afterCreateAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAttachment implements mm_repository.AttachmentRepository
+func (mmCreateAttachment *AttachmentRepositoryMock) CreateAttachment(ctx context.Context, attachment *model.Attachment) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmCreateAttachment.beforeCreateAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAttachment.afterCreateAttachmentCounter, 1)
+
+	mmCreateAttachment.t.Helper()
+
+	if mmCreateAttachment.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.inspectFuncCreateAttachment(ctx, attachment)
+	}
+
+	mm_params := AttachmentRepositoryMockCreateAttachmentParams{ctx, attachment}
+
+	// Record call args
+	mmCreateAttachment.CreateAttachmentMock.mutex.Lock()
+	mmCreateAttachment.CreateAttachmentMock.callArgs = append(mmCreateAttachment.CreateAttachmentMock.callArgs, &mm_params)
+	mmCreateAttachment.CreateAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateAttachment.CreateAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmCreateAttachment.CreateAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAttachment.CreateAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockCreateAttachmentParams{ctx, attachment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAttachment.t.Errorf("AttachmentRepositoryMock.CreateAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.attachment != nil && !minimock.Equal(*mm_want_ptrs.attachment, mm_got.attachment) {
+				mmCreateAttachment.t.Errorf("AttachmentRepositoryMock.CreateAttachment got unexpected parameter attachment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originAttachment, *mm_want_ptrs.attachment, mm_got.attachment, minimock.Diff(*mm_want_ptrs.attachment, mm_got.attachment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAttachment.t.Errorf("AttachmentRepositoryMock.CreateAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAttachment.t.Fatal("No results are set for the AttachmentRepositoryMock.CreateAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmCreateAttachment.funcCreateAttachment != nil {
+		return mmCreateAttachment.funcCreateAttachment(ctx, attachment)
+	}
+	mmCreateAttachment.t.Fatalf("Unexpected call to AttachmentRepositoryMock.CreateAttachment. %v %v", ctx, attachment)
+	return
+}
+
+// CreateAttachmentAfterCounter returns a count of finished AttachmentRepositoryMock.CreateAttachment invocations
+func (mmCreateAttachment *AttachmentRepositoryMock) CreateAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.afterCreateAttachmentCounter)
+}
+
+// CreateAttachmentBeforeCounter returns a count of AttachmentRepositoryMock.CreateAttachment invocations
+func (mmCreateAttachment *AttachmentRepositoryMock) CreateAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.beforeCreateAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.CreateAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAttachment *mAttachmentRepositoryMockCreateAttachment) Calls() []*AttachmentRepositoryMockCreateAttachmentParams {
+	mmCreateAttachment.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockCreateAttachmentParams, len(mmCreateAttachment.callArgs))
+	copy(argCopy, mmCreateAttachment.callArgs)
+
+	mmCreateAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAttachmentDone returns true if the count of the CreateAttachment invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockCreateAttachmentDone() bool {
+	if m.CreateAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAttachmentMock.invocationsDone()
+}
+
+// MinimockCreateAttachmentInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockCreateAttachmentInspect() {
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.CreateAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAttachmentCounter := mm_atomic.LoadUint64(&m.afterCreateAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAttachmentMock.defaultExpectation != nil && afterCreateAttachmentCounter < 1 {
+		if m.CreateAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.CreateAttachment at\n%s", m.CreateAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.CreateAttachment at\n%s with params: %#v", m.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.CreateAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAttachment != nil && afterCreateAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.CreateAttachment at\n%s", m.funcCreateAttachmentOrigin)
+	}
+
+	if !m.CreateAttachmentMock.invocationsDone() && afterCreateAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.CreateAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAttachmentMock.expectedInvocations), m.CreateAttachmentMock.expectedInvocationsOrigin, afterCreateAttachmentCounter)
+	}
+}
+
+type mAttachmentRepositoryMockDeleteChatAttachments struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockDeleteChatAttachmentsExpectation
+	expectations       []*AttachmentRepositoryMockDeleteChatAttachmentsExpectation
+
+	callArgs []*AttachmentRepositoryMockDeleteChatAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockDeleteChatAttachmentsExpectation specifies expectation struct of the AttachmentRepository.DeleteChatAttachments
+type AttachmentRepositoryMockDeleteChatAttachmentsExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockDeleteChatAttachmentsParams
+	paramPtrs          *AttachmentRepositoryMockDeleteChatAttachmentsParamPtrs
+	expectationOrigins AttachmentRepositoryMockDeleteChatAttachmentsExpectationOrigins
+	results            *AttachmentRepositoryMockDeleteChatAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockDeleteChatAttachmentsParams contains parameters of the AttachmentRepository.DeleteChatAttachments
+type AttachmentRepositoryMockDeleteChatAttachmentsParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// AttachmentRepositoryMockDeleteChatAttachmentsParamPtrs contains pointers to parameters of the AttachmentRepository.DeleteChatAttachments
+type AttachmentRepositoryMockDeleteChatAttachmentsParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// AttachmentRepositoryMockDeleteChatAttachmentsResults contains results of the AttachmentRepository.DeleteChatAttachments
+type AttachmentRepositoryMockDeleteChatAttachmentsResults struct {
+	apa1 []*model.Attachment
+	err  error
+}
+
+// AttachmentRepositoryMockDeleteChatAttachmentsOrigins contains origins of expectations of the AttachmentRepository.DeleteChatAttachments
+type AttachmentRepositoryMockDeleteChatAttachmentsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Optional() *mAttachmentRepositoryMockDeleteChatAttachments {
+	mmDeleteChatAttachments.optional = true
+	return mmDeleteChatAttachments
+}
+
+// Expect sets up expected params for AttachmentRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Expect(ctx context.Context, chatID int64) *mAttachmentRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteChatAttachments.defaultExpectation.params = &AttachmentRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteChatAttachments.expectations {
+		if minimock.Equal(e.params, mmDeleteChatAttachments.defaultExpectation.params) {
+			mmDeleteChatAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteChatAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteChatAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.params != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteChatAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteChatAttachmentsParamPtrs{}
+	}
+	mmDeleteChatAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteChatAttachments
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for AttachmentRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) ExpectChatIDParam2(chatID int64) *mAttachmentRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteChatAttachmentsExpectation{}
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.params != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteChatAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteChatAttachmentsParamPtrs{}
+	}
+	mmDeleteChatAttachments.defaultExpectation.paramPtrs.chatID = &chatID
+	mmDeleteChatAttachments.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmDeleteChatAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Inspect(f func(ctx context.Context, chatID int64)) *mAttachmentRepositoryMockDeleteChatAttachments {
+	if mmDeleteChatAttachments.mock.inspectFuncDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.DeleteChatAttachments")
+	}
+
+	mmDeleteChatAttachments.mock.inspectFuncDeleteChatAttachments = f
+
+	return mmDeleteChatAttachments
+}
+
+// Return sets up results that will be returned by AttachmentRepository.DeleteChatAttachments
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Return(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	if mmDeleteChatAttachments.defaultExpectation == nil {
+		mmDeleteChatAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteChatAttachmentsExpectation{mock: mmDeleteChatAttachments.mock}
+	}
+	mmDeleteChatAttachments.defaultExpectation.results = &AttachmentRepositoryMockDeleteChatAttachmentsResults{apa1, err}
+	mmDeleteChatAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.DeleteChatAttachments method
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Set(f func(ctx context.Context, chatID int64) (apa1 []*model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmDeleteChatAttachments.defaultExpectation != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.DeleteChatAttachments method")
+	}
+
+	if len(mmDeleteChatAttachments.expectations) > 0 {
+		mmDeleteChatAttachments.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.DeleteChatAttachments method")
+	}
+
+	mmDeleteChatAttachments.mock.funcDeleteChatAttachments = f
+	mmDeleteChatAttachments.mock.funcDeleteChatAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments.mock
+}
+
+// When sets expectation for the AttachmentRepository.DeleteChatAttachments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) When(ctx context.Context, chatID int64) *AttachmentRepositoryMockDeleteChatAttachmentsExpectation {
+	if mmDeleteChatAttachments.mock.funcDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteChatAttachments mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockDeleteChatAttachmentsExpectation{
+		mock:               mmDeleteChatAttachments.mock,
+		params:             &AttachmentRepositoryMockDeleteChatAttachmentsParams{ctx, chatID},
+		expectationOrigins: AttachmentRepositoryMockDeleteChatAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteChatAttachments.expectations = append(mmDeleteChatAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.DeleteChatAttachments return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockDeleteChatAttachmentsExpectation) Then(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockDeleteChatAttachmentsResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.DeleteChatAttachments should be invoked
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Times(n uint64) *mAttachmentRepositoryMockDeleteChatAttachments {
+	if n == 0 {
+		mmDeleteChatAttachments.mock.t.Fatalf("Times of AttachmentRepositoryMock.DeleteChatAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteChatAttachments.expectedInvocations, n)
+	mmDeleteChatAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteChatAttachments
+}
+
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) invocationsDone() bool {
+	if len(mmDeleteChatAttachments.expectations) == 0 && mmDeleteChatAttachments.defaultExpectation == nil && mmDeleteChatAttachments.mock.funcDeleteChatAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteChatAttachments.mock.afterDeleteChatAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteChatAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteChatAttachments implements mm_repository.AttachmentRepository
+func (mmDeleteChatAttachments *AttachmentRepositoryMock) DeleteChatAttachments(ctx context.Context, chatID int64) (apa1 []*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmDeleteChatAttachments.beforeDeleteChatAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteChatAttachments.afterDeleteChatAttachmentsCounter, 1)
+
+	mmDeleteChatAttachments.t.Helper()
+
+	if mmDeleteChatAttachments.inspectFuncDeleteChatAttachments != nil {
+		mmDeleteChatAttachments.inspectFuncDeleteChatAttachments(ctx, chatID)
+	}
+
+	mm_params := AttachmentRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+
+	// Record call args
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.mutex.Lock()
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.callArgs = append(mmDeleteChatAttachments.DeleteChatAttachmentsMock.callArgs, &mm_params)
+	mmDeleteChatAttachments.DeleteChatAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteChatAttachments.DeleteChatAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockDeleteChatAttachmentsParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteChatAttachments.t.Errorf("AttachmentRepositoryMock.DeleteChatAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmDeleteChatAttachments.t.Errorf("AttachmentRepositoryMock.DeleteChatAttachments got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteChatAttachments.t.Errorf("AttachmentRepositoryMock.DeleteChatAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteChatAttachments.DeleteChatAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteChatAttachments.t.Fatal("No results are set for the AttachmentRepositoryMock.DeleteChatAttachments")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmDeleteChatAttachments.funcDeleteChatAttachments != nil {
+		return mmDeleteChatAttachments.funcDeleteChatAttachments(ctx, chatID)
+	}
+	mmDeleteChatAttachments.t.Fatalf("Unexpected call to AttachmentRepositoryMock.DeleteChatAttachments. %v %v", ctx, chatID)
+	return
+}
+
+// DeleteChatAttachmentsAfterCounter returns a count of finished AttachmentRepositoryMock.DeleteChatAttachments invocations
+func (mmDeleteChatAttachments *AttachmentRepositoryMock) DeleteChatAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChatAttachments.afterDeleteChatAttachmentsCounter)
+}
+
+// DeleteChatAttachmentsBeforeCounter returns a count of AttachmentRepositoryMock.DeleteChatAttachments invocations
+func (mmDeleteChatAttachments *AttachmentRepositoryMock) DeleteChatAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteChatAttachments.beforeDeleteChatAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.DeleteChatAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteChatAttachments *mAttachmentRepositoryMockDeleteChatAttachments) Calls() []*AttachmentRepositoryMockDeleteChatAttachmentsParams {
+	mmDeleteChatAttachments.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockDeleteChatAttachmentsParams, len(mmDeleteChatAttachments.callArgs))
+	copy(argCopy, mmDeleteChatAttachments.callArgs)
+
+	mmDeleteChatAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteChatAttachmentsDone returns true if the count of the DeleteChatAttachments invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockDeleteChatAttachmentsDone() bool {
+	if m.DeleteChatAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteChatAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteChatAttachmentsMock.invocationsDone()
+}
+
+// MinimockDeleteChatAttachmentsInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockDeleteChatAttachmentsInspect() {
+	for _, e := range m.DeleteChatAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteChatAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteChatAttachmentsCounter := mm_atomic.LoadUint64(&m.afterDeleteChatAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteChatAttachmentsMock.defaultExpectation != nil && afterDeleteChatAttachmentsCounter < 1 {
+		if m.DeleteChatAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteChatAttachments at\n%s", m.DeleteChatAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteChatAttachments at\n%s with params: %#v", m.DeleteChatAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteChatAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteChatAttachments != nil && afterDeleteChatAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteChatAttachments at\n%s", m.funcDeleteChatAttachmentsOrigin)
+	}
+
+	if !m.DeleteChatAttachmentsMock.invocationsDone() && afterDeleteChatAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.DeleteChatAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteChatAttachmentsMock.expectedInvocations), m.DeleteChatAttachmentsMock.expectedInvocationsOrigin, afterDeleteChatAttachmentsCounter)
+	}
+}
+
+type mAttachmentRepositoryMockDeleteMessageAttachments struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockDeleteMessageAttachmentsExpectation
+	expectations       []*AttachmentRepositoryMockDeleteMessageAttachmentsExpectation
+
+	callArgs []*AttachmentRepositoryMockDeleteMessageAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockDeleteMessageAttachmentsExpectation specifies expectation struct of the AttachmentRepository.DeleteMessageAttachments
+type AttachmentRepositoryMockDeleteMessageAttachmentsExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockDeleteMessageAttachmentsParams
+	paramPtrs          *AttachmentRepositoryMockDeleteMessageAttachmentsParamPtrs
+	expectationOrigins AttachmentRepositoryMockDeleteMessageAttachmentsExpectationOrigins
+	results            *AttachmentRepositoryMockDeleteMessageAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockDeleteMessageAttachmentsParams contains parameters of the AttachmentRepository.DeleteMessageAttachments
+type AttachmentRepositoryMockDeleteMessageAttachmentsParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// AttachmentRepositoryMockDeleteMessageAttachmentsParamPtrs contains pointers to parameters of the AttachmentRepository.DeleteMessageAttachments
+type AttachmentRepositoryMockDeleteMessageAttachmentsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// AttachmentRepositoryMockDeleteMessageAttachmentsResults contains results of the AttachmentRepository.DeleteMessageAttachments
+type AttachmentRepositoryMockDeleteMessageAttachmentsResults struct {
+	apa1 []*model.Attachment
+	err  error
+}
+
+// AttachmentRepositoryMockDeleteMessageAttachmentsOrigins contains origins of expectations of the AttachmentRepository.DeleteMessageAttachments
+type AttachmentRepositoryMockDeleteMessageAttachmentsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Optional() *mAttachmentRepositoryMockDeleteMessageAttachments {
+	mmDeleteMessageAttachments.optional = true
+	return mmDeleteMessageAttachments
+}
+
+// Expect sets up expected params for AttachmentRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Expect(ctx context.Context, messageID int64) *mAttachmentRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessageAttachments.defaultExpectation.params = &AttachmentRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessageAttachments.expectations {
+		if minimock.Equal(e.params, mmDeleteMessageAttachments.defaultExpectation.params) {
+			mmDeleteMessageAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessageAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessageAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.params != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessageAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteMessageAttachmentsParamPtrs{}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessageAttachments
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for AttachmentRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) ExpectMessageIDParam2(messageID int64) *mAttachmentRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteMessageAttachmentsExpectation{}
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.params != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Expect")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessageAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteMessageAttachmentsParamPtrs{}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessageAttachments.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessageAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Inspect(f func(ctx context.Context, messageID int64)) *mAttachmentRepositoryMockDeleteMessageAttachments {
+	if mmDeleteMessageAttachments.mock.inspectFuncDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.DeleteMessageAttachments")
+	}
+
+	mmDeleteMessageAttachments.mock.inspectFuncDeleteMessageAttachments = f
+
+	return mmDeleteMessageAttachments
+}
+
+// Return sets up results that will be returned by AttachmentRepository.DeleteMessageAttachments
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Return(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	if mmDeleteMessageAttachments.defaultExpectation == nil {
+		mmDeleteMessageAttachments.defaultExpectation = &AttachmentRepositoryMockDeleteMessageAttachmentsExpectation{mock: mmDeleteMessageAttachments.mock}
+	}
+	mmDeleteMessageAttachments.defaultExpectation.results = &AttachmentRepositoryMockDeleteMessageAttachmentsResults{apa1, err}
+	mmDeleteMessageAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.DeleteMessageAttachments method
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Set(f func(ctx context.Context, messageID int64) (apa1 []*model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmDeleteMessageAttachments.defaultExpectation != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.DeleteMessageAttachments method")
+	}
+
+	if len(mmDeleteMessageAttachments.expectations) > 0 {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.DeleteMessageAttachments method")
+	}
+
+	mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments = f
+	mmDeleteMessageAttachments.mock.funcDeleteMessageAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments.mock
+}
+
+// When sets expectation for the AttachmentRepository.DeleteMessageAttachments which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) When(ctx context.Context, messageID int64) *AttachmentRepositoryMockDeleteMessageAttachmentsExpectation {
+	if mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.mock.t.Fatalf("AttachmentRepositoryMock.DeleteMessageAttachments mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockDeleteMessageAttachmentsExpectation{
+		mock:               mmDeleteMessageAttachments.mock,
+		params:             &AttachmentRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID},
+		expectationOrigins: AttachmentRepositoryMockDeleteMessageAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessageAttachments.expectations = append(mmDeleteMessageAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.DeleteMessageAttachments return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockDeleteMessageAttachmentsExpectation) Then(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockDeleteMessageAttachmentsResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.DeleteMessageAttachments should be invoked
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Times(n uint64) *mAttachmentRepositoryMockDeleteMessageAttachments {
+	if n == 0 {
+		mmDeleteMessageAttachments.mock.t.Fatalf("Times of AttachmentRepositoryMock.DeleteMessageAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessageAttachments.expectedInvocations, n)
+	mmDeleteMessageAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessageAttachments
+}
+
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) invocationsDone() bool {
+	if len(mmDeleteMessageAttachments.expectations) == 0 && mmDeleteMessageAttachments.defaultExpectation == nil && mmDeleteMessageAttachments.mock.funcDeleteMessageAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessageAttachments.mock.afterDeleteMessageAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessageAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessageAttachments implements mm_repository.AttachmentRepository
+func (mmDeleteMessageAttachments *AttachmentRepositoryMock) DeleteMessageAttachments(ctx context.Context, messageID int64) (apa1 []*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmDeleteMessageAttachments.beforeDeleteMessageAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessageAttachments.afterDeleteMessageAttachmentsCounter, 1)
+
+	mmDeleteMessageAttachments.t.Helper()
+
+	if mmDeleteMessageAttachments.inspectFuncDeleteMessageAttachments != nil {
+		mmDeleteMessageAttachments.inspectFuncDeleteMessageAttachments(ctx, messageID)
+	}
+
+	mm_params := AttachmentRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+
+	// Record call args
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.mutex.Lock()
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.callArgs = append(mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.callArgs, &mm_params)
+	mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockDeleteMessageAttachmentsParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessageAttachments.t.Errorf("AttachmentRepositoryMock.DeleteMessageAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessageAttachments.t.Errorf("AttachmentRepositoryMock.DeleteMessageAttachments got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessageAttachments.t.Errorf("AttachmentRepositoryMock.DeleteMessageAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessageAttachments.DeleteMessageAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessageAttachments.t.Fatal("No results are set for the AttachmentRepositoryMock.DeleteMessageAttachments")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmDeleteMessageAttachments.funcDeleteMessageAttachments != nil {
+		return mmDeleteMessageAttachments.funcDeleteMessageAttachments(ctx, messageID)
+	}
+	mmDeleteMessageAttachments.t.Fatalf("Unexpected call to AttachmentRepositoryMock.DeleteMessageAttachments. %v %v", ctx, messageID)
+	return
+}
+
+// DeleteMessageAttachmentsAfterCounter returns a count of finished AttachmentRepositoryMock.DeleteMessageAttachments invocations
+func (mmDeleteMessageAttachments *AttachmentRepositoryMock) DeleteMessageAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessageAttachments.afterDeleteMessageAttachmentsCounter)
+}
+
+// DeleteMessageAttachmentsBeforeCounter returns a count of AttachmentRepositoryMock.DeleteMessageAttachments invocations
+func (mmDeleteMessageAttachments *AttachmentRepositoryMock) DeleteMessageAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessageAttachments.beforeDeleteMessageAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.DeleteMessageAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessageAttachments *mAttachmentRepositoryMockDeleteMessageAttachments) Calls() []*AttachmentRepositoryMockDeleteMessageAttachmentsParams {
+	mmDeleteMessageAttachments.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockDeleteMessageAttachmentsParams, len(mmDeleteMessageAttachments.callArgs))
+	copy(argCopy, mmDeleteMessageAttachments.callArgs)
+
+	mmDeleteMessageAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageAttachmentsDone returns true if the count of the DeleteMessageAttachments invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockDeleteMessageAttachmentsDone() bool {
+	if m.DeleteMessageAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageAttachmentsMock.invocationsDone()
+}
+
+// MinimockDeleteMessageAttachmentsInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockDeleteMessageAttachmentsInspect() {
+	for _, e := range m.DeleteMessageAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteMessageAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageAttachmentsCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageAttachmentsMock.defaultExpectation != nil && afterDeleteMessageAttachmentsCounter < 1 {
+		if m.DeleteMessageAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteMessageAttachments at\n%s", m.DeleteMessageAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteMessageAttachments at\n%s with params: %#v", m.DeleteMessageAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessageAttachments != nil && afterDeleteMessageAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteMessageAttachments at\n%s", m.funcDeleteMessageAttachmentsOrigin)
+	}
+
+	if !m.DeleteMessageAttachmentsMock.invocationsDone() && afterDeleteMessageAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.DeleteMessageAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageAttachmentsMock.expectedInvocations), m.DeleteMessageAttachmentsMock.expectedInvocationsOrigin, afterDeleteMessageAttachmentsCounter)
+	}
+}
+
+type mAttachmentRepositoryMockDeleteStaleUploads struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockDeleteStaleUploadsExpectation
+	expectations       []*AttachmentRepositoryMockDeleteStaleUploadsExpectation
+
+	callArgs []*AttachmentRepositoryMockDeleteStaleUploadsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockDeleteStaleUploadsExpectation specifies expectation struct of the AttachmentRepository.DeleteStaleUploads
+type AttachmentRepositoryMockDeleteStaleUploadsExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockDeleteStaleUploadsParams
+	paramPtrs          *AttachmentRepositoryMockDeleteStaleUploadsParamPtrs
+	expectationOrigins AttachmentRepositoryMockDeleteStaleUploadsExpectationOrigins
+	results            *AttachmentRepositoryMockDeleteStaleUploadsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockDeleteStaleUploadsParams contains parameters of the AttachmentRepository.DeleteStaleUploads
+type AttachmentRepositoryMockDeleteStaleUploadsParams struct {
+	ctx    context.Context
+	before time.Time
+	limit  int
+}
+
+// AttachmentRepositoryMockDeleteStaleUploadsParamPtrs contains pointers to parameters of the AttachmentRepository.DeleteStaleUploads
+type AttachmentRepositoryMockDeleteStaleUploadsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+	limit  *int
+}
+
+// AttachmentRepositoryMockDeleteStaleUploadsResults contains results of the AttachmentRepository.DeleteStaleUploads
+type AttachmentRepositoryMockDeleteStaleUploadsResults struct {
+	apa1 []*model.Attachment
+	err  error
+}
+
+// AttachmentRepositoryMockDeleteStaleUploadsOrigins contains origins of expectations of the AttachmentRepository.DeleteStaleUploads
+type AttachmentRepositoryMockDeleteStaleUploadsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Optional() *mAttachmentRepositoryMockDeleteStaleUploads {
+	mmDeleteStaleUploads.optional = true
+	return mmDeleteStaleUploads
+}
+
+// Expect sets up expected params for AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Expect(ctx context.Context, before time.Time, limit int) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &AttachmentRepositoryMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStaleUploads.defaultExpectation.params = &AttachmentRepositoryMockDeleteStaleUploadsParams{ctx, before, limit}
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteStaleUploads.expectations {
+		if minimock.Equal(e.params, mmDeleteStaleUploads.defaultExpectation.params) {
+			mmDeleteStaleUploads.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStaleUploads.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStaleUploads
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &AttachmentRepositoryMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.params != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Expect")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmDeleteStaleUploads.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteStaleUploadsParamPtrs{}
+	}
+	mmDeleteStaleUploads.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteStaleUploads
+}
+
+// ExpectBeforeParam2 sets up expected param before for AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) ExpectBeforeParam2(before time.Time) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &AttachmentRepositoryMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.params != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Expect")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmDeleteStaleUploads.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteStaleUploadsParamPtrs{}
+	}
+	mmDeleteStaleUploads.defaultExpectation.paramPtrs.before = &before
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmDeleteStaleUploads
+}
+
+// ExpectLimitParam3 sets up expected param limit for AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) ExpectLimitParam3(limit int) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &AttachmentRepositoryMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.params != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Expect")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmDeleteStaleUploads.defaultExpectation.paramPtrs = &AttachmentRepositoryMockDeleteStaleUploadsParamPtrs{}
+	}
+	mmDeleteStaleUploads.defaultExpectation.paramPtrs.limit = &limit
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDeleteStaleUploads
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Inspect(f func(ctx context.Context, before time.Time, limit int)) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.inspectFuncDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.DeleteStaleUploads")
+	}
+
+	mmDeleteStaleUploads.mock.inspectFuncDeleteStaleUploads = f
+
+	return mmDeleteStaleUploads
+}
+
+// Return sets up results that will be returned by AttachmentRepository.DeleteStaleUploads
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Return(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &AttachmentRepositoryMockDeleteStaleUploadsExpectation{mock: mmDeleteStaleUploads.mock}
+	}
+	mmDeleteStaleUploads.defaultExpectation.results = &AttachmentRepositoryMockDeleteStaleUploadsResults{apa1, err}
+	mmDeleteStaleUploads.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.DeleteStaleUploads method
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Set(f func(ctx context.Context, before time.Time, limit int) (apa1 []*model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmDeleteStaleUploads.defaultExpectation != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.DeleteStaleUploads method")
+	}
+
+	if len(mmDeleteStaleUploads.expectations) > 0 {
+		mmDeleteStaleUploads.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.DeleteStaleUploads method")
+	}
+
+	mmDeleteStaleUploads.mock.funcDeleteStaleUploads = f
+	mmDeleteStaleUploads.mock.funcDeleteStaleUploadsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads.mock
+}
+
+// When sets expectation for the AttachmentRepository.DeleteStaleUploads which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) When(ctx context.Context, before time.Time, limit int) *AttachmentRepositoryMockDeleteStaleUploadsExpectation {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("AttachmentRepositoryMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockDeleteStaleUploadsExpectation{
+		mock:               mmDeleteStaleUploads.mock,
+		params:             &AttachmentRepositoryMockDeleteStaleUploadsParams{ctx, before, limit},
+		expectationOrigins: AttachmentRepositoryMockDeleteStaleUploadsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteStaleUploads.expectations = append(mmDeleteStaleUploads.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.DeleteStaleUploads return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockDeleteStaleUploadsExpectation) Then(apa1 []*model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockDeleteStaleUploadsResults{apa1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.DeleteStaleUploads should be invoked
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Times(n uint64) *mAttachmentRepositoryMockDeleteStaleUploads {
+	if n == 0 {
+		mmDeleteStaleUploads.mock.t.Fatalf("Times of AttachmentRepositoryMock.DeleteStaleUploads mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStaleUploads.expectedInvocations, n)
+	mmDeleteStaleUploads.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads
+}
+
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) invocationsDone() bool {
+	if len(mmDeleteStaleUploads.expectations) == 0 && mmDeleteStaleUploads.defaultExpectation == nil && mmDeleteStaleUploads.mock.funcDeleteStaleUploads == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStaleUploads.mock.afterDeleteStaleUploadsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStaleUploads.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStaleUploads implements mm_repository.AttachmentRepository
+func (mmDeleteStaleUploads *AttachmentRepositoryMock) DeleteStaleUploads(ctx context.Context, before time.Time, limit int) (apa1 []*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmDeleteStaleUploads.beforeDeleteStaleUploadsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStaleUploads.afterDeleteStaleUploadsCounter, 1)
+
+	mmDeleteStaleUploads.t.Helper()
+
+	if mmDeleteStaleUploads.inspectFuncDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.inspectFuncDeleteStaleUploads(ctx, before, limit)
+	}
+
+	mm_params := AttachmentRepositoryMockDeleteStaleUploadsParams{ctx, before, limit}
+
+	// Record call args
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.mutex.Lock()
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.callArgs = append(mmDeleteStaleUploads.DeleteStaleUploadsMock.callArgs, &mm_params)
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStaleUploads.DeleteStaleUploadsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.apa1, e.results.err
+		}
+	}
+
+	if mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockDeleteStaleUploadsParams{ctx, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStaleUploads.t.Errorf("AttachmentRepositoryMock.DeleteStaleUploads got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmDeleteStaleUploads.t.Errorf("AttachmentRepositoryMock.DeleteStaleUploads got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDeleteStaleUploads.t.Errorf("AttachmentRepositoryMock.DeleteStaleUploads got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStaleUploads.t.Errorf("AttachmentRepositoryMock.DeleteStaleUploads got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStaleUploads.t.Fatal("No results are set for the AttachmentRepositoryMock.DeleteStaleUploads")
+		}
+		return (*mm_results).apa1, (*mm_results).err
+	}
+	if mmDeleteStaleUploads.funcDeleteStaleUploads != nil {
+		return mmDeleteStaleUploads.funcDeleteStaleUploads(ctx, before, limit)
+	}
+	mmDeleteStaleUploads.t.Fatalf("Unexpected call to AttachmentRepositoryMock.DeleteStaleUploads. %v %v %v", ctx, before, limit)
+	return
+}
+
+// DeleteStaleUploadsAfterCounter returns a count of finished AttachmentRepositoryMock.DeleteStaleUploads invocations
+func (mmDeleteStaleUploads *AttachmentRepositoryMock) DeleteStaleUploadsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStaleUploads.afterDeleteStaleUploadsCounter)
+}
+
+// DeleteStaleUploadsBeforeCounter returns a count of AttachmentRepositoryMock.DeleteStaleUploads invocations
+func (mmDeleteStaleUploads *AttachmentRepositoryMock) DeleteStaleUploadsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStaleUploads.beforeDeleteStaleUploadsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.DeleteStaleUploads.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStaleUploads *mAttachmentRepositoryMockDeleteStaleUploads) Calls() []*AttachmentRepositoryMockDeleteStaleUploadsParams {
+	mmDeleteStaleUploads.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockDeleteStaleUploadsParams, len(mmDeleteStaleUploads.callArgs))
+	copy(argCopy, mmDeleteStaleUploads.callArgs)
+
+	mmDeleteStaleUploads.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStaleUploadsDone returns true if the count of the DeleteStaleUploads invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockDeleteStaleUploadsDone() bool {
+	if m.DeleteStaleUploadsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStaleUploadsMock.invocationsDone()
+}
+
+// MinimockDeleteStaleUploadsInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockDeleteStaleUploadsInspect() {
+	for _, e := range m.DeleteStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteStaleUploads at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteStaleUploadsCounter := mm_atomic.LoadUint64(&m.afterDeleteStaleUploadsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStaleUploadsMock.defaultExpectation != nil && afterDeleteStaleUploadsCounter < 1 {
+		if m.DeleteStaleUploadsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteStaleUploads at\n%s", m.DeleteStaleUploadsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteStaleUploads at\n%s with params: %#v", m.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStaleUploadsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStaleUploads != nil && afterDeleteStaleUploadsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.DeleteStaleUploads at\n%s", m.funcDeleteStaleUploadsOrigin)
+	}
+
+	if !m.DeleteStaleUploadsMock.invocationsDone() && afterDeleteStaleUploadsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.DeleteStaleUploads at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStaleUploadsMock.expectedInvocations), m.DeleteStaleUploadsMock.expectedInvocationsOrigin, afterDeleteStaleUploadsCounter)
+	}
+}
+
+type mAttachmentRepositoryMockGetAttachment struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockGetAttachmentExpectation
+	expectations       []*AttachmentRepositoryMockGetAttachmentExpectation
+
+	callArgs []*AttachmentRepositoryMockGetAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockGetAttachmentExpectation specifies expectation struct of the AttachmentRepository.GetAttachment
+type AttachmentRepositoryMockGetAttachmentExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockGetAttachmentParams
+	paramPtrs          *AttachmentRepositoryMockGetAttachmentParamPtrs
+	expectationOrigins AttachmentRepositoryMockGetAttachmentExpectationOrigins
+	results            *AttachmentRepositoryMockGetAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockGetAttachmentParams contains parameters of the AttachmentRepository.GetAttachment
+type AttachmentRepositoryMockGetAttachmentParams struct {
+	ctx context.Context
+	id  int64
+}
+
+// AttachmentRepositoryMockGetAttachmentParamPtrs contains pointers to parameters of the AttachmentRepository.GetAttachment
+type AttachmentRepositoryMockGetAttachmentParamPtrs struct {
+	ctx *context.Context
+	id  *int64
+}
+
+// AttachmentRepositoryMockGetAttachmentResults contains results of the AttachmentRepository.GetAttachment
+type AttachmentRepositoryMockGetAttachmentResults struct {
+	ap1 *model.Attachment
+	err error
+}
+
+// AttachmentRepositoryMockGetAttachmentOrigins contains origins of expectations of the AttachmentRepository.GetAttachment
+type AttachmentRepositoryMockGetAttachmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originId  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Optional() *mAttachmentRepositoryMockGetAttachment {
+	mmGetAttachment.optional = true
+	return mmGetAttachment
+}
+
+// Expect sets up expected params for AttachmentRepository.GetAttachment
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Expect(ctx context.Context, id int64) *mAttachmentRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &AttachmentRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmGetAttachment.defaultExpectation.params = &AttachmentRepositoryMockGetAttachmentParams{ctx, id}
+	mmGetAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAttachment.expectations {
+		if minimock.Equal(e.params, mmGetAttachment.defaultExpectation.params) {
+			mmGetAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.GetAttachment
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &AttachmentRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.params != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Expect")
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs == nil {
+		mmGetAttachment.defaultExpectation.paramPtrs = &AttachmentRepositoryMockGetAttachmentParamPtrs{}
+	}
+	mmGetAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAttachment
+}
+
+// ExpectIdParam2 sets up expected param id for AttachmentRepository.GetAttachment
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) ExpectIdParam2(id int64) *mAttachmentRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &AttachmentRepositoryMockGetAttachmentExpectation{}
+	}
+
+	if mmGetAttachment.defaultExpectation.params != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Expect")
+	}
+
+	if mmGetAttachment.defaultExpectation.paramPtrs == nil {
+		mmGetAttachment.defaultExpectation.paramPtrs = &AttachmentRepositoryMockGetAttachmentParamPtrs{}
+	}
+	mmGetAttachment.defaultExpectation.paramPtrs.id = &id
+	mmGetAttachment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmGetAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.GetAttachment
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Inspect(f func(ctx context.Context, id int64)) *mAttachmentRepositoryMockGetAttachment {
+	if mmGetAttachment.mock.inspectFuncGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.GetAttachment")
+	}
+
+	mmGetAttachment.mock.inspectFuncGetAttachment = f
+
+	return mmGetAttachment
+}
+
+// Return sets up results that will be returned by AttachmentRepository.GetAttachment
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Return(ap1 *model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	if mmGetAttachment.defaultExpectation == nil {
+		mmGetAttachment.defaultExpectation = &AttachmentRepositoryMockGetAttachmentExpectation{mock: mmGetAttachment.mock}
+	}
+	mmGetAttachment.defaultExpectation.results = &AttachmentRepositoryMockGetAttachmentResults{ap1, err}
+	mmGetAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.GetAttachment method
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Set(f func(ctx context.Context, id int64) (ap1 *model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmGetAttachment.defaultExpectation != nil {
+		mmGetAttachment.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.GetAttachment method")
+	}
+
+	if len(mmGetAttachment.expectations) > 0 {
+		mmGetAttachment.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.GetAttachment method")
+	}
+
+	mmGetAttachment.mock.funcGetAttachment = f
+	mmGetAttachment.mock.funcGetAttachmentOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment.mock
+}
+
+// When sets expectation for the AttachmentRepository.GetAttachment which will trigger the result defined by the following
+// Then helper
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) When(ctx context.Context, id int64) *AttachmentRepositoryMockGetAttachmentExpectation {
+	if mmGetAttachment.mock.funcGetAttachment != nil {
+		mmGetAttachment.mock.t.Fatalf("AttachmentRepositoryMock.GetAttachment mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockGetAttachmentExpectation{
+		mock:               mmGetAttachment.mock,
+		params:             &AttachmentRepositoryMockGetAttachmentParams{ctx, id},
+		expectationOrigins: AttachmentRepositoryMockGetAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAttachment.expectations = append(mmGetAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.GetAttachment return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockGetAttachmentExpectation) Then(ap1 *model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockGetAttachmentResults{ap1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.GetAttachment should be invoked
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Times(n uint64) *mAttachmentRepositoryMockGetAttachment {
+	if n == 0 {
+		mmGetAttachment.mock.t.Fatalf("Times of AttachmentRepositoryMock.GetAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAttachment.expectedInvocations, n)
+	mmGetAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAttachment
+}
+
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) invocationsDone() bool {
+	if len(mmGetAttachment.expectations) == 0 && mmGetAttachment.defaultExpectation == nil && mmGetAttachment.mock.funcGetAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAttachment.mock.afterGetAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAttachment implements mm_repository.AttachmentRepository
+func (mmGetAttachment *AttachmentRepositoryMock) GetAttachment(ctx context.Context, id int64) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmGetAttachment.beforeGetAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAttachment.afterGetAttachmentCounter, 1)
+
+	mmGetAttachment.t.Helper()
+
+	if mmGetAttachment.inspectFuncGetAttachment != nil {
+		mmGetAttachment.inspectFuncGetAttachment(ctx, id)
+	}
+
+	mm_params := AttachmentRepositoryMockGetAttachmentParams{ctx, id}
+
+	// Record call args
+	mmGetAttachment.GetAttachmentMock.mutex.Lock()
+	mmGetAttachment.GetAttachmentMock.callArgs = append(mmGetAttachment.GetAttachmentMock.callArgs, &mm_params)
+	mmGetAttachment.GetAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmGetAttachment.GetAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.err
+		}
+	}
+
+	if mmGetAttachment.GetAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAttachment.GetAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAttachment.GetAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAttachment.GetAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockGetAttachmentParams{ctx, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAttachment.t.Errorf("AttachmentRepositoryMock.GetAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmGetAttachment.t.Errorf("AttachmentRepositoryMock.GetAttachment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAttachment.t.Errorf("AttachmentRepositoryMock.GetAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAttachment.GetAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAttachment.GetAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAttachment.t.Fatal("No results are set for the AttachmentRepositoryMock.GetAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).err
+	}
+	if mmGetAttachment.funcGetAttachment != nil {
+		return mmGetAttachment.funcGetAttachment(ctx, id)
+	}
+	mmGetAttachment.t.Fatalf("Unexpected call to AttachmentRepositoryMock.GetAttachment. %v %v", ctx, id)
+	return
+}
+
+// GetAttachmentAfterCounter returns a count of finished AttachmentRepositoryMock.GetAttachment invocations
+func (mmGetAttachment *AttachmentRepositoryMock) GetAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachment.afterGetAttachmentCounter)
+}
+
+// GetAttachmentBeforeCounter returns a count of AttachmentRepositoryMock.GetAttachment invocations
+func (mmGetAttachment *AttachmentRepositoryMock) GetAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachment.beforeGetAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.GetAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAttachment *mAttachmentRepositoryMockGetAttachment) Calls() []*AttachmentRepositoryMockGetAttachmentParams {
+	mmGetAttachment.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockGetAttachmentParams, len(mmGetAttachment.callArgs))
+	copy(argCopy, mmGetAttachment.callArgs)
+
+	mmGetAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAttachmentDone returns true if the count of the GetAttachment invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockGetAttachmentDone() bool {
+	if m.GetAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAttachmentMock.invocationsDone()
+}
+
+// MinimockGetAttachmentInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockGetAttachmentInspect() {
+	for _, e := range m.GetAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.GetAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAttachmentCounter := mm_atomic.LoadUint64(&m.afterGetAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAttachmentMock.defaultExpectation != nil && afterGetAttachmentCounter < 1 {
+		if m.GetAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.GetAttachment at\n%s", m.GetAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.GetAttachment at\n%s with params: %#v", m.GetAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.GetAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAttachment != nil && afterGetAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.GetAttachment at\n%s", m.funcGetAttachmentOrigin)
+	}
+
+	if !m.GetAttachmentMock.invocationsDone() && afterGetAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.GetAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAttachmentMock.expectedInvocations), m.GetAttachmentMock.expectedInvocationsOrigin, afterGetAttachmentCounter)
+	}
+}
+
+type mAttachmentRepositoryMockLinkAttachments struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockLinkAttachmentsExpectation
+	expectations       []*AttachmentRepositoryMockLinkAttachmentsExpectation
+
+	callArgs []*AttachmentRepositoryMockLinkAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockLinkAttachmentsExpectation specifies expectation struct of the AttachmentRepository.LinkAttachments
+type AttachmentRepositoryMockLinkAttachmentsExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockLinkAttachmentsParams
+	paramPtrs          *AttachmentRepositoryMockLinkAttachmentsParamPtrs
+	expectationOrigins AttachmentRepositoryMockLinkAttachmentsExpectationOrigins
+	results            *AttachmentRepositoryMockLinkAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockLinkAttachmentsParams contains parameters of the AttachmentRepository.LinkAttachments
+type AttachmentRepositoryMockLinkAttachmentsParams struct {
+	ctx       context.Context
+	messageID int64
+	chatID    int64
+	uploader  string
+	ids       []int64
+}
+
+// AttachmentRepositoryMockLinkAttachmentsParamPtrs contains pointers to parameters of the AttachmentRepository.LinkAttachments
+type AttachmentRepositoryMockLinkAttachmentsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	chatID    *int64
+	uploader  *string
+	ids       *[]int64
+}
+
+// AttachmentRepositoryMockLinkAttachmentsResults contains results of the AttachmentRepository.LinkAttachments
+type AttachmentRepositoryMockLinkAttachmentsResults struct {
+	i1  int64
+	err error
+}
+
+// AttachmentRepositoryMockLinkAttachmentsOrigins contains origins of expectations of the AttachmentRepository.LinkAttachments
+type AttachmentRepositoryMockLinkAttachmentsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originChatID    string
+	originUploader  string
+	originIds       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Optional() *mAttachmentRepositoryMockLinkAttachments {
+	mmLinkAttachments.optional = true
+	return mmLinkAttachments
+}
+
+// Expect sets up expected params for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Expect(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmLinkAttachments.defaultExpectation.params = &AttachmentRepositoryMockLinkAttachmentsParams{ctx, messageID, chatID, uploader, ids}
+	mmLinkAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLinkAttachments.expectations {
+		if minimock.Equal(e.params, mmLinkAttachments.defaultExpectation.params) {
+			mmLinkAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLinkAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmLinkAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.params != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Expect")
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs == nil {
+		mmLinkAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockLinkAttachmentsParamPtrs{}
+	}
+	mmLinkAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLinkAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLinkAttachments
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) ExpectMessageIDParam2(messageID int64) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.params != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Expect")
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs == nil {
+		mmLinkAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockLinkAttachmentsParamPtrs{}
+	}
+	mmLinkAttachments.defaultExpectation.paramPtrs.messageID = &messageID
+	mmLinkAttachments.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmLinkAttachments
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) ExpectChatIDParam3(chatID int64) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.params != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Expect")
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs == nil {
+		mmLinkAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockLinkAttachmentsParamPtrs{}
+	}
+	mmLinkAttachments.defaultExpectation.paramPtrs.chatID = &chatID
+	mmLinkAttachments.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmLinkAttachments
+}
+
+// ExpectUploaderParam4 sets up expected param uploader for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) ExpectUploaderParam4(uploader string) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.params != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Expect")
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs == nil {
+		mmLinkAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockLinkAttachmentsParamPtrs{}
+	}
+	mmLinkAttachments.defaultExpectation.paramPtrs.uploader = &uploader
+	mmLinkAttachments.defaultExpectation.expectationOrigins.originUploader = minimock.CallerInfo(1)
+
+	return mmLinkAttachments
+}
+
+// ExpectIdsParam5 sets up expected param ids for AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) ExpectIdsParam5(ids []int64) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{}
+	}
+
+	if mmLinkAttachments.defaultExpectation.params != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Expect")
+	}
+
+	if mmLinkAttachments.defaultExpectation.paramPtrs == nil {
+		mmLinkAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockLinkAttachmentsParamPtrs{}
+	}
+	mmLinkAttachments.defaultExpectation.paramPtrs.ids = &ids
+	mmLinkAttachments.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmLinkAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Inspect(f func(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64)) *mAttachmentRepositoryMockLinkAttachments {
+	if mmLinkAttachments.mock.inspectFuncLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.LinkAttachments")
+	}
+
+	mmLinkAttachments.mock.inspectFuncLinkAttachments = f
+
+	return mmLinkAttachments
+}
+
+// Return sets up results that will be returned by AttachmentRepository.LinkAttachments
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Return(i1 int64, err error) *AttachmentRepositoryMock {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	if mmLinkAttachments.defaultExpectation == nil {
+		mmLinkAttachments.defaultExpectation = &AttachmentRepositoryMockLinkAttachmentsExpectation{mock: mmLinkAttachments.mock}
+	}
+	mmLinkAttachments.defaultExpectation.results = &AttachmentRepositoryMockLinkAttachmentsResults{i1, err}
+	mmLinkAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLinkAttachments.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.LinkAttachments method
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Set(f func(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64) (i1 int64, err error)) *AttachmentRepositoryMock {
+	if mmLinkAttachments.defaultExpectation != nil {
+		mmLinkAttachments.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.LinkAttachments method")
+	}
+
+	if len(mmLinkAttachments.expectations) > 0 {
+		mmLinkAttachments.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.LinkAttachments method")
+	}
+
+	mmLinkAttachments.mock.funcLinkAttachments = f
+	mmLinkAttachments.mock.funcLinkAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmLinkAttachments.mock
+}
+
+// When sets expectation for the AttachmentRepository.LinkAttachments which will trigger the result defined by the following
+// Then helper
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) When(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64) *AttachmentRepositoryMockLinkAttachmentsExpectation {
+	if mmLinkAttachments.mock.funcLinkAttachments != nil {
+		mmLinkAttachments.mock.t.Fatalf("AttachmentRepositoryMock.LinkAttachments mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockLinkAttachmentsExpectation{
+		mock:               mmLinkAttachments.mock,
+		params:             &AttachmentRepositoryMockLinkAttachmentsParams{ctx, messageID, chatID, uploader, ids},
+		expectationOrigins: AttachmentRepositoryMockLinkAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLinkAttachments.expectations = append(mmLinkAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.LinkAttachments return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockLinkAttachmentsExpectation) Then(i1 int64, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockLinkAttachmentsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.LinkAttachments should be invoked
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Times(n uint64) *mAttachmentRepositoryMockLinkAttachments {
+	if n == 0 {
+		mmLinkAttachments.mock.t.Fatalf("Times of AttachmentRepositoryMock.LinkAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLinkAttachments.expectedInvocations, n)
+	mmLinkAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLinkAttachments
+}
+
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) invocationsDone() bool {
+	if len(mmLinkAttachments.expectations) == 0 && mmLinkAttachments.defaultExpectation == nil && mmLinkAttachments.mock.funcLinkAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLinkAttachments.mock.afterLinkAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLinkAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LinkAttachments implements mm_repository.AttachmentRepository
+func (mmLinkAttachments *AttachmentRepositoryMock) LinkAttachments(ctx context.Context, messageID int64, chatID int64, uploader string, ids []int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmLinkAttachments.beforeLinkAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmLinkAttachments.afterLinkAttachmentsCounter, 1)
+
+	mmLinkAttachments.t.Helper()
+
+	if mmLinkAttachments.inspectFuncLinkAttachments != nil {
+		mmLinkAttachments.inspectFuncLinkAttachments(ctx, messageID, chatID, uploader, ids)
+	}
+
+	mm_params := AttachmentRepositoryMockLinkAttachmentsParams{ctx, messageID, chatID, uploader, ids}
+
+	// Record call args
+	mmLinkAttachments.LinkAttachmentsMock.mutex.Lock()
+	mmLinkAttachments.LinkAttachmentsMock.callArgs = append(mmLinkAttachments.LinkAttachmentsMock.callArgs, &mm_params)
+	mmLinkAttachments.LinkAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmLinkAttachments.LinkAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmLinkAttachments.LinkAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockLinkAttachmentsParams{ctx, messageID, chatID, uploader, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.uploader != nil && !minimock.Equal(*mm_want_ptrs.uploader, mm_got.uploader) {
+				mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameter uploader, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.originUploader, *mm_want_ptrs.uploader, mm_got.uploader, minimock.Diff(*mm_want_ptrs.uploader, mm_got.uploader))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLinkAttachments.t.Errorf("AttachmentRepositoryMock.LinkAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLinkAttachments.LinkAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLinkAttachments.t.Fatal("No results are set for the AttachmentRepositoryMock.LinkAttachments")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmLinkAttachments.funcLinkAttachments != nil {
+		return mmLinkAttachments.funcLinkAttachments(ctx, messageID, chatID, uploader, ids)
+	}
+	mmLinkAttachments.t.Fatalf("Unexpected call to AttachmentRepositoryMock.LinkAttachments. %v %v %v %v %v", ctx, messageID, chatID, uploader, ids)
+	return
+}
+
+// LinkAttachmentsAfterCounter returns a count of finished AttachmentRepositoryMock.LinkAttachments invocations
+func (mmLinkAttachments *AttachmentRepositoryMock) LinkAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkAttachments.afterLinkAttachmentsCounter)
+}
+
+// LinkAttachmentsBeforeCounter returns a count of AttachmentRepositoryMock.LinkAttachments invocations
+func (mmLinkAttachments *AttachmentRepositoryMock) LinkAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLinkAttachments.beforeLinkAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.LinkAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLinkAttachments *mAttachmentRepositoryMockLinkAttachments) Calls() []*AttachmentRepositoryMockLinkAttachmentsParams {
+	mmLinkAttachments.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockLinkAttachmentsParams, len(mmLinkAttachments.callArgs))
+	copy(argCopy, mmLinkAttachments.callArgs)
+
+	mmLinkAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLinkAttachmentsDone returns true if the count of the LinkAttachments invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockLinkAttachmentsDone() bool {
+	if m.LinkAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LinkAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LinkAttachmentsMock.invocationsDone()
+}
+
+// MinimockLinkAttachmentsInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockLinkAttachmentsInspect() {
+	for _, e := range m.LinkAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.LinkAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLinkAttachmentsCounter := mm_atomic.LoadUint64(&m.afterLinkAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LinkAttachmentsMock.defaultExpectation != nil && afterLinkAttachmentsCounter < 1 {
+		if m.LinkAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.LinkAttachments at\n%s", m.LinkAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.LinkAttachments at\n%s with params: %#v", m.LinkAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.LinkAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLinkAttachments != nil && afterLinkAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.LinkAttachments at\n%s", m.funcLinkAttachmentsOrigin)
+	}
+
+	if !m.LinkAttachmentsMock.invocationsDone() && afterLinkAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.LinkAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LinkAttachmentsMock.expectedInvocations), m.LinkAttachmentsMock.expectedInvocationsOrigin, afterLinkAttachmentsCounter)
+	}
+}
+
+type mAttachmentRepositoryMockListAttachments struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockListAttachmentsExpectation
+	expectations       []*AttachmentRepositoryMockListAttachmentsExpectation
+
+	callArgs []*AttachmentRepositoryMockListAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockListAttachmentsExpectation specifies expectation struct of the AttachmentRepository.ListAttachments
+type AttachmentRepositoryMockListAttachmentsExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockListAttachmentsParams
+	paramPtrs          *AttachmentRepositoryMockListAttachmentsParamPtrs
+	expectationOrigins AttachmentRepositoryMockListAttachmentsExpectationOrigins
+	results            *AttachmentRepositoryMockListAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockListAttachmentsParams contains parameters of the AttachmentRepository.ListAttachments
+type AttachmentRepositoryMockListAttachmentsParams struct {
+	ctx        context.Context
+	messageIDs []int64
+}
+
+// AttachmentRepositoryMockListAttachmentsParamPtrs contains pointers to parameters of the AttachmentRepository.ListAttachments
+type AttachmentRepositoryMockListAttachmentsParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+}
+
+// AttachmentRepositoryMockListAttachmentsResults contains results of the AttachmentRepository.ListAttachments
+type AttachmentRepositoryMockListAttachmentsResults struct {
+	m1  map[int64][]*model.Attachment
+	err error
+}
+
+// AttachmentRepositoryMockListAttachmentsOrigins contains origins of expectations of the AttachmentRepository.ListAttachments
+type AttachmentRepositoryMockListAttachmentsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Optional() *mAttachmentRepositoryMockListAttachments {
+	mmListAttachments.optional = true
+	return mmListAttachments
+}
+
+// Expect sets up expected params for AttachmentRepository.ListAttachments
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Expect(ctx context.Context, messageIDs []int64) *mAttachmentRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &AttachmentRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmListAttachments.defaultExpectation.params = &AttachmentRepositoryMockListAttachmentsParams{ctx, messageIDs}
+	mmListAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListAttachments.expectations {
+		if minimock.Equal(e.params, mmListAttachments.defaultExpectation.params) {
+			mmListAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmListAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.ListAttachments
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &AttachmentRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.params != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Expect")
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs == nil {
+		mmListAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockListAttachmentsParamPtrs{}
+	}
+	mmListAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListAttachments
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for AttachmentRepository.ListAttachments
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) ExpectMessageIDsParam2(messageIDs []int64) *mAttachmentRepositoryMockListAttachments {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &AttachmentRepositoryMockListAttachmentsExpectation{}
+	}
+
+	if mmListAttachments.defaultExpectation.params != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Expect")
+	}
+
+	if mmListAttachments.defaultExpectation.paramPtrs == nil {
+		mmListAttachments.defaultExpectation.paramPtrs = &AttachmentRepositoryMockListAttachmentsParamPtrs{}
+	}
+	mmListAttachments.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListAttachments.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.ListAttachments
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Inspect(f func(ctx context.Context, messageIDs []int64)) *mAttachmentRepositoryMockListAttachments {
+	if mmListAttachments.mock.inspectFuncListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.ListAttachments")
+	}
+
+	mmListAttachments.mock.inspectFuncListAttachments = f
+
+	return mmListAttachments
+}
+
+// Return sets up results that will be returned by AttachmentRepository.ListAttachments
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Return(m1 map[int64][]*model.Attachment, err error) *AttachmentRepositoryMock {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	if mmListAttachments.defaultExpectation == nil {
+		mmListAttachments.defaultExpectation = &AttachmentRepositoryMockListAttachmentsExpectation{mock: mmListAttachments.mock}
+	}
+	mmListAttachments.defaultExpectation.results = &AttachmentRepositoryMockListAttachmentsResults{m1, err}
+	mmListAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListAttachments.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.ListAttachments method
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Set(f func(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error)) *AttachmentRepositoryMock {
+	if mmListAttachments.defaultExpectation != nil {
+		mmListAttachments.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.ListAttachments method")
+	}
+
+	if len(mmListAttachments.expectations) > 0 {
+		mmListAttachments.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.ListAttachments method")
+	}
+
+	mmListAttachments.mock.funcListAttachments = f
+	mmListAttachments.mock.funcListAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmListAttachments.mock
+}
+
+// When sets expectation for the AttachmentRepository.ListAttachments which will trigger the result defined by the following
+// Then helper
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) When(ctx context.Context, messageIDs []int64) *AttachmentRepositoryMockListAttachmentsExpectation {
+	if mmListAttachments.mock.funcListAttachments != nil {
+		mmListAttachments.mock.t.Fatalf("AttachmentRepositoryMock.ListAttachments mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockListAttachmentsExpectation{
+		mock:               mmListAttachments.mock,
+		params:             &AttachmentRepositoryMockListAttachmentsParams{ctx, messageIDs},
+		expectationOrigins: AttachmentRepositoryMockListAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListAttachments.expectations = append(mmListAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.ListAttachments return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockListAttachmentsExpectation) Then(m1 map[int64][]*model.Attachment, err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockListAttachmentsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.ListAttachments should be invoked
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Times(n uint64) *mAttachmentRepositoryMockListAttachments {
+	if n == 0 {
+		mmListAttachments.mock.t.Fatalf("Times of AttachmentRepositoryMock.ListAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListAttachments.expectedInvocations, n)
+	mmListAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListAttachments
+}
+
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) invocationsDone() bool {
+	if len(mmListAttachments.expectations) == 0 && mmListAttachments.defaultExpectation == nil && mmListAttachments.mock.funcListAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListAttachments.mock.afterListAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListAttachments implements mm_repository.AttachmentRepository
+func (mmListAttachments *AttachmentRepositoryMock) ListAttachments(ctx context.Context, messageIDs []int64) (m1 map[int64][]*model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmListAttachments.beforeListAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListAttachments.afterListAttachmentsCounter, 1)
+
+	mmListAttachments.t.Helper()
+
+	if mmListAttachments.inspectFuncListAttachments != nil {
+		mmListAttachments.inspectFuncListAttachments(ctx, messageIDs)
+	}
+
+	mm_params := AttachmentRepositoryMockListAttachmentsParams{ctx, messageIDs}
+
+	// Record call args
+	mmListAttachments.ListAttachmentsMock.mutex.Lock()
+	mmListAttachments.ListAttachmentsMock.callArgs = append(mmListAttachments.ListAttachmentsMock.callArgs, &mm_params)
+	mmListAttachments.ListAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmListAttachments.ListAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListAttachments.ListAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListAttachments.ListAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListAttachments.ListAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmListAttachments.ListAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockListAttachmentsParams{ctx, messageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListAttachments.t.Errorf("AttachmentRepositoryMock.ListAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListAttachments.t.Errorf("AttachmentRepositoryMock.ListAttachments got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListAttachments.t.Errorf("AttachmentRepositoryMock.ListAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListAttachments.ListAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListAttachments.ListAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListAttachments.t.Fatal("No results are set for the AttachmentRepositoryMock.ListAttachments")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListAttachments.funcListAttachments != nil {
+		return mmListAttachments.funcListAttachments(ctx, messageIDs)
+	}
+	mmListAttachments.t.Fatalf("Unexpected call to AttachmentRepositoryMock.ListAttachments. %v %v", ctx, messageIDs)
+	return
+}
+
+// ListAttachmentsAfterCounter returns a count of finished AttachmentRepositoryMock.ListAttachments invocations
+func (mmListAttachments *AttachmentRepositoryMock) ListAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttachments.afterListAttachmentsCounter)
+}
+
+// ListAttachmentsBeforeCounter returns a count of AttachmentRepositoryMock.ListAttachments invocations
+func (mmListAttachments *AttachmentRepositoryMock) ListAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListAttachments.beforeListAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.ListAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListAttachments *mAttachmentRepositoryMockListAttachments) Calls() []*AttachmentRepositoryMockListAttachmentsParams {
+	mmListAttachments.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockListAttachmentsParams, len(mmListAttachments.callArgs))
+	copy(argCopy, mmListAttachments.callArgs)
+
+	mmListAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListAttachmentsDone returns true if the count of the ListAttachments invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockListAttachmentsDone() bool {
+	if m.ListAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListAttachmentsMock.invocationsDone()
+}
+
+// MinimockListAttachmentsInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockListAttachmentsInspect() {
+	for _, e := range m.ListAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.ListAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListAttachmentsCounter := mm_atomic.LoadUint64(&m.afterListAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListAttachmentsMock.defaultExpectation != nil && afterListAttachmentsCounter < 1 {
+		if m.ListAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.ListAttachments at\n%s", m.ListAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.ListAttachments at\n%s with params: %#v", m.ListAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.ListAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListAttachments != nil && afterListAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.ListAttachments at\n%s", m.funcListAttachmentsOrigin)
+	}
+
+	if !m.ListAttachmentsMock.invocationsDone() && afterListAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.ListAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListAttachmentsMock.expectedInvocations), m.ListAttachmentsMock.expectedInvocationsOrigin, afterListAttachmentsCounter)
+	}
+}
+
+type mAttachmentRepositoryMockSetImageInfo struct {
+	optional           bool
+	mock               *AttachmentRepositoryMock
+	defaultExpectation *AttachmentRepositoryMockSetImageInfoExpectation
+	expectations       []*AttachmentRepositoryMockSetImageInfoExpectation
+
+	callArgs []*AttachmentRepositoryMockSetImageInfoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentRepositoryMockSetImageInfoExpectation specifies expectation struct of the AttachmentRepository.SetImageInfo
+type AttachmentRepositoryMockSetImageInfoExpectation struct {
+	mock               *AttachmentRepositoryMock
+	params             *AttachmentRepositoryMockSetImageInfoParams
+	paramPtrs          *AttachmentRepositoryMockSetImageInfoParamPtrs
+	expectationOrigins AttachmentRepositoryMockSetImageInfoExpectationOrigins
+	results            *AttachmentRepositoryMockSetImageInfoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentRepositoryMockSetImageInfoParams contains parameters of the AttachmentRepository.SetImageInfo
+type AttachmentRepositoryMockSetImageInfoParams struct {
+	ctx        context.Context
+	id         int64
+	width      int
+	height     int
+	thumbnails []*model.Thumbnail
+}
+
+// AttachmentRepositoryMockSetImageInfoParamPtrs contains pointers to parameters of the AttachmentRepository.SetImageInfo
+type AttachmentRepositoryMockSetImageInfoParamPtrs struct {
+	ctx        *context.Context
+	id         *int64
+	width      *int
+	height     *int
+	thumbnails *[]*model.Thumbnail
+}
+
+// AttachmentRepositoryMockSetImageInfoResults contains results of the AttachmentRepository.SetImageInfo
+type AttachmentRepositoryMockSetImageInfoResults struct {
+	err error
+}
+
+// AttachmentRepositoryMockSetImageInfoOrigins contains origins of expectations of the AttachmentRepository.SetImageInfo
+type AttachmentRepositoryMockSetImageInfoExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originId         string
+	originWidth      string
+	originHeight     string
+	originThumbnails string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Optional() *mAttachmentRepositoryMockSetImageInfo {
+	mmSetImageInfo.optional = true
+	return mmSetImageInfo
+}
+
+// Expect sets up expected params for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Expect(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by ExpectParams functions")
+	}
+
+	mmSetImageInfo.defaultExpectation.params = &AttachmentRepositoryMockSetImageInfoParams{ctx, id, width, height, thumbnails}
+	mmSetImageInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetImageInfo.expectations {
+		if minimock.Equal(e.params, mmSetImageInfo.defaultExpectation.params) {
+			mmSetImageInfo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetImageInfo.defaultExpectation.params)
+		}
+	}
+
+	return mmSetImageInfo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) ExpectCtxParam1(ctx context.Context) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.params != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Expect")
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs == nil {
+		mmSetImageInfo.defaultExpectation.paramPtrs = &AttachmentRepositoryMockSetImageInfoParamPtrs{}
+	}
+	mmSetImageInfo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetImageInfo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetImageInfo
+}
+
+// ExpectIdParam2 sets up expected param id for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) ExpectIdParam2(id int64) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.params != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Expect")
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs == nil {
+		mmSetImageInfo.defaultExpectation.paramPtrs = &AttachmentRepositoryMockSetImageInfoParamPtrs{}
+	}
+	mmSetImageInfo.defaultExpectation.paramPtrs.id = &id
+	mmSetImageInfo.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmSetImageInfo
+}
+
+// ExpectWidthParam3 sets up expected param width for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) ExpectWidthParam3(width int) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.params != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Expect")
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs == nil {
+		mmSetImageInfo.defaultExpectation.paramPtrs = &AttachmentRepositoryMockSetImageInfoParamPtrs{}
+	}
+	mmSetImageInfo.defaultExpectation.paramPtrs.width = &width
+	mmSetImageInfo.defaultExpectation.expectationOrigins.originWidth = minimock.CallerInfo(1)
+
+	return mmSetImageInfo
+}
+
+// ExpectHeightParam4 sets up expected param height for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) ExpectHeightParam4(height int) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.params != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Expect")
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs == nil {
+		mmSetImageInfo.defaultExpectation.paramPtrs = &AttachmentRepositoryMockSetImageInfoParamPtrs{}
+	}
+	mmSetImageInfo.defaultExpectation.paramPtrs.height = &height
+	mmSetImageInfo.defaultExpectation.expectationOrigins.originHeight = minimock.CallerInfo(1)
+
+	return mmSetImageInfo
+}
+
+// ExpectThumbnailsParam5 sets up expected param thumbnails for AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) ExpectThumbnailsParam5(thumbnails []*model.Thumbnail) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{}
+	}
+
+	if mmSetImageInfo.defaultExpectation.params != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Expect")
+	}
+
+	if mmSetImageInfo.defaultExpectation.paramPtrs == nil {
+		mmSetImageInfo.defaultExpectation.paramPtrs = &AttachmentRepositoryMockSetImageInfoParamPtrs{}
+	}
+	mmSetImageInfo.defaultExpectation.paramPtrs.thumbnails = &thumbnails
+	mmSetImageInfo.defaultExpectation.expectationOrigins.originThumbnails = minimock.CallerInfo(1)
+
+	return mmSetImageInfo
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Inspect(f func(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail)) *mAttachmentRepositoryMockSetImageInfo {
+	if mmSetImageInfo.mock.inspectFuncSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("Inspect function is already set for AttachmentRepositoryMock.SetImageInfo")
+	}
+
+	mmSetImageInfo.mock.inspectFuncSetImageInfo = f
+
+	return mmSetImageInfo
+}
+
+// Return sets up results that will be returned by AttachmentRepository.SetImageInfo
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Return(err error) *AttachmentRepositoryMock {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	if mmSetImageInfo.defaultExpectation == nil {
+		mmSetImageInfo.defaultExpectation = &AttachmentRepositoryMockSetImageInfoExpectation{mock: mmSetImageInfo.mock}
+	}
+	mmSetImageInfo.defaultExpectation.results = &AttachmentRepositoryMockSetImageInfoResults{err}
+	mmSetImageInfo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetImageInfo.mock
+}
+
+// Set uses given function f to mock the AttachmentRepository.SetImageInfo method
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Set(f func(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail) (err error)) *AttachmentRepositoryMock {
+	if mmSetImageInfo.defaultExpectation != nil {
+		mmSetImageInfo.mock.t.Fatalf("Default expectation is already set for the AttachmentRepository.SetImageInfo method")
+	}
+
+	if len(mmSetImageInfo.expectations) > 0 {
+		mmSetImageInfo.mock.t.Fatalf("Some expectations are already set for the AttachmentRepository.SetImageInfo method")
+	}
+
+	mmSetImageInfo.mock.funcSetImageInfo = f
+	mmSetImageInfo.mock.funcSetImageInfoOrigin = minimock.CallerInfo(1)
+	return mmSetImageInfo.mock
+}
+
+// When sets expectation for the AttachmentRepository.SetImageInfo which will trigger the result defined by the following
+// Then helper
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) When(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail) *AttachmentRepositoryMockSetImageInfoExpectation {
+	if mmSetImageInfo.mock.funcSetImageInfo != nil {
+		mmSetImageInfo.mock.t.Fatalf("AttachmentRepositoryMock.SetImageInfo mock is already set by Set")
+	}
+
+	expectation := &AttachmentRepositoryMockSetImageInfoExpectation{
+		mock:               mmSetImageInfo.mock,
+		params:             &AttachmentRepositoryMockSetImageInfoParams{ctx, id, width, height, thumbnails},
+		expectationOrigins: AttachmentRepositoryMockSetImageInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetImageInfo.expectations = append(mmSetImageInfo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentRepository.SetImageInfo return parameters for the expectation previously defined by the When method
+func (e *AttachmentRepositoryMockSetImageInfoExpectation) Then(err error) *AttachmentRepositoryMock {
+	e.results = &AttachmentRepositoryMockSetImageInfoResults{err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentRepository.SetImageInfo should be invoked
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Times(n uint64) *mAttachmentRepositoryMockSetImageInfo {
+	if n == 0 {
+		mmSetImageInfo.mock.t.Fatalf("Times of AttachmentRepositoryMock.SetImageInfo mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetImageInfo.expectedInvocations, n)
+	mmSetImageInfo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetImageInfo
+}
+
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) invocationsDone() bool {
+	if len(mmSetImageInfo.expectations) == 0 && mmSetImageInfo.defaultExpectation == nil && mmSetImageInfo.mock.funcSetImageInfo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetImageInfo.mock.afterSetImageInfoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetImageInfo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetImageInfo implements mm_repository.AttachmentRepository
+func (mmSetImageInfo *AttachmentRepositoryMock) SetImageInfo(ctx context.Context, id int64, width int, height int, thumbnails []*model.Thumbnail) (err error) {
+	mm_atomic.AddUint64(&mmSetImageInfo.beforeSetImageInfoCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetImageInfo.afterSetImageInfoCounter, 1)
+
+	mmSetImageInfo.t.Helper()
+
+	if mmSetImageInfo.inspectFuncSetImageInfo != nil {
+		mmSetImageInfo.inspectFuncSetImageInfo(ctx, id, width, height, thumbnails)
+	}
+
+	mm_params := AttachmentRepositoryMockSetImageInfoParams{ctx, id, width, height, thumbnails}
+
+	// Record call args
+	mmSetImageInfo.SetImageInfoMock.mutex.Lock()
+	mmSetImageInfo.SetImageInfoMock.callArgs = append(mmSetImageInfo.SetImageInfoMock.callArgs, &mm_params)
+	mmSetImageInfo.SetImageInfoMock.mutex.Unlock()
+
+	for _, e := range mmSetImageInfo.SetImageInfoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetImageInfo.SetImageInfoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetImageInfo.SetImageInfoMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetImageInfo.SetImageInfoMock.defaultExpectation.params
+		mm_want_ptrs := mmSetImageInfo.SetImageInfoMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentRepositoryMockSetImageInfoParams{ctx, id, width, height, thumbnails}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.width != nil && !minimock.Equal(*mm_want_ptrs.width, mm_got.width) {
+				mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameter width, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.originWidth, *mm_want_ptrs.width, mm_got.width, minimock.Diff(*mm_want_ptrs.width, mm_got.width))
+			}
+
+			if mm_want_ptrs.height != nil && !minimock.Equal(*mm_want_ptrs.height, mm_got.height) {
+				mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameter height, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.originHeight, *mm_want_ptrs.height, mm_got.height, minimock.Diff(*mm_want_ptrs.height, mm_got.height))
+			}
+
+			if mm_want_ptrs.thumbnails != nil && !minimock.Equal(*mm_want_ptrs.thumbnails, mm_got.thumbnails) {
+				mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameter thumbnails, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.originThumbnails, *mm_want_ptrs.thumbnails, mm_got.thumbnails, minimock.Diff(*mm_want_ptrs.thumbnails, mm_got.thumbnails))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetImageInfo.t.Errorf("AttachmentRepositoryMock.SetImageInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetImageInfo.SetImageInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetImageInfo.SetImageInfoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetImageInfo.t.Fatal("No results are set for the AttachmentRepositoryMock.SetImageInfo")
+		}
+		return (*mm_results).err
+	}
+	if mmSetImageInfo.funcSetImageInfo != nil {
+		return mmSetImageInfo.funcSetImageInfo(ctx, id, width, height, thumbnails)
+	}
+	mmSetImageInfo.t.Fatalf("Unexpected call to AttachmentRepositoryMock.SetImageInfo. %v %v %v %v %v", ctx, id, width, height, thumbnails)
+	return
+}
+
+// SetImageInfoAfterCounter returns a count of finished AttachmentRepositoryMock.SetImageInfo invocations
+func (mmSetImageInfo *AttachmentRepositoryMock) SetImageInfoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetImageInfo.afterSetImageInfoCounter)
+}
+
+// SetImageInfoBeforeCounter returns a count of AttachmentRepositoryMock.SetImageInfo invocations
+func (mmSetImageInfo *AttachmentRepositoryMock) SetImageInfoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetImageInfo.beforeSetImageInfoCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentRepositoryMock.SetImageInfo.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetImageInfo *mAttachmentRepositoryMockSetImageInfo) Calls() []*AttachmentRepositoryMockSetImageInfoParams {
+	mmSetImageInfo.mutex.RLock()
+
+	argCopy := make([]*AttachmentRepositoryMockSetImageInfoParams, len(mmSetImageInfo.callArgs))
+	copy(argCopy, mmSetImageInfo.callArgs)
+
+	mmSetImageInfo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetImageInfoDone returns true if the count of the SetImageInfo invocations corresponds
+// the number of defined expectations
+func (m *AttachmentRepositoryMock) MinimockSetImageInfoDone() bool {
+	if m.SetImageInfoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetImageInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetImageInfoMock.invocationsDone()
+}
+
+// MinimockSetImageInfoInspect logs each unmet expectation
+func (m *AttachmentRepositoryMock) MinimockSetImageInfoInspect() {
+	for _, e := range m.SetImageInfoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.SetImageInfo at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetImageInfoCounter := mm_atomic.LoadUint64(&m.afterSetImageInfoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetImageInfoMock.defaultExpectation != nil && afterSetImageInfoCounter < 1 {
+		if m.SetImageInfoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.SetImageInfo at\n%s", m.SetImageInfoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentRepositoryMock.SetImageInfo at\n%s with params: %#v", m.SetImageInfoMock.defaultExpectation.expectationOrigins.origin, *m.SetImageInfoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetImageInfo != nil && afterSetImageInfoCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentRepositoryMock.SetImageInfo at\n%s", m.funcSetImageInfoOrigin)
+	}
+
+	if !m.SetImageInfoMock.invocationsDone() && afterSetImageInfoCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentRepositoryMock.SetImageInfo at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetImageInfoMock.expectedInvocations), m.SetImageInfoMock.expectedInvocationsOrigin, afterSetImageInfoCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AttachmentRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAttachmentInspect()
+
+			m.MinimockDeleteChatAttachmentsInspect()
+
+			m.MinimockDeleteMessageAttachmentsInspect()
+
+			m.MinimockDeleteStaleUploadsInspect()
+
+			m.MinimockGetAttachmentInspect()
+
+			m.MinimockLinkAttachmentsInspect()
+
+			m.MinimockListAttachmentsInspect()
+
+			m.MinimockSetImageInfoInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AttachmentRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AttachmentRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAttachmentDone() &&
+		m.MinimockDeleteChatAttachmentsDone() &&
+		m.MinimockDeleteMessageAttachmentsDone() &&
+		m.MinimockDeleteStaleUploadsDone() &&
+		m.MinimockGetAttachmentDone() &&
+		m.MinimockLinkAttachmentsDone() &&
+		m.MinimockListAttachmentsDone() &&
+		m.MinimockSetImageInfoDone()
+}
@@ -0,0 +1,1098 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat/chat_server/internal/repository.ChangeRepository -o change_repository_mock.go -n ChangeRepositoryMock -p mocks
+
+import (
+	"chat/chat_server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ChangeRepositoryMock implements mm_repository.ChangeRepository
+type ChangeRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddChanges          func(ctx context.Context, changes ...*model.Change) (err error)
+	funcAddChangesOrigin    string
+	inspectFuncAddChanges   func(ctx context.Context, changes ...*model.Change)
+	afterAddChangesCounter  uint64
+	beforeAddChangesCounter uint64
+	AddChangesMock          mChangeRepositoryMockAddChanges
+
+	funcHorizon          func(ctx context.Context) (i1 int64, err error)
+	funcHorizonOrigin    string
+	inspectFuncHorizon   func(ctx context.Context)
+	afterHorizonCounter  uint64
+	beforeHorizonCounter uint64
+	HorizonMock          mChangeRepositoryMockHorizon
+
+	funcListChanges          func(ctx context.Context, query *model.ChangeQuery) (cpa1 []*model.Change, err error)
+	funcListChangesOrigin    string
+	inspectFuncListChanges   func(ctx context.Context, query *model.ChangeQuery)
+	afterListChangesCounter  uint64
+	beforeListChangesCounter uint64
+	ListChangesMock          mChangeRepositoryMockListChanges
+}
+
+// NewChangeRepositoryMock returns a mock for mm_repository.ChangeRepository
+func NewChangeRepositoryMock(t minimock.Tester) *ChangeRepositoryMock {
+	m := &ChangeRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddChangesMock = mChangeRepositoryMockAddChanges{mock: m}
+	m.AddChangesMock.callArgs = []*ChangeRepositoryMockAddChangesParams{}
+
+	m.HorizonMock = mChangeRepositoryMockHorizon{mock: m}
+	m.HorizonMock.callArgs = []*ChangeRepositoryMockHorizonParams{}
+
+	m.ListChangesMock = mChangeRepositoryMockListChanges{mock: m}
+	m.ListChangesMock.callArgs = []*ChangeRepositoryMockListChangesParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mChangeRepositoryMockAddChanges struct {
+	optional           bool
+	mock               *ChangeRepositoryMock
+	defaultExpectation *ChangeRepositoryMockAddChangesExpectation
+	expectations       []*ChangeRepositoryMockAddChangesExpectation
+
+	callArgs []*ChangeRepositoryMockAddChangesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChangeRepositoryMockAddChangesExpectation specifies expectation struct of the ChangeRepository.AddChanges
+type ChangeRepositoryMockAddChangesExpectation struct {
+	mock               *ChangeRepositoryMock
+	params             *ChangeRepositoryMockAddChangesParams
+	paramPtrs          *ChangeRepositoryMockAddChangesParamPtrs
+	expectationOrigins ChangeRepositoryMockAddChangesExpectationOrigins
+	results            *ChangeRepositoryMockAddChangesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChangeRepositoryMockAddChangesParams contains parameters of the ChangeRepository.AddChanges
+type ChangeRepositoryMockAddChangesParams struct {
+	ctx     context.Context
+	changes []*model.Change
+}
+
+// ChangeRepositoryMockAddChangesParamPtrs contains pointers to parameters of the ChangeRepository.AddChanges
+type ChangeRepositoryMockAddChangesParamPtrs struct {
+	ctx     *context.Context
+	changes *[]*model.Change
+}
+
+// ChangeRepositoryMockAddChangesResults contains results of the ChangeRepository.AddChanges
+type ChangeRepositoryMockAddChangesResults struct {
+	err error
+}
+
+// ChangeRepositoryMockAddChangesOrigins contains origins of expectations of the ChangeRepository.AddChanges
+type ChangeRepositoryMockAddChangesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChanges string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Optional() *mChangeRepositoryMockAddChanges {
+	mmAddChanges.optional = true
+	return mmAddChanges
+}
+
+// Expect sets up expected params for ChangeRepository.AddChanges
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Expect(ctx context.Context, changes ...*model.Change) *mChangeRepositoryMockAddChanges {
+	if mmAddChanges.mock.funcAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Set")
+	}
+
+	if mmAddChanges.defaultExpectation == nil {
+		mmAddChanges.defaultExpectation = &ChangeRepositoryMockAddChangesExpectation{}
+	}
+
+	if mmAddChanges.defaultExpectation.paramPtrs != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by ExpectParams functions")
+	}
+
+	mmAddChanges.defaultExpectation.params = &ChangeRepositoryMockAddChangesParams{ctx, changes}
+	mmAddChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddChanges.expectations {
+		if minimock.Equal(e.params, mmAddChanges.defaultExpectation.params) {
+			mmAddChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmAddChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChangeRepository.AddChanges
+func (mmAddChanges *mChangeRepositoryMockAddChanges) ExpectCtxParam1(ctx context.Context) *mChangeRepositoryMockAddChanges {
+	if mmAddChanges.mock.funcAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Set")
+	}
+
+	if mmAddChanges.defaultExpectation == nil {
+		mmAddChanges.defaultExpectation = &ChangeRepositoryMockAddChangesExpectation{}
+	}
+
+	if mmAddChanges.defaultExpectation.params != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Expect")
+	}
+
+	if mmAddChanges.defaultExpectation.paramPtrs == nil {
+		mmAddChanges.defaultExpectation.paramPtrs = &ChangeRepositoryMockAddChangesParamPtrs{}
+	}
+	mmAddChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddChanges
+}
+
+// ExpectChangesParam2 sets up expected param changes for ChangeRepository.AddChanges
+func (mmAddChanges *mChangeRepositoryMockAddChanges) ExpectChangesParam2(changes ...*model.Change) *mChangeRepositoryMockAddChanges {
+	if mmAddChanges.mock.funcAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Set")
+	}
+
+	if mmAddChanges.defaultExpectation == nil {
+		mmAddChanges.defaultExpectation = &ChangeRepositoryMockAddChangesExpectation{}
+	}
+
+	if mmAddChanges.defaultExpectation.params != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Expect")
+	}
+
+	if mmAddChanges.defaultExpectation.paramPtrs == nil {
+		mmAddChanges.defaultExpectation.paramPtrs = &ChangeRepositoryMockAddChangesParamPtrs{}
+	}
+	mmAddChanges.defaultExpectation.paramPtrs.changes = &changes
+	mmAddChanges.defaultExpectation.expectationOrigins.originChanges = minimock.CallerInfo(1)
+
+	return mmAddChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChangeRepository.AddChanges
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Inspect(f func(ctx context.Context, changes ...*model.Change)) *mChangeRepositoryMockAddChanges {
+	if mmAddChanges.mock.inspectFuncAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("Inspect function is already set for ChangeRepositoryMock.AddChanges")
+	}
+
+	mmAddChanges.mock.inspectFuncAddChanges = f
+
+	return mmAddChanges
+}
+
+// Return sets up results that will be returned by ChangeRepository.AddChanges
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Return(err error) *ChangeRepositoryMock {
+	if mmAddChanges.mock.funcAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Set")
+	}
+
+	if mmAddChanges.defaultExpectation == nil {
+		mmAddChanges.defaultExpectation = &ChangeRepositoryMockAddChangesExpectation{mock: mmAddChanges.mock}
+	}
+	mmAddChanges.defaultExpectation.results = &ChangeRepositoryMockAddChangesResults{err}
+	mmAddChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddChanges.mock
+}
+
+// Set uses given function f to mock the ChangeRepository.AddChanges method
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Set(f func(ctx context.Context, changes ...*model.Change) (err error)) *ChangeRepositoryMock {
+	if mmAddChanges.defaultExpectation != nil {
+		mmAddChanges.mock.t.Fatalf("Default expectation is already set for the ChangeRepository.AddChanges method")
+	}
+
+	if len(mmAddChanges.expectations) > 0 {
+		mmAddChanges.mock.t.Fatalf("Some expectations are already set for the ChangeRepository.AddChanges method")
+	}
+
+	mmAddChanges.mock.funcAddChanges = f
+	mmAddChanges.mock.funcAddChangesOrigin = minimock.CallerInfo(1)
+	return mmAddChanges.mock
+}
+
+// When sets expectation for the ChangeRepository.AddChanges which will trigger the result defined by the following
+// Then helper
+func (mmAddChanges *mChangeRepositoryMockAddChanges) When(ctx context.Context, changes ...*model.Change) *ChangeRepositoryMockAddChangesExpectation {
+	if mmAddChanges.mock.funcAddChanges != nil {
+		mmAddChanges.mock.t.Fatalf("ChangeRepositoryMock.AddChanges mock is already set by Set")
+	}
+
+	expectation := &ChangeRepositoryMockAddChangesExpectation{
+		mock:               mmAddChanges.mock,
+		params:             &ChangeRepositoryMockAddChangesParams{ctx, changes},
+		expectationOrigins: ChangeRepositoryMockAddChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddChanges.expectations = append(mmAddChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChangeRepository.AddChanges return parameters for the expectation previously defined by the When method
+func (e *ChangeRepositoryMockAddChangesExpectation) Then(err error) *ChangeRepositoryMock {
+	e.results = &ChangeRepositoryMockAddChangesResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChangeRepository.AddChanges should be invoked
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Times(n uint64) *mChangeRepositoryMockAddChanges {
+	if n == 0 {
+		mmAddChanges.mock.t.Fatalf("Times of ChangeRepositoryMock.AddChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddChanges.expectedInvocations, n)
+	mmAddChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddChanges
+}
+
+func (mmAddChanges *mChangeRepositoryMockAddChanges) invocationsDone() bool {
+	if len(mmAddChanges.expectations) == 0 && mmAddChanges.defaultExpectation == nil && mmAddChanges.mock.funcAddChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddChanges.mock.afterAddChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddChanges implements mm_repository.ChangeRepository
+func (mmAddChanges *ChangeRepositoryMock) AddChanges(ctx context.Context, changes ...*model.Change) (err error) {
+	mm_atomic.AddUint64(&mmAddChanges.beforeAddChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddChanges.afterAddChangesCounter, 1)
+
+	mmAddChanges.t.Helper()
+
+	if mmAddChanges.inspectFuncAddChanges != nil {
+		mmAddChanges.inspectFuncAddChanges(ctx, changes...)
+	}
+
+	mm_params := ChangeRepositoryMockAddChangesParams{ctx, changes}
+
+	// Record call args
+	mmAddChanges.AddChangesMock.mutex.Lock()
+	mmAddChanges.AddChangesMock.callArgs = append(mmAddChanges.AddChangesMock.callArgs, &mm_params)
+	mmAddChanges.AddChangesMock.mutex.Unlock()
+
+	for _, e := range mmAddChanges.AddChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddChanges.AddChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddChanges.AddChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddChanges.AddChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmAddChanges.AddChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChangeRepositoryMockAddChangesParams{ctx, changes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddChanges.t.Errorf("ChangeRepositoryMock.AddChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChanges.AddChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.changes != nil && !minimock.Equal(*mm_want_ptrs.changes, mm_got.changes) {
+				mmAddChanges.t.Errorf("ChangeRepositoryMock.AddChanges got unexpected parameter changes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddChanges.AddChangesMock.defaultExpectation.expectationOrigins.originChanges, *mm_want_ptrs.changes, mm_got.changes, minimock.Diff(*mm_want_ptrs.changes, mm_got.changes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddChanges.t.Errorf("ChangeRepositoryMock.AddChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddChanges.AddChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddChanges.AddChangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddChanges.t.Fatal("No results are set for the ChangeRepositoryMock.AddChanges")
+		}
+		return (*mm_results).err
+	}
+	if mmAddChanges.funcAddChanges != nil {
+		return mmAddChanges.funcAddChanges(ctx, changes...)
+	}
+	mmAddChanges.t.Fatalf("Unexpected call to ChangeRepositoryMock.AddChanges. %v %v", ctx, changes)
+	return
+}
+
+// AddChangesAfterCounter returns a count of finished ChangeRepositoryMock.AddChanges invocations
+func (mmAddChanges *ChangeRepositoryMock) AddChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChanges.afterAddChangesCounter)
+}
+
+// AddChangesBeforeCounter returns a count of ChangeRepositoryMock.AddChanges invocations
+func (mmAddChanges *ChangeRepositoryMock) AddChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddChanges.beforeAddChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChangeRepositoryMock.AddChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddChanges *mChangeRepositoryMockAddChanges) Calls() []*ChangeRepositoryMockAddChangesParams {
+	mmAddChanges.mutex.RLock()
+
+	argCopy := make([]*ChangeRepositoryMockAddChangesParams, len(mmAddChanges.callArgs))
+	copy(argCopy, mmAddChanges.callArgs)
+
+	mmAddChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddChangesDone returns true if the count of the AddChanges invocations corresponds
+// the number of defined expectations
+func (m *ChangeRepositoryMock) MinimockAddChangesDone() bool {
+	if m.AddChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddChangesMock.invocationsDone()
+}
+
+// MinimockAddChangesInspect logs each unmet expectation
+func (m *ChangeRepositoryMock) MinimockAddChangesInspect() {
+	for _, e := range m.AddChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.AddChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddChangesCounter := mm_atomic.LoadUint64(&m.afterAddChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddChangesMock.defaultExpectation != nil && afterAddChangesCounter < 1 {
+		if m.AddChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.AddChanges at\n%s", m.AddChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.AddChanges at\n%s with params: %#v", m.AddChangesMock.defaultExpectation.expectationOrigins.origin, *m.AddChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddChanges != nil && afterAddChangesCounter < 1 {
+		m.t.Errorf("Expected call to ChangeRepositoryMock.AddChanges at\n%s", m.funcAddChangesOrigin)
+	}
+
+	if !m.AddChangesMock.invocationsDone() && afterAddChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChangeRepositoryMock.AddChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddChangesMock.expectedInvocations), m.AddChangesMock.expectedInvocationsOrigin, afterAddChangesCounter)
+	}
+}
+
+type mChangeRepositoryMockHorizon struct {
+	optional           bool
+	mock               *ChangeRepositoryMock
+	defaultExpectation *ChangeRepositoryMockHorizonExpectation
+	expectations       []*ChangeRepositoryMockHorizonExpectation
+
+	callArgs []*ChangeRepositoryMockHorizonParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChangeRepositoryMockHorizonExpectation specifies expectation struct of the ChangeRepository.Horizon
+type ChangeRepositoryMockHorizonExpectation struct {
+	mock               *ChangeRepositoryMock
+	params             *ChangeRepositoryMockHorizonParams
+	paramPtrs          *ChangeRepositoryMockHorizonParamPtrs
+	expectationOrigins ChangeRepositoryMockHorizonExpectationOrigins
+	results            *ChangeRepositoryMockHorizonResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChangeRepositoryMockHorizonParams contains parameters of the ChangeRepository.Horizon
+type ChangeRepositoryMockHorizonParams struct {
+	ctx context.Context
+}
+
+// ChangeRepositoryMockHorizonParamPtrs contains pointers to parameters of the ChangeRepository.Horizon
+type ChangeRepositoryMockHorizonParamPtrs struct {
+	ctx *context.Context
+}
+
+// ChangeRepositoryMockHorizonResults contains results of the ChangeRepository.Horizon
+type ChangeRepositoryMockHorizonResults struct {
+	i1  int64
+	err error
+}
+
+// ChangeRepositoryMockHorizonOrigins contains origins of expectations of the ChangeRepository.Horizon
+type ChangeRepositoryMockHorizonExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHorizon *mChangeRepositoryMockHorizon) Optional() *mChangeRepositoryMockHorizon {
+	mmHorizon.optional = true
+	return mmHorizon
+}
+
+// Expect sets up expected params for ChangeRepository.Horizon
+func (mmHorizon *mChangeRepositoryMockHorizon) Expect(ctx context.Context) *mChangeRepositoryMockHorizon {
+	if mmHorizon.mock.funcHorizon != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by Set")
+	}
+
+	if mmHorizon.defaultExpectation == nil {
+		mmHorizon.defaultExpectation = &ChangeRepositoryMockHorizonExpectation{}
+	}
+
+	if mmHorizon.defaultExpectation.paramPtrs != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by ExpectParams functions")
+	}
+
+	mmHorizon.defaultExpectation.params = &ChangeRepositoryMockHorizonParams{ctx}
+	mmHorizon.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHorizon.expectations {
+		if minimock.Equal(e.params, mmHorizon.defaultExpectation.params) {
+			mmHorizon.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHorizon.defaultExpectation.params)
+		}
+	}
+
+	return mmHorizon
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChangeRepository.Horizon
+func (mmHorizon *mChangeRepositoryMockHorizon) ExpectCtxParam1(ctx context.Context) *mChangeRepositoryMockHorizon {
+	if mmHorizon.mock.funcHorizon != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by Set")
+	}
+
+	if mmHorizon.defaultExpectation == nil {
+		mmHorizon.defaultExpectation = &ChangeRepositoryMockHorizonExpectation{}
+	}
+
+	if mmHorizon.defaultExpectation.params != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by Expect")
+	}
+
+	if mmHorizon.defaultExpectation.paramPtrs == nil {
+		mmHorizon.defaultExpectation.paramPtrs = &ChangeRepositoryMockHorizonParamPtrs{}
+	}
+	mmHorizon.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHorizon.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHorizon
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChangeRepository.Horizon
+func (mmHorizon *mChangeRepositoryMockHorizon) Inspect(f func(ctx context.Context)) *mChangeRepositoryMockHorizon {
+	if mmHorizon.mock.inspectFuncHorizon != nil {
+		mmHorizon.mock.t.Fatalf("Inspect function is already set for ChangeRepositoryMock.Horizon")
+	}
+
+	mmHorizon.mock.inspectFuncHorizon = f
+
+	return mmHorizon
+}
+
+// Return sets up results that will be returned by ChangeRepository.Horizon
+func (mmHorizon *mChangeRepositoryMockHorizon) Return(i1 int64, err error) *ChangeRepositoryMock {
+	if mmHorizon.mock.funcHorizon != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by Set")
+	}
+
+	if mmHorizon.defaultExpectation == nil {
+		mmHorizon.defaultExpectation = &ChangeRepositoryMockHorizonExpectation{mock: mmHorizon.mock}
+	}
+	mmHorizon.defaultExpectation.results = &ChangeRepositoryMockHorizonResults{i1, err}
+	mmHorizon.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHorizon.mock
+}
+
+// Set uses given function f to mock the ChangeRepository.Horizon method
+func (mmHorizon *mChangeRepositoryMockHorizon) Set(f func(ctx context.Context) (i1 int64, err error)) *ChangeRepositoryMock {
+	if mmHorizon.defaultExpectation != nil {
+		mmHorizon.mock.t.Fatalf("Default expectation is already set for the ChangeRepository.Horizon method")
+	}
+
+	if len(mmHorizon.expectations) > 0 {
+		mmHorizon.mock.t.Fatalf("Some expectations are already set for the ChangeRepository.Horizon method")
+	}
+
+	mmHorizon.mock.funcHorizon = f
+	mmHorizon.mock.funcHorizonOrigin = minimock.CallerInfo(1)
+	return mmHorizon.mock
+}
+
+// When sets expectation for the ChangeRepository.Horizon which will trigger the result defined by the following
+// Then helper
+func (mmHorizon *mChangeRepositoryMockHorizon) When(ctx context.Context) *ChangeRepositoryMockHorizonExpectation {
+	if mmHorizon.mock.funcHorizon != nil {
+		mmHorizon.mock.t.Fatalf("ChangeRepositoryMock.Horizon mock is already set by Set")
+	}
+
+	expectation := &ChangeRepositoryMockHorizonExpectation{
+		mock:               mmHorizon.mock,
+		params:             &ChangeRepositoryMockHorizonParams{ctx},
+		expectationOrigins: ChangeRepositoryMockHorizonExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHorizon.expectations = append(mmHorizon.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChangeRepository.Horizon return parameters for the expectation previously defined by the When method
+func (e *ChangeRepositoryMockHorizonExpectation) Then(i1 int64, err error) *ChangeRepositoryMock {
+	e.results = &ChangeRepositoryMockHorizonResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChangeRepository.Horizon should be invoked
+func (mmHorizon *mChangeRepositoryMockHorizon) Times(n uint64) *mChangeRepositoryMockHorizon {
+	if n == 0 {
+		mmHorizon.mock.t.Fatalf("Times of ChangeRepositoryMock.Horizon mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHorizon.expectedInvocations, n)
+	mmHorizon.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHorizon
+}
+
+func (mmHorizon *mChangeRepositoryMockHorizon) invocationsDone() bool {
+	if len(mmHorizon.expectations) == 0 && mmHorizon.defaultExpectation == nil && mmHorizon.mock.funcHorizon == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHorizon.mock.afterHorizonCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHorizon.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Horizon implements mm_repository.ChangeRepository
+func (mmHorizon *ChangeRepositoryMock) Horizon(ctx context.Context) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmHorizon.beforeHorizonCounter, 1)
+	defer mm_atomic.AddUint64(&mmHorizon.afterHorizonCounter, 1)
+
+	mmHorizon.t.Helper()
+
+	if mmHorizon.inspectFuncHorizon != nil {
+		mmHorizon.inspectFuncHorizon(ctx)
+	}
+
+	mm_params := ChangeRepositoryMockHorizonParams{ctx}
+
+	// Record call args
+	mmHorizon.HorizonMock.mutex.Lock()
+	mmHorizon.HorizonMock.callArgs = append(mmHorizon.HorizonMock.callArgs, &mm_params)
+	mmHorizon.HorizonMock.mutex.Unlock()
+
+	for _, e := range mmHorizon.HorizonMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmHorizon.HorizonMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHorizon.HorizonMock.defaultExpectation.Counter, 1)
+		mm_want := mmHorizon.HorizonMock.defaultExpectation.params
+		mm_want_ptrs := mmHorizon.HorizonMock.defaultExpectation.paramPtrs
+
+		mm_got := ChangeRepositoryMockHorizonParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHorizon.t.Errorf("ChangeRepositoryMock.Horizon got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHorizon.HorizonMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHorizon.t.Errorf("ChangeRepositoryMock.Horizon got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHorizon.HorizonMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHorizon.HorizonMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHorizon.t.Fatal("No results are set for the ChangeRepositoryMock.Horizon")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmHorizon.funcHorizon != nil {
+		return mmHorizon.funcHorizon(ctx)
+	}
+	mmHorizon.t.Fatalf("Unexpected call to ChangeRepositoryMock.Horizon. %v", ctx)
+	return
+}
+
+// HorizonAfterCounter returns a count of finished ChangeRepositoryMock.Horizon invocations
+func (mmHorizon *ChangeRepositoryMock) HorizonAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHorizon.afterHorizonCounter)
+}
+
+// HorizonBeforeCounter returns a count of ChangeRepositoryMock.Horizon invocations
+func (mmHorizon *ChangeRepositoryMock) HorizonBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHorizon.beforeHorizonCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChangeRepositoryMock.Horizon.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHorizon *mChangeRepositoryMockHorizon) Calls() []*ChangeRepositoryMockHorizonParams {
+	mmHorizon.mutex.RLock()
+
+	argCopy := make([]*ChangeRepositoryMockHorizonParams, len(mmHorizon.callArgs))
+	copy(argCopy, mmHorizon.callArgs)
+
+	mmHorizon.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHorizonDone returns true if the count of the Horizon invocations corresponds
+// the number of defined expectations
+func (m *ChangeRepositoryMock) MinimockHorizonDone() bool {
+	if m.HorizonMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HorizonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HorizonMock.invocationsDone()
+}
+
+// MinimockHorizonInspect logs each unmet expectation
+func (m *ChangeRepositoryMock) MinimockHorizonInspect() {
+	for _, e := range m.HorizonMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.Horizon at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHorizonCounter := mm_atomic.LoadUint64(&m.afterHorizonCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HorizonMock.defaultExpectation != nil && afterHorizonCounter < 1 {
+		if m.HorizonMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.Horizon at\n%s", m.HorizonMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.Horizon at\n%s with params: %#v", m.HorizonMock.defaultExpectation.expectationOrigins.origin, *m.HorizonMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHorizon != nil && afterHorizonCounter < 1 {
+		m.t.Errorf("Expected call to ChangeRepositoryMock.Horizon at\n%s", m.funcHorizonOrigin)
+	}
+
+	if !m.HorizonMock.invocationsDone() && afterHorizonCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChangeRepositoryMock.Horizon at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HorizonMock.expectedInvocations), m.HorizonMock.expectedInvocationsOrigin, afterHorizonCounter)
+	}
+}
+
+type mChangeRepositoryMockListChanges struct {
+	optional           bool
+	mock               *ChangeRepositoryMock
+	defaultExpectation *ChangeRepositoryMockListChangesExpectation
+	expectations       []*ChangeRepositoryMockListChangesExpectation
+
+	callArgs []*ChangeRepositoryMockListChangesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChangeRepositoryMockListChangesExpectation specifies expectation struct of the ChangeRepository.ListChanges
+type ChangeRepositoryMockListChangesExpectation struct {
+	mock               *ChangeRepositoryMock
+	params             *ChangeRepositoryMockListChangesParams
+	paramPtrs          *ChangeRepositoryMockListChangesParamPtrs
+	expectationOrigins ChangeRepositoryMockListChangesExpectationOrigins
+	results            *ChangeRepositoryMockListChangesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChangeRepositoryMockListChangesParams contains parameters of the ChangeRepository.ListChanges
+type ChangeRepositoryMockListChangesParams struct {
+	ctx   context.Context
+	query *model.ChangeQuery
+}
+
+// ChangeRepositoryMockListChangesParamPtrs contains pointers to parameters of the ChangeRepository.ListChanges
+type ChangeRepositoryMockListChangesParamPtrs struct {
+	ctx   *context.Context
+	query **model.ChangeQuery
+}
+
+// ChangeRepositoryMockListChangesResults contains results of the ChangeRepository.ListChanges
+type ChangeRepositoryMockListChangesResults struct {
+	cpa1 []*model.Change
+	err  error
+}
+
+// ChangeRepositoryMockListChangesOrigins contains origins of expectations of the ChangeRepository.ListChanges
+type ChangeRepositoryMockListChangesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChanges *mChangeRepositoryMockListChanges) Optional() *mChangeRepositoryMockListChanges {
+	mmListChanges.optional = true
+	return mmListChanges
+}
+
+// Expect sets up expected params for ChangeRepository.ListChanges
+func (mmListChanges *mChangeRepositoryMockListChanges) Expect(ctx context.Context, query *model.ChangeQuery) *mChangeRepositoryMockListChanges {
+	if mmListChanges.mock.funcListChanges != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Set")
+	}
+
+	if mmListChanges.defaultExpectation == nil {
+		mmListChanges.defaultExpectation = &ChangeRepositoryMockListChangesExpectation{}
+	}
+
+	if mmListChanges.defaultExpectation.paramPtrs != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by ExpectParams functions")
+	}
+
+	mmListChanges.defaultExpectation.params = &ChangeRepositoryMockListChangesParams{ctx, query}
+	mmListChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChanges.expectations {
+		if minimock.Equal(e.params, mmListChanges.defaultExpectation.params) {
+			mmListChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmListChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChangeRepository.ListChanges
+func (mmListChanges *mChangeRepositoryMockListChanges) ExpectCtxParam1(ctx context.Context) *mChangeRepositoryMockListChanges {
+	if mmListChanges.mock.funcListChanges != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Set")
+	}
+
+	if mmListChanges.defaultExpectation == nil {
+		mmListChanges.defaultExpectation = &ChangeRepositoryMockListChangesExpectation{}
+	}
+
+	if mmListChanges.defaultExpectation.params != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Expect")
+	}
+
+	if mmListChanges.defaultExpectation.paramPtrs == nil {
+		mmListChanges.defaultExpectation.paramPtrs = &ChangeRepositoryMockListChangesParamPtrs{}
+	}
+	mmListChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChanges
+}
+
+// ExpectQueryParam2 sets up expected param query for ChangeRepository.ListChanges
+func (mmListChanges *mChangeRepositoryMockListChanges) ExpectQueryParam2(query *model.ChangeQuery) *mChangeRepositoryMockListChanges {
+	if mmListChanges.mock.funcListChanges != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Set")
+	}
+
+	if mmListChanges.defaultExpectation == nil {
+		mmListChanges.defaultExpectation = &ChangeRepositoryMockListChangesExpectation{}
+	}
+
+	if mmListChanges.defaultExpectation.params != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Expect")
+	}
+
+	if mmListChanges.defaultExpectation.paramPtrs == nil {
+		mmListChanges.defaultExpectation.paramPtrs = &ChangeRepositoryMockListChangesParamPtrs{}
+	}
+	mmListChanges.defaultExpectation.paramPtrs.query = &query
+	mmListChanges.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChangeRepository.ListChanges
+func (mmListChanges *mChangeRepositoryMockListChanges) Inspect(f func(ctx context.Context, query *model.ChangeQuery)) *mChangeRepositoryMockListChanges {
+	if mmListChanges.mock.inspectFuncListChanges != nil {
+		mmListChanges.mock.t.Fatalf("Inspect function is already set for ChangeRepositoryMock.ListChanges")
+	}
+
+	mmListChanges.mock.inspectFuncListChanges = f
+
+	return mmListChanges
+}
+
+// Return sets up results that will be returned by ChangeRepository.ListChanges
+func (mmListChanges *mChangeRepositoryMockListChanges) Return(cpa1 []*model.Change, err error) *ChangeRepositoryMock {
+	if mmListChanges.mock.funcListChanges != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Set")
+	}
+
+	if mmListChanges.defaultExpectation == nil {
+		mmListChanges.defaultExpectation = &ChangeRepositoryMockListChangesExpectation{mock: mmListChanges.mock}
+	}
+	mmListChanges.defaultExpectation.results = &ChangeRepositoryMockListChangesResults{cpa1, err}
+	mmListChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChanges.mock
+}
+
+// Set uses given function f to mock the ChangeRepository.ListChanges method
+func (mmListChanges *mChangeRepositoryMockListChanges) Set(f func(ctx context.Context, query *model.ChangeQuery) (cpa1 []*model.Change, err error)) *ChangeRepositoryMock {
+	if mmListChanges.defaultExpectation != nil {
+		mmListChanges.mock.t.Fatalf("Default expectation is already set for the ChangeRepository.ListChanges method")
+	}
+
+	if len(mmListChanges.expectations) > 0 {
+		mmListChanges.mock.t.Fatalf("Some expectations are already set for the ChangeRepository.ListChanges method")
+	}
+
+	mmListChanges.mock.funcListChanges = f
+	mmListChanges.mock.funcListChangesOrigin = minimock.CallerInfo(1)
+	return mmListChanges.mock
+}
+
+// When sets expectation for the ChangeRepository.ListChanges which will trigger the result defined by the following
+// Then helper
+func (mmListChanges *mChangeRepositoryMockListChanges) When(ctx context.Context, query *model.ChangeQuery) *ChangeRepositoryMockListChangesExpectation {
+	if mmListChanges.mock.funcListChanges != nil {
+		mmListChanges.mock.t.Fatalf("ChangeRepositoryMock.ListChanges mock is already set by Set")
+	}
+
+	expectation := &ChangeRepositoryMockListChangesExpectation{
+		mock:               mmListChanges.mock,
+		params:             &ChangeRepositoryMockListChangesParams{ctx, query},
+		expectationOrigins: ChangeRepositoryMockListChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChanges.expectations = append(mmListChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChangeRepository.ListChanges return parameters for the expectation previously defined by the When method
+func (e *ChangeRepositoryMockListChangesExpectation) Then(cpa1 []*model.Change, err error) *ChangeRepositoryMock {
+	e.results = &ChangeRepositoryMockListChangesResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChangeRepository.ListChanges should be invoked
+func (mmListChanges *mChangeRepositoryMockListChanges) Times(n uint64) *mChangeRepositoryMockListChanges {
+	if n == 0 {
+		mmListChanges.mock.t.Fatalf("Times of ChangeRepositoryMock.ListChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChanges.expectedInvocations, n)
+	mmListChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChanges
+}
+
+func (mmListChanges *mChangeRepositoryMockListChanges) invocationsDone() bool {
+	if len(mmListChanges.expectations) == 0 && mmListChanges.defaultExpectation == nil && mmListChanges.mock.funcListChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChanges.mock.afterListChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChanges implements mm_repository.ChangeRepository
+func (mmListChanges *ChangeRepositoryMock) ListChanges(ctx context.Context, query *model.ChangeQuery) (cpa1 []*model.Change, err error) {
+	mm_atomic.AddUint64(&mmListChanges.beforeListChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChanges.afterListChangesCounter, 1)
+
+	mmListChanges.t.Helper()
+
+	if mmListChanges.inspectFuncListChanges != nil {
+		mmListChanges.inspectFuncListChanges(ctx, query)
+	}
+
+	mm_params := ChangeRepositoryMockListChangesParams{ctx, query}
+
+	// Record call args
+	mmListChanges.ListChangesMock.mutex.Lock()
+	mmListChanges.ListChangesMock.callArgs = append(mmListChanges.ListChangesMock.callArgs, &mm_params)
+	mmListChanges.ListChangesMock.mutex.Unlock()
+
+	for _, e := range mmListChanges.ListChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListChanges.ListChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChanges.ListChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChanges.ListChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmListChanges.ListChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChangeRepositoryMockListChangesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChanges.t.Errorf("ChangeRepositoryMock.ListChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChanges.ListChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListChanges.t.Errorf("ChangeRepositoryMock.ListChanges got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChanges.ListChangesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChanges.t.Errorf("ChangeRepositoryMock.ListChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChanges.ListChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChanges.ListChangesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChanges.t.Fatal("No results are set for the ChangeRepositoryMock.ListChanges")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListChanges.funcListChanges != nil {
+		return mmListChanges.funcListChanges(ctx, query)
+	}
+	mmListChanges.t.Fatalf("Unexpected call to ChangeRepositoryMock.ListChanges. %v %v", ctx, query)
+	return
+}
+
+// ListChangesAfterCounter returns a count of finished ChangeRepositoryMock.ListChanges invocations
+func (mmListChanges *ChangeRepositoryMock) ListChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChanges.afterListChangesCounter)
+}
+
+// ListChangesBeforeCounter returns a count of ChangeRepositoryMock.ListChanges invocations
+func (mmListChanges *ChangeRepositoryMock) ListChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChanges.beforeListChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChangeRepositoryMock.ListChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChanges *mChangeRepositoryMockListChanges) Calls() []*ChangeRepositoryMockListChangesParams {
+	mmListChanges.mutex.RLock()
+
+	argCopy := make([]*ChangeRepositoryMockListChangesParams, len(mmListChanges.callArgs))
+	copy(argCopy, mmListChanges.callArgs)
+
+	mmListChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChangesDone returns true if the count of the ListChanges invocations corresponds
+// the number of defined expectations
+func (m *ChangeRepositoryMock) MinimockListChangesDone() bool {
+	if m.ListChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChangesMock.invocationsDone()
+}
+
+// MinimockListChangesInspect logs each unmet expectation
+func (m *ChangeRepositoryMock) MinimockListChangesInspect() {
+	for _, e := range m.ListChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.ListChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChangesCounter := mm_atomic.LoadUint64(&m.afterListChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChangesMock.defaultExpectation != nil && afterListChangesCounter < 1 {
+		if m.ListChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.ListChanges at\n%s", m.ListChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChangeRepositoryMock.ListChanges at\n%s with params: %#v", m.ListChangesMock.defaultExpectation.expectationOrigins.origin, *m.ListChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChanges != nil && afterListChangesCounter < 1 {
+		m.t.Errorf("Expected call to ChangeRepositoryMock.ListChanges at\n%s", m.funcListChangesOrigin)
+	}
+
+	if !m.ListChangesMock.invocationsDone() && afterListChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChangeRepositoryMock.ListChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChangesMock.expectedInvocations), m.ListChangesMock.expectedInvocationsOrigin, afterListChangesCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ChangeRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddChangesInspect()
+
+			m.MinimockHorizonInspect()
+
+			m.MinimockListChangesInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ChangeRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ChangeRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddChangesDone() &&
+		m.MinimockHorizonDone() &&
+		m.MinimockListChangesDone()
+}
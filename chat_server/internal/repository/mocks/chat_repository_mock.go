@@ -5,10 +5,10 @@ package mocks
 //go:generate minimock -i chat/chat_server/internal/repository.ChatRepository -o chat_repository_mock.go -n ChatRepositoryMock -p mocks
 
 import (
+	"chat/chat_server/internal/model"
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
-	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -19,6 +19,13 @@ type ChatRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAddMembers          func(ctx context.Context, chatID int64, usernames []string) (err error)
+	funcAddMembersOrigin    string
+	inspectFuncAddMembers   func(ctx context.Context, chatID int64, usernames []string)
+	afterAddMembersCounter  uint64
+	beforeAddMembersCounter uint64
+	AddMembersMock          mChatRepositoryMockAddMembers
+
 	funcChatExists          func(ctx context.Context, chatID int64) (b1 bool, err error)
 	funcChatExistsOrigin    string
 	inspectFuncChatExists   func(ctx context.Context, chatID int64)
@@ -26,9 +33,9 @@ type ChatRepositoryMock struct {
 	beforeChatExistsCounter uint64
 	ChatExistsMock          mChatRepositoryMockChatExists
 
-	funcCreateChat          func(ctx context.Context, usernames []string) (i1 int64, err error)
+	funcCreateChat          func(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) (i1 int64, err error)
 	funcCreateChatOrigin    string
-	inspectFuncCreateChat   func(ctx context.Context, usernames []string)
+	inspectFuncCreateChat   func(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string)
 	afterCreateChatCounter  uint64
 	beforeCreateChatCounter uint64
 	CreateChatMock          mChatRepositoryMockCreateChat
@@ -40,19 +47,110 @@ type ChatRepositoryMock struct {
 	beforeDeleteChatCounter uint64
 	DeleteChatMock          mChatRepositoryMockDeleteChat
 
-	funcGetChatUsers          func(ctx context.Context, chatID int64) (sa1 []string, err error)
-	funcGetChatUsersOrigin    string
-	inspectFuncGetChatUsers   func(ctx context.Context, chatID int64)
-	afterGetChatUsersCounter  uint64
-	beforeGetChatUsersCounter uint64
-	GetChatUsersMock          mChatRepositoryMockGetChatUsers
-
-	funcSendMessage          func(ctx context.Context, fromUser string, text string, ts time.Time) (err error)
-	funcSendMessageOrigin    string
-	inspectFuncSendMessage   func(ctx context.Context, fromUser string, text string, ts time.Time)
-	afterSendMessageCounter  uint64
-	beforeSendMessageCounter uint64
-	SendMessageMock          mChatRepositoryMockSendMessage
+	funcFilterContacts          func(ctx context.Context, username string, usernames []string) (sa1 []string, err error)
+	funcFilterContactsOrigin    string
+	inspectFuncFilterContacts   func(ctx context.Context, username string, usernames []string)
+	afterFilterContactsCounter  uint64
+	beforeFilterContactsCounter uint64
+	FilterContactsMock          mChatRepositoryMockFilterContacts
+
+	funcFindByClientRequestID          func(ctx context.Context, createdBy string, clientRequestID string) (i1 int64, err error)
+	funcFindByClientRequestIDOrigin    string
+	inspectFuncFindByClientRequestID   func(ctx context.Context, createdBy string, clientRequestID string)
+	afterFindByClientRequestIDCounter  uint64
+	beforeFindByClientRequestIDCounter uint64
+	FindByClientRequestIDMock          mChatRepositoryMockFindByClientRequestID
+
+	funcFindDirectChat          func(ctx context.Context, a string, b string) (i1 int64, err error)
+	funcFindDirectChatOrigin    string
+	inspectFuncFindDirectChat   func(ctx context.Context, a string, b string)
+	afterFindDirectChatCounter  uint64
+	beforeFindDirectChatCounter uint64
+	FindDirectChatMock          mChatRepositoryMockFindDirectChat
+
+	funcGetChat          func(ctx context.Context, chatID int64) (cp1 *model.Chat, err error)
+	funcGetChatOrigin    string
+	inspectFuncGetChat   func(ctx context.Context, chatID int64)
+	afterGetChatCounter  uint64
+	beforeGetChatCounter uint64
+	GetChatMock          mChatRepositoryMockGetChat
+
+	funcGetChatMembers          func(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error)
+	funcGetChatMembersOrigin    string
+	inspectFuncGetChatMembers   func(ctx context.Context, chatID int64)
+	afterGetChatMembersCounter  uint64
+	beforeGetChatMembersCounter uint64
+	GetChatMembersMock          mChatRepositoryMockGetChatMembers
+
+	funcGetReadCursor          func(ctx context.Context, chatID int64, username string) (rp1 *model.ReadCursor, err error)
+	funcGetReadCursorOrigin    string
+	inspectFuncGetReadCursor   func(ctx context.Context, chatID int64, username string)
+	afterGetReadCursorCounter  uint64
+	beforeGetReadCursorCounter uint64
+	GetReadCursorMock          mChatRepositoryMockGetReadCursor
+
+	funcGetReadCursors          func(ctx context.Context, chatID int64) (rpa1 []*model.ReadCursor, err error)
+	funcGetReadCursorsOrigin    string
+	inspectFuncGetReadCursors   func(ctx context.Context, chatID int64)
+	afterGetReadCursorsCounter  uint64
+	beforeGetReadCursorsCounter uint64
+	GetReadCursorsMock          mChatRepositoryMockGetReadCursors
+
+	funcIsChatMember          func(ctx context.Context, chatID int64, username string) (b1 bool, err error)
+	funcIsChatMemberOrigin    string
+	inspectFuncIsChatMember   func(ctx context.Context, chatID int64, username string)
+	afterIsChatMemberCounter  uint64
+	beforeIsChatMemberCounter uint64
+	IsChatMemberMock          mChatRepositoryMockIsChatMember
+
+	funcListChatIDs          func(ctx context.Context, username string) (ia1 []int64, err error)
+	funcListChatIDsOrigin    string
+	inspectFuncListChatIDs   func(ctx context.Context, username string)
+	afterListChatIDsCounter  uint64
+	beforeListChatIDsCounter uint64
+	ListChatIDsMock          mChatRepositoryMockListChatIDs
+
+	funcListChats          func(ctx context.Context, query *model.ChatListQuery) (cpa1 []*model.ChatSummary, err error)
+	funcListChatsOrigin    string
+	inspectFuncListChats   func(ctx context.Context, query *model.ChatListQuery)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatRepositoryMockListChats
+
+	funcLockChat          func(ctx context.Context, chatID int64) (b1 bool, err error)
+	funcLockChatOrigin    string
+	inspectFuncLockChat   func(ctx context.Context, chatID int64)
+	afterLockChatCounter  uint64
+	beforeLockChatCounter uint64
+	LockChatMock          mChatRepositoryMockLockChat
+
+	funcRemoveMember          func(ctx context.Context, chatID int64, username string) (b1 bool, err error)
+	funcRemoveMemberOrigin    string
+	inspectFuncRemoveMember   func(ctx context.Context, chatID int64, username string)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mChatRepositoryMockRemoveMember
+
+	funcSaveReadCursors          func(ctx context.Context, cursors []*model.ReadCursor) (err error)
+	funcSaveReadCursorsOrigin    string
+	inspectFuncSaveReadCursors   func(ctx context.Context, cursors []*model.ReadCursor)
+	afterSaveReadCursorsCounter  uint64
+	beforeSaveReadCursorsCounter uint64
+	SaveReadCursorsMock          mChatRepositoryMockSaveReadCursors
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, username string, role model.Role) (b1 bool, err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, username string, role model.Role)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatRepositoryMockSetMemberRole
+
+	funcUpdateChat          func(ctx context.Context, update *model.ChatUpdate) (err error)
+	funcUpdateChatOrigin    string
+	inspectFuncUpdateChat   func(ctx context.Context, update *model.ChatUpdate)
+	afterUpdateChatCounter  uint64
+	beforeUpdateChatCounter uint64
+	UpdateChatMock          mChatRepositoryMockUpdateChat
 }
 
 // NewChatRepositoryMock returns a mock for mm_repository.ChatRepository
@@ -63,6 +161,9 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AddMembersMock = mChatRepositoryMockAddMembers{mock: m}
+	m.AddMembersMock.callArgs = []*ChatRepositoryMockAddMembersParams{}
+
 	m.ChatExistsMock = mChatRepositoryMockChatExists{mock: m}
 	m.ChatExistsMock.callArgs = []*ChatRepositoryMockChatExistsParams{}
 
@@ -72,17 +173,429 @@ func NewChatRepositoryMock(t minimock.Tester) *ChatRepositoryMock {
 	m.DeleteChatMock = mChatRepositoryMockDeleteChat{mock: m}
 	m.DeleteChatMock.callArgs = []*ChatRepositoryMockDeleteChatParams{}
 
-	m.GetChatUsersMock = mChatRepositoryMockGetChatUsers{mock: m}
-	m.GetChatUsersMock.callArgs = []*ChatRepositoryMockGetChatUsersParams{}
+	m.FilterContactsMock = mChatRepositoryMockFilterContacts{mock: m}
+	m.FilterContactsMock.callArgs = []*ChatRepositoryMockFilterContactsParams{}
+
+	m.FindByClientRequestIDMock = mChatRepositoryMockFindByClientRequestID{mock: m}
+	m.FindByClientRequestIDMock.callArgs = []*ChatRepositoryMockFindByClientRequestIDParams{}
+
+	m.FindDirectChatMock = mChatRepositoryMockFindDirectChat{mock: m}
+	m.FindDirectChatMock.callArgs = []*ChatRepositoryMockFindDirectChatParams{}
+
+	m.GetChatMock = mChatRepositoryMockGetChat{mock: m}
+	m.GetChatMock.callArgs = []*ChatRepositoryMockGetChatParams{}
+
+	m.GetChatMembersMock = mChatRepositoryMockGetChatMembers{mock: m}
+	m.GetChatMembersMock.callArgs = []*ChatRepositoryMockGetChatMembersParams{}
+
+	m.GetReadCursorMock = mChatRepositoryMockGetReadCursor{mock: m}
+	m.GetReadCursorMock.callArgs = []*ChatRepositoryMockGetReadCursorParams{}
+
+	m.GetReadCursorsMock = mChatRepositoryMockGetReadCursors{mock: m}
+	m.GetReadCursorsMock.callArgs = []*ChatRepositoryMockGetReadCursorsParams{}
+
+	m.IsChatMemberMock = mChatRepositoryMockIsChatMember{mock: m}
+	m.IsChatMemberMock.callArgs = []*ChatRepositoryMockIsChatMemberParams{}
+
+	m.ListChatIDsMock = mChatRepositoryMockListChatIDs{mock: m}
+	m.ListChatIDsMock.callArgs = []*ChatRepositoryMockListChatIDsParams{}
+
+	m.ListChatsMock = mChatRepositoryMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatRepositoryMockListChatsParams{}
+
+	m.LockChatMock = mChatRepositoryMockLockChat{mock: m}
+	m.LockChatMock.callArgs = []*ChatRepositoryMockLockChatParams{}
+
+	m.RemoveMemberMock = mChatRepositoryMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*ChatRepositoryMockRemoveMemberParams{}
+
+	m.SaveReadCursorsMock = mChatRepositoryMockSaveReadCursors{mock: m}
+	m.SaveReadCursorsMock.callArgs = []*ChatRepositoryMockSaveReadCursorsParams{}
+
+	m.SetMemberRoleMock = mChatRepositoryMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatRepositoryMockSetMemberRoleParams{}
 
-	m.SendMessageMock = mChatRepositoryMockSendMessage{mock: m}
-	m.SendMessageMock.callArgs = []*ChatRepositoryMockSendMessageParams{}
+	m.UpdateChatMock = mChatRepositoryMockUpdateChat{mock: m}
+	m.UpdateChatMock.callArgs = []*ChatRepositoryMockUpdateChatParams{}
 
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mChatRepositoryMockAddMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockAddMembersExpectation
+	expectations       []*ChatRepositoryMockAddMembersExpectation
+
+	callArgs []*ChatRepositoryMockAddMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockAddMembersExpectation specifies expectation struct of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockAddMembersParams
+	paramPtrs          *ChatRepositoryMockAddMembersParamPtrs
+	expectationOrigins ChatRepositoryMockAddMembersExpectationOrigins
+	results            *ChatRepositoryMockAddMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockAddMembersParams contains parameters of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersParams struct {
+	ctx       context.Context
+	chatID    int64
+	usernames []string
+}
+
+// ChatRepositoryMockAddMembersParamPtrs contains pointers to parameters of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	usernames *[]string
+}
+
+// ChatRepositoryMockAddMembersResults contains results of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersResults struct {
+	err error
+}
+
+// ChatRepositoryMockAddMembersOrigins contains origins of expectations of the ChatRepository.AddMembers
+type ChatRepositoryMockAddMembersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMembers *mChatRepositoryMockAddMembers) Optional() *mChatRepositoryMockAddMembers {
+	mmAddMembers.optional = true
+	return mmAddMembers
+}
+
+// Expect sets up expected params for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Expect(ctx context.Context, chatID int64, usernames []string) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by ExpectParams functions")
+	}
+
+	mmAddMembers.defaultExpectation.params = &ChatRepositoryMockAddMembersParams{ctx, chatID, usernames}
+	mmAddMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddMembers.expectations {
+		if minimock.Equal(e.params, mmAddMembers.defaultExpectation.params) {
+			mmAddMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectUsernamesParam3 sets up expected param usernames for ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) ExpectUsernamesParam3(usernames []string) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.usernames = &usernames
+	mmAddMembers.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Inspect(f func(ctx context.Context, chatID int64, usernames []string)) *mChatRepositoryMockAddMembers {
+	if mmAddMembers.mock.inspectFuncAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.AddMembers")
+	}
+
+	mmAddMembers.mock.inspectFuncAddMembers = f
+
+	return mmAddMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.AddMembers
+func (mmAddMembers *mChatRepositoryMockAddMembers) Return(err error) *ChatRepositoryMock {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatRepositoryMockAddMembersExpectation{mock: mmAddMembers.mock}
+	}
+	mmAddMembers.defaultExpectation.results = &ChatRepositoryMockAddMembersResults{err}
+	mmAddMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.AddMembers method
+func (mmAddMembers *mChatRepositoryMockAddMembers) Set(f func(ctx context.Context, chatID int64, usernames []string) (err error)) *ChatRepositoryMock {
+	if mmAddMembers.defaultExpectation != nil {
+		mmAddMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.AddMembers method")
+	}
+
+	if len(mmAddMembers.expectations) > 0 {
+		mmAddMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.AddMembers method")
+	}
+
+	mmAddMembers.mock.funcAddMembers = f
+	mmAddMembers.mock.funcAddMembersOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// When sets expectation for the ChatRepository.AddMembers which will trigger the result defined by the following
+// Then helper
+func (mmAddMembers *mChatRepositoryMockAddMembers) When(ctx context.Context, chatID int64, usernames []string) *ChatRepositoryMockAddMembersExpectation {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatRepositoryMock.AddMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockAddMembersExpectation{
+		mock:               mmAddMembers.mock,
+		params:             &ChatRepositoryMockAddMembersParams{ctx, chatID, usernames},
+		expectationOrigins: ChatRepositoryMockAddMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddMembers.expectations = append(mmAddMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.AddMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockAddMembersExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockAddMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.AddMembers should be invoked
+func (mmAddMembers *mChatRepositoryMockAddMembers) Times(n uint64) *mChatRepositoryMockAddMembers {
+	if n == 0 {
+		mmAddMembers.mock.t.Fatalf("Times of ChatRepositoryMock.AddMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMembers.expectedInvocations, n)
+	mmAddMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddMembers
+}
+
+func (mmAddMembers *mChatRepositoryMockAddMembers) invocationsDone() bool {
+	if len(mmAddMembers.expectations) == 0 && mmAddMembers.defaultExpectation == nil && mmAddMembers.mock.funcAddMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMembers.mock.afterAddMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMembers implements mm_repository.ChatRepository
+func (mmAddMembers *ChatRepositoryMock) AddMembers(ctx context.Context, chatID int64, usernames []string) (err error) {
+	mm_atomic.AddUint64(&mmAddMembers.beforeAddMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMembers.afterAddMembersCounter, 1)
+
+	mmAddMembers.t.Helper()
+
+	if mmAddMembers.inspectFuncAddMembers != nil {
+		mmAddMembers.inspectFuncAddMembers(ctx, chatID, usernames)
+	}
+
+	mm_params := ChatRepositoryMockAddMembersParams{ctx, chatID, usernames}
+
+	// Record call args
+	mmAddMembers.AddMembersMock.mutex.Lock()
+	mmAddMembers.AddMembersMock.callArgs = append(mmAddMembers.AddMembersMock.callArgs, &mm_params)
+	mmAddMembers.AddMembersMock.mutex.Unlock()
+
+	for _, e := range mmAddMembers.AddMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMembers.AddMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMembers.AddMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMembers.AddMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMembers.AddMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockAddMembersParams{ctx, chatID, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMembers.t.Errorf("ChatRepositoryMock.AddMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMembers.AddMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMembers.t.Fatal("No results are set for the ChatRepositoryMock.AddMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMembers.funcAddMembers != nil {
+		return mmAddMembers.funcAddMembers(ctx, chatID, usernames)
+	}
+	mmAddMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.AddMembers. %v %v %v", ctx, chatID, usernames)
+	return
+}
+
+// AddMembersAfterCounter returns a count of finished ChatRepositoryMock.AddMembers invocations
+func (mmAddMembers *ChatRepositoryMock) AddMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.afterAddMembersCounter)
+}
+
+// AddMembersBeforeCounter returns a count of ChatRepositoryMock.AddMembers invocations
+func (mmAddMembers *ChatRepositoryMock) AddMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.beforeAddMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.AddMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMembers *mChatRepositoryMockAddMembers) Calls() []*ChatRepositoryMockAddMembersParams {
+	mmAddMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockAddMembersParams, len(mmAddMembers.callArgs))
+	copy(argCopy, mmAddMembers.callArgs)
+
+	mmAddMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMembersDone returns true if the count of the AddMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockAddMembersDone() bool {
+	if m.AddMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMembersMock.invocationsDone()
+}
+
+// MinimockAddMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockAddMembersInspect() {
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddMembersCounter := mm_atomic.LoadUint64(&m.afterAddMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMembersMock.defaultExpectation != nil && afterAddMembersCounter < 1 {
+		if m.AddMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s", m.AddMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s with params: %#v", m.AddMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMembers != nil && afterAddMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.AddMembers at\n%s", m.funcAddMembersOrigin)
+	}
+
+	if !m.AddMembersMock.invocationsDone() && afterAddMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.AddMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMembersMock.expectedInvocations), m.AddMembersMock.expectedInvocationsOrigin, afterAddMembersCounter)
+	}
+}
+
 type mChatRepositoryMockChatExists struct {
 	optional           bool
 	mock               *ChatRepositoryMock
@@ -452,14 +965,18 @@ type ChatRepositoryMockCreateChatExpectation struct {
 
 // ChatRepositoryMockCreateChatParams contains parameters of the ChatRepository.CreateChat
 type ChatRepositoryMockCreateChatParams struct {
-	ctx       context.Context
-	usernames []string
+	ctx             context.Context
+	info            *model.ChatInfo
+	members         []*model.ChatMember
+	clientRequestID string
 }
 
 // ChatRepositoryMockCreateChatParamPtrs contains pointers to parameters of the ChatRepository.CreateChat
 type ChatRepositoryMockCreateChatParamPtrs struct {
-	ctx       *context.Context
-	usernames *[]string
+	ctx             *context.Context
+	info            **model.ChatInfo
+	members         *[]*model.ChatMember
+	clientRequestID *string
 }
 
 // ChatRepositoryMockCreateChatResults contains results of the ChatRepository.CreateChat
@@ -470,9 +987,11 @@ type ChatRepositoryMockCreateChatResults struct {
 
 // ChatRepositoryMockCreateChatOrigins contains origins of expectations of the ChatRepository.CreateChat
 type ChatRepositoryMockCreateChatExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originUsernames string
+	origin                string
+	originCtx             string
+	originInfo            string
+	originMembers         string
+	originClientRequestID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -486,7 +1005,7 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) Optional() *mChatRepositoryMo
 }
 
 // Expect sets up expected params for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, usernames []string) *mChatRepositoryMockCreateChat {
+func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) *mChatRepositoryMockCreateChat {
 	if mmCreateChat.mock.funcCreateChat != nil {
 		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
 	}
@@ -499,7 +1018,7 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) Expect(ctx context.Context, u
 		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by ExpectParams functions")
 	}
 
-	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, usernames}
+	mmCreateChat.defaultExpectation.params = &ChatRepositoryMockCreateChatParams{ctx, info, members, clientRequestID}
 	mmCreateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmCreateChat.expectations {
 		if minimock.Equal(e.params, mmCreateChat.defaultExpectation.params) {
@@ -533,8 +1052,54 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectCtxParam1(ctx context.C
 	return mmCreateChat
 }
 
-// ExpectUsernamesParam2 sets up expected param usernames for ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectUsernamesParam2(usernames []string) *mChatRepositoryMockCreateChat {
+// ExpectInfoParam2 sets up expected param info for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectInfoParam2(info *model.ChatInfo) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.info = &info
+	mmCreateChat.defaultExpectation.expectationOrigins.originInfo = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectMembersParam3 sets up expected param members for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectMembersParam3(members []*model.ChatMember) *mChatRepositoryMockCreateChat {
+	if mmCreateChat.mock.funcCreateChat != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
+	}
+
+	if mmCreateChat.defaultExpectation == nil {
+		mmCreateChat.defaultExpectation = &ChatRepositoryMockCreateChatExpectation{}
+	}
+
+	if mmCreateChat.defaultExpectation.params != nil {
+		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Expect")
+	}
+
+	if mmCreateChat.defaultExpectation.paramPtrs == nil {
+		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
+	}
+	mmCreateChat.defaultExpectation.paramPtrs.members = &members
+	mmCreateChat.defaultExpectation.expectationOrigins.originMembers = minimock.CallerInfo(1)
+
+	return mmCreateChat
+}
+
+// ExpectClientRequestIDParam4 sets up expected param clientRequestID for ChatRepository.CreateChat
+func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectClientRequestIDParam4(clientRequestID string) *mChatRepositoryMockCreateChat {
 	if mmCreateChat.mock.funcCreateChat != nil {
 		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
 	}
@@ -550,14 +1115,14 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) ExpectUsernamesParam2(usernam
 	if mmCreateChat.defaultExpectation.paramPtrs == nil {
 		mmCreateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockCreateChatParamPtrs{}
 	}
-	mmCreateChat.defaultExpectation.paramPtrs.usernames = &usernames
-	mmCreateChat.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+	mmCreateChat.defaultExpectation.paramPtrs.clientRequestID = &clientRequestID
+	mmCreateChat.defaultExpectation.expectationOrigins.originClientRequestID = minimock.CallerInfo(1)
 
 	return mmCreateChat
 }
 
 // Inspect accepts an inspector function that has same arguments as the ChatRepository.CreateChat
-func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, usernames []string)) *mChatRepositoryMockCreateChat {
+func (mmCreateChat *mChatRepositoryMockCreateChat) Inspect(f func(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string)) *mChatRepositoryMockCreateChat {
 	if mmCreateChat.mock.inspectFuncCreateChat != nil {
 		mmCreateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.CreateChat")
 	}
@@ -582,7 +1147,7 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) Return(i1 int64, err error) *
 }
 
 // Set uses given function f to mock the ChatRepository.CreateChat method
-func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, usernames []string) (i1 int64, err error)) *ChatRepositoryMock {
+func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) (i1 int64, err error)) *ChatRepositoryMock {
 	if mmCreateChat.defaultExpectation != nil {
 		mmCreateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.CreateChat method")
 	}
@@ -598,14 +1163,14 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) Set(f func(ctx context.Contex
 
 // When sets expectation for the ChatRepository.CreateChat which will trigger the result defined by the following
 // Then helper
-func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, usernames []string) *ChatRepositoryMockCreateChatExpectation {
+func (mmCreateChat *mChatRepositoryMockCreateChat) When(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) *ChatRepositoryMockCreateChatExpectation {
 	if mmCreateChat.mock.funcCreateChat != nil {
 		mmCreateChat.mock.t.Fatalf("ChatRepositoryMock.CreateChat mock is already set by Set")
 	}
 
 	expectation := &ChatRepositoryMockCreateChatExpectation{
 		mock:               mmCreateChat.mock,
-		params:             &ChatRepositoryMockCreateChatParams{ctx, usernames},
+		params:             &ChatRepositoryMockCreateChatParams{ctx, info, members, clientRequestID},
 		expectationOrigins: ChatRepositoryMockCreateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmCreateChat.expectations = append(mmCreateChat.expectations, expectation)
@@ -640,17 +1205,17 @@ func (mmCreateChat *mChatRepositoryMockCreateChat) invocationsDone() bool {
 }
 
 // CreateChat implements mm_repository.ChatRepository
-func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, usernames []string) (i1 int64, err error) {
+func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, info *model.ChatInfo, members []*model.ChatMember, clientRequestID string) (i1 int64, err error) {
 	mm_atomic.AddUint64(&mmCreateChat.beforeCreateChatCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateChat.afterCreateChatCounter, 1)
 
 	mmCreateChat.t.Helper()
 
 	if mmCreateChat.inspectFuncCreateChat != nil {
-		mmCreateChat.inspectFuncCreateChat(ctx, usernames)
+		mmCreateChat.inspectFuncCreateChat(ctx, info, members, clientRequestID)
 	}
 
-	mm_params := ChatRepositoryMockCreateChatParams{ctx, usernames}
+	mm_params := ChatRepositoryMockCreateChatParams{ctx, info, members, clientRequestID}
 
 	// Record call args
 	mmCreateChat.CreateChatMock.mutex.Lock()
@@ -669,7 +1234,7 @@ func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, username
 		mm_want := mmCreateChat.CreateChatMock.defaultExpectation.params
 		mm_want_ptrs := mmCreateChat.CreateChatMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockCreateChatParams{ctx, usernames}
+		mm_got := ChatRepositoryMockCreateChatParams{ctx, info, members, clientRequestID}
 
 		if mm_want_ptrs != nil {
 
@@ -678,9 +1243,19 @@ func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, username
 					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
-				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			if mm_want_ptrs.info != nil && !minimock.Equal(*mm_want_ptrs.info, mm_got.info) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter info, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originInfo, *mm_want_ptrs.info, mm_got.info, minimock.Diff(*mm_want_ptrs.info, mm_got.info))
+			}
+
+			if mm_want_ptrs.members != nil && !minimock.Equal(*mm_want_ptrs.members, mm_got.members) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter members, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originMembers, *mm_want_ptrs.members, mm_got.members, minimock.Diff(*mm_want_ptrs.members, mm_got.members))
+			}
+
+			if mm_want_ptrs.clientRequestID != nil && !minimock.Equal(*mm_want_ptrs.clientRequestID, mm_got.clientRequestID) {
+				mmCreateChat.t.Errorf("ChatRepositoryMock.CreateChat got unexpected parameter clientRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateChat.CreateChatMock.defaultExpectation.expectationOrigins.originClientRequestID, *mm_want_ptrs.clientRequestID, mm_got.clientRequestID, minimock.Diff(*mm_want_ptrs.clientRequestID, mm_got.clientRequestID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -695,9 +1270,9 @@ func (mmCreateChat *ChatRepositoryMock) CreateChat(ctx context.Context, username
 		return (*mm_results).i1, (*mm_results).err
 	}
 	if mmCreateChat.funcCreateChat != nil {
-		return mmCreateChat.funcCreateChat(ctx, usernames)
+		return mmCreateChat.funcCreateChat(ctx, info, members, clientRequestID)
 	}
-	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v", ctx, usernames)
+	mmCreateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.CreateChat. %v %v %v %v", ctx, info, members, clientRequestID)
 	return
 }
 
@@ -1111,53 +1686,4354 @@ func (m *ChatRepositoryMock) MinimockDeleteChatInspect() {
 	}
 }
 
-type mChatRepositoryMockGetChatUsers struct {
+type mChatRepositoryMockFilterContacts struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockGetChatUsersExpectation
-	expectations       []*ChatRepositoryMockGetChatUsersExpectation
+	defaultExpectation *ChatRepositoryMockFilterContactsExpectation
+	expectations       []*ChatRepositoryMockFilterContactsExpectation
 
-	callArgs []*ChatRepositoryMockGetChatUsersParams
+	callArgs []*ChatRepositoryMockFilterContactsParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockGetChatUsersExpectation specifies expectation struct of the ChatRepository.GetChatUsers
-type ChatRepositoryMockGetChatUsersExpectation struct {
+// ChatRepositoryMockFilterContactsExpectation specifies expectation struct of the ChatRepository.FilterContacts
+type ChatRepositoryMockFilterContactsExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockGetChatUsersParams
-	paramPtrs          *ChatRepositoryMockGetChatUsersParamPtrs
-	expectationOrigins ChatRepositoryMockGetChatUsersExpectationOrigins
-	results            *ChatRepositoryMockGetChatUsersResults
+	params             *ChatRepositoryMockFilterContactsParams
+	paramPtrs          *ChatRepositoryMockFilterContactsParamPtrs
+	expectationOrigins ChatRepositoryMockFilterContactsExpectationOrigins
+	results            *ChatRepositoryMockFilterContactsResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockGetChatUsersParams contains parameters of the ChatRepository.GetChatUsers
-type ChatRepositoryMockGetChatUsersParams struct {
-	ctx    context.Context
+// ChatRepositoryMockFilterContactsParams contains parameters of the ChatRepository.FilterContacts
+type ChatRepositoryMockFilterContactsParams struct {
+	ctx       context.Context
+	username  string
+	usernames []string
+}
+
+// ChatRepositoryMockFilterContactsParamPtrs contains pointers to parameters of the ChatRepository.FilterContacts
+type ChatRepositoryMockFilterContactsParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	usernames *[]string
+}
+
+// ChatRepositoryMockFilterContactsResults contains results of the ChatRepository.FilterContacts
+type ChatRepositoryMockFilterContactsResults struct {
+	sa1 []string
+	err error
+}
+
+// ChatRepositoryMockFilterContactsOrigins contains origins of expectations of the ChatRepository.FilterContacts
+type ChatRepositoryMockFilterContactsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Optional() *mChatRepositoryMockFilterContacts {
+	mmFilterContacts.optional = true
+	return mmFilterContacts
+}
+
+// Expect sets up expected params for ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Expect(ctx context.Context, username string, usernames []string) *mChatRepositoryMockFilterContacts {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	if mmFilterContacts.defaultExpectation == nil {
+		mmFilterContacts.defaultExpectation = &ChatRepositoryMockFilterContactsExpectation{}
+	}
+
+	if mmFilterContacts.defaultExpectation.paramPtrs != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by ExpectParams functions")
+	}
+
+	mmFilterContacts.defaultExpectation.params = &ChatRepositoryMockFilterContactsParams{ctx, username, usernames}
+	mmFilterContacts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFilterContacts.expectations {
+		if minimock.Equal(e.params, mmFilterContacts.defaultExpectation.params) {
+			mmFilterContacts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFilterContacts.defaultExpectation.params)
+		}
+	}
+
+	return mmFilterContacts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockFilterContacts {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	if mmFilterContacts.defaultExpectation == nil {
+		mmFilterContacts.defaultExpectation = &ChatRepositoryMockFilterContactsExpectation{}
+	}
+
+	if mmFilterContacts.defaultExpectation.params != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Expect")
+	}
+
+	if mmFilterContacts.defaultExpectation.paramPtrs == nil {
+		mmFilterContacts.defaultExpectation.paramPtrs = &ChatRepositoryMockFilterContactsParamPtrs{}
+	}
+	mmFilterContacts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFilterContacts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFilterContacts
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) ExpectUsernameParam2(username string) *mChatRepositoryMockFilterContacts {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	if mmFilterContacts.defaultExpectation == nil {
+		mmFilterContacts.defaultExpectation = &ChatRepositoryMockFilterContactsExpectation{}
+	}
+
+	if mmFilterContacts.defaultExpectation.params != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Expect")
+	}
+
+	if mmFilterContacts.defaultExpectation.paramPtrs == nil {
+		mmFilterContacts.defaultExpectation.paramPtrs = &ChatRepositoryMockFilterContactsParamPtrs{}
+	}
+	mmFilterContacts.defaultExpectation.paramPtrs.username = &username
+	mmFilterContacts.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmFilterContacts
+}
+
+// ExpectUsernamesParam3 sets up expected param usernames for ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) ExpectUsernamesParam3(usernames []string) *mChatRepositoryMockFilterContacts {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	if mmFilterContacts.defaultExpectation == nil {
+		mmFilterContacts.defaultExpectation = &ChatRepositoryMockFilterContactsExpectation{}
+	}
+
+	if mmFilterContacts.defaultExpectation.params != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Expect")
+	}
+
+	if mmFilterContacts.defaultExpectation.paramPtrs == nil {
+		mmFilterContacts.defaultExpectation.paramPtrs = &ChatRepositoryMockFilterContactsParamPtrs{}
+	}
+	mmFilterContacts.defaultExpectation.paramPtrs.usernames = &usernames
+	mmFilterContacts.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmFilterContacts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Inspect(f func(ctx context.Context, username string, usernames []string)) *mChatRepositoryMockFilterContacts {
+	if mmFilterContacts.mock.inspectFuncFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.FilterContacts")
+	}
+
+	mmFilterContacts.mock.inspectFuncFilterContacts = f
+
+	return mmFilterContacts
+}
+
+// Return sets up results that will be returned by ChatRepository.FilterContacts
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Return(sa1 []string, err error) *ChatRepositoryMock {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	if mmFilterContacts.defaultExpectation == nil {
+		mmFilterContacts.defaultExpectation = &ChatRepositoryMockFilterContactsExpectation{mock: mmFilterContacts.mock}
+	}
+	mmFilterContacts.defaultExpectation.results = &ChatRepositoryMockFilterContactsResults{sa1, err}
+	mmFilterContacts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFilterContacts.mock
+}
+
+// Set uses given function f to mock the ChatRepository.FilterContacts method
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Set(f func(ctx context.Context, username string, usernames []string) (sa1 []string, err error)) *ChatRepositoryMock {
+	if mmFilterContacts.defaultExpectation != nil {
+		mmFilterContacts.mock.t.Fatalf("Default expectation is already set for the ChatRepository.FilterContacts method")
+	}
+
+	if len(mmFilterContacts.expectations) > 0 {
+		mmFilterContacts.mock.t.Fatalf("Some expectations are already set for the ChatRepository.FilterContacts method")
+	}
+
+	mmFilterContacts.mock.funcFilterContacts = f
+	mmFilterContacts.mock.funcFilterContactsOrigin = minimock.CallerInfo(1)
+	return mmFilterContacts.mock
+}
+
+// When sets expectation for the ChatRepository.FilterContacts which will trigger the result defined by the following
+// Then helper
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) When(ctx context.Context, username string, usernames []string) *ChatRepositoryMockFilterContactsExpectation {
+	if mmFilterContacts.mock.funcFilterContacts != nil {
+		mmFilterContacts.mock.t.Fatalf("ChatRepositoryMock.FilterContacts mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockFilterContactsExpectation{
+		mock:               mmFilterContacts.mock,
+		params:             &ChatRepositoryMockFilterContactsParams{ctx, username, usernames},
+		expectationOrigins: ChatRepositoryMockFilterContactsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFilterContacts.expectations = append(mmFilterContacts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.FilterContacts return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockFilterContactsExpectation) Then(sa1 []string, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockFilterContactsResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.FilterContacts should be invoked
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Times(n uint64) *mChatRepositoryMockFilterContacts {
+	if n == 0 {
+		mmFilterContacts.mock.t.Fatalf("Times of ChatRepositoryMock.FilterContacts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFilterContacts.expectedInvocations, n)
+	mmFilterContacts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFilterContacts
+}
+
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) invocationsDone() bool {
+	if len(mmFilterContacts.expectations) == 0 && mmFilterContacts.defaultExpectation == nil && mmFilterContacts.mock.funcFilterContacts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFilterContacts.mock.afterFilterContactsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFilterContacts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FilterContacts implements mm_repository.ChatRepository
+func (mmFilterContacts *ChatRepositoryMock) FilterContacts(ctx context.Context, username string, usernames []string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmFilterContacts.beforeFilterContactsCounter, 1)
+	defer mm_atomic.AddUint64(&mmFilterContacts.afterFilterContactsCounter, 1)
+
+	mmFilterContacts.t.Helper()
+
+	if mmFilterContacts.inspectFuncFilterContacts != nil {
+		mmFilterContacts.inspectFuncFilterContacts(ctx, username, usernames)
+	}
+
+	mm_params := ChatRepositoryMockFilterContactsParams{ctx, username, usernames}
+
+	// Record call args
+	mmFilterContacts.FilterContactsMock.mutex.Lock()
+	mmFilterContacts.FilterContactsMock.callArgs = append(mmFilterContacts.FilterContactsMock.callArgs, &mm_params)
+	mmFilterContacts.FilterContactsMock.mutex.Unlock()
+
+	for _, e := range mmFilterContacts.FilterContactsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmFilterContacts.FilterContactsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFilterContacts.FilterContactsMock.defaultExpectation.Counter, 1)
+		mm_want := mmFilterContacts.FilterContactsMock.defaultExpectation.params
+		mm_want_ptrs := mmFilterContacts.FilterContactsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockFilterContactsParams{ctx, username, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFilterContacts.t.Errorf("ChatRepositoryMock.FilterContacts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFilterContacts.FilterContactsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmFilterContacts.t.Errorf("ChatRepositoryMock.FilterContacts got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFilterContacts.FilterContactsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmFilterContacts.t.Errorf("ChatRepositoryMock.FilterContacts got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFilterContacts.FilterContactsMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFilterContacts.t.Errorf("ChatRepositoryMock.FilterContacts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFilterContacts.FilterContactsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFilterContacts.FilterContactsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFilterContacts.t.Fatal("No results are set for the ChatRepositoryMock.FilterContacts")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmFilterContacts.funcFilterContacts != nil {
+		return mmFilterContacts.funcFilterContacts(ctx, username, usernames)
+	}
+	mmFilterContacts.t.Fatalf("Unexpected call to ChatRepositoryMock.FilterContacts. %v %v %v", ctx, username, usernames)
+	return
+}
+
+// FilterContactsAfterCounter returns a count of finished ChatRepositoryMock.FilterContacts invocations
+func (mmFilterContacts *ChatRepositoryMock) FilterContactsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFilterContacts.afterFilterContactsCounter)
+}
+
+// FilterContactsBeforeCounter returns a count of ChatRepositoryMock.FilterContacts invocations
+func (mmFilterContacts *ChatRepositoryMock) FilterContactsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFilterContacts.beforeFilterContactsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.FilterContacts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFilterContacts *mChatRepositoryMockFilterContacts) Calls() []*ChatRepositoryMockFilterContactsParams {
+	mmFilterContacts.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockFilterContactsParams, len(mmFilterContacts.callArgs))
+	copy(argCopy, mmFilterContacts.callArgs)
+
+	mmFilterContacts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFilterContactsDone returns true if the count of the FilterContacts invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockFilterContactsDone() bool {
+	if m.FilterContactsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FilterContactsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FilterContactsMock.invocationsDone()
+}
+
+// MinimockFilterContactsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockFilterContactsInspect() {
+	for _, e := range m.FilterContactsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FilterContacts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFilterContactsCounter := mm_atomic.LoadUint64(&m.afterFilterContactsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FilterContactsMock.defaultExpectation != nil && afterFilterContactsCounter < 1 {
+		if m.FilterContactsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FilterContacts at\n%s", m.FilterContactsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FilterContacts at\n%s with params: %#v", m.FilterContactsMock.defaultExpectation.expectationOrigins.origin, *m.FilterContactsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFilterContacts != nil && afterFilterContactsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.FilterContacts at\n%s", m.funcFilterContactsOrigin)
+	}
+
+	if !m.FilterContactsMock.invocationsDone() && afterFilterContactsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.FilterContacts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FilterContactsMock.expectedInvocations), m.FilterContactsMock.expectedInvocationsOrigin, afterFilterContactsCounter)
+	}
+}
+
+type mChatRepositoryMockFindByClientRequestID struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockFindByClientRequestIDExpectation
+	expectations       []*ChatRepositoryMockFindByClientRequestIDExpectation
+
+	callArgs []*ChatRepositoryMockFindByClientRequestIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockFindByClientRequestIDExpectation specifies expectation struct of the ChatRepository.FindByClientRequestID
+type ChatRepositoryMockFindByClientRequestIDExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockFindByClientRequestIDParams
+	paramPtrs          *ChatRepositoryMockFindByClientRequestIDParamPtrs
+	expectationOrigins ChatRepositoryMockFindByClientRequestIDExpectationOrigins
+	results            *ChatRepositoryMockFindByClientRequestIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockFindByClientRequestIDParams contains parameters of the ChatRepository.FindByClientRequestID
+type ChatRepositoryMockFindByClientRequestIDParams struct {
+	ctx             context.Context
+	createdBy       string
+	clientRequestID string
+}
+
+// ChatRepositoryMockFindByClientRequestIDParamPtrs contains pointers to parameters of the ChatRepository.FindByClientRequestID
+type ChatRepositoryMockFindByClientRequestIDParamPtrs struct {
+	ctx             *context.Context
+	createdBy       *string
+	clientRequestID *string
+}
+
+// ChatRepositoryMockFindByClientRequestIDResults contains results of the ChatRepository.FindByClientRequestID
+type ChatRepositoryMockFindByClientRequestIDResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockFindByClientRequestIDOrigins contains origins of expectations of the ChatRepository.FindByClientRequestID
+type ChatRepositoryMockFindByClientRequestIDExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originCreatedBy       string
+	originClientRequestID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Optional() *mChatRepositoryMockFindByClientRequestID {
+	mmFindByClientRequestID.optional = true
+	return mmFindByClientRequestID
+}
+
+// Expect sets up expected params for ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Expect(ctx context.Context, createdBy string, clientRequestID string) *mChatRepositoryMockFindByClientRequestID {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation == nil {
+		mmFindByClientRequestID.defaultExpectation = &ChatRepositoryMockFindByClientRequestIDExpectation{}
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.paramPtrs != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by ExpectParams functions")
+	}
+
+	mmFindByClientRequestID.defaultExpectation.params = &ChatRepositoryMockFindByClientRequestIDParams{ctx, createdBy, clientRequestID}
+	mmFindByClientRequestID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByClientRequestID.expectations {
+		if minimock.Equal(e.params, mmFindByClientRequestID.defaultExpectation.params) {
+			mmFindByClientRequestID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByClientRequestID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByClientRequestID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockFindByClientRequestID {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation == nil {
+		mmFindByClientRequestID.defaultExpectation = &ChatRepositoryMockFindByClientRequestIDExpectation{}
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.params != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Expect")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientRequestID.defaultExpectation.paramPtrs = &ChatRepositoryMockFindByClientRequestIDParamPtrs{}
+	}
+	mmFindByClientRequestID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByClientRequestID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByClientRequestID
+}
+
+// ExpectCreatedByParam2 sets up expected param createdBy for ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) ExpectCreatedByParam2(createdBy string) *mChatRepositoryMockFindByClientRequestID {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation == nil {
+		mmFindByClientRequestID.defaultExpectation = &ChatRepositoryMockFindByClientRequestIDExpectation{}
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.params != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Expect")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientRequestID.defaultExpectation.paramPtrs = &ChatRepositoryMockFindByClientRequestIDParamPtrs{}
+	}
+	mmFindByClientRequestID.defaultExpectation.paramPtrs.createdBy = &createdBy
+	mmFindByClientRequestID.defaultExpectation.expectationOrigins.originCreatedBy = minimock.CallerInfo(1)
+
+	return mmFindByClientRequestID
+}
+
+// ExpectClientRequestIDParam3 sets up expected param clientRequestID for ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) ExpectClientRequestIDParam3(clientRequestID string) *mChatRepositoryMockFindByClientRequestID {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation == nil {
+		mmFindByClientRequestID.defaultExpectation = &ChatRepositoryMockFindByClientRequestIDExpectation{}
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.params != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Expect")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientRequestID.defaultExpectation.paramPtrs = &ChatRepositoryMockFindByClientRequestIDParamPtrs{}
+	}
+	mmFindByClientRequestID.defaultExpectation.paramPtrs.clientRequestID = &clientRequestID
+	mmFindByClientRequestID.defaultExpectation.expectationOrigins.originClientRequestID = minimock.CallerInfo(1)
+
+	return mmFindByClientRequestID
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Inspect(f func(ctx context.Context, createdBy string, clientRequestID string)) *mChatRepositoryMockFindByClientRequestID {
+	if mmFindByClientRequestID.mock.inspectFuncFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.FindByClientRequestID")
+	}
+
+	mmFindByClientRequestID.mock.inspectFuncFindByClientRequestID = f
+
+	return mmFindByClientRequestID
+}
+
+// Return sets up results that will be returned by ChatRepository.FindByClientRequestID
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	if mmFindByClientRequestID.defaultExpectation == nil {
+		mmFindByClientRequestID.defaultExpectation = &ChatRepositoryMockFindByClientRequestIDExpectation{mock: mmFindByClientRequestID.mock}
+	}
+	mmFindByClientRequestID.defaultExpectation.results = &ChatRepositoryMockFindByClientRequestIDResults{i1, err}
+	mmFindByClientRequestID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByClientRequestID.mock
+}
+
+// Set uses given function f to mock the ChatRepository.FindByClientRequestID method
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Set(f func(ctx context.Context, createdBy string, clientRequestID string) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmFindByClientRequestID.defaultExpectation != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("Default expectation is already set for the ChatRepository.FindByClientRequestID method")
+	}
+
+	if len(mmFindByClientRequestID.expectations) > 0 {
+		mmFindByClientRequestID.mock.t.Fatalf("Some expectations are already set for the ChatRepository.FindByClientRequestID method")
+	}
+
+	mmFindByClientRequestID.mock.funcFindByClientRequestID = f
+	mmFindByClientRequestID.mock.funcFindByClientRequestIDOrigin = minimock.CallerInfo(1)
+	return mmFindByClientRequestID.mock
+}
+
+// When sets expectation for the ChatRepository.FindByClientRequestID which will trigger the result defined by the following
+// Then helper
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) When(ctx context.Context, createdBy string, clientRequestID string) *ChatRepositoryMockFindByClientRequestIDExpectation {
+	if mmFindByClientRequestID.mock.funcFindByClientRequestID != nil {
+		mmFindByClientRequestID.mock.t.Fatalf("ChatRepositoryMock.FindByClientRequestID mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockFindByClientRequestIDExpectation{
+		mock:               mmFindByClientRequestID.mock,
+		params:             &ChatRepositoryMockFindByClientRequestIDParams{ctx, createdBy, clientRequestID},
+		expectationOrigins: ChatRepositoryMockFindByClientRequestIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByClientRequestID.expectations = append(mmFindByClientRequestID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.FindByClientRequestID return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockFindByClientRequestIDExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockFindByClientRequestIDResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.FindByClientRequestID should be invoked
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Times(n uint64) *mChatRepositoryMockFindByClientRequestID {
+	if n == 0 {
+		mmFindByClientRequestID.mock.t.Fatalf("Times of ChatRepositoryMock.FindByClientRequestID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByClientRequestID.expectedInvocations, n)
+	mmFindByClientRequestID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByClientRequestID
+}
+
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) invocationsDone() bool {
+	if len(mmFindByClientRequestID.expectations) == 0 && mmFindByClientRequestID.defaultExpectation == nil && mmFindByClientRequestID.mock.funcFindByClientRequestID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByClientRequestID.mock.afterFindByClientRequestIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByClientRequestID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByClientRequestID implements mm_repository.ChatRepository
+func (mmFindByClientRequestID *ChatRepositoryMock) FindByClientRequestID(ctx context.Context, createdBy string, clientRequestID string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmFindByClientRequestID.beforeFindByClientRequestIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByClientRequestID.afterFindByClientRequestIDCounter, 1)
+
+	mmFindByClientRequestID.t.Helper()
+
+	if mmFindByClientRequestID.inspectFuncFindByClientRequestID != nil {
+		mmFindByClientRequestID.inspectFuncFindByClientRequestID(ctx, createdBy, clientRequestID)
+	}
+
+	mm_params := ChatRepositoryMockFindByClientRequestIDParams{ctx, createdBy, clientRequestID}
+
+	// Record call args
+	mmFindByClientRequestID.FindByClientRequestIDMock.mutex.Lock()
+	mmFindByClientRequestID.FindByClientRequestIDMock.callArgs = append(mmFindByClientRequestID.FindByClientRequestIDMock.callArgs, &mm_params)
+	mmFindByClientRequestID.FindByClientRequestIDMock.mutex.Unlock()
+
+	for _, e := range mmFindByClientRequestID.FindByClientRequestIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockFindByClientRequestIDParams{ctx, createdBy, clientRequestID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByClientRequestID.t.Errorf("ChatRepositoryMock.FindByClientRequestID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.createdBy != nil && !minimock.Equal(*mm_want_ptrs.createdBy, mm_got.createdBy) {
+				mmFindByClientRequestID.t.Errorf("ChatRepositoryMock.FindByClientRequestID got unexpected parameter createdBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.expectationOrigins.originCreatedBy, *mm_want_ptrs.createdBy, mm_got.createdBy, minimock.Diff(*mm_want_ptrs.createdBy, mm_got.createdBy))
+			}
+
+			if mm_want_ptrs.clientRequestID != nil && !minimock.Equal(*mm_want_ptrs.clientRequestID, mm_got.clientRequestID) {
+				mmFindByClientRequestID.t.Errorf("ChatRepositoryMock.FindByClientRequestID got unexpected parameter clientRequestID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.expectationOrigins.originClientRequestID, *mm_want_ptrs.clientRequestID, mm_got.clientRequestID, minimock.Diff(*mm_want_ptrs.clientRequestID, mm_got.clientRequestID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByClientRequestID.t.Errorf("ChatRepositoryMock.FindByClientRequestID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByClientRequestID.FindByClientRequestIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByClientRequestID.t.Fatal("No results are set for the ChatRepositoryMock.FindByClientRequestID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmFindByClientRequestID.funcFindByClientRequestID != nil {
+		return mmFindByClientRequestID.funcFindByClientRequestID(ctx, createdBy, clientRequestID)
+	}
+	mmFindByClientRequestID.t.Fatalf("Unexpected call to ChatRepositoryMock.FindByClientRequestID. %v %v %v", ctx, createdBy, clientRequestID)
+	return
+}
+
+// FindByClientRequestIDAfterCounter returns a count of finished ChatRepositoryMock.FindByClientRequestID invocations
+func (mmFindByClientRequestID *ChatRepositoryMock) FindByClientRequestIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByClientRequestID.afterFindByClientRequestIDCounter)
+}
+
+// FindByClientRequestIDBeforeCounter returns a count of ChatRepositoryMock.FindByClientRequestID invocations
+func (mmFindByClientRequestID *ChatRepositoryMock) FindByClientRequestIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByClientRequestID.beforeFindByClientRequestIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.FindByClientRequestID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByClientRequestID *mChatRepositoryMockFindByClientRequestID) Calls() []*ChatRepositoryMockFindByClientRequestIDParams {
+	mmFindByClientRequestID.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockFindByClientRequestIDParams, len(mmFindByClientRequestID.callArgs))
+	copy(argCopy, mmFindByClientRequestID.callArgs)
+
+	mmFindByClientRequestID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByClientRequestIDDone returns true if the count of the FindByClientRequestID invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockFindByClientRequestIDDone() bool {
+	if m.FindByClientRequestIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByClientRequestIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByClientRequestIDMock.invocationsDone()
+}
+
+// MinimockFindByClientRequestIDInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockFindByClientRequestIDInspect() {
+	for _, e := range m.FindByClientRequestIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindByClientRequestID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByClientRequestIDCounter := mm_atomic.LoadUint64(&m.afterFindByClientRequestIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByClientRequestIDMock.defaultExpectation != nil && afterFindByClientRequestIDCounter < 1 {
+		if m.FindByClientRequestIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindByClientRequestID at\n%s", m.FindByClientRequestIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindByClientRequestID at\n%s with params: %#v", m.FindByClientRequestIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByClientRequestIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByClientRequestID != nil && afterFindByClientRequestIDCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.FindByClientRequestID at\n%s", m.funcFindByClientRequestIDOrigin)
+	}
+
+	if !m.FindByClientRequestIDMock.invocationsDone() && afterFindByClientRequestIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.FindByClientRequestID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByClientRequestIDMock.expectedInvocations), m.FindByClientRequestIDMock.expectedInvocationsOrigin, afterFindByClientRequestIDCounter)
+	}
+}
+
+type mChatRepositoryMockFindDirectChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockFindDirectChatExpectation
+	expectations       []*ChatRepositoryMockFindDirectChatExpectation
+
+	callArgs []*ChatRepositoryMockFindDirectChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockFindDirectChatExpectation specifies expectation struct of the ChatRepository.FindDirectChat
+type ChatRepositoryMockFindDirectChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockFindDirectChatParams
+	paramPtrs          *ChatRepositoryMockFindDirectChatParamPtrs
+	expectationOrigins ChatRepositoryMockFindDirectChatExpectationOrigins
+	results            *ChatRepositoryMockFindDirectChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockFindDirectChatParams contains parameters of the ChatRepository.FindDirectChat
+type ChatRepositoryMockFindDirectChatParams struct {
+	ctx context.Context
+	a   string
+	b   string
+}
+
+// ChatRepositoryMockFindDirectChatParamPtrs contains pointers to parameters of the ChatRepository.FindDirectChat
+type ChatRepositoryMockFindDirectChatParamPtrs struct {
+	ctx *context.Context
+	a   *string
+	b   *string
+}
+
+// ChatRepositoryMockFindDirectChatResults contains results of the ChatRepository.FindDirectChat
+type ChatRepositoryMockFindDirectChatResults struct {
+	i1  int64
+	err error
+}
+
+// ChatRepositoryMockFindDirectChatOrigins contains origins of expectations of the ChatRepository.FindDirectChat
+type ChatRepositoryMockFindDirectChatExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originA   string
+	originB   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Optional() *mChatRepositoryMockFindDirectChat {
+	mmFindDirectChat.optional = true
+	return mmFindDirectChat
+}
+
+// Expect sets up expected params for ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Expect(ctx context.Context, a string, b string) *mChatRepositoryMockFindDirectChat {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	if mmFindDirectChat.defaultExpectation == nil {
+		mmFindDirectChat.defaultExpectation = &ChatRepositoryMockFindDirectChatExpectation{}
+	}
+
+	if mmFindDirectChat.defaultExpectation.paramPtrs != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by ExpectParams functions")
+	}
+
+	mmFindDirectChat.defaultExpectation.params = &ChatRepositoryMockFindDirectChatParams{ctx, a, b}
+	mmFindDirectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindDirectChat.expectations {
+		if minimock.Equal(e.params, mmFindDirectChat.defaultExpectation.params) {
+			mmFindDirectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindDirectChat.defaultExpectation.params)
+		}
+	}
+
+	return mmFindDirectChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockFindDirectChat {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	if mmFindDirectChat.defaultExpectation == nil {
+		mmFindDirectChat.defaultExpectation = &ChatRepositoryMockFindDirectChatExpectation{}
+	}
+
+	if mmFindDirectChat.defaultExpectation.params != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Expect")
+	}
+
+	if mmFindDirectChat.defaultExpectation.paramPtrs == nil {
+		mmFindDirectChat.defaultExpectation.paramPtrs = &ChatRepositoryMockFindDirectChatParamPtrs{}
+	}
+	mmFindDirectChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindDirectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindDirectChat
+}
+
+// ExpectAParam2 sets up expected param a for ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) ExpectAParam2(a string) *mChatRepositoryMockFindDirectChat {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	if mmFindDirectChat.defaultExpectation == nil {
+		mmFindDirectChat.defaultExpectation = &ChatRepositoryMockFindDirectChatExpectation{}
+	}
+
+	if mmFindDirectChat.defaultExpectation.params != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Expect")
+	}
+
+	if mmFindDirectChat.defaultExpectation.paramPtrs == nil {
+		mmFindDirectChat.defaultExpectation.paramPtrs = &ChatRepositoryMockFindDirectChatParamPtrs{}
+	}
+	mmFindDirectChat.defaultExpectation.paramPtrs.a = &a
+	mmFindDirectChat.defaultExpectation.expectationOrigins.originA = minimock.CallerInfo(1)
+
+	return mmFindDirectChat
+}
+
+// ExpectBParam3 sets up expected param b for ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) ExpectBParam3(b string) *mChatRepositoryMockFindDirectChat {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	if mmFindDirectChat.defaultExpectation == nil {
+		mmFindDirectChat.defaultExpectation = &ChatRepositoryMockFindDirectChatExpectation{}
+	}
+
+	if mmFindDirectChat.defaultExpectation.params != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Expect")
+	}
+
+	if mmFindDirectChat.defaultExpectation.paramPtrs == nil {
+		mmFindDirectChat.defaultExpectation.paramPtrs = &ChatRepositoryMockFindDirectChatParamPtrs{}
+	}
+	mmFindDirectChat.defaultExpectation.paramPtrs.b = &b
+	mmFindDirectChat.defaultExpectation.expectationOrigins.originB = minimock.CallerInfo(1)
+
+	return mmFindDirectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Inspect(f func(ctx context.Context, a string, b string)) *mChatRepositoryMockFindDirectChat {
+	if mmFindDirectChat.mock.inspectFuncFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.FindDirectChat")
+	}
+
+	mmFindDirectChat.mock.inspectFuncFindDirectChat = f
+
+	return mmFindDirectChat
+}
+
+// Return sets up results that will be returned by ChatRepository.FindDirectChat
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Return(i1 int64, err error) *ChatRepositoryMock {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	if mmFindDirectChat.defaultExpectation == nil {
+		mmFindDirectChat.defaultExpectation = &ChatRepositoryMockFindDirectChatExpectation{mock: mmFindDirectChat.mock}
+	}
+	mmFindDirectChat.defaultExpectation.results = &ChatRepositoryMockFindDirectChatResults{i1, err}
+	mmFindDirectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindDirectChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.FindDirectChat method
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Set(f func(ctx context.Context, a string, b string) (i1 int64, err error)) *ChatRepositoryMock {
+	if mmFindDirectChat.defaultExpectation != nil {
+		mmFindDirectChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.FindDirectChat method")
+	}
+
+	if len(mmFindDirectChat.expectations) > 0 {
+		mmFindDirectChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.FindDirectChat method")
+	}
+
+	mmFindDirectChat.mock.funcFindDirectChat = f
+	mmFindDirectChat.mock.funcFindDirectChatOrigin = minimock.CallerInfo(1)
+	return mmFindDirectChat.mock
+}
+
+// When sets expectation for the ChatRepository.FindDirectChat which will trigger the result defined by the following
+// Then helper
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) When(ctx context.Context, a string, b string) *ChatRepositoryMockFindDirectChatExpectation {
+	if mmFindDirectChat.mock.funcFindDirectChat != nil {
+		mmFindDirectChat.mock.t.Fatalf("ChatRepositoryMock.FindDirectChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockFindDirectChatExpectation{
+		mock:               mmFindDirectChat.mock,
+		params:             &ChatRepositoryMockFindDirectChatParams{ctx, a, b},
+		expectationOrigins: ChatRepositoryMockFindDirectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindDirectChat.expectations = append(mmFindDirectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.FindDirectChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockFindDirectChatExpectation) Then(i1 int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockFindDirectChatResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.FindDirectChat should be invoked
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Times(n uint64) *mChatRepositoryMockFindDirectChat {
+	if n == 0 {
+		mmFindDirectChat.mock.t.Fatalf("Times of ChatRepositoryMock.FindDirectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindDirectChat.expectedInvocations, n)
+	mmFindDirectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindDirectChat
+}
+
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) invocationsDone() bool {
+	if len(mmFindDirectChat.expectations) == 0 && mmFindDirectChat.defaultExpectation == nil && mmFindDirectChat.mock.funcFindDirectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindDirectChat.mock.afterFindDirectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindDirectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindDirectChat implements mm_repository.ChatRepository
+func (mmFindDirectChat *ChatRepositoryMock) FindDirectChat(ctx context.Context, a string, b string) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmFindDirectChat.beforeFindDirectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindDirectChat.afterFindDirectChatCounter, 1)
+
+	mmFindDirectChat.t.Helper()
+
+	if mmFindDirectChat.inspectFuncFindDirectChat != nil {
+		mmFindDirectChat.inspectFuncFindDirectChat(ctx, a, b)
+	}
+
+	mm_params := ChatRepositoryMockFindDirectChatParams{ctx, a, b}
+
+	// Record call args
+	mmFindDirectChat.FindDirectChatMock.mutex.Lock()
+	mmFindDirectChat.FindDirectChatMock.callArgs = append(mmFindDirectChat.FindDirectChatMock.callArgs, &mm_params)
+	mmFindDirectChat.FindDirectChatMock.mutex.Unlock()
+
+	for _, e := range mmFindDirectChat.FindDirectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmFindDirectChat.FindDirectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindDirectChat.FindDirectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindDirectChat.FindDirectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmFindDirectChat.FindDirectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockFindDirectChatParams{ctx, a, b}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindDirectChat.t.Errorf("ChatRepositoryMock.FindDirectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindDirectChat.FindDirectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.a != nil && !minimock.Equal(*mm_want_ptrs.a, mm_got.a) {
+				mmFindDirectChat.t.Errorf("ChatRepositoryMock.FindDirectChat got unexpected parameter a, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindDirectChat.FindDirectChatMock.defaultExpectation.expectationOrigins.originA, *mm_want_ptrs.a, mm_got.a, minimock.Diff(*mm_want_ptrs.a, mm_got.a))
+			}
+
+			if mm_want_ptrs.b != nil && !minimock.Equal(*mm_want_ptrs.b, mm_got.b) {
+				mmFindDirectChat.t.Errorf("ChatRepositoryMock.FindDirectChat got unexpected parameter b, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindDirectChat.FindDirectChatMock.defaultExpectation.expectationOrigins.originB, *mm_want_ptrs.b, mm_got.b, minimock.Diff(*mm_want_ptrs.b, mm_got.b))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindDirectChat.t.Errorf("ChatRepositoryMock.FindDirectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindDirectChat.FindDirectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindDirectChat.FindDirectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindDirectChat.t.Fatal("No results are set for the ChatRepositoryMock.FindDirectChat")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmFindDirectChat.funcFindDirectChat != nil {
+		return mmFindDirectChat.funcFindDirectChat(ctx, a, b)
+	}
+	mmFindDirectChat.t.Fatalf("Unexpected call to ChatRepositoryMock.FindDirectChat. %v %v %v", ctx, a, b)
+	return
+}
+
+// FindDirectChatAfterCounter returns a count of finished ChatRepositoryMock.FindDirectChat invocations
+func (mmFindDirectChat *ChatRepositoryMock) FindDirectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindDirectChat.afterFindDirectChatCounter)
+}
+
+// FindDirectChatBeforeCounter returns a count of ChatRepositoryMock.FindDirectChat invocations
+func (mmFindDirectChat *ChatRepositoryMock) FindDirectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindDirectChat.beforeFindDirectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.FindDirectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindDirectChat *mChatRepositoryMockFindDirectChat) Calls() []*ChatRepositoryMockFindDirectChatParams {
+	mmFindDirectChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockFindDirectChatParams, len(mmFindDirectChat.callArgs))
+	copy(argCopy, mmFindDirectChat.callArgs)
+
+	mmFindDirectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindDirectChatDone returns true if the count of the FindDirectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockFindDirectChatDone() bool {
+	if m.FindDirectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindDirectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindDirectChatMock.invocationsDone()
+}
+
+// MinimockFindDirectChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockFindDirectChatInspect() {
+	for _, e := range m.FindDirectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindDirectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindDirectChatCounter := mm_atomic.LoadUint64(&m.afterFindDirectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindDirectChatMock.defaultExpectation != nil && afterFindDirectChatCounter < 1 {
+		if m.FindDirectChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindDirectChat at\n%s", m.FindDirectChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.FindDirectChat at\n%s with params: %#v", m.FindDirectChatMock.defaultExpectation.expectationOrigins.origin, *m.FindDirectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindDirectChat != nil && afterFindDirectChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.FindDirectChat at\n%s", m.funcFindDirectChatOrigin)
+	}
+
+	if !m.FindDirectChatMock.invocationsDone() && afterFindDirectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.FindDirectChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindDirectChatMock.expectedInvocations), m.FindDirectChatMock.expectedInvocationsOrigin, afterFindDirectChatCounter)
+	}
+}
+
+type mChatRepositoryMockGetChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetChatExpectation
+	expectations       []*ChatRepositoryMockGetChatExpectation
+
+	callArgs []*ChatRepositoryMockGetChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetChatExpectation specifies expectation struct of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetChatParams
+	paramPtrs          *ChatRepositoryMockGetChatParamPtrs
+	expectationOrigins ChatRepositoryMockGetChatExpectationOrigins
+	results            *ChatRepositoryMockGetChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetChatParams contains parameters of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockGetChatParamPtrs contains pointers to parameters of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockGetChatResults contains results of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatRepositoryMockGetChatOrigins contains origins of expectations of the ChatRepository.GetChat
+type ChatRepositoryMockGetChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChat *mChatRepositoryMockGetChat) Optional() *mChatRepositoryMockGetChat {
+	mmGetChat.optional = true
+	return mmGetChat
+}
+
+// Expect sets up expected params for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetChat.defaultExpectation.params = &ChatRepositoryMockGetChatParams{ctx, chatID}
+	mmGetChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChat.expectations {
+		if minimock.Equal(e.params, mmGetChat.defaultExpectation.params) {
+			mmGetChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockGetChat {
+	if mmGetChat.mock.inspectFuncGetChat != nil {
+		mmGetChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetChat")
+	}
+
+	mmGetChat.mock.inspectFuncGetChat = f
+
+	return mmGetChat
+}
+
+// Return sets up results that will be returned by ChatRepository.GetChat
+func (mmGetChat *mChatRepositoryMockGetChat) Return(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatRepositoryMockGetChatExpectation{mock: mmGetChat.mock}
+	}
+	mmGetChat.defaultExpectation.results = &ChatRepositoryMockGetChatResults{cp1, err}
+	mmGetChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetChat method
+func (mmGetChat *mChatRepositoryMockGetChat) Set(f func(ctx context.Context, chatID int64) (cp1 *model.Chat, err error)) *ChatRepositoryMock {
+	if mmGetChat.defaultExpectation != nil {
+		mmGetChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetChat method")
+	}
+
+	if len(mmGetChat.expectations) > 0 {
+		mmGetChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetChat method")
+	}
+
+	mmGetChat.mock.funcGetChat = f
+	mmGetChat.mock.funcGetChatOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// When sets expectation for the ChatRepository.GetChat which will trigger the result defined by the following
+// Then helper
+func (mmGetChat *mChatRepositoryMockGetChat) When(ctx context.Context, chatID int64) *ChatRepositoryMockGetChatExpectation {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatRepositoryMock.GetChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetChatExpectation{
+		mock:               mmGetChat.mock,
+		params:             &ChatRepositoryMockGetChatParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockGetChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChat.expectations = append(mmGetChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetChatExpectation) Then(cp1 *model.Chat, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetChatResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetChat should be invoked
+func (mmGetChat *mChatRepositoryMockGetChat) Times(n uint64) *mChatRepositoryMockGetChat {
+	if n == 0 {
+		mmGetChat.mock.t.Fatalf("Times of ChatRepositoryMock.GetChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChat.expectedInvocations, n)
+	mmGetChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChat
+}
+
+func (mmGetChat *mChatRepositoryMockGetChat) invocationsDone() bool {
+	if len(mmGetChat.expectations) == 0 && mmGetChat.defaultExpectation == nil && mmGetChat.mock.funcGetChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChat.mock.afterGetChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChat implements mm_repository.ChatRepository
+func (mmGetChat *ChatRepositoryMock) GetChat(ctx context.Context, chatID int64) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetChat.beforeGetChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChat.afterGetChatCounter, 1)
+
+	mmGetChat.t.Helper()
+
+	if mmGetChat.inspectFuncGetChat != nil {
+		mmGetChat.inspectFuncGetChat(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockGetChatParams{ctx, chatID}
+
+	// Record call args
+	mmGetChat.GetChatMock.mutex.Lock()
+	mmGetChat.GetChatMock.callArgs = append(mmGetChat.GetChatMock.callArgs, &mm_params)
+	mmGetChat.GetChatMock.mutex.Unlock()
+
+	for _, e := range mmGetChat.GetChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetChat.GetChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChat.GetChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChat.GetChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChat.GetChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetChatParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChat.t.Errorf("ChatRepositoryMock.GetChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChat.GetChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChat.t.Fatal("No results are set for the ChatRepositoryMock.GetChat")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetChat.funcGetChat != nil {
+		return mmGetChat.funcGetChat(ctx, chatID)
+	}
+	mmGetChat.t.Fatalf("Unexpected call to ChatRepositoryMock.GetChat. %v %v", ctx, chatID)
+	return
+}
+
+// GetChatAfterCounter returns a count of finished ChatRepositoryMock.GetChat invocations
+func (mmGetChat *ChatRepositoryMock) GetChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.afterGetChatCounter)
+}
+
+// GetChatBeforeCounter returns a count of ChatRepositoryMock.GetChat invocations
+func (mmGetChat *ChatRepositoryMock) GetChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.beforeGetChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChat *mChatRepositoryMockGetChat) Calls() []*ChatRepositoryMockGetChatParams {
+	mmGetChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetChatParams, len(mmGetChat.callArgs))
+	copy(argCopy, mmGetChat.callArgs)
+
+	mmGetChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatDone returns true if the count of the GetChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetChatDone() bool {
+	if m.GetChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMock.invocationsDone()
+}
+
+// MinimockGetChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetChatInspect() {
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatCounter := mm_atomic.LoadUint64(&m.afterGetChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMock.defaultExpectation != nil && afterGetChatCounter < 1 {
+		if m.GetChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s", m.GetChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s with params: %#v", m.GetChatMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChat != nil && afterGetChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetChat at\n%s", m.funcGetChatOrigin)
+	}
+
+	if !m.GetChatMock.invocationsDone() && afterGetChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMock.expectedInvocations), m.GetChatMock.expectedInvocationsOrigin, afterGetChatCounter)
+	}
+}
+
+type mChatRepositoryMockGetChatMembers struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetChatMembersExpectation
+	expectations       []*ChatRepositoryMockGetChatMembersExpectation
+
+	callArgs []*ChatRepositoryMockGetChatMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetChatMembersExpectation specifies expectation struct of the ChatRepository.GetChatMembers
+type ChatRepositoryMockGetChatMembersExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetChatMembersParams
+	paramPtrs          *ChatRepositoryMockGetChatMembersParamPtrs
+	expectationOrigins ChatRepositoryMockGetChatMembersExpectationOrigins
+	results            *ChatRepositoryMockGetChatMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetChatMembersParams contains parameters of the ChatRepository.GetChatMembers
+type ChatRepositoryMockGetChatMembersParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockGetChatMembersParamPtrs contains pointers to parameters of the ChatRepository.GetChatMembers
+type ChatRepositoryMockGetChatMembersParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockGetChatMembersResults contains results of the ChatRepository.GetChatMembers
+type ChatRepositoryMockGetChatMembersResults struct {
+	cpa1 []*model.ChatMember
+	err  error
+}
+
+// ChatRepositoryMockGetChatMembersOrigins contains origins of expectations of the ChatRepository.GetChatMembers
+type ChatRepositoryMockGetChatMembersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Optional() *mChatRepositoryMockGetChatMembers {
+	mmGetChatMembers.optional = true
+	return mmGetChatMembers
+}
+
+// Expect sets up expected params for ChatRepository.GetChatMembers
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockGetChatMembers {
+	if mmGetChatMembers.mock.funcGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Set")
+	}
+
+	if mmGetChatMembers.defaultExpectation == nil {
+		mmGetChatMembers.defaultExpectation = &ChatRepositoryMockGetChatMembersExpectation{}
+	}
+
+	if mmGetChatMembers.defaultExpectation.paramPtrs != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by ExpectParams functions")
+	}
+
+	mmGetChatMembers.defaultExpectation.params = &ChatRepositoryMockGetChatMembersParams{ctx, chatID}
+	mmGetChatMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChatMembers.expectations {
+		if minimock.Equal(e.params, mmGetChatMembers.defaultExpectation.params) {
+			mmGetChatMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChatMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChatMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetChatMembers
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetChatMembers {
+	if mmGetChatMembers.mock.funcGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Set")
+	}
+
+	if mmGetChatMembers.defaultExpectation == nil {
+		mmGetChatMembers.defaultExpectation = &ChatRepositoryMockGetChatMembersExpectation{}
+	}
+
+	if mmGetChatMembers.defaultExpectation.params != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Expect")
+	}
+
+	if mmGetChatMembers.defaultExpectation.paramPtrs == nil {
+		mmGetChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatMembersParamPtrs{}
+	}
+	mmGetChatMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChatMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChatMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetChatMembers
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetChatMembers {
+	if mmGetChatMembers.mock.funcGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Set")
+	}
+
+	if mmGetChatMembers.defaultExpectation == nil {
+		mmGetChatMembers.defaultExpectation = &ChatRepositoryMockGetChatMembersExpectation{}
+	}
+
+	if mmGetChatMembers.defaultExpectation.params != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Expect")
+	}
+
+	if mmGetChatMembers.defaultExpectation.paramPtrs == nil {
+		mmGetChatMembers.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatMembersParamPtrs{}
+	}
+	mmGetChatMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetChatMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetChatMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetChatMembers
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockGetChatMembers {
+	if mmGetChatMembers.mock.inspectFuncGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetChatMembers")
+	}
+
+	mmGetChatMembers.mock.inspectFuncGetChatMembers = f
+
+	return mmGetChatMembers
+}
+
+// Return sets up results that will be returned by ChatRepository.GetChatMembers
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Return(cpa1 []*model.ChatMember, err error) *ChatRepositoryMock {
+	if mmGetChatMembers.mock.funcGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Set")
+	}
+
+	if mmGetChatMembers.defaultExpectation == nil {
+		mmGetChatMembers.defaultExpectation = &ChatRepositoryMockGetChatMembersExpectation{mock: mmGetChatMembers.mock}
+	}
+	mmGetChatMembers.defaultExpectation.results = &ChatRepositoryMockGetChatMembersResults{cpa1, err}
+	mmGetChatMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChatMembers.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetChatMembers method
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Set(f func(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error)) *ChatRepositoryMock {
+	if mmGetChatMembers.defaultExpectation != nil {
+		mmGetChatMembers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetChatMembers method")
+	}
+
+	if len(mmGetChatMembers.expectations) > 0 {
+		mmGetChatMembers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetChatMembers method")
+	}
+
+	mmGetChatMembers.mock.funcGetChatMembers = f
+	mmGetChatMembers.mock.funcGetChatMembersOrigin = minimock.CallerInfo(1)
+	return mmGetChatMembers.mock
+}
+
+// When sets expectation for the ChatRepository.GetChatMembers which will trigger the result defined by the following
+// Then helper
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) When(ctx context.Context, chatID int64) *ChatRepositoryMockGetChatMembersExpectation {
+	if mmGetChatMembers.mock.funcGetChatMembers != nil {
+		mmGetChatMembers.mock.t.Fatalf("ChatRepositoryMock.GetChatMembers mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetChatMembersExpectation{
+		mock:               mmGetChatMembers.mock,
+		params:             &ChatRepositoryMockGetChatMembersParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockGetChatMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChatMembers.expectations = append(mmGetChatMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetChatMembers return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetChatMembersExpectation) Then(cpa1 []*model.ChatMember, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetChatMembersResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetChatMembers should be invoked
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Times(n uint64) *mChatRepositoryMockGetChatMembers {
+	if n == 0 {
+		mmGetChatMembers.mock.t.Fatalf("Times of ChatRepositoryMock.GetChatMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChatMembers.expectedInvocations, n)
+	mmGetChatMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChatMembers
+}
+
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) invocationsDone() bool {
+	if len(mmGetChatMembers.expectations) == 0 && mmGetChatMembers.defaultExpectation == nil && mmGetChatMembers.mock.funcGetChatMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChatMembers.mock.afterGetChatMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChatMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChatMembers implements mm_repository.ChatRepository
+func (mmGetChatMembers *ChatRepositoryMock) GetChatMembers(ctx context.Context, chatID int64) (cpa1 []*model.ChatMember, err error) {
+	mm_atomic.AddUint64(&mmGetChatMembers.beforeGetChatMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChatMembers.afterGetChatMembersCounter, 1)
+
+	mmGetChatMembers.t.Helper()
+
+	if mmGetChatMembers.inspectFuncGetChatMembers != nil {
+		mmGetChatMembers.inspectFuncGetChatMembers(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockGetChatMembersParams{ctx, chatID}
+
+	// Record call args
+	mmGetChatMembers.GetChatMembersMock.mutex.Lock()
+	mmGetChatMembers.GetChatMembersMock.callArgs = append(mmGetChatMembers.GetChatMembersMock.callArgs, &mm_params)
+	mmGetChatMembers.GetChatMembersMock.mutex.Unlock()
+
+	for _, e := range mmGetChatMembers.GetChatMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmGetChatMembers.GetChatMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChatMembers.GetChatMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChatMembers.GetChatMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChatMembers.GetChatMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetChatMembersParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChatMembers.t.Errorf("ChatRepositoryMock.GetChatMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMembers.GetChatMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetChatMembers.t.Errorf("ChatRepositoryMock.GetChatMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChatMembers.GetChatMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChatMembers.t.Errorf("ChatRepositoryMock.GetChatMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChatMembers.GetChatMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChatMembers.GetChatMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChatMembers.t.Fatal("No results are set for the ChatRepositoryMock.GetChatMembers")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmGetChatMembers.funcGetChatMembers != nil {
+		return mmGetChatMembers.funcGetChatMembers(ctx, chatID)
+	}
+	mmGetChatMembers.t.Fatalf("Unexpected call to ChatRepositoryMock.GetChatMembers. %v %v", ctx, chatID)
+	return
+}
+
+// GetChatMembersAfterCounter returns a count of finished ChatRepositoryMock.GetChatMembers invocations
+func (mmGetChatMembers *ChatRepositoryMock) GetChatMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMembers.afterGetChatMembersCounter)
+}
+
+// GetChatMembersBeforeCounter returns a count of ChatRepositoryMock.GetChatMembers invocations
+func (mmGetChatMembers *ChatRepositoryMock) GetChatMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChatMembers.beforeGetChatMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetChatMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChatMembers *mChatRepositoryMockGetChatMembers) Calls() []*ChatRepositoryMockGetChatMembersParams {
+	mmGetChatMembers.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetChatMembersParams, len(mmGetChatMembers.callArgs))
+	copy(argCopy, mmGetChatMembers.callArgs)
+
+	mmGetChatMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatMembersDone returns true if the count of the GetChatMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetChatMembersDone() bool {
+	if m.GetChatMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMembersMock.invocationsDone()
+}
+
+// MinimockGetChatMembersInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetChatMembersInspect() {
+	for _, e := range m.GetChatMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatMembersCounter := mm_atomic.LoadUint64(&m.afterGetChatMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMembersMock.defaultExpectation != nil && afterGetChatMembersCounter < 1 {
+		if m.GetChatMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMembers at\n%s", m.GetChatMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMembers at\n%s with params: %#v", m.GetChatMembersMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChatMembers != nil && afterGetChatMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetChatMembers at\n%s", m.funcGetChatMembersOrigin)
+	}
+
+	if !m.GetChatMembersMock.invocationsDone() && afterGetChatMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetChatMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMembersMock.expectedInvocations), m.GetChatMembersMock.expectedInvocationsOrigin, afterGetChatMembersCounter)
+	}
+}
+
+type mChatRepositoryMockGetReadCursor struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetReadCursorExpectation
+	expectations       []*ChatRepositoryMockGetReadCursorExpectation
+
+	callArgs []*ChatRepositoryMockGetReadCursorParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetReadCursorExpectation specifies expectation struct of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetReadCursorParams
+	paramPtrs          *ChatRepositoryMockGetReadCursorParamPtrs
+	expectationOrigins ChatRepositoryMockGetReadCursorExpectationOrigins
+	results            *ChatRepositoryMockGetReadCursorResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetReadCursorParams contains parameters of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockGetReadCursorParamPtrs contains pointers to parameters of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockGetReadCursorResults contains results of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorResults struct {
+	rp1 *model.ReadCursor
+	err error
+}
+
+// ChatRepositoryMockGetReadCursorOrigins contains origins of expectations of the ChatRepository.GetReadCursor
+type ChatRepositoryMockGetReadCursorExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Optional() *mChatRepositoryMockGetReadCursor {
+	mmGetReadCursor.optional = true
+	return mmGetReadCursor
+}
+
+// Expect sets up expected params for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadCursor.defaultExpectation.params = &ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+	mmGetReadCursor.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReadCursor.expectations {
+		if minimock.Equal(e.params, mmGetReadCursor.defaultExpectation.params) {
+			mmGetReadCursor.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadCursor.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadCursor
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) ExpectUsernameParam3(username string) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{}
+	}
+
+	if mmGetReadCursor.defaultExpectation.params != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Expect")
+	}
+
+	if mmGetReadCursor.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursor.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorParamPtrs{}
+	}
+	mmGetReadCursor.defaultExpectation.paramPtrs.username = &username
+	mmGetReadCursor.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetReadCursor
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockGetReadCursor {
+	if mmGetReadCursor.mock.inspectFuncGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetReadCursor")
+	}
+
+	mmGetReadCursor.mock.inspectFuncGetReadCursor = f
+
+	return mmGetReadCursor
+}
+
+// Return sets up results that will be returned by ChatRepository.GetReadCursor
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Return(rp1 *model.ReadCursor, err error) *ChatRepositoryMock {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	if mmGetReadCursor.defaultExpectation == nil {
+		mmGetReadCursor.defaultExpectation = &ChatRepositoryMockGetReadCursorExpectation{mock: mmGetReadCursor.mock}
+	}
+	mmGetReadCursor.defaultExpectation.results = &ChatRepositoryMockGetReadCursorResults{rp1, err}
+	mmGetReadCursor.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetReadCursor method
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Set(f func(ctx context.Context, chatID int64, username string) (rp1 *model.ReadCursor, err error)) *ChatRepositoryMock {
+	if mmGetReadCursor.defaultExpectation != nil {
+		mmGetReadCursor.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetReadCursor method")
+	}
+
+	if len(mmGetReadCursor.expectations) > 0 {
+		mmGetReadCursor.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetReadCursor method")
+	}
+
+	mmGetReadCursor.mock.funcGetReadCursor = f
+	mmGetReadCursor.mock.funcGetReadCursorOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor.mock
+}
+
+// When sets expectation for the ChatRepository.GetReadCursor which will trigger the result defined by the following
+// Then helper
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockGetReadCursorExpectation {
+	if mmGetReadCursor.mock.funcGetReadCursor != nil {
+		mmGetReadCursor.mock.t.Fatalf("ChatRepositoryMock.GetReadCursor mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetReadCursorExpectation{
+		mock:               mmGetReadCursor.mock,
+		params:             &ChatRepositoryMockGetReadCursorParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockGetReadCursorExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReadCursor.expectations = append(mmGetReadCursor.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetReadCursor return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetReadCursorExpectation) Then(rp1 *model.ReadCursor, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetReadCursorResults{rp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetReadCursor should be invoked
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Times(n uint64) *mChatRepositoryMockGetReadCursor {
+	if n == 0 {
+		mmGetReadCursor.mock.t.Fatalf("Times of ChatRepositoryMock.GetReadCursor mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadCursor.expectedInvocations, n)
+	mmGetReadCursor.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursor
+}
+
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) invocationsDone() bool {
+	if len(mmGetReadCursor.expectations) == 0 && mmGetReadCursor.defaultExpectation == nil && mmGetReadCursor.mock.funcGetReadCursor == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadCursor.mock.afterGetReadCursorCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadCursor.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadCursor implements mm_repository.ChatRepository
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursor(ctx context.Context, chatID int64, username string) (rp1 *model.ReadCursor, err error) {
+	mm_atomic.AddUint64(&mmGetReadCursor.beforeGetReadCursorCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadCursor.afterGetReadCursorCounter, 1)
+
+	mmGetReadCursor.t.Helper()
+
+	if mmGetReadCursor.inspectFuncGetReadCursor != nil {
+		mmGetReadCursor.inspectFuncGetReadCursor(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+
+	// Record call args
+	mmGetReadCursor.GetReadCursorMock.mutex.Lock()
+	mmGetReadCursor.GetReadCursorMock.callArgs = append(mmGetReadCursor.GetReadCursorMock.callArgs, &mm_params)
+	mmGetReadCursor.GetReadCursorMock.mutex.Unlock()
+
+	for _, e := range mmGetReadCursor.GetReadCursorMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rp1, e.results.err
+		}
+	}
+
+	if mmGetReadCursor.GetReadCursorMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadCursor.GetReadCursorMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadCursor.GetReadCursorMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadCursor.GetReadCursorMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetReadCursorParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadCursor.t.Errorf("ChatRepositoryMock.GetReadCursor got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReadCursor.GetReadCursorMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadCursor.GetReadCursorMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadCursor.t.Fatal("No results are set for the ChatRepositoryMock.GetReadCursor")
+		}
+		return (*mm_results).rp1, (*mm_results).err
+	}
+	if mmGetReadCursor.funcGetReadCursor != nil {
+		return mmGetReadCursor.funcGetReadCursor(ctx, chatID, username)
+	}
+	mmGetReadCursor.t.Fatalf("Unexpected call to ChatRepositoryMock.GetReadCursor. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetReadCursorAfterCounter returns a count of finished ChatRepositoryMock.GetReadCursor invocations
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursorAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursor.afterGetReadCursorCounter)
+}
+
+// GetReadCursorBeforeCounter returns a count of ChatRepositoryMock.GetReadCursor invocations
+func (mmGetReadCursor *ChatRepositoryMock) GetReadCursorBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursor.beforeGetReadCursorCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetReadCursor.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadCursor *mChatRepositoryMockGetReadCursor) Calls() []*ChatRepositoryMockGetReadCursorParams {
+	mmGetReadCursor.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetReadCursorParams, len(mmGetReadCursor.callArgs))
+	copy(argCopy, mmGetReadCursor.callArgs)
+
+	mmGetReadCursor.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadCursorDone returns true if the count of the GetReadCursor invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetReadCursorDone() bool {
+	if m.GetReadCursorMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadCursorMock.invocationsDone()
+}
+
+// MinimockGetReadCursorInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetReadCursorInspect() {
+	for _, e := range m.GetReadCursorMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReadCursorCounter := mm_atomic.LoadUint64(&m.afterGetReadCursorCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadCursorMock.defaultExpectation != nil && afterGetReadCursorCounter < 1 {
+		if m.GetReadCursorMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s", m.GetReadCursorMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s with params: %#v", m.GetReadCursorMock.defaultExpectation.expectationOrigins.origin, *m.GetReadCursorMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadCursor != nil && afterGetReadCursorCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursor at\n%s", m.funcGetReadCursorOrigin)
+	}
+
+	if !m.GetReadCursorMock.invocationsDone() && afterGetReadCursorCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetReadCursor at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadCursorMock.expectedInvocations), m.GetReadCursorMock.expectedInvocationsOrigin, afterGetReadCursorCounter)
+	}
+}
+
+type mChatRepositoryMockGetReadCursors struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockGetReadCursorsExpectation
+	expectations       []*ChatRepositoryMockGetReadCursorsExpectation
+
+	callArgs []*ChatRepositoryMockGetReadCursorsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockGetReadCursorsExpectation specifies expectation struct of the ChatRepository.GetReadCursors
+type ChatRepositoryMockGetReadCursorsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockGetReadCursorsParams
+	paramPtrs          *ChatRepositoryMockGetReadCursorsParamPtrs
+	expectationOrigins ChatRepositoryMockGetReadCursorsExpectationOrigins
+	results            *ChatRepositoryMockGetReadCursorsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockGetReadCursorsParams contains parameters of the ChatRepository.GetReadCursors
+type ChatRepositoryMockGetReadCursorsParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// ChatRepositoryMockGetReadCursorsParamPtrs contains pointers to parameters of the ChatRepository.GetReadCursors
+type ChatRepositoryMockGetReadCursorsParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockGetReadCursorsResults contains results of the ChatRepository.GetReadCursors
+type ChatRepositoryMockGetReadCursorsResults struct {
+	rpa1 []*model.ReadCursor
+	err  error
+}
+
+// ChatRepositoryMockGetReadCursorsOrigins contains origins of expectations of the ChatRepository.GetReadCursors
+type ChatRepositoryMockGetReadCursorsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Optional() *mChatRepositoryMockGetReadCursors {
+	mmGetReadCursors.optional = true
+	return mmGetReadCursors
+}
+
+// Expect sets up expected params for ChatRepository.GetReadCursors
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockGetReadCursors {
+	if mmGetReadCursors.mock.funcGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Set")
+	}
+
+	if mmGetReadCursors.defaultExpectation == nil {
+		mmGetReadCursors.defaultExpectation = &ChatRepositoryMockGetReadCursorsExpectation{}
+	}
+
+	if mmGetReadCursors.defaultExpectation.paramPtrs != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadCursors.defaultExpectation.params = &ChatRepositoryMockGetReadCursorsParams{ctx, chatID}
+	mmGetReadCursors.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReadCursors.expectations {
+		if minimock.Equal(e.params, mmGetReadCursors.defaultExpectation.params) {
+			mmGetReadCursors.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadCursors.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadCursors
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetReadCursors
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetReadCursors {
+	if mmGetReadCursors.mock.funcGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Set")
+	}
+
+	if mmGetReadCursors.defaultExpectation == nil {
+		mmGetReadCursors.defaultExpectation = &ChatRepositoryMockGetReadCursorsExpectation{}
+	}
+
+	if mmGetReadCursors.defaultExpectation.params != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Expect")
+	}
+
+	if mmGetReadCursors.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursors.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorsParamPtrs{}
+	}
+	mmGetReadCursors.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReadCursors.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReadCursors
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetReadCursors
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetReadCursors {
+	if mmGetReadCursors.mock.funcGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Set")
+	}
+
+	if mmGetReadCursors.defaultExpectation == nil {
+		mmGetReadCursors.defaultExpectation = &ChatRepositoryMockGetReadCursorsExpectation{}
+	}
+
+	if mmGetReadCursors.defaultExpectation.params != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Expect")
+	}
+
+	if mmGetReadCursors.defaultExpectation.paramPtrs == nil {
+		mmGetReadCursors.defaultExpectation.paramPtrs = &ChatRepositoryMockGetReadCursorsParamPtrs{}
+	}
+	mmGetReadCursors.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetReadCursors.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetReadCursors
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetReadCursors
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockGetReadCursors {
+	if mmGetReadCursors.mock.inspectFuncGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetReadCursors")
+	}
+
+	mmGetReadCursors.mock.inspectFuncGetReadCursors = f
+
+	return mmGetReadCursors
+}
+
+// Return sets up results that will be returned by ChatRepository.GetReadCursors
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Return(rpa1 []*model.ReadCursor, err error) *ChatRepositoryMock {
+	if mmGetReadCursors.mock.funcGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Set")
+	}
+
+	if mmGetReadCursors.defaultExpectation == nil {
+		mmGetReadCursors.defaultExpectation = &ChatRepositoryMockGetReadCursorsExpectation{mock: mmGetReadCursors.mock}
+	}
+	mmGetReadCursors.defaultExpectation.results = &ChatRepositoryMockGetReadCursorsResults{rpa1, err}
+	mmGetReadCursors.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursors.mock
+}
+
+// Set uses given function f to mock the ChatRepository.GetReadCursors method
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Set(f func(ctx context.Context, chatID int64) (rpa1 []*model.ReadCursor, err error)) *ChatRepositoryMock {
+	if mmGetReadCursors.defaultExpectation != nil {
+		mmGetReadCursors.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetReadCursors method")
+	}
+
+	if len(mmGetReadCursors.expectations) > 0 {
+		mmGetReadCursors.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetReadCursors method")
+	}
+
+	mmGetReadCursors.mock.funcGetReadCursors = f
+	mmGetReadCursors.mock.funcGetReadCursorsOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursors.mock
+}
+
+// When sets expectation for the ChatRepository.GetReadCursors which will trigger the result defined by the following
+// Then helper
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) When(ctx context.Context, chatID int64) *ChatRepositoryMockGetReadCursorsExpectation {
+	if mmGetReadCursors.mock.funcGetReadCursors != nil {
+		mmGetReadCursors.mock.t.Fatalf("ChatRepositoryMock.GetReadCursors mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockGetReadCursorsExpectation{
+		mock:               mmGetReadCursors.mock,
+		params:             &ChatRepositoryMockGetReadCursorsParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockGetReadCursorsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReadCursors.expectations = append(mmGetReadCursors.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.GetReadCursors return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockGetReadCursorsExpectation) Then(rpa1 []*model.ReadCursor, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockGetReadCursorsResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.GetReadCursors should be invoked
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Times(n uint64) *mChatRepositoryMockGetReadCursors {
+	if n == 0 {
+		mmGetReadCursors.mock.t.Fatalf("Times of ChatRepositoryMock.GetReadCursors mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadCursors.expectedInvocations, n)
+	mmGetReadCursors.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReadCursors
+}
+
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) invocationsDone() bool {
+	if len(mmGetReadCursors.expectations) == 0 && mmGetReadCursors.defaultExpectation == nil && mmGetReadCursors.mock.funcGetReadCursors == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadCursors.mock.afterGetReadCursorsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadCursors.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadCursors implements mm_repository.ChatRepository
+func (mmGetReadCursors *ChatRepositoryMock) GetReadCursors(ctx context.Context, chatID int64) (rpa1 []*model.ReadCursor, err error) {
+	mm_atomic.AddUint64(&mmGetReadCursors.beforeGetReadCursorsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadCursors.afterGetReadCursorsCounter, 1)
+
+	mmGetReadCursors.t.Helper()
+
+	if mmGetReadCursors.inspectFuncGetReadCursors != nil {
+		mmGetReadCursors.inspectFuncGetReadCursors(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockGetReadCursorsParams{ctx, chatID}
+
+	// Record call args
+	mmGetReadCursors.GetReadCursorsMock.mutex.Lock()
+	mmGetReadCursors.GetReadCursorsMock.callArgs = append(mmGetReadCursors.GetReadCursorsMock.callArgs, &mm_params)
+	mmGetReadCursors.GetReadCursorsMock.mutex.Unlock()
+
+	for _, e := range mmGetReadCursors.GetReadCursorsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetReadCursors.GetReadCursorsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadCursors.GetReadCursorsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadCursors.GetReadCursorsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadCursors.GetReadCursorsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockGetReadCursorsParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadCursors.t.Errorf("ChatRepositoryMock.GetReadCursors got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursors.GetReadCursorsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetReadCursors.t.Errorf("ChatRepositoryMock.GetReadCursors got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadCursors.GetReadCursorsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadCursors.t.Errorf("ChatRepositoryMock.GetReadCursors got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReadCursors.GetReadCursorsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadCursors.GetReadCursorsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadCursors.t.Fatal("No results are set for the ChatRepositoryMock.GetReadCursors")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetReadCursors.funcGetReadCursors != nil {
+		return mmGetReadCursors.funcGetReadCursors(ctx, chatID)
+	}
+	mmGetReadCursors.t.Fatalf("Unexpected call to ChatRepositoryMock.GetReadCursors. %v %v", ctx, chatID)
+	return
+}
+
+// GetReadCursorsAfterCounter returns a count of finished ChatRepositoryMock.GetReadCursors invocations
+func (mmGetReadCursors *ChatRepositoryMock) GetReadCursorsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursors.afterGetReadCursorsCounter)
+}
+
+// GetReadCursorsBeforeCounter returns a count of ChatRepositoryMock.GetReadCursors invocations
+func (mmGetReadCursors *ChatRepositoryMock) GetReadCursorsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadCursors.beforeGetReadCursorsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetReadCursors.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadCursors *mChatRepositoryMockGetReadCursors) Calls() []*ChatRepositoryMockGetReadCursorsParams {
+	mmGetReadCursors.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockGetReadCursorsParams, len(mmGetReadCursors.callArgs))
+	copy(argCopy, mmGetReadCursors.callArgs)
+
+	mmGetReadCursors.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadCursorsDone returns true if the count of the GetReadCursors invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockGetReadCursorsDone() bool {
+	if m.GetReadCursorsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadCursorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadCursorsMock.invocationsDone()
+}
+
+// MinimockGetReadCursorsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockGetReadCursorsInspect() {
+	for _, e := range m.GetReadCursorsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursors at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReadCursorsCounter := mm_atomic.LoadUint64(&m.afterGetReadCursorsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadCursorsMock.defaultExpectation != nil && afterGetReadCursorsCounter < 1 {
+		if m.GetReadCursorsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursors at\n%s", m.GetReadCursorsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursors at\n%s with params: %#v", m.GetReadCursorsMock.defaultExpectation.expectationOrigins.origin, *m.GetReadCursorsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadCursors != nil && afterGetReadCursorsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.GetReadCursors at\n%s", m.funcGetReadCursorsOrigin)
+	}
+
+	if !m.GetReadCursorsMock.invocationsDone() && afterGetReadCursorsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetReadCursors at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadCursorsMock.expectedInvocations), m.GetReadCursorsMock.expectedInvocationsOrigin, afterGetReadCursorsCounter)
+	}
+}
+
+type mChatRepositoryMockIsChatMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockIsChatMemberExpectation
+	expectations       []*ChatRepositoryMockIsChatMemberExpectation
+
+	callArgs []*ChatRepositoryMockIsChatMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockIsChatMemberExpectation specifies expectation struct of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockIsChatMemberParams
+	paramPtrs          *ChatRepositoryMockIsChatMemberParamPtrs
+	expectationOrigins ChatRepositoryMockIsChatMemberExpectationOrigins
+	results            *ChatRepositoryMockIsChatMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockIsChatMemberParams contains parameters of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockIsChatMemberParamPtrs contains pointers to parameters of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockIsChatMemberResults contains results of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockIsChatMemberOrigins contains origins of expectations of the ChatRepository.IsChatMember
+type ChatRepositoryMockIsChatMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Optional() *mChatRepositoryMockIsChatMember {
+	mmIsChatMember.optional = true
+	return mmIsChatMember
+}
+
+// Expect sets up expected params for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by ExpectParams functions")
+	}
+
+	mmIsChatMember.defaultExpectation.params = &ChatRepositoryMockIsChatMemberParams{ctx, chatID, username}
+	mmIsChatMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmIsChatMember.expectations {
+		if minimock.Equal(e.params, mmIsChatMember.defaultExpectation.params) {
+			mmIsChatMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmIsChatMember.defaultExpectation.params)
+		}
+	}
+
+	return mmIsChatMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmIsChatMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmIsChatMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) ExpectUsernameParam3(username string) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{}
+	}
+
+	if mmIsChatMember.defaultExpectation.params != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Expect")
+	}
+
+	if mmIsChatMember.defaultExpectation.paramPtrs == nil {
+		mmIsChatMember.defaultExpectation.paramPtrs = &ChatRepositoryMockIsChatMemberParamPtrs{}
+	}
+	mmIsChatMember.defaultExpectation.paramPtrs.username = &username
+	mmIsChatMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmIsChatMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockIsChatMember {
+	if mmIsChatMember.mock.inspectFuncIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.IsChatMember")
+	}
+
+	mmIsChatMember.mock.inspectFuncIsChatMember = f
+
+	return mmIsChatMember
+}
+
+// Return sets up results that will be returned by ChatRepository.IsChatMember
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	if mmIsChatMember.defaultExpectation == nil {
+		mmIsChatMember.defaultExpectation = &ChatRepositoryMockIsChatMemberExpectation{mock: mmIsChatMember.mock}
+	}
+	mmIsChatMember.defaultExpectation.results = &ChatRepositoryMockIsChatMemberResults{b1, err}
+	mmIsChatMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.IsChatMember method
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Set(f func(ctx context.Context, chatID int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmIsChatMember.defaultExpectation != nil {
+		mmIsChatMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.IsChatMember method")
+	}
+
+	if len(mmIsChatMember.expectations) > 0 {
+		mmIsChatMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.IsChatMember method")
+	}
+
+	mmIsChatMember.mock.funcIsChatMember = f
+	mmIsChatMember.mock.funcIsChatMemberOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember.mock
+}
+
+// When sets expectation for the ChatRepository.IsChatMember which will trigger the result defined by the following
+// Then helper
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockIsChatMemberExpectation {
+	if mmIsChatMember.mock.funcIsChatMember != nil {
+		mmIsChatMember.mock.t.Fatalf("ChatRepositoryMock.IsChatMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockIsChatMemberExpectation{
+		mock:               mmIsChatMember.mock,
+		params:             &ChatRepositoryMockIsChatMemberParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockIsChatMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmIsChatMember.expectations = append(mmIsChatMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.IsChatMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockIsChatMemberExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockIsChatMemberResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.IsChatMember should be invoked
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Times(n uint64) *mChatRepositoryMockIsChatMember {
+	if n == 0 {
+		mmIsChatMember.mock.t.Fatalf("Times of ChatRepositoryMock.IsChatMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmIsChatMember.expectedInvocations, n)
+	mmIsChatMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmIsChatMember
+}
+
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) invocationsDone() bool {
+	if len(mmIsChatMember.expectations) == 0 && mmIsChatMember.defaultExpectation == nil && mmIsChatMember.mock.funcIsChatMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmIsChatMember.mock.afterIsChatMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmIsChatMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// IsChatMember implements mm_repository.ChatRepository
+func (mmIsChatMember *ChatRepositoryMock) IsChatMember(ctx context.Context, chatID int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmIsChatMember.beforeIsChatMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmIsChatMember.afterIsChatMemberCounter, 1)
+
+	mmIsChatMember.t.Helper()
+
+	if mmIsChatMember.inspectFuncIsChatMember != nil {
+		mmIsChatMember.inspectFuncIsChatMember(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockIsChatMemberParams{ctx, chatID, username}
+
+	// Record call args
+	mmIsChatMember.IsChatMemberMock.mutex.Lock()
+	mmIsChatMember.IsChatMemberMock.callArgs = append(mmIsChatMember.IsChatMemberMock.callArgs, &mm_params)
+	mmIsChatMember.IsChatMemberMock.mutex.Unlock()
+
+	for _, e := range mmIsChatMember.IsChatMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmIsChatMember.IsChatMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmIsChatMember.IsChatMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmIsChatMember.IsChatMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmIsChatMember.IsChatMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockIsChatMemberParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmIsChatMember.t.Errorf("ChatRepositoryMock.IsChatMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmIsChatMember.IsChatMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmIsChatMember.IsChatMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmIsChatMember.t.Fatal("No results are set for the ChatRepositoryMock.IsChatMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmIsChatMember.funcIsChatMember != nil {
+		return mmIsChatMember.funcIsChatMember(ctx, chatID, username)
+	}
+	mmIsChatMember.t.Fatalf("Unexpected call to ChatRepositoryMock.IsChatMember. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// IsChatMemberAfterCounter returns a count of finished ChatRepositoryMock.IsChatMember invocations
+func (mmIsChatMember *ChatRepositoryMock) IsChatMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatMember.afterIsChatMemberCounter)
+}
+
+// IsChatMemberBeforeCounter returns a count of ChatRepositoryMock.IsChatMember invocations
+func (mmIsChatMember *ChatRepositoryMock) IsChatMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmIsChatMember.beforeIsChatMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.IsChatMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmIsChatMember *mChatRepositoryMockIsChatMember) Calls() []*ChatRepositoryMockIsChatMemberParams {
+	mmIsChatMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockIsChatMemberParams, len(mmIsChatMember.callArgs))
+	copy(argCopy, mmIsChatMember.callArgs)
+
+	mmIsChatMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockIsChatMemberDone returns true if the count of the IsChatMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockIsChatMemberDone() bool {
+	if m.IsChatMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.IsChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.IsChatMemberMock.invocationsDone()
+}
+
+// MinimockIsChatMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockIsChatMemberInspect() {
+	for _, e := range m.IsChatMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterIsChatMemberCounter := mm_atomic.LoadUint64(&m.afterIsChatMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.IsChatMemberMock.defaultExpectation != nil && afterIsChatMemberCounter < 1 {
+		if m.IsChatMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s", m.IsChatMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s with params: %#v", m.IsChatMemberMock.defaultExpectation.expectationOrigins.origin, *m.IsChatMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcIsChatMember != nil && afterIsChatMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.IsChatMember at\n%s", m.funcIsChatMemberOrigin)
+	}
+
+	if !m.IsChatMemberMock.invocationsDone() && afterIsChatMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.IsChatMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.IsChatMemberMock.expectedInvocations), m.IsChatMemberMock.expectedInvocationsOrigin, afterIsChatMemberCounter)
+	}
+}
+
+type mChatRepositoryMockListChatIDs struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListChatIDsExpectation
+	expectations       []*ChatRepositoryMockListChatIDsExpectation
+
+	callArgs []*ChatRepositoryMockListChatIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListChatIDsExpectation specifies expectation struct of the ChatRepository.ListChatIDs
+type ChatRepositoryMockListChatIDsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListChatIDsParams
+	paramPtrs          *ChatRepositoryMockListChatIDsParamPtrs
+	expectationOrigins ChatRepositoryMockListChatIDsExpectationOrigins
+	results            *ChatRepositoryMockListChatIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListChatIDsParams contains parameters of the ChatRepository.ListChatIDs
+type ChatRepositoryMockListChatIDsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ChatRepositoryMockListChatIDsParamPtrs contains pointers to parameters of the ChatRepository.ListChatIDs
+type ChatRepositoryMockListChatIDsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ChatRepositoryMockListChatIDsResults contains results of the ChatRepository.ListChatIDs
+type ChatRepositoryMockListChatIDsResults struct {
+	ia1 []int64
+	err error
+}
+
+// ChatRepositoryMockListChatIDsOrigins contains origins of expectations of the ChatRepository.ListChatIDs
+type ChatRepositoryMockListChatIDsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Optional() *mChatRepositoryMockListChatIDs {
+	mmListChatIDs.optional = true
+	return mmListChatIDs
+}
+
+// Expect sets up expected params for ChatRepository.ListChatIDs
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Expect(ctx context.Context, username string) *mChatRepositoryMockListChatIDs {
+	if mmListChatIDs.mock.funcListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Set")
+	}
+
+	if mmListChatIDs.defaultExpectation == nil {
+		mmListChatIDs.defaultExpectation = &ChatRepositoryMockListChatIDsExpectation{}
+	}
+
+	if mmListChatIDs.defaultExpectation.paramPtrs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by ExpectParams functions")
+	}
+
+	mmListChatIDs.defaultExpectation.params = &ChatRepositoryMockListChatIDsParams{ctx, username}
+	mmListChatIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChatIDs.expectations {
+		if minimock.Equal(e.params, mmListChatIDs.defaultExpectation.params) {
+			mmListChatIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChatIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmListChatIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListChatIDs
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListChatIDs {
+	if mmListChatIDs.mock.funcListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Set")
+	}
+
+	if mmListChatIDs.defaultExpectation == nil {
+		mmListChatIDs.defaultExpectation = &ChatRepositoryMockListChatIDsExpectation{}
+	}
+
+	if mmListChatIDs.defaultExpectation.params != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Expect")
+	}
+
+	if mmListChatIDs.defaultExpectation.paramPtrs == nil {
+		mmListChatIDs.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatIDsParamPtrs{}
+	}
+	mmListChatIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChatIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChatIDs
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatRepository.ListChatIDs
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) ExpectUsernameParam2(username string) *mChatRepositoryMockListChatIDs {
+	if mmListChatIDs.mock.funcListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Set")
+	}
+
+	if mmListChatIDs.defaultExpectation == nil {
+		mmListChatIDs.defaultExpectation = &ChatRepositoryMockListChatIDsExpectation{}
+	}
+
+	if mmListChatIDs.defaultExpectation.params != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Expect")
+	}
+
+	if mmListChatIDs.defaultExpectation.paramPtrs == nil {
+		mmListChatIDs.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatIDsParamPtrs{}
+	}
+	mmListChatIDs.defaultExpectation.paramPtrs.username = &username
+	mmListChatIDs.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListChatIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListChatIDs
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Inspect(f func(ctx context.Context, username string)) *mChatRepositoryMockListChatIDs {
+	if mmListChatIDs.mock.inspectFuncListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListChatIDs")
+	}
+
+	mmListChatIDs.mock.inspectFuncListChatIDs = f
+
+	return mmListChatIDs
+}
+
+// Return sets up results that will be returned by ChatRepository.ListChatIDs
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Return(ia1 []int64, err error) *ChatRepositoryMock {
+	if mmListChatIDs.mock.funcListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Set")
+	}
+
+	if mmListChatIDs.defaultExpectation == nil {
+		mmListChatIDs.defaultExpectation = &ChatRepositoryMockListChatIDsExpectation{mock: mmListChatIDs.mock}
+	}
+	mmListChatIDs.defaultExpectation.results = &ChatRepositoryMockListChatIDsResults{ia1, err}
+	mmListChatIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChatIDs.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListChatIDs method
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Set(f func(ctx context.Context, username string) (ia1 []int64, err error)) *ChatRepositoryMock {
+	if mmListChatIDs.defaultExpectation != nil {
+		mmListChatIDs.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListChatIDs method")
+	}
+
+	if len(mmListChatIDs.expectations) > 0 {
+		mmListChatIDs.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListChatIDs method")
+	}
+
+	mmListChatIDs.mock.funcListChatIDs = f
+	mmListChatIDs.mock.funcListChatIDsOrigin = minimock.CallerInfo(1)
+	return mmListChatIDs.mock
+}
+
+// When sets expectation for the ChatRepository.ListChatIDs which will trigger the result defined by the following
+// Then helper
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) When(ctx context.Context, username string) *ChatRepositoryMockListChatIDsExpectation {
+	if mmListChatIDs.mock.funcListChatIDs != nil {
+		mmListChatIDs.mock.t.Fatalf("ChatRepositoryMock.ListChatIDs mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListChatIDsExpectation{
+		mock:               mmListChatIDs.mock,
+		params:             &ChatRepositoryMockListChatIDsParams{ctx, username},
+		expectationOrigins: ChatRepositoryMockListChatIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChatIDs.expectations = append(mmListChatIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListChatIDs return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListChatIDsExpectation) Then(ia1 []int64, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListChatIDsResults{ia1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListChatIDs should be invoked
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Times(n uint64) *mChatRepositoryMockListChatIDs {
+	if n == 0 {
+		mmListChatIDs.mock.t.Fatalf("Times of ChatRepositoryMock.ListChatIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChatIDs.expectedInvocations, n)
+	mmListChatIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChatIDs
+}
+
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) invocationsDone() bool {
+	if len(mmListChatIDs.expectations) == 0 && mmListChatIDs.defaultExpectation == nil && mmListChatIDs.mock.funcListChatIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChatIDs.mock.afterListChatIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChatIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChatIDs implements mm_repository.ChatRepository
+func (mmListChatIDs *ChatRepositoryMock) ListChatIDs(ctx context.Context, username string) (ia1 []int64, err error) {
+	mm_atomic.AddUint64(&mmListChatIDs.beforeListChatIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChatIDs.afterListChatIDsCounter, 1)
+
+	mmListChatIDs.t.Helper()
+
+	if mmListChatIDs.inspectFuncListChatIDs != nil {
+		mmListChatIDs.inspectFuncListChatIDs(ctx, username)
+	}
+
+	mm_params := ChatRepositoryMockListChatIDsParams{ctx, username}
+
+	// Record call args
+	mmListChatIDs.ListChatIDsMock.mutex.Lock()
+	mmListChatIDs.ListChatIDsMock.callArgs = append(mmListChatIDs.ListChatIDsMock.callArgs, &mm_params)
+	mmListChatIDs.ListChatIDsMock.mutex.Unlock()
+
+	for _, e := range mmListChatIDs.ListChatIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ia1, e.results.err
+		}
+	}
+
+	if mmListChatIDs.ListChatIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChatIDs.ListChatIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChatIDs.ListChatIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChatIDs.ListChatIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListChatIDsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChatIDs.t.Errorf("ChatRepositoryMock.ListChatIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChatIDs.ListChatIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListChatIDs.t.Errorf("ChatRepositoryMock.ListChatIDs got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChatIDs.ListChatIDsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChatIDs.t.Errorf("ChatRepositoryMock.ListChatIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChatIDs.ListChatIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChatIDs.ListChatIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChatIDs.t.Fatal("No results are set for the ChatRepositoryMock.ListChatIDs")
+		}
+		return (*mm_results).ia1, (*mm_results).err
+	}
+	if mmListChatIDs.funcListChatIDs != nil {
+		return mmListChatIDs.funcListChatIDs(ctx, username)
+	}
+	mmListChatIDs.t.Fatalf("Unexpected call to ChatRepositoryMock.ListChatIDs. %v %v", ctx, username)
+	return
+}
+
+// ListChatIDsAfterCounter returns a count of finished ChatRepositoryMock.ListChatIDs invocations
+func (mmListChatIDs *ChatRepositoryMock) ListChatIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChatIDs.afterListChatIDsCounter)
+}
+
+// ListChatIDsBeforeCounter returns a count of ChatRepositoryMock.ListChatIDs invocations
+func (mmListChatIDs *ChatRepositoryMock) ListChatIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChatIDs.beforeListChatIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListChatIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChatIDs *mChatRepositoryMockListChatIDs) Calls() []*ChatRepositoryMockListChatIDsParams {
+	mmListChatIDs.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListChatIDsParams, len(mmListChatIDs.callArgs))
+	copy(argCopy, mmListChatIDs.callArgs)
+
+	mmListChatIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatIDsDone returns true if the count of the ListChatIDs invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListChatIDsDone() bool {
+	if m.ListChatIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatIDsMock.invocationsDone()
+}
+
+// MinimockListChatIDsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListChatIDsInspect() {
+	for _, e := range m.ListChatIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChatIDsCounter := mm_atomic.LoadUint64(&m.afterListChatIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatIDsMock.defaultExpectation != nil && afterListChatIDsCounter < 1 {
+		if m.ListChatIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatIDs at\n%s", m.ListChatIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChatIDs at\n%s with params: %#v", m.ListChatIDsMock.defaultExpectation.expectationOrigins.origin, *m.ListChatIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChatIDs != nil && afterListChatIDsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListChatIDs at\n%s", m.funcListChatIDsOrigin)
+	}
+
+	if !m.ListChatIDsMock.invocationsDone() && afterListChatIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListChatIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatIDsMock.expectedInvocations), m.ListChatIDsMock.expectedInvocationsOrigin, afterListChatIDsCounter)
+	}
+}
+
+type mChatRepositoryMockListChats struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockListChatsExpectation
+	expectations       []*ChatRepositoryMockListChatsExpectation
+
+	callArgs []*ChatRepositoryMockListChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockListChatsExpectation specifies expectation struct of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockListChatsParams
+	paramPtrs          *ChatRepositoryMockListChatsParamPtrs
+	expectationOrigins ChatRepositoryMockListChatsExpectationOrigins
+	results            *ChatRepositoryMockListChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockListChatsParams contains parameters of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsParams struct {
+	ctx   context.Context
+	query *model.ChatListQuery
+}
+
+// ChatRepositoryMockListChatsParamPtrs contains pointers to parameters of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsParamPtrs struct {
+	ctx   *context.Context
+	query **model.ChatListQuery
+}
+
+// ChatRepositoryMockListChatsResults contains results of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsResults struct {
+	cpa1 []*model.ChatSummary
+	err  error
+}
+
+// ChatRepositoryMockListChatsOrigins contains origins of expectations of the ChatRepository.ListChats
+type ChatRepositoryMockListChatsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChats *mChatRepositoryMockListChats) Optional() *mChatRepositoryMockListChats {
+	mmListChats.optional = true
+	return mmListChats
+}
+
+// Expect sets up expected params for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Expect(ctx context.Context, query *model.ChatListQuery) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by ExpectParams functions")
+	}
+
+	mmListChats.defaultExpectation.params = &ChatRepositoryMockListChatsParams{ctx, query}
+	mmListChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
+		}
+	}
+
+	return mmListChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) ExpectQueryParam2(query *model.ChatListQuery) *mChatRepositoryMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatRepositoryMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.query = &query
+	mmListChats.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Inspect(f func(ctx context.Context, query *model.ChatListQuery)) *mChatRepositoryMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.ListChats")
+	}
+
+	mmListChats.mock.inspectFuncListChats = f
+
+	return mmListChats
+}
+
+// Return sets up results that will be returned by ChatRepository.ListChats
+func (mmListChats *mChatRepositoryMockListChats) Return(cpa1 []*model.ChatSummary, err error) *ChatRepositoryMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatRepositoryMockListChatsExpectation{mock: mmListChats.mock}
+	}
+	mmListChats.defaultExpectation.results = &ChatRepositoryMockListChatsResults{cpa1, err}
+	mmListChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// Set uses given function f to mock the ChatRepository.ListChats method
+func (mmListChats *mChatRepositoryMockListChats) Set(f func(ctx context.Context, query *model.ChatListQuery) (cpa1 []*model.ChatSummary, err error)) *ChatRepositoryMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatRepository.ListChats method")
+	}
+
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatRepository.ListChats method")
+	}
+
+	mmListChats.mock.funcListChats = f
+	mmListChats.mock.funcListChatsOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// When sets expectation for the ChatRepository.ListChats which will trigger the result defined by the following
+// Then helper
+func (mmListChats *mChatRepositoryMockListChats) When(ctx context.Context, query *model.ChatListQuery) *ChatRepositoryMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatRepositoryMock.ListChats mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockListChatsExpectation{
+		mock:               mmListChats.mock,
+		params:             &ChatRepositoryMockListChatsParams{ctx, query},
+		expectationOrigins: ChatRepositoryMockListChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockListChatsExpectation) Then(cpa1 []*model.ChatSummary, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockListChatsResults{cpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.ListChats should be invoked
+func (mmListChats *mChatRepositoryMockListChats) Times(n uint64) *mChatRepositoryMockListChats {
+	if n == 0 {
+		mmListChats.mock.t.Fatalf("Times of ChatRepositoryMock.ListChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	mmListChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChats
+}
+
+func (mmListChats *mChatRepositoryMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChats implements mm_repository.ChatRepository
+func (mmListChats *ChatRepositoryMock) ListChats(ctx context.Context, query *model.ChatListQuery) (cpa1 []*model.ChatSummary, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
+
+	mmListChats.t.Helper()
+
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, query)
+	}
+
+	mm_params := ChatRepositoryMockListChatsParams{ctx, query}
+
+	// Record call args
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
+
+	for _, e := range mmListChats.ListChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cpa1, e.results.err
+		}
+	}
+
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockListChatsParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChats.t.Errorf("ChatRepositoryMock.ListChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChats.t.Fatal("No results are set for the ChatRepositoryMock.ListChats")
+		}
+		return (*mm_results).cpa1, (*mm_results).err
+	}
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, query)
+	}
+	mmListChats.t.Fatalf("Unexpected call to ChatRepositoryMock.ListChats. %v %v", ctx, query)
+	return
+}
+
+// ListChatsAfterCounter returns a count of finished ChatRepositoryMock.ListChats invocations
+func (mmListChats *ChatRepositoryMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
+}
+
+// ListChatsBeforeCounter returns a count of ChatRepositoryMock.ListChats invocations
+func (mmListChats *ChatRepositoryMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.ListChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChats *mChatRepositoryMockListChats) Calls() []*ChatRepositoryMockListChatsParams {
+	mmListChats.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
+
+	mmListChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatsMock.invocationsDone()
+}
+
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChats at\n%s", m.ListChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.ListChats at\n%s with params: %#v", m.ListChatsMock.defaultExpectation.expectationOrigins.origin, *m.ListChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.ListChats at\n%s", m.funcListChatsOrigin)
+	}
+
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.ListChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), m.ListChatsMock.expectedInvocationsOrigin, afterListChatsCounter)
+	}
+}
+
+type mChatRepositoryMockLockChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockLockChatExpectation
+	expectations       []*ChatRepositoryMockLockChatExpectation
+
+	callArgs []*ChatRepositoryMockLockChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockLockChatExpectation specifies expectation struct of the ChatRepository.LockChat
+type ChatRepositoryMockLockChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockLockChatParams
+	paramPtrs          *ChatRepositoryMockLockChatParamPtrs
+	expectationOrigins ChatRepositoryMockLockChatExpectationOrigins
+	results            *ChatRepositoryMockLockChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockLockChatParams contains parameters of the ChatRepository.LockChat
+type ChatRepositoryMockLockChatParams struct {
+	ctx    context.Context
 	chatID int64
 }
 
-// ChatRepositoryMockGetChatUsersParamPtrs contains pointers to parameters of the ChatRepository.GetChatUsers
-type ChatRepositoryMockGetChatUsersParamPtrs struct {
-	ctx    *context.Context
-	chatID *int64
+// ChatRepositoryMockLockChatParamPtrs contains pointers to parameters of the ChatRepository.LockChat
+type ChatRepositoryMockLockChatParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// ChatRepositoryMockLockChatResults contains results of the ChatRepository.LockChat
+type ChatRepositoryMockLockChatResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockLockChatOrigins contains origins of expectations of the ChatRepository.LockChat
+type ChatRepositoryMockLockChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockChat *mChatRepositoryMockLockChat) Optional() *mChatRepositoryMockLockChat {
+	mmLockChat.optional = true
+	return mmLockChat
+}
+
+// Expect sets up expected params for ChatRepository.LockChat
+func (mmLockChat *mChatRepositoryMockLockChat) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockLockChat {
+	if mmLockChat.mock.funcLockChat != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Set")
+	}
+
+	if mmLockChat.defaultExpectation == nil {
+		mmLockChat.defaultExpectation = &ChatRepositoryMockLockChatExpectation{}
+	}
+
+	if mmLockChat.defaultExpectation.paramPtrs != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by ExpectParams functions")
+	}
+
+	mmLockChat.defaultExpectation.params = &ChatRepositoryMockLockChatParams{ctx, chatID}
+	mmLockChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockChat.expectations {
+		if minimock.Equal(e.params, mmLockChat.defaultExpectation.params) {
+			mmLockChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockChat.defaultExpectation.params)
+		}
+	}
+
+	return mmLockChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.LockChat
+func (mmLockChat *mChatRepositoryMockLockChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockLockChat {
+	if mmLockChat.mock.funcLockChat != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Set")
+	}
+
+	if mmLockChat.defaultExpectation == nil {
+		mmLockChat.defaultExpectation = &ChatRepositoryMockLockChatExpectation{}
+	}
+
+	if mmLockChat.defaultExpectation.params != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Expect")
+	}
+
+	if mmLockChat.defaultExpectation.paramPtrs == nil {
+		mmLockChat.defaultExpectation.paramPtrs = &ChatRepositoryMockLockChatParamPtrs{}
+	}
+	mmLockChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.LockChat
+func (mmLockChat *mChatRepositoryMockLockChat) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockLockChat {
+	if mmLockChat.mock.funcLockChat != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Set")
+	}
+
+	if mmLockChat.defaultExpectation == nil {
+		mmLockChat.defaultExpectation = &ChatRepositoryMockLockChatExpectation{}
+	}
+
+	if mmLockChat.defaultExpectation.params != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Expect")
+	}
+
+	if mmLockChat.defaultExpectation.paramPtrs == nil {
+		mmLockChat.defaultExpectation.paramPtrs = &ChatRepositoryMockLockChatParamPtrs{}
+	}
+	mmLockChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmLockChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmLockChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.LockChat
+func (mmLockChat *mChatRepositoryMockLockChat) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockLockChat {
+	if mmLockChat.mock.inspectFuncLockChat != nil {
+		mmLockChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.LockChat")
+	}
+
+	mmLockChat.mock.inspectFuncLockChat = f
+
+	return mmLockChat
+}
+
+// Return sets up results that will be returned by ChatRepository.LockChat
+func (mmLockChat *mChatRepositoryMockLockChat) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmLockChat.mock.funcLockChat != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Set")
+	}
+
+	if mmLockChat.defaultExpectation == nil {
+		mmLockChat.defaultExpectation = &ChatRepositoryMockLockChatExpectation{mock: mmLockChat.mock}
+	}
+	mmLockChat.defaultExpectation.results = &ChatRepositoryMockLockChatResults{b1, err}
+	mmLockChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.LockChat method
+func (mmLockChat *mChatRepositoryMockLockChat) Set(f func(ctx context.Context, chatID int64) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmLockChat.defaultExpectation != nil {
+		mmLockChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.LockChat method")
+	}
+
+	if len(mmLockChat.expectations) > 0 {
+		mmLockChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.LockChat method")
+	}
+
+	mmLockChat.mock.funcLockChat = f
+	mmLockChat.mock.funcLockChatOrigin = minimock.CallerInfo(1)
+	return mmLockChat.mock
+}
+
+// When sets expectation for the ChatRepository.LockChat which will trigger the result defined by the following
+// Then helper
+func (mmLockChat *mChatRepositoryMockLockChat) When(ctx context.Context, chatID int64) *ChatRepositoryMockLockChatExpectation {
+	if mmLockChat.mock.funcLockChat != nil {
+		mmLockChat.mock.t.Fatalf("ChatRepositoryMock.LockChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockLockChatExpectation{
+		mock:               mmLockChat.mock,
+		params:             &ChatRepositoryMockLockChatParams{ctx, chatID},
+		expectationOrigins: ChatRepositoryMockLockChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockChat.expectations = append(mmLockChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.LockChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockLockChatExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockLockChatResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.LockChat should be invoked
+func (mmLockChat *mChatRepositoryMockLockChat) Times(n uint64) *mChatRepositoryMockLockChat {
+	if n == 0 {
+		mmLockChat.mock.t.Fatalf("Times of ChatRepositoryMock.LockChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockChat.expectedInvocations, n)
+	mmLockChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockChat
+}
+
+func (mmLockChat *mChatRepositoryMockLockChat) invocationsDone() bool {
+	if len(mmLockChat.expectations) == 0 && mmLockChat.defaultExpectation == nil && mmLockChat.mock.funcLockChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockChat.mock.afterLockChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockChat implements mm_repository.ChatRepository
+func (mmLockChat *ChatRepositoryMock) LockChat(ctx context.Context, chatID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmLockChat.beforeLockChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockChat.afterLockChatCounter, 1)
+
+	mmLockChat.t.Helper()
+
+	if mmLockChat.inspectFuncLockChat != nil {
+		mmLockChat.inspectFuncLockChat(ctx, chatID)
+	}
+
+	mm_params := ChatRepositoryMockLockChatParams{ctx, chatID}
+
+	// Record call args
+	mmLockChat.LockChatMock.mutex.Lock()
+	mmLockChat.LockChatMock.callArgs = append(mmLockChat.LockChatMock.callArgs, &mm_params)
+	mmLockChat.LockChatMock.mutex.Unlock()
+
+	for _, e := range mmLockChat.LockChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmLockChat.LockChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockChat.LockChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockChat.LockChatMock.defaultExpectation.params
+		mm_want_ptrs := mmLockChat.LockChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockLockChatParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockChat.t.Errorf("ChatRepositoryMock.LockChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockChat.LockChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLockChat.t.Errorf("ChatRepositoryMock.LockChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockChat.LockChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockChat.t.Errorf("ChatRepositoryMock.LockChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockChat.LockChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockChat.LockChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockChat.t.Fatal("No results are set for the ChatRepositoryMock.LockChat")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmLockChat.funcLockChat != nil {
+		return mmLockChat.funcLockChat(ctx, chatID)
+	}
+	mmLockChat.t.Fatalf("Unexpected call to ChatRepositoryMock.LockChat. %v %v", ctx, chatID)
+	return
+}
+
+// LockChatAfterCounter returns a count of finished ChatRepositoryMock.LockChat invocations
+func (mmLockChat *ChatRepositoryMock) LockChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChat.afterLockChatCounter)
+}
+
+// LockChatBeforeCounter returns a count of ChatRepositoryMock.LockChat invocations
+func (mmLockChat *ChatRepositoryMock) LockChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockChat.beforeLockChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.LockChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockChat *mChatRepositoryMockLockChat) Calls() []*ChatRepositoryMockLockChatParams {
+	mmLockChat.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockLockChatParams, len(mmLockChat.callArgs))
+	copy(argCopy, mmLockChat.callArgs)
+
+	mmLockChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockChatDone returns true if the count of the LockChat invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockLockChatDone() bool {
+	if m.LockChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockChatMock.invocationsDone()
+}
+
+// MinimockLockChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockLockChatInspect() {
+	for _, e := range m.LockChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockChatCounter := mm_atomic.LoadUint64(&m.afterLockChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockChatMock.defaultExpectation != nil && afterLockChatCounter < 1 {
+		if m.LockChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockChat at\n%s", m.LockChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.LockChat at\n%s with params: %#v", m.LockChatMock.defaultExpectation.expectationOrigins.origin, *m.LockChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockChat != nil && afterLockChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.LockChat at\n%s", m.funcLockChatOrigin)
+	}
+
+	if !m.LockChatMock.invocationsDone() && afterLockChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.LockChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockChatMock.expectedInvocations), m.LockChatMock.expectedInvocationsOrigin, afterLockChatCounter)
+	}
+}
+
+type mChatRepositoryMockRemoveMember struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockRemoveMemberExpectation
+	expectations       []*ChatRepositoryMockRemoveMemberExpectation
+
+	callArgs []*ChatRepositoryMockRemoveMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockRemoveMemberExpectation specifies expectation struct of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockRemoveMemberParams
+	paramPtrs          *ChatRepositoryMockRemoveMemberParamPtrs
+	expectationOrigins ChatRepositoryMockRemoveMemberExpectationOrigins
+	results            *ChatRepositoryMockRemoveMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockRemoveMemberParams contains parameters of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatRepositoryMockRemoveMemberParamPtrs contains pointers to parameters of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatRepositoryMockRemoveMemberResults contains results of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberResults struct {
+	b1  bool
+	err error
+}
+
+// ChatRepositoryMockRemoveMemberOrigins contains origins of expectations of the ChatRepository.RemoveMember
+type ChatRepositoryMockRemoveMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Optional() *mChatRepositoryMockRemoveMember {
+	mmRemoveMember.optional = true
+	return mmRemoveMember
+}
+
+// Expect sets up expected params for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Expect(ctx context.Context, chatID int64, username string) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &ChatRepositoryMockRemoveMemberParams{ctx, chatID, username}
+	mmRemoveMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) ExpectUsernameParam3(username string) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatRepositoryMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.username = &username
+	mmRemoveMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatRepositoryMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by ChatRepository.RemoveMember
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatRepositoryMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &ChatRepositoryMockRemoveMemberResults{b1, err}
+	mmRemoveMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the ChatRepository.RemoveMember method
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Set(f func(ctx context.Context, chatID int64, username string) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the ChatRepository.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the ChatRepository.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	mmRemoveMember.mock.funcRemoveMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the ChatRepository.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) When(ctx context.Context, chatID int64, username string) *ChatRepositoryMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatRepositoryMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockRemoveMemberExpectation{
+		mock:               mmRemoveMember.mock,
+		params:             &ChatRepositoryMockRemoveMemberParams{ctx, chatID, username},
+		expectationOrigins: ChatRepositoryMockRemoveMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockRemoveMemberExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockRemoveMemberResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.RemoveMember should be invoked
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Times(n uint64) *mChatRepositoryMockRemoveMember {
+	if n == 0 {
+		mmRemoveMember.mock.t.Fatalf("Times of ChatRepositoryMock.RemoveMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMember.expectedInvocations, n)
+	mmRemoveMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember
+}
+
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) invocationsDone() bool {
+	if len(mmRemoveMember.expectations) == 0 && mmRemoveMember.defaultExpectation == nil && mmRemoveMember.mock.funcRemoveMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMember.mock.afterRemoveMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMember implements mm_repository.ChatRepository
+func (mmRemoveMember *ChatRepositoryMock) RemoveMember(ctx context.Context, chatID int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	mmRemoveMember.t.Helper()
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, chatID, username)
+	}
+
+	mm_params := ChatRepositoryMockRemoveMemberParams{ctx, chatID, username}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockRemoveMemberParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("ChatRepositoryMock.RemoveMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the ChatRepositoryMock.RemoveMember")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, chatID, username)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to ChatRepositoryMock.RemoveMember. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished ChatRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *ChatRepositoryMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
 }
 
-// ChatRepositoryMockGetChatUsersResults contains results of the ChatRepository.GetChatUsers
-type ChatRepositoryMockGetChatUsersResults struct {
-	sa1 []string
+// RemoveMemberBeforeCounter returns a count of ChatRepositoryMock.RemoveMember invocations
+func (mmRemoveMember *ChatRepositoryMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mChatRepositoryMockRemoveMember) Calls() []*ChatRepositoryMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockRemoveMemberDone() bool {
+	if m.RemoveMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMemberMock.invocationsDone()
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && afterRemoveMemberCounter < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s", m.RemoveMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s with params: %#v", m.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && afterRemoveMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.RemoveMember at\n%s", m.funcRemoveMemberOrigin)
+	}
+
+	if !m.RemoveMemberMock.invocationsDone() && afterRemoveMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.RemoveMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMemberMock.expectedInvocations), m.RemoveMemberMock.expectedInvocationsOrigin, afterRemoveMemberCounter)
+	}
+}
+
+type mChatRepositoryMockSaveReadCursors struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockSaveReadCursorsExpectation
+	expectations       []*ChatRepositoryMockSaveReadCursorsExpectation
+
+	callArgs []*ChatRepositoryMockSaveReadCursorsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockSaveReadCursorsExpectation specifies expectation struct of the ChatRepository.SaveReadCursors
+type ChatRepositoryMockSaveReadCursorsExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockSaveReadCursorsParams
+	paramPtrs          *ChatRepositoryMockSaveReadCursorsParamPtrs
+	expectationOrigins ChatRepositoryMockSaveReadCursorsExpectationOrigins
+	results            *ChatRepositoryMockSaveReadCursorsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockSaveReadCursorsParams contains parameters of the ChatRepository.SaveReadCursors
+type ChatRepositoryMockSaveReadCursorsParams struct {
+	ctx     context.Context
+	cursors []*model.ReadCursor
+}
+
+// ChatRepositoryMockSaveReadCursorsParamPtrs contains pointers to parameters of the ChatRepository.SaveReadCursors
+type ChatRepositoryMockSaveReadCursorsParamPtrs struct {
+	ctx     *context.Context
+	cursors *[]*model.ReadCursor
+}
+
+// ChatRepositoryMockSaveReadCursorsResults contains results of the ChatRepository.SaveReadCursors
+type ChatRepositoryMockSaveReadCursorsResults struct {
 	err error
 }
 
-// ChatRepositoryMockGetChatUsersOrigins contains origins of expectations of the ChatRepository.GetChatUsers
-type ChatRepositoryMockGetChatUsersExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originChatID string
+// ChatRepositoryMockSaveReadCursorsOrigins contains origins of expectations of the ChatRepository.SaveReadCursors
+type ChatRepositoryMockSaveReadCursorsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originCursors string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1165,347 +6041,348 @@ type ChatRepositoryMockGetChatUsersExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Optional() *mChatRepositoryMockGetChatUsers {
-	mmGetChatUsers.optional = true
-	return mmGetChatUsers
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Optional() *mChatRepositoryMockSaveReadCursors {
+	mmSaveReadCursors.optional = true
+	return mmSaveReadCursors
 }
 
-// Expect sets up expected params for ChatRepository.GetChatUsers
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Expect(ctx context.Context, chatID int64) *mChatRepositoryMockGetChatUsers {
-	if mmGetChatUsers.mock.funcGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Set")
+// Expect sets up expected params for ChatRepository.SaveReadCursors
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Expect(ctx context.Context, cursors []*model.ReadCursor) *mChatRepositoryMockSaveReadCursors {
+	if mmSaveReadCursors.mock.funcSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Set")
 	}
 
-	if mmGetChatUsers.defaultExpectation == nil {
-		mmGetChatUsers.defaultExpectation = &ChatRepositoryMockGetChatUsersExpectation{}
+	if mmSaveReadCursors.defaultExpectation == nil {
+		mmSaveReadCursors.defaultExpectation = &ChatRepositoryMockSaveReadCursorsExpectation{}
 	}
 
-	if mmGetChatUsers.defaultExpectation.paramPtrs != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by ExpectParams functions")
+	if mmSaveReadCursors.defaultExpectation.paramPtrs != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by ExpectParams functions")
 	}
 
-	mmGetChatUsers.defaultExpectation.params = &ChatRepositoryMockGetChatUsersParams{ctx, chatID}
-	mmGetChatUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetChatUsers.expectations {
-		if minimock.Equal(e.params, mmGetChatUsers.defaultExpectation.params) {
-			mmGetChatUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChatUsers.defaultExpectation.params)
+	mmSaveReadCursors.defaultExpectation.params = &ChatRepositoryMockSaveReadCursorsParams{ctx, cursors}
+	mmSaveReadCursors.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveReadCursors.expectations {
+		if minimock.Equal(e.params, mmSaveReadCursors.defaultExpectation.params) {
+			mmSaveReadCursors.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveReadCursors.defaultExpectation.params)
 		}
 	}
 
-	return mmGetChatUsers
+	return mmSaveReadCursors
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.GetChatUsers
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockGetChatUsers {
-	if mmGetChatUsers.mock.funcGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SaveReadCursors
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSaveReadCursors {
+	if mmSaveReadCursors.mock.funcSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Set")
 	}
 
-	if mmGetChatUsers.defaultExpectation == nil {
-		mmGetChatUsers.defaultExpectation = &ChatRepositoryMockGetChatUsersExpectation{}
+	if mmSaveReadCursors.defaultExpectation == nil {
+		mmSaveReadCursors.defaultExpectation = &ChatRepositoryMockSaveReadCursorsExpectation{}
 	}
 
-	if mmGetChatUsers.defaultExpectation.params != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Expect")
+	if mmSaveReadCursors.defaultExpectation.params != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Expect")
 	}
 
-	if mmGetChatUsers.defaultExpectation.paramPtrs == nil {
-		mmGetChatUsers.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatUsersParamPtrs{}
+	if mmSaveReadCursors.defaultExpectation.paramPtrs == nil {
+		mmSaveReadCursors.defaultExpectation.paramPtrs = &ChatRepositoryMockSaveReadCursorsParamPtrs{}
 	}
-	mmGetChatUsers.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetChatUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSaveReadCursors.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveReadCursors.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetChatUsers
+	return mmSaveReadCursors
 }
 
-// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.GetChatUsers
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockGetChatUsers {
-	if mmGetChatUsers.mock.funcGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Set")
+// ExpectCursorsParam2 sets up expected param cursors for ChatRepository.SaveReadCursors
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) ExpectCursorsParam2(cursors []*model.ReadCursor) *mChatRepositoryMockSaveReadCursors {
+	if mmSaveReadCursors.mock.funcSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Set")
 	}
 
-	if mmGetChatUsers.defaultExpectation == nil {
-		mmGetChatUsers.defaultExpectation = &ChatRepositoryMockGetChatUsersExpectation{}
+	if mmSaveReadCursors.defaultExpectation == nil {
+		mmSaveReadCursors.defaultExpectation = &ChatRepositoryMockSaveReadCursorsExpectation{}
 	}
 
-	if mmGetChatUsers.defaultExpectation.params != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Expect")
+	if mmSaveReadCursors.defaultExpectation.params != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Expect")
 	}
 
-	if mmGetChatUsers.defaultExpectation.paramPtrs == nil {
-		mmGetChatUsers.defaultExpectation.paramPtrs = &ChatRepositoryMockGetChatUsersParamPtrs{}
+	if mmSaveReadCursors.defaultExpectation.paramPtrs == nil {
+		mmSaveReadCursors.defaultExpectation.paramPtrs = &ChatRepositoryMockSaveReadCursorsParamPtrs{}
 	}
-	mmGetChatUsers.defaultExpectation.paramPtrs.chatID = &chatID
-	mmGetChatUsers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+	mmSaveReadCursors.defaultExpectation.paramPtrs.cursors = &cursors
+	mmSaveReadCursors.defaultExpectation.expectationOrigins.originCursors = minimock.CallerInfo(1)
 
-	return mmGetChatUsers
+	return mmSaveReadCursors
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.GetChatUsers
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Inspect(f func(ctx context.Context, chatID int64)) *mChatRepositoryMockGetChatUsers {
-	if mmGetChatUsers.mock.inspectFuncGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.GetChatUsers")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SaveReadCursors
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Inspect(f func(ctx context.Context, cursors []*model.ReadCursor)) *mChatRepositoryMockSaveReadCursors {
+	if mmSaveReadCursors.mock.inspectFuncSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SaveReadCursors")
 	}
 
-	mmGetChatUsers.mock.inspectFuncGetChatUsers = f
+	mmSaveReadCursors.mock.inspectFuncSaveReadCursors = f
 
-	return mmGetChatUsers
+	return mmSaveReadCursors
 }
 
-// Return sets up results that will be returned by ChatRepository.GetChatUsers
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Return(sa1 []string, err error) *ChatRepositoryMock {
-	if mmGetChatUsers.mock.funcGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.SaveReadCursors
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Return(err error) *ChatRepositoryMock {
+	if mmSaveReadCursors.mock.funcSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Set")
 	}
 
-	if mmGetChatUsers.defaultExpectation == nil {
-		mmGetChatUsers.defaultExpectation = &ChatRepositoryMockGetChatUsersExpectation{mock: mmGetChatUsers.mock}
+	if mmSaveReadCursors.defaultExpectation == nil {
+		mmSaveReadCursors.defaultExpectation = &ChatRepositoryMockSaveReadCursorsExpectation{mock: mmSaveReadCursors.mock}
 	}
-	mmGetChatUsers.defaultExpectation.results = &ChatRepositoryMockGetChatUsersResults{sa1, err}
-	mmGetChatUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetChatUsers.mock
+	mmSaveReadCursors.defaultExpectation.results = &ChatRepositoryMockSaveReadCursorsResults{err}
+	mmSaveReadCursors.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveReadCursors.mock
 }
 
-// Set uses given function f to mock the ChatRepository.GetChatUsers method
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Set(f func(ctx context.Context, chatID int64) (sa1 []string, err error)) *ChatRepositoryMock {
-	if mmGetChatUsers.defaultExpectation != nil {
-		mmGetChatUsers.mock.t.Fatalf("Default expectation is already set for the ChatRepository.GetChatUsers method")
+// Set uses given function f to mock the ChatRepository.SaveReadCursors method
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Set(f func(ctx context.Context, cursors []*model.ReadCursor) (err error)) *ChatRepositoryMock {
+	if mmSaveReadCursors.defaultExpectation != nil {
+		mmSaveReadCursors.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SaveReadCursors method")
 	}
 
-	if len(mmGetChatUsers.expectations) > 0 {
-		mmGetChatUsers.mock.t.Fatalf("Some expectations are already set for the ChatRepository.GetChatUsers method")
+	if len(mmSaveReadCursors.expectations) > 0 {
+		mmSaveReadCursors.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SaveReadCursors method")
 	}
 
-	mmGetChatUsers.mock.funcGetChatUsers = f
-	mmGetChatUsers.mock.funcGetChatUsersOrigin = minimock.CallerInfo(1)
-	return mmGetChatUsers.mock
+	mmSaveReadCursors.mock.funcSaveReadCursors = f
+	mmSaveReadCursors.mock.funcSaveReadCursorsOrigin = minimock.CallerInfo(1)
+	return mmSaveReadCursors.mock
 }
 
-// When sets expectation for the ChatRepository.GetChatUsers which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.SaveReadCursors which will trigger the result defined by the following
 // Then helper
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) When(ctx context.Context, chatID int64) *ChatRepositoryMockGetChatUsersExpectation {
-	if mmGetChatUsers.mock.funcGetChatUsers != nil {
-		mmGetChatUsers.mock.t.Fatalf("ChatRepositoryMock.GetChatUsers mock is already set by Set")
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) When(ctx context.Context, cursors []*model.ReadCursor) *ChatRepositoryMockSaveReadCursorsExpectation {
+	if mmSaveReadCursors.mock.funcSaveReadCursors != nil {
+		mmSaveReadCursors.mock.t.Fatalf("ChatRepositoryMock.SaveReadCursors mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockGetChatUsersExpectation{
-		mock:               mmGetChatUsers.mock,
-		params:             &ChatRepositoryMockGetChatUsersParams{ctx, chatID},
-		expectationOrigins: ChatRepositoryMockGetChatUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockSaveReadCursorsExpectation{
+		mock:               mmSaveReadCursors.mock,
+		params:             &ChatRepositoryMockSaveReadCursorsParams{ctx, cursors},
+		expectationOrigins: ChatRepositoryMockSaveReadCursorsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetChatUsers.expectations = append(mmGetChatUsers.expectations, expectation)
+	mmSaveReadCursors.expectations = append(mmSaveReadCursors.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.GetChatUsers return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockGetChatUsersExpectation) Then(sa1 []string, err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockGetChatUsersResults{sa1, err}
+// Then sets up ChatRepository.SaveReadCursors return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSaveReadCursorsExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSaveReadCursorsResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.GetChatUsers should be invoked
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Times(n uint64) *mChatRepositoryMockGetChatUsers {
+// Times sets number of times ChatRepository.SaveReadCursors should be invoked
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Times(n uint64) *mChatRepositoryMockSaveReadCursors {
 	if n == 0 {
-		mmGetChatUsers.mock.t.Fatalf("Times of ChatRepositoryMock.GetChatUsers mock can not be zero")
+		mmSaveReadCursors.mock.t.Fatalf("Times of ChatRepositoryMock.SaveReadCursors mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetChatUsers.expectedInvocations, n)
-	mmGetChatUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetChatUsers
+	mm_atomic.StoreUint64(&mmSaveReadCursors.expectedInvocations, n)
+	mmSaveReadCursors.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveReadCursors
 }
 
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) invocationsDone() bool {
-	if len(mmGetChatUsers.expectations) == 0 && mmGetChatUsers.defaultExpectation == nil && mmGetChatUsers.mock.funcGetChatUsers == nil {
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) invocationsDone() bool {
+	if len(mmSaveReadCursors.expectations) == 0 && mmSaveReadCursors.defaultExpectation == nil && mmSaveReadCursors.mock.funcSaveReadCursors == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetChatUsers.mock.afterGetChatUsersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetChatUsers.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveReadCursors.mock.afterSaveReadCursorsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveReadCursors.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetChatUsers implements mm_repository.ChatRepository
-func (mmGetChatUsers *ChatRepositoryMock) GetChatUsers(ctx context.Context, chatID int64) (sa1 []string, err error) {
-	mm_atomic.AddUint64(&mmGetChatUsers.beforeGetChatUsersCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetChatUsers.afterGetChatUsersCounter, 1)
+// SaveReadCursors implements mm_repository.ChatRepository
+func (mmSaveReadCursors *ChatRepositoryMock) SaveReadCursors(ctx context.Context, cursors []*model.ReadCursor) (err error) {
+	mm_atomic.AddUint64(&mmSaveReadCursors.beforeSaveReadCursorsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveReadCursors.afterSaveReadCursorsCounter, 1)
 
-	mmGetChatUsers.t.Helper()
+	mmSaveReadCursors.t.Helper()
 
-	if mmGetChatUsers.inspectFuncGetChatUsers != nil {
-		mmGetChatUsers.inspectFuncGetChatUsers(ctx, chatID)
+	if mmSaveReadCursors.inspectFuncSaveReadCursors != nil {
+		mmSaveReadCursors.inspectFuncSaveReadCursors(ctx, cursors)
 	}
 
-	mm_params := ChatRepositoryMockGetChatUsersParams{ctx, chatID}
+	mm_params := ChatRepositoryMockSaveReadCursorsParams{ctx, cursors}
 
 	// Record call args
-	mmGetChatUsers.GetChatUsersMock.mutex.Lock()
-	mmGetChatUsers.GetChatUsersMock.callArgs = append(mmGetChatUsers.GetChatUsersMock.callArgs, &mm_params)
-	mmGetChatUsers.GetChatUsersMock.mutex.Unlock()
+	mmSaveReadCursors.SaveReadCursorsMock.mutex.Lock()
+	mmSaveReadCursors.SaveReadCursorsMock.callArgs = append(mmSaveReadCursors.SaveReadCursorsMock.callArgs, &mm_params)
+	mmSaveReadCursors.SaveReadCursorsMock.mutex.Unlock()
 
-	for _, e := range mmGetChatUsers.GetChatUsersMock.expectations {
+	for _, e := range mmSaveReadCursors.SaveReadCursorsMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.sa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetChatUsers.GetChatUsersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetChatUsers.GetChatUsersMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetChatUsers.GetChatUsersMock.defaultExpectation.params
-		mm_want_ptrs := mmGetChatUsers.GetChatUsersMock.defaultExpectation.paramPtrs
+	if mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockGetChatUsersParams{ctx, chatID}
+		mm_got := ChatRepositoryMockSaveReadCursorsParams{ctx, cursors}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetChatUsers.t.Errorf("ChatRepositoryMock.GetChatUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetChatUsers.GetChatUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSaveReadCursors.t.Errorf("ChatRepositoryMock.SaveReadCursors got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
-				mmGetChatUsers.t.Errorf("ChatRepositoryMock.GetChatUsers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetChatUsers.GetChatUsersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			if mm_want_ptrs.cursors != nil && !minimock.Equal(*mm_want_ptrs.cursors, mm_got.cursors) {
+				mmSaveReadCursors.t.Errorf("ChatRepositoryMock.SaveReadCursors got unexpected parameter cursors, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.expectationOrigins.originCursors, *mm_want_ptrs.cursors, mm_got.cursors, minimock.Diff(*mm_want_ptrs.cursors, mm_got.cursors))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetChatUsers.t.Errorf("ChatRepositoryMock.GetChatUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetChatUsers.GetChatUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveReadCursors.t.Errorf("ChatRepositoryMock.SaveReadCursors got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetChatUsers.GetChatUsersMock.defaultExpectation.results
+		mm_results := mmSaveReadCursors.SaveReadCursorsMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetChatUsers.t.Fatal("No results are set for the ChatRepositoryMock.GetChatUsers")
+			mmSaveReadCursors.t.Fatal("No results are set for the ChatRepositoryMock.SaveReadCursors")
 		}
-		return (*mm_results).sa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetChatUsers.funcGetChatUsers != nil {
-		return mmGetChatUsers.funcGetChatUsers(ctx, chatID)
+	if mmSaveReadCursors.funcSaveReadCursors != nil {
+		return mmSaveReadCursors.funcSaveReadCursors(ctx, cursors)
 	}
-	mmGetChatUsers.t.Fatalf("Unexpected call to ChatRepositoryMock.GetChatUsers. %v %v", ctx, chatID)
+	mmSaveReadCursors.t.Fatalf("Unexpected call to ChatRepositoryMock.SaveReadCursors. %v %v", ctx, cursors)
 	return
 }
 
-// GetChatUsersAfterCounter returns a count of finished ChatRepositoryMock.GetChatUsers invocations
-func (mmGetChatUsers *ChatRepositoryMock) GetChatUsersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetChatUsers.afterGetChatUsersCounter)
+// SaveReadCursorsAfterCounter returns a count of finished ChatRepositoryMock.SaveReadCursors invocations
+func (mmSaveReadCursors *ChatRepositoryMock) SaveReadCursorsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReadCursors.afterSaveReadCursorsCounter)
 }
 
-// GetChatUsersBeforeCounter returns a count of ChatRepositoryMock.GetChatUsers invocations
-func (mmGetChatUsers *ChatRepositoryMock) GetChatUsersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetChatUsers.beforeGetChatUsersCounter)
+// SaveReadCursorsBeforeCounter returns a count of ChatRepositoryMock.SaveReadCursors invocations
+func (mmSaveReadCursors *ChatRepositoryMock) SaveReadCursorsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveReadCursors.beforeSaveReadCursorsCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.GetChatUsers.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SaveReadCursors.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetChatUsers *mChatRepositoryMockGetChatUsers) Calls() []*ChatRepositoryMockGetChatUsersParams {
-	mmGetChatUsers.mutex.RLock()
+func (mmSaveReadCursors *mChatRepositoryMockSaveReadCursors) Calls() []*ChatRepositoryMockSaveReadCursorsParams {
+	mmSaveReadCursors.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockGetChatUsersParams, len(mmGetChatUsers.callArgs))
-	copy(argCopy, mmGetChatUsers.callArgs)
+	argCopy := make([]*ChatRepositoryMockSaveReadCursorsParams, len(mmSaveReadCursors.callArgs))
+	copy(argCopy, mmSaveReadCursors.callArgs)
 
-	mmGetChatUsers.mutex.RUnlock()
+	mmSaveReadCursors.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetChatUsersDone returns true if the count of the GetChatUsers invocations corresponds
+// MinimockSaveReadCursorsDone returns true if the count of the SaveReadCursors invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockGetChatUsersDone() bool {
-	if m.GetChatUsersMock.optional {
+func (m *ChatRepositoryMock) MinimockSaveReadCursorsDone() bool {
+	if m.SaveReadCursorsMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetChatUsersMock.expectations {
+	for _, e := range m.SaveReadCursorsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetChatUsersMock.invocationsDone()
+	return m.SaveReadCursorsMock.invocationsDone()
 }
 
-// MinimockGetChatUsersInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockGetChatUsersInspect() {
-	for _, e := range m.GetChatUsersMock.expectations {
+// MinimockSaveReadCursorsInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSaveReadCursorsInspect() {
+	for _, e := range m.SaveReadCursorsMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveReadCursors at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetChatUsersCounter := mm_atomic.LoadUint64(&m.afterGetChatUsersCounter)
+	afterSaveReadCursorsCounter := mm_atomic.LoadUint64(&m.afterSaveReadCursorsCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetChatUsersMock.defaultExpectation != nil && afterGetChatUsersCounter < 1 {
-		if m.GetChatUsersMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatUsers at\n%s", m.GetChatUsersMock.defaultExpectation.returnOrigin)
+	if m.SaveReadCursorsMock.defaultExpectation != nil && afterSaveReadCursorsCounter < 1 {
+		if m.SaveReadCursorsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveReadCursors at\n%s", m.SaveReadCursorsMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.GetChatUsers at\n%s with params: %#v", m.GetChatUsersMock.defaultExpectation.expectationOrigins.origin, *m.GetChatUsersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.SaveReadCursors at\n%s with params: %#v", m.SaveReadCursorsMock.defaultExpectation.expectationOrigins.origin, *m.SaveReadCursorsMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetChatUsers != nil && afterGetChatUsersCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.GetChatUsers at\n%s", m.funcGetChatUsersOrigin)
+	if m.funcSaveReadCursors != nil && afterSaveReadCursorsCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SaveReadCursors at\n%s", m.funcSaveReadCursorsOrigin)
 	}
 
-	if !m.GetChatUsersMock.invocationsDone() && afterGetChatUsersCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.GetChatUsers at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetChatUsersMock.expectedInvocations), m.GetChatUsersMock.expectedInvocationsOrigin, afterGetChatUsersCounter)
+	if !m.SaveReadCursorsMock.invocationsDone() && afterSaveReadCursorsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SaveReadCursors at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveReadCursorsMock.expectedInvocations), m.SaveReadCursorsMock.expectedInvocationsOrigin, afterSaveReadCursorsCounter)
 	}
 }
 
-type mChatRepositoryMockSendMessage struct {
+type mChatRepositoryMockSetMemberRole struct {
 	optional           bool
 	mock               *ChatRepositoryMock
-	defaultExpectation *ChatRepositoryMockSendMessageExpectation
-	expectations       []*ChatRepositoryMockSendMessageExpectation
+	defaultExpectation *ChatRepositoryMockSetMemberRoleExpectation
+	expectations       []*ChatRepositoryMockSetMemberRoleExpectation
 
-	callArgs []*ChatRepositoryMockSendMessageParams
+	callArgs []*ChatRepositoryMockSetMemberRoleParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatRepositoryMockSendMessageExpectation specifies expectation struct of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectation struct {
+// ChatRepositoryMockSetMemberRoleExpectation specifies expectation struct of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectation struct {
 	mock               *ChatRepositoryMock
-	params             *ChatRepositoryMockSendMessageParams
-	paramPtrs          *ChatRepositoryMockSendMessageParamPtrs
-	expectationOrigins ChatRepositoryMockSendMessageExpectationOrigins
-	results            *ChatRepositoryMockSendMessageResults
+	params             *ChatRepositoryMockSetMemberRoleParams
+	paramPtrs          *ChatRepositoryMockSetMemberRoleParamPtrs
+	expectationOrigins ChatRepositoryMockSetMemberRoleExpectationOrigins
+	results            *ChatRepositoryMockSetMemberRoleResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatRepositoryMockSendMessageParams contains parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParams struct {
+// ChatRepositoryMockSetMemberRoleParams contains parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParams struct {
 	ctx      context.Context
-	fromUser string
-	text     string
-	ts       time.Time
+	chatID   int64
+	username string
+	role     model.Role
 }
 
-// ChatRepositoryMockSendMessageParamPtrs contains pointers to parameters of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageParamPtrs struct {
+// ChatRepositoryMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleParamPtrs struct {
 	ctx      *context.Context
-	fromUser *string
-	text     *string
-	ts       *time.Time
+	chatID   *int64
+	username *string
+	role     *model.Role
 }
 
-// ChatRepositoryMockSendMessageResults contains results of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageResults struct {
+// ChatRepositoryMockSetMemberRoleResults contains results of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleResults struct {
+	b1  bool
 	err error
 }
 
-// ChatRepositoryMockSendMessageOrigins contains origins of expectations of the ChatRepository.SendMessage
-type ChatRepositoryMockSendMessageExpectationOrigins struct {
+// ChatRepositoryMockSetMemberRoleOrigins contains origins of expectations of the ChatRepository.SetMemberRole
+type ChatRepositoryMockSetMemberRoleExpectationOrigins struct {
 	origin         string
 	originCtx      string
-	originFromUser string
-	originText     string
-	originTs       string
+	originChatID   string
+	originUsername string
+	originRole     string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1513,348 +6390,690 @@ type ChatRepositoryMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatRepositoryMockSendMessage) Optional() *mChatRepositoryMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Optional() *mChatRepositoryMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
 }
 
-// Expect sets up expected params for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Expect(ctx context.Context, fromUser string, text string, ts time.Time) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Expect(ctx context.Context, chatID int64, username string, role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatRepositoryMockSendMessageParams{ctx, fromUser, text, ts}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmSetMemberRole.defaultExpectation.params = &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// ExpectFromUserParam2 sets up expected param fromUser for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectFromUserParam2(fromUser string) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.fromUser = &fromUser
-	mmSendMessage.defaultExpectation.expectationOrigins.originFromUser = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// ExpectTextParam3 sets up expected param text for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectTextParam3(text string) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectUsernameParam3 sets up expected param username for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectUsernameParam3(username string) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.text = &text
-	mmSendMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.username = &username
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// ExpectTsParam4 sets up expected param ts for ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) ExpectTsParam4(ts time.Time) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// ExpectRoleParam4 sets up expected param role for ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) ExpectRoleParam4(role model.Role) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Expect")
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatRepositoryMockSendMessageParamPtrs{}
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatRepositoryMockSetMemberRoleParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ts = &ts
-	mmSendMessage.defaultExpectation.expectationOrigins.originTs = minimock.CallerInfo(1)
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Inspect(f func(ctx context.Context, fromUser string, text string, ts time.Time)) *mChatRepositoryMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SendMessage")
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, username string, role model.Role)) *mChatRepositoryMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.SetMemberRole")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
 
-	return mmSendMessage
+	return mmSetMemberRole
 }
 
-// Return sets up results that will be returned by ChatRepository.SendMessage
-func (mmSendMessage *mChatRepositoryMockSendMessage) Return(err error) *ChatRepositoryMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+// Return sets up results that will be returned by ChatRepository.SetMemberRole
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Return(b1 bool, err error) *ChatRepositoryMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatRepositoryMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
 	}
-	mmSendMessage.defaultExpectation.results = &ChatRepositoryMockSendMessageResults{err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmSetMemberRole.defaultExpectation.results = &ChatRepositoryMockSetMemberRoleResults{b1, err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
 }
 
-// Set uses given function f to mock the ChatRepository.SendMessage method
-func (mmSendMessage *mChatRepositoryMockSendMessage) Set(f func(ctx context.Context, fromUser string, text string, ts time.Time) (err error)) *ChatRepositoryMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SendMessage method")
+// Set uses given function f to mock the ChatRepository.SetMemberRole method
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, username string, role model.Role) (b1 bool, err error)) *ChatRepositoryMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatRepository.SetMemberRole method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SendMessage method")
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatRepository.SetMemberRole method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
 }
 
-// When sets expectation for the ChatRepository.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatRepository.SetMemberRole which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatRepositoryMockSendMessage) When(ctx context.Context, fromUser string, text string, ts time.Time) *ChatRepositoryMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatRepositoryMock.SendMessage mock is already set by Set")
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) When(ctx context.Context, chatID int64, username string, role model.Role) *ChatRepositoryMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatRepositoryMock.SetMemberRole mock is already set by Set")
 	}
 
-	expectation := &ChatRepositoryMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatRepositoryMockSendMessageParams{ctx, fromUser, text, ts},
-		expectationOrigins: ChatRepositoryMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatRepositoryMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role},
+		expectationOrigins: ChatRepositoryMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatRepository.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatRepositoryMockSendMessageExpectation) Then(err error) *ChatRepositoryMock {
-	e.results = &ChatRepositoryMockSendMessageResults{err}
+// Then sets up ChatRepository.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockSetMemberRoleExpectation) Then(b1 bool, err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockSetMemberRoleResults{b1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatRepository.SendMessage should be invoked
-func (mmSendMessage *mChatRepositoryMockSendMessage) Times(n uint64) *mChatRepositoryMockSendMessage {
+// Times sets number of times ChatRepository.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Times(n uint64) *mChatRepositoryMockSetMemberRole {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatRepositoryMock.SendMessage mock can not be zero")
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatRepositoryMock.SetMemberRole mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
 }
 
-func (mmSendMessage *mChatRepositoryMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_repository.ChatRepository
-func (mmSendMessage *ChatRepositoryMock) SendMessage(ctx context.Context, fromUser string, text string, ts time.Time) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// SetMemberRole implements mm_repository.ChatRepository
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRole(ctx context.Context, chatID int64, username string, role model.Role) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmSetMemberRole.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, fromUser, text, ts)
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, username, role)
 	}
 
-	mm_params := ChatRepositoryMockSendMessageParams{ctx, fromUser, text, ts}
+	mm_params := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.b1, e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatRepositoryMockSendMessageParams{ctx, fromUser, text, ts}
+		mm_got := ChatRepositoryMockSetMemberRoleParams{ctx, chatID, username, role}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
 			}
 
-			if mm_want_ptrs.fromUser != nil && !minimock.Equal(*mm_want_ptrs.fromUser, mm_got.fromUser) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter fromUser, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originFromUser, *mm_want_ptrs.fromUser, mm_got.fromUser, minimock.Diff(*mm_want_ptrs.fromUser, mm_got.fromUser))
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
-			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMemberRole.t.Errorf("ChatRepositoryMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMemberRole.t.Fatal("No results are set for the ChatRepositoryMock.SetMemberRole")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, username, role)
+	}
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatRepositoryMock.SetMemberRole. %v %v %v %v", ctx, chatID, username, role)
+	return
+}
+
+// SetMemberRoleAfterCounter returns a count of finished ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
+}
+
+// SetMemberRoleBeforeCounter returns a count of ChatRepositoryMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatRepositoryMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.SetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMemberRole *mChatRepositoryMockSetMemberRole) Calls() []*ChatRepositoryMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatRepositoryMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
+
+	mmSetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatRepositoryMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMemberRoleMock.invocationsDone()
+}
+
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
+	}
+
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
+	}
+}
+
+type mChatRepositoryMockUpdateChat struct {
+	optional           bool
+	mock               *ChatRepositoryMock
+	defaultExpectation *ChatRepositoryMockUpdateChatExpectation
+	expectations       []*ChatRepositoryMockUpdateChatExpectation
+
+	callArgs []*ChatRepositoryMockUpdateChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatRepositoryMockUpdateChatExpectation specifies expectation struct of the ChatRepository.UpdateChat
+type ChatRepositoryMockUpdateChatExpectation struct {
+	mock               *ChatRepositoryMock
+	params             *ChatRepositoryMockUpdateChatParams
+	paramPtrs          *ChatRepositoryMockUpdateChatParamPtrs
+	expectationOrigins ChatRepositoryMockUpdateChatExpectationOrigins
+	results            *ChatRepositoryMockUpdateChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatRepositoryMockUpdateChatParams contains parameters of the ChatRepository.UpdateChat
+type ChatRepositoryMockUpdateChatParams struct {
+	ctx    context.Context
+	update *model.ChatUpdate
+}
+
+// ChatRepositoryMockUpdateChatParamPtrs contains pointers to parameters of the ChatRepository.UpdateChat
+type ChatRepositoryMockUpdateChatParamPtrs struct {
+	ctx    *context.Context
+	update **model.ChatUpdate
+}
+
+// ChatRepositoryMockUpdateChatResults contains results of the ChatRepository.UpdateChat
+type ChatRepositoryMockUpdateChatResults struct {
+	err error
+}
+
+// ChatRepositoryMockUpdateChatOrigins contains origins of expectations of the ChatRepository.UpdateChat
+type ChatRepositoryMockUpdateChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpdate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Optional() *mChatRepositoryMockUpdateChat {
+	mmUpdateChat.optional = true
+	return mmUpdateChat
+}
+
+// Expect sets up expected params for ChatRepository.UpdateChat
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Expect(ctx context.Context, update *model.ChatUpdate) *mChatRepositoryMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Set")
+	}
+
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatRepositoryMockUpdateChatExpectation{}
+	}
+
+	if mmUpdateChat.defaultExpectation.paramPtrs != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by ExpectParams functions")
+	}
+
+	mmUpdateChat.defaultExpectation.params = &ChatRepositoryMockUpdateChatParams{ctx, update}
+	mmUpdateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateChat.expectations {
+		if minimock.Equal(e.params, mmUpdateChat.defaultExpectation.params) {
+			mmUpdateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateChat.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdateChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatRepository.UpdateChat
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) ExpectCtxParam1(ctx context.Context) *mChatRepositoryMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Set")
+	}
+
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatRepositoryMockUpdateChatExpectation{}
+	}
+
+	if mmUpdateChat.defaultExpectation.params != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Expect")
+	}
+
+	if mmUpdateChat.defaultExpectation.paramPtrs == nil {
+		mmUpdateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateChatParamPtrs{}
+	}
+	mmUpdateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdateChat
+}
+
+// ExpectUpdateParam2 sets up expected param update for ChatRepository.UpdateChat
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) ExpectUpdateParam2(update *model.ChatUpdate) *mChatRepositoryMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Set")
+	}
+
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatRepositoryMockUpdateChatExpectation{}
+	}
+
+	if mmUpdateChat.defaultExpectation.params != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Expect")
+	}
+
+	if mmUpdateChat.defaultExpectation.paramPtrs == nil {
+		mmUpdateChat.defaultExpectation.paramPtrs = &ChatRepositoryMockUpdateChatParamPtrs{}
+	}
+	mmUpdateChat.defaultExpectation.paramPtrs.update = &update
+	mmUpdateChat.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatRepository.UpdateChat
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Inspect(f func(ctx context.Context, update *model.ChatUpdate)) *mChatRepositoryMockUpdateChat {
+	if mmUpdateChat.mock.inspectFuncUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("Inspect function is already set for ChatRepositoryMock.UpdateChat")
+	}
+
+	mmUpdateChat.mock.inspectFuncUpdateChat = f
+
+	return mmUpdateChat
+}
+
+// Return sets up results that will be returned by ChatRepository.UpdateChat
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Return(err error) *ChatRepositoryMock {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Set")
+	}
+
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatRepositoryMockUpdateChatExpectation{mock: mmUpdateChat.mock}
+	}
+	mmUpdateChat.defaultExpectation.results = &ChatRepositoryMockUpdateChatResults{err}
+	mmUpdateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat.mock
+}
+
+// Set uses given function f to mock the ChatRepository.UpdateChat method
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Set(f func(ctx context.Context, update *model.ChatUpdate) (err error)) *ChatRepositoryMock {
+	if mmUpdateChat.defaultExpectation != nil {
+		mmUpdateChat.mock.t.Fatalf("Default expectation is already set for the ChatRepository.UpdateChat method")
+	}
+
+	if len(mmUpdateChat.expectations) > 0 {
+		mmUpdateChat.mock.t.Fatalf("Some expectations are already set for the ChatRepository.UpdateChat method")
+	}
+
+	mmUpdateChat.mock.funcUpdateChat = f
+	mmUpdateChat.mock.funcUpdateChatOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat.mock
+}
+
+// When sets expectation for the ChatRepository.UpdateChat which will trigger the result defined by the following
+// Then helper
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) When(ctx context.Context, update *model.ChatUpdate) *ChatRepositoryMockUpdateChatExpectation {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatRepositoryMock.UpdateChat mock is already set by Set")
+	}
+
+	expectation := &ChatRepositoryMockUpdateChatExpectation{
+		mock:               mmUpdateChat.mock,
+		params:             &ChatRepositoryMockUpdateChatParams{ctx, update},
+		expectationOrigins: ChatRepositoryMockUpdateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdateChat.expectations = append(mmUpdateChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatRepository.UpdateChat return parameters for the expectation previously defined by the When method
+func (e *ChatRepositoryMockUpdateChatExpectation) Then(err error) *ChatRepositoryMock {
+	e.results = &ChatRepositoryMockUpdateChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatRepository.UpdateChat should be invoked
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Times(n uint64) *mChatRepositoryMockUpdateChat {
+	if n == 0 {
+		mmUpdateChat.mock.t.Fatalf("Times of ChatRepositoryMock.UpdateChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdateChat.expectedInvocations, n)
+	mmUpdateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat
+}
+
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) invocationsDone() bool {
+	if len(mmUpdateChat.expectations) == 0 && mmUpdateChat.defaultExpectation == nil && mmUpdateChat.mock.funcUpdateChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateChat.mock.afterUpdateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdateChat implements mm_repository.ChatRepository
+func (mmUpdateChat *ChatRepositoryMock) UpdateChat(ctx context.Context, update *model.ChatUpdate) (err error) {
+	mm_atomic.AddUint64(&mmUpdateChat.beforeUpdateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateChat.afterUpdateChatCounter, 1)
+
+	mmUpdateChat.t.Helper()
+
+	if mmUpdateChat.inspectFuncUpdateChat != nil {
+		mmUpdateChat.inspectFuncUpdateChat(ctx, update)
+	}
+
+	mm_params := ChatRepositoryMockUpdateChatParams{ctx, update}
+
+	// Record call args
+	mmUpdateChat.UpdateChatMock.mutex.Lock()
+	mmUpdateChat.UpdateChatMock.callArgs = append(mmUpdateChat.UpdateChatMock.callArgs, &mm_params)
+	mmUpdateChat.UpdateChatMock.mutex.Unlock()
+
+	for _, e := range mmUpdateChat.UpdateChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmUpdateChat.UpdateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateChat.UpdateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateChat.UpdateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateChat.UpdateChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatRepositoryMockUpdateChatParams{ctx, update}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdateChat.t.Errorf("ChatRepositoryMock.UpdateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.ts != nil && !minimock.Equal(*mm_want_ptrs.ts, mm_got.ts) {
-				mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameter ts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originTs, *mm_want_ptrs.ts, mm_got.ts, minimock.Diff(*mm_want_ptrs.ts, mm_got.ts))
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateChat.t.Errorf("ChatRepositoryMock.UpdateChat got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatRepositoryMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateChat.t.Errorf("ChatRepositoryMock.UpdateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmUpdateChat.UpdateChatMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatRepositoryMock.SendMessage")
+			mmUpdateChat.t.Fatal("No results are set for the ChatRepositoryMock.UpdateChat")
 		}
 		return (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, fromUser, text, ts)
+	if mmUpdateChat.funcUpdateChat != nil {
+		return mmUpdateChat.funcUpdateChat(ctx, update)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatRepositoryMock.SendMessage. %v %v %v %v", ctx, fromUser, text, ts)
+	mmUpdateChat.t.Fatalf("Unexpected call to ChatRepositoryMock.UpdateChat. %v %v", ctx, update)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// UpdateChatAfterCounter returns a count of finished ChatRepositoryMock.UpdateChat invocations
+func (mmUpdateChat *ChatRepositoryMock) UpdateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChat.afterUpdateChatCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatRepositoryMock.SendMessage invocations
-func (mmSendMessage *ChatRepositoryMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// UpdateChatBeforeCounter returns a count of ChatRepositoryMock.UpdateChat invocations
+func (mmUpdateChat *ChatRepositoryMock) UpdateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChat.beforeUpdateChatCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatRepositoryMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatRepositoryMock.UpdateChat.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatRepositoryMockSendMessage) Calls() []*ChatRepositoryMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmUpdateChat *mChatRepositoryMockUpdateChat) Calls() []*ChatRepositoryMockUpdateChatParams {
+	mmUpdateChat.mutex.RLock()
 
-	argCopy := make([]*ChatRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatRepositoryMockUpdateChatParams, len(mmUpdateChat.callArgs))
+	copy(argCopy, mmUpdateChat.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmUpdateChat.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockUpdateChatDone returns true if the count of the UpdateChat invocations corresponds
 // the number of defined expectations
-func (m *ChatRepositoryMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatRepositoryMock) MinimockUpdateChatDone() bool {
+	if m.UpdateChatMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.UpdateChatMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.UpdateChatMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockUpdateChatInspect logs each unmet expectation
+func (m *ChatRepositoryMock) MinimockUpdateChatInspect() {
+	for _, e := range m.UpdateChatMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterUpdateChatCounter := mm_atomic.LoadUint64(&m.afterUpdateChatCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.UpdateChatMock.defaultExpectation != nil && afterUpdateChatCounter < 1 {
+		if m.UpdateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChat at\n%s", m.UpdateChatMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChat at\n%s with params: %#v", m.UpdateChatMock.defaultExpectation.expectationOrigins.origin, *m.UpdateChatMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatRepositoryMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcUpdateChat != nil && afterUpdateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatRepositoryMock.UpdateChat at\n%s", m.funcUpdateChatOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatRepositoryMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.UpdateChatMock.invocationsDone() && afterUpdateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatRepositoryMock.UpdateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateChatMock.expectedInvocations), m.UpdateChatMock.expectedInvocationsOrigin, afterUpdateChatCounter)
 	}
 }
 
@@ -1862,15 +7081,43 @@ func (m *ChatRepositoryMock) MinimockSendMessageInspect() {
 func (m *ChatRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAddMembersInspect()
+
 			m.MinimockChatExistsInspect()
 
 			m.MinimockCreateChatInspect()
 
 			m.MinimockDeleteChatInspect()
 
-			m.MinimockGetChatUsersInspect()
+			m.MinimockFilterContactsInspect()
+
+			m.MinimockFindByClientRequestIDInspect()
+
+			m.MinimockFindDirectChatInspect()
+
+			m.MinimockGetChatInspect()
+
+			m.MinimockGetChatMembersInspect()
+
+			m.MinimockGetReadCursorInspect()
+
+			m.MinimockGetReadCursorsInspect()
+
+			m.MinimockIsChatMemberInspect()
+
+			m.MinimockListChatIDsInspect()
+
+			m.MinimockListChatsInspect()
+
+			m.MinimockLockChatInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockSaveReadCursorsInspect()
+
+			m.MinimockSetMemberRoleInspect()
 
-			m.MinimockSendMessageInspect()
+			m.MinimockUpdateChatInspect()
 		}
 	})
 }
@@ -1894,9 +7141,23 @@ func (m *ChatRepositoryMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAddMembersDone() &&
 		m.MinimockChatExistsDone() &&
 		m.MinimockCreateChatDone() &&
 		m.MinimockDeleteChatDone() &&
-		m.MinimockGetChatUsersDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockFilterContactsDone() &&
+		m.MinimockFindByClientRequestIDDone() &&
+		m.MinimockFindDirectChatDone() &&
+		m.MinimockGetChatDone() &&
+		m.MinimockGetChatMembersDone() &&
+		m.MinimockGetReadCursorDone() &&
+		m.MinimockGetReadCursorsDone() &&
+		m.MinimockIsChatMemberDone() &&
+		m.MinimockListChatIDsDone() &&
+		m.MinimockListChatsDone() &&
+		m.MinimockLockChatDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockSaveReadCursorsDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockUpdateChatDone()
 }
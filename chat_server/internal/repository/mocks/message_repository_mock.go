@@ -0,0 +1,9547 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat/chat_server/internal/repository.MessageRepository -o message_repository_mock.go -n MessageRepositoryMock -p mocks
+
+import (
+	"chat/chat_server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// MessageRepositoryMock implements mm_repository.MessageRepository
+type MessageRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddPin          func(ctx context.Context, chatID int64, messageID int64, pinnedBy string) (b1 bool, err error)
+	funcAddPinOrigin    string
+	inspectFuncAddPin   func(ctx context.Context, chatID int64, messageID int64, pinnedBy string)
+	afterAddPinCounter  uint64
+	beforeAddPinCounter uint64
+	AddPinMock          mMessageRepositoryMockAddPin
+
+	funcAddReaction          func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, messageID int64, username string, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mMessageRepositoryMockAddReaction
+
+	funcAddReply          func(ctx context.Context, rootID int64, at time.Time) (err error)
+	funcAddReplyOrigin    string
+	inspectFuncAddReply   func(ctx context.Context, rootID int64, at time.Time)
+	afterAddReplyCounter  uint64
+	beforeAddReplyCounter uint64
+	AddReplyMock          mMessageRepositoryMockAddReply
+
+	funcCountPins          func(ctx context.Context, chatID int64) (i1 int, err error)
+	funcCountPinsOrigin    string
+	inspectFuncCountPins   func(ctx context.Context, chatID int64)
+	afterCountPinsCounter  uint64
+	beforeCountPinsCounter uint64
+	CountPinsMock          mMessageRepositoryMockCountPins
+
+	funcDeleteMessage          func(ctx context.Context, messageID int64) (err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, messageID int64)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mMessageRepositoryMockDeleteMessage
+
+	funcEditMessage          func(ctx context.Context, messageID int64, text string) (mp1 *model.Message, err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, messageID int64, text string)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mMessageRepositoryMockEditMessage
+
+	funcFindByClientMessageID          func(ctx context.Context, chatID int64, from string, clientMessageID string) (mp1 *model.Message, err error)
+	funcFindByClientMessageIDOrigin    string
+	inspectFuncFindByClientMessageID   func(ctx context.Context, chatID int64, from string, clientMessageID string)
+	afterFindByClientMessageIDCounter  uint64
+	beforeFindByClientMessageIDCounter uint64
+	FindByClientMessageIDMock          mMessageRepositoryMockFindByClientMessageID
+
+	funcGetMessage          func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)
+	funcGetMessageOrigin    string
+	inspectFuncGetMessage   func(ctx context.Context, messageID int64)
+	afterGetMessageCounter  uint64
+	beforeGetMessageCounter uint64
+	GetMessageMock          mMessageRepositoryMockGetMessage
+
+	funcListMentionedUsers          func(ctx context.Context, messageIDs []int64) (m1 map[int64][]string, err error)
+	funcListMentionedUsersOrigin    string
+	inspectFuncListMentionedUsers   func(ctx context.Context, messageIDs []int64)
+	afterListMentionedUsersCounter  uint64
+	beforeListMentionedUsersCounter uint64
+	ListMentionedUsersMock          mMessageRepositoryMockListMentionedUsers
+
+	funcListMentions          func(ctx context.Context, query *model.MentionQuery) (mpa1 []*model.Message, err error)
+	funcListMentionsOrigin    string
+	inspectFuncListMentions   func(ctx context.Context, query *model.MentionQuery)
+	afterListMentionsCounter  uint64
+	beforeListMentionsCounter uint64
+	ListMentionsMock          mMessageRepositoryMockListMentions
+
+	funcListMessages          func(ctx context.Context, query *model.MessageListQuery) (mpa1 []*model.Message, err error)
+	funcListMessagesOrigin    string
+	inspectFuncListMessages   func(ctx context.Context, query *model.MessageListQuery)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mMessageRepositoryMockListMessages
+
+	funcListMessagesByIDs          func(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error)
+	funcListMessagesByIDsOrigin    string
+	inspectFuncListMessagesByIDs   func(ctx context.Context, ids []int64)
+	afterListMessagesByIDsCounter  uint64
+	beforeListMessagesByIDsCounter uint64
+	ListMessagesByIDsMock          mMessageRepositoryMockListMessagesByIDs
+
+	funcListMessagesBySeq          func(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int) (mpa1 []*model.Message, err error)
+	funcListMessagesBySeqOrigin    string
+	inspectFuncListMessagesBySeq   func(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int)
+	afterListMessagesBySeqCounter  uint64
+	beforeListMessagesBySeqCounter uint64
+	ListMessagesBySeqMock          mMessageRepositoryMockListMessagesBySeq
+
+	funcListPinned          func(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error)
+	funcListPinnedOrigin    string
+	inspectFuncListPinned   func(ctx context.Context, chatID int64)
+	afterListPinnedCounter  uint64
+	beforeListPinnedCounter uint64
+	ListPinnedMock          mMessageRepositoryMockListPinned
+
+	funcListReactions          func(ctx context.Context, messageIDs []int64, username string) (m1 map[int64][]*model.Reaction, err error)
+	funcListReactionsOrigin    string
+	inspectFuncListReactions   func(ctx context.Context, messageIDs []int64, username string)
+	afterListReactionsCounter  uint64
+	beforeListReactionsCounter uint64
+	ListReactionsMock          mMessageRepositoryMockListReactions
+
+	funcListReplies          func(ctx context.Context, rootID int64, cursor int64, limit int) (mpa1 []*model.Message, err error)
+	funcListRepliesOrigin    string
+	inspectFuncListReplies   func(ctx context.Context, rootID int64, cursor int64, limit int)
+	afterListRepliesCounter  uint64
+	beforeListRepliesCounter uint64
+	ListRepliesMock          mMessageRepositoryMockListReplies
+
+	funcLockExpired          func(ctx context.Context, now time.Time, limit int) (mpa1 []*model.Message, err error)
+	funcLockExpiredOrigin    string
+	inspectFuncLockExpired   func(ctx context.Context, now time.Time, limit int)
+	afterLockExpiredCounter  uint64
+	beforeLockExpiredCounter uint64
+	LockExpiredMock          mMessageRepositoryMockLockExpired
+
+	funcLockMessage          func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)
+	funcLockMessageOrigin    string
+	inspectFuncLockMessage   func(ctx context.Context, messageID int64)
+	afterLockMessageCounter  uint64
+	beforeLockMessageCounter uint64
+	LockMessageMock          mMessageRepositoryMockLockMessage
+
+	funcPurgeMessages          func(ctx context.Context, ids []int64) (err error)
+	funcPurgeMessagesOrigin    string
+	inspectFuncPurgeMessages   func(ctx context.Context, ids []int64)
+	afterPurgeMessagesCounter  uint64
+	beforePurgeMessagesCounter uint64
+	PurgeMessagesMock          mMessageRepositoryMockPurgeMessages
+
+	funcRecountReplies          func(ctx context.Context, rootIDs []int64) (err error)
+	funcRecountRepliesOrigin    string
+	inspectFuncRecountReplies   func(ctx context.Context, rootIDs []int64)
+	afterRecountRepliesCounter  uint64
+	beforeRecountRepliesCounter uint64
+	RecountRepliesMock          mMessageRepositoryMockRecountReplies
+
+	funcRemovePin          func(ctx context.Context, messageID int64) (b1 bool, err error)
+	funcRemovePinOrigin    string
+	inspectFuncRemovePin   func(ctx context.Context, messageID int64)
+	afterRemovePinCounter  uint64
+	beforeRemovePinCounter uint64
+	RemovePinMock          mMessageRepositoryMockRemovePin
+
+	funcRemoveReaction          func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, messageID int64, username string, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mMessageRepositoryMockRemoveReaction
+
+	funcSearchMessages          func(ctx context.Context, query *model.MessageSearchQuery) (spa1 []*model.SearchResult, err error)
+	funcSearchMessagesOrigin    string
+	inspectFuncSearchMessages   func(ctx context.Context, query *model.MessageSearchQuery)
+	afterSearchMessagesCounter  uint64
+	beforeSearchMessagesCounter uint64
+	SearchMessagesMock          mMessageRepositoryMockSearchMessages
+
+	funcSendMessage          func(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error)
+	funcSendMessageOrigin    string
+	inspectFuncSendMessage   func(ctx context.Context, msg *model.Message)
+	afterSendMessageCounter  uint64
+	beforeSendMessageCounter uint64
+	SendMessageMock          mMessageRepositoryMockSendMessage
+
+	funcSetMentions          func(ctx context.Context, messageID int64, chatID int64, usernames []string) (err error)
+	funcSetMentionsOrigin    string
+	inspectFuncSetMentions   func(ctx context.Context, messageID int64, chatID int64, usernames []string)
+	afterSetMentionsCounter  uint64
+	beforeSetMentionsCounter uint64
+	SetMentionsMock          mMessageRepositoryMockSetMentions
+}
+
+// NewMessageRepositoryMock returns a mock for mm_repository.MessageRepository
+func NewMessageRepositoryMock(t minimock.Tester) *MessageRepositoryMock {
+	m := &MessageRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddPinMock = mMessageRepositoryMockAddPin{mock: m}
+	m.AddPinMock.callArgs = []*MessageRepositoryMockAddPinParams{}
+
+	m.AddReactionMock = mMessageRepositoryMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*MessageRepositoryMockAddReactionParams{}
+
+	m.AddReplyMock = mMessageRepositoryMockAddReply{mock: m}
+	m.AddReplyMock.callArgs = []*MessageRepositoryMockAddReplyParams{}
+
+	m.CountPinsMock = mMessageRepositoryMockCountPins{mock: m}
+	m.CountPinsMock.callArgs = []*MessageRepositoryMockCountPinsParams{}
+
+	m.DeleteMessageMock = mMessageRepositoryMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*MessageRepositoryMockDeleteMessageParams{}
+
+	m.EditMessageMock = mMessageRepositoryMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*MessageRepositoryMockEditMessageParams{}
+
+	m.FindByClientMessageIDMock = mMessageRepositoryMockFindByClientMessageID{mock: m}
+	m.FindByClientMessageIDMock.callArgs = []*MessageRepositoryMockFindByClientMessageIDParams{}
+
+	m.GetMessageMock = mMessageRepositoryMockGetMessage{mock: m}
+	m.GetMessageMock.callArgs = []*MessageRepositoryMockGetMessageParams{}
+
+	m.ListMentionedUsersMock = mMessageRepositoryMockListMentionedUsers{mock: m}
+	m.ListMentionedUsersMock.callArgs = []*MessageRepositoryMockListMentionedUsersParams{}
+
+	m.ListMentionsMock = mMessageRepositoryMockListMentions{mock: m}
+	m.ListMentionsMock.callArgs = []*MessageRepositoryMockListMentionsParams{}
+
+	m.ListMessagesMock = mMessageRepositoryMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*MessageRepositoryMockListMessagesParams{}
+
+	m.ListMessagesByIDsMock = mMessageRepositoryMockListMessagesByIDs{mock: m}
+	m.ListMessagesByIDsMock.callArgs = []*MessageRepositoryMockListMessagesByIDsParams{}
+
+	m.ListMessagesBySeqMock = mMessageRepositoryMockListMessagesBySeq{mock: m}
+	m.ListMessagesBySeqMock.callArgs = []*MessageRepositoryMockListMessagesBySeqParams{}
+
+	m.ListPinnedMock = mMessageRepositoryMockListPinned{mock: m}
+	m.ListPinnedMock.callArgs = []*MessageRepositoryMockListPinnedParams{}
+
+	m.ListReactionsMock = mMessageRepositoryMockListReactions{mock: m}
+	m.ListReactionsMock.callArgs = []*MessageRepositoryMockListReactionsParams{}
+
+	m.ListRepliesMock = mMessageRepositoryMockListReplies{mock: m}
+	m.ListRepliesMock.callArgs = []*MessageRepositoryMockListRepliesParams{}
+
+	m.LockExpiredMock = mMessageRepositoryMockLockExpired{mock: m}
+	m.LockExpiredMock.callArgs = []*MessageRepositoryMockLockExpiredParams{}
+
+	m.LockMessageMock = mMessageRepositoryMockLockMessage{mock: m}
+	m.LockMessageMock.callArgs = []*MessageRepositoryMockLockMessageParams{}
+
+	m.PurgeMessagesMock = mMessageRepositoryMockPurgeMessages{mock: m}
+	m.PurgeMessagesMock.callArgs = []*MessageRepositoryMockPurgeMessagesParams{}
+
+	m.RecountRepliesMock = mMessageRepositoryMockRecountReplies{mock: m}
+	m.RecountRepliesMock.callArgs = []*MessageRepositoryMockRecountRepliesParams{}
+
+	m.RemovePinMock = mMessageRepositoryMockRemovePin{mock: m}
+	m.RemovePinMock.callArgs = []*MessageRepositoryMockRemovePinParams{}
+
+	m.RemoveReactionMock = mMessageRepositoryMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*MessageRepositoryMockRemoveReactionParams{}
+
+	m.SearchMessagesMock = mMessageRepositoryMockSearchMessages{mock: m}
+	m.SearchMessagesMock.callArgs = []*MessageRepositoryMockSearchMessagesParams{}
+
+	m.SendMessageMock = mMessageRepositoryMockSendMessage{mock: m}
+	m.SendMessageMock.callArgs = []*MessageRepositoryMockSendMessageParams{}
+
+	m.SetMentionsMock = mMessageRepositoryMockSetMentions{mock: m}
+	m.SetMentionsMock.callArgs = []*MessageRepositoryMockSetMentionsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mMessageRepositoryMockAddPin struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAddPinExpectation
+	expectations       []*MessageRepositoryMockAddPinExpectation
+
+	callArgs []*MessageRepositoryMockAddPinParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAddPinExpectation specifies expectation struct of the MessageRepository.AddPin
+type MessageRepositoryMockAddPinExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAddPinParams
+	paramPtrs          *MessageRepositoryMockAddPinParamPtrs
+	expectationOrigins MessageRepositoryMockAddPinExpectationOrigins
+	results            *MessageRepositoryMockAddPinResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAddPinParams contains parameters of the MessageRepository.AddPin
+type MessageRepositoryMockAddPinParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	pinnedBy  string
+}
+
+// MessageRepositoryMockAddPinParamPtrs contains pointers to parameters of the MessageRepository.AddPin
+type MessageRepositoryMockAddPinParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	pinnedBy  *string
+}
+
+// MessageRepositoryMockAddPinResults contains results of the MessageRepository.AddPin
+type MessageRepositoryMockAddPinResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockAddPinOrigins contains origins of expectations of the MessageRepository.AddPin
+type MessageRepositoryMockAddPinExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originPinnedBy  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddPin *mMessageRepositoryMockAddPin) Optional() *mMessageRepositoryMockAddPin {
+	mmAddPin.optional = true
+	return mmAddPin
+}
+
+// Expect sets up expected params for MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) Expect(ctx context.Context, chatID int64, messageID int64, pinnedBy string) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{}
+	}
+
+	if mmAddPin.defaultExpectation.paramPtrs != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by ExpectParams functions")
+	}
+
+	mmAddPin.defaultExpectation.params = &MessageRepositoryMockAddPinParams{ctx, chatID, messageID, pinnedBy}
+	mmAddPin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddPin.expectations {
+		if minimock.Equal(e.params, mmAddPin.defaultExpectation.params) {
+			mmAddPin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddPin.defaultExpectation.params)
+		}
+	}
+
+	return mmAddPin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{}
+	}
+
+	if mmAddPin.defaultExpectation.params != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Expect")
+	}
+
+	if mmAddPin.defaultExpectation.paramPtrs == nil {
+		mmAddPin.defaultExpectation.paramPtrs = &MessageRepositoryMockAddPinParamPtrs{}
+	}
+	mmAddPin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddPin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddPin
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{}
+	}
+
+	if mmAddPin.defaultExpectation.params != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Expect")
+	}
+
+	if mmAddPin.defaultExpectation.paramPtrs == nil {
+		mmAddPin.defaultExpectation.paramPtrs = &MessageRepositoryMockAddPinParamPtrs{}
+	}
+	mmAddPin.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddPin.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddPin
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) ExpectMessageIDParam3(messageID int64) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{}
+	}
+
+	if mmAddPin.defaultExpectation.params != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Expect")
+	}
+
+	if mmAddPin.defaultExpectation.paramPtrs == nil {
+		mmAddPin.defaultExpectation.paramPtrs = &MessageRepositoryMockAddPinParamPtrs{}
+	}
+	mmAddPin.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddPin.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAddPin
+}
+
+// ExpectPinnedByParam4 sets up expected param pinnedBy for MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) ExpectPinnedByParam4(pinnedBy string) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{}
+	}
+
+	if mmAddPin.defaultExpectation.params != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Expect")
+	}
+
+	if mmAddPin.defaultExpectation.paramPtrs == nil {
+		mmAddPin.defaultExpectation.paramPtrs = &MessageRepositoryMockAddPinParamPtrs{}
+	}
+	mmAddPin.defaultExpectation.paramPtrs.pinnedBy = &pinnedBy
+	mmAddPin.defaultExpectation.expectationOrigins.originPinnedBy = minimock.CallerInfo(1)
+
+	return mmAddPin
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) Inspect(f func(ctx context.Context, chatID int64, messageID int64, pinnedBy string)) *mMessageRepositoryMockAddPin {
+	if mmAddPin.mock.inspectFuncAddPin != nil {
+		mmAddPin.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AddPin")
+	}
+
+	mmAddPin.mock.inspectFuncAddPin = f
+
+	return mmAddPin
+}
+
+// Return sets up results that will be returned by MessageRepository.AddPin
+func (mmAddPin *mMessageRepositoryMockAddPin) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	if mmAddPin.defaultExpectation == nil {
+		mmAddPin.defaultExpectation = &MessageRepositoryMockAddPinExpectation{mock: mmAddPin.mock}
+	}
+	mmAddPin.defaultExpectation.results = &MessageRepositoryMockAddPinResults{b1, err}
+	mmAddPin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddPin.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AddPin method
+func (mmAddPin *mMessageRepositoryMockAddPin) Set(f func(ctx context.Context, chatID int64, messageID int64, pinnedBy string) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmAddPin.defaultExpectation != nil {
+		mmAddPin.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AddPin method")
+	}
+
+	if len(mmAddPin.expectations) > 0 {
+		mmAddPin.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AddPin method")
+	}
+
+	mmAddPin.mock.funcAddPin = f
+	mmAddPin.mock.funcAddPinOrigin = minimock.CallerInfo(1)
+	return mmAddPin.mock
+}
+
+// When sets expectation for the MessageRepository.AddPin which will trigger the result defined by the following
+// Then helper
+func (mmAddPin *mMessageRepositoryMockAddPin) When(ctx context.Context, chatID int64, messageID int64, pinnedBy string) *MessageRepositoryMockAddPinExpectation {
+	if mmAddPin.mock.funcAddPin != nil {
+		mmAddPin.mock.t.Fatalf("MessageRepositoryMock.AddPin mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAddPinExpectation{
+		mock:               mmAddPin.mock,
+		params:             &MessageRepositoryMockAddPinParams{ctx, chatID, messageID, pinnedBy},
+		expectationOrigins: MessageRepositoryMockAddPinExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddPin.expectations = append(mmAddPin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AddPin return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAddPinExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAddPinResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AddPin should be invoked
+func (mmAddPin *mMessageRepositoryMockAddPin) Times(n uint64) *mMessageRepositoryMockAddPin {
+	if n == 0 {
+		mmAddPin.mock.t.Fatalf("Times of MessageRepositoryMock.AddPin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddPin.expectedInvocations, n)
+	mmAddPin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddPin
+}
+
+func (mmAddPin *mMessageRepositoryMockAddPin) invocationsDone() bool {
+	if len(mmAddPin.expectations) == 0 && mmAddPin.defaultExpectation == nil && mmAddPin.mock.funcAddPin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddPin.mock.afterAddPinCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddPin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddPin implements mm_repository.MessageRepository
+func (mmAddPin *MessageRepositoryMock) AddPin(ctx context.Context, chatID int64, messageID int64, pinnedBy string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddPin.beforeAddPinCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddPin.afterAddPinCounter, 1)
+
+	mmAddPin.t.Helper()
+
+	if mmAddPin.inspectFuncAddPin != nil {
+		mmAddPin.inspectFuncAddPin(ctx, chatID, messageID, pinnedBy)
+	}
+
+	mm_params := MessageRepositoryMockAddPinParams{ctx, chatID, messageID, pinnedBy}
+
+	// Record call args
+	mmAddPin.AddPinMock.mutex.Lock()
+	mmAddPin.AddPinMock.callArgs = append(mmAddPin.AddPinMock.callArgs, &mm_params)
+	mmAddPin.AddPinMock.mutex.Unlock()
+
+	for _, e := range mmAddPin.AddPinMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddPin.AddPinMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddPin.AddPinMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddPin.AddPinMock.defaultExpectation.params
+		mm_want_ptrs := mmAddPin.AddPinMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAddPinParams{ctx, chatID, messageID, pinnedBy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddPin.t.Errorf("MessageRepositoryMock.AddPin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPin.AddPinMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddPin.t.Errorf("MessageRepositoryMock.AddPin got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPin.AddPinMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddPin.t.Errorf("MessageRepositoryMock.AddPin got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPin.AddPinMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.pinnedBy != nil && !minimock.Equal(*mm_want_ptrs.pinnedBy, mm_got.pinnedBy) {
+				mmAddPin.t.Errorf("MessageRepositoryMock.AddPin got unexpected parameter pinnedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddPin.AddPinMock.defaultExpectation.expectationOrigins.originPinnedBy, *mm_want_ptrs.pinnedBy, mm_got.pinnedBy, minimock.Diff(*mm_want_ptrs.pinnedBy, mm_got.pinnedBy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddPin.t.Errorf("MessageRepositoryMock.AddPin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddPin.AddPinMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddPin.AddPinMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddPin.t.Fatal("No results are set for the MessageRepositoryMock.AddPin")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddPin.funcAddPin != nil {
+		return mmAddPin.funcAddPin(ctx, chatID, messageID, pinnedBy)
+	}
+	mmAddPin.t.Fatalf("Unexpected call to MessageRepositoryMock.AddPin. %v %v %v %v", ctx, chatID, messageID, pinnedBy)
+	return
+}
+
+// AddPinAfterCounter returns a count of finished MessageRepositoryMock.AddPin invocations
+func (mmAddPin *MessageRepositoryMock) AddPinAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPin.afterAddPinCounter)
+}
+
+// AddPinBeforeCounter returns a count of MessageRepositoryMock.AddPin invocations
+func (mmAddPin *MessageRepositoryMock) AddPinBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddPin.beforeAddPinCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AddPin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddPin *mMessageRepositoryMockAddPin) Calls() []*MessageRepositoryMockAddPinParams {
+	mmAddPin.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAddPinParams, len(mmAddPin.callArgs))
+	copy(argCopy, mmAddPin.callArgs)
+
+	mmAddPin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddPinDone returns true if the count of the AddPin invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAddPinDone() bool {
+	if m.AddPinMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddPinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddPinMock.invocationsDone()
+}
+
+// MinimockAddPinInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAddPinInspect() {
+	for _, e := range m.AddPinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddPin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddPinCounter := mm_atomic.LoadUint64(&m.afterAddPinCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddPinMock.defaultExpectation != nil && afterAddPinCounter < 1 {
+		if m.AddPinMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddPin at\n%s", m.AddPinMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddPin at\n%s with params: %#v", m.AddPinMock.defaultExpectation.expectationOrigins.origin, *m.AddPinMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddPin != nil && afterAddPinCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AddPin at\n%s", m.funcAddPinOrigin)
+	}
+
+	if !m.AddPinMock.invocationsDone() && afterAddPinCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AddPin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddPinMock.expectedInvocations), m.AddPinMock.expectedInvocationsOrigin, afterAddPinCounter)
+	}
+}
+
+type mMessageRepositoryMockAddReaction struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAddReactionExpectation
+	expectations       []*MessageRepositoryMockAddReactionExpectation
+
+	callArgs []*MessageRepositoryMockAddReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAddReactionExpectation specifies expectation struct of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAddReactionParams
+	paramPtrs          *MessageRepositoryMockAddReactionParamPtrs
+	expectationOrigins MessageRepositoryMockAddReactionExpectationOrigins
+	results            *MessageRepositoryMockAddReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAddReactionParams contains parameters of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	username  string
+	emoji     string
+}
+
+// MessageRepositoryMockAddReactionParamPtrs contains pointers to parameters of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	username  *string
+	emoji     *string
+}
+
+// MessageRepositoryMockAddReactionResults contains results of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockAddReactionOrigins contains origins of expectations of the MessageRepository.AddReaction
+type MessageRepositoryMockAddReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUsername  string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Optional() *mMessageRepositoryMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
+}
+
+// Expect sets up expected params for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Expect(ctx context.Context, messageID int64, username string, emoji string) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &MessageRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectUsernameParam3 sets up expected param username for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectUsernameParam3(username string) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.username = &username
+	mmAddReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) ExpectEmojiParam4(emoji string) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Inspect(f func(ctx context.Context, messageID int64, username string, emoji string)) *mMessageRepositoryMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by MessageRepository.AddReaction
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &MessageRepositoryMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &MessageRepositoryMockAddReactionResults{b1, err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AddReaction method
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Set(f func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the MessageRepository.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mMessageRepositoryMockAddReaction) When(ctx context.Context, messageID int64, username string, emoji string) *MessageRepositoryMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("MessageRepositoryMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &MessageRepositoryMockAddReactionParams{ctx, messageID, username, emoji},
+		expectationOrigins: MessageRepositoryMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AddReaction return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAddReactionExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAddReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AddReaction should be invoked
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Times(n uint64) *mMessageRepositoryMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of MessageRepositoryMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mMessageRepositoryMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements mm_repository.MessageRepository
+func (mmAddReaction *MessageRepositoryMock) AddReaction(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, messageID, username, emoji)
+	}
+
+	mm_params := MessageRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAddReactionParams{ctx, messageID, username, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("MessageRepositoryMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the MessageRepositoryMock.AddReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, messageID, username, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to MessageRepositoryMock.AddReaction. %v %v %v %v", ctx, messageID, username, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished MessageRepositoryMock.AddReaction invocations
+func (mmAddReaction *MessageRepositoryMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of MessageRepositoryMock.AddReaction invocations
+func (mmAddReaction *MessageRepositoryMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mMessageRepositoryMockAddReaction) Calls() []*MessageRepositoryMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mMessageRepositoryMockAddReply struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockAddReplyExpectation
+	expectations       []*MessageRepositoryMockAddReplyExpectation
+
+	callArgs []*MessageRepositoryMockAddReplyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockAddReplyExpectation specifies expectation struct of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockAddReplyParams
+	paramPtrs          *MessageRepositoryMockAddReplyParamPtrs
+	expectationOrigins MessageRepositoryMockAddReplyExpectationOrigins
+	results            *MessageRepositoryMockAddReplyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockAddReplyParams contains parameters of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyParams struct {
+	ctx    context.Context
+	rootID int64
+	at     time.Time
+}
+
+// MessageRepositoryMockAddReplyParamPtrs contains pointers to parameters of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyParamPtrs struct {
+	ctx    *context.Context
+	rootID *int64
+	at     *time.Time
+}
+
+// MessageRepositoryMockAddReplyResults contains results of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyResults struct {
+	err error
+}
+
+// MessageRepositoryMockAddReplyOrigins contains origins of expectations of the MessageRepository.AddReply
+type MessageRepositoryMockAddReplyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRootID string
+	originAt     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReply *mMessageRepositoryMockAddReply) Optional() *mMessageRepositoryMockAddReply {
+	mmAddReply.optional = true
+	return mmAddReply
+}
+
+// Expect sets up expected params for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Expect(ctx context.Context, rootID int64, at time.Time) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by ExpectParams functions")
+	}
+
+	mmAddReply.defaultExpectation.params = &MessageRepositoryMockAddReplyParams{ctx, rootID, at}
+	mmAddReply.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReply.expectations {
+		if minimock.Equal(e.params, mmAddReply.defaultExpectation.params) {
+			mmAddReply.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReply.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReply
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.params != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Expect")
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs == nil {
+		mmAddReply.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReplyParamPtrs{}
+	}
+	mmAddReply.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReply.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReply
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) ExpectRootIDParam2(rootID int64) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.params != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Expect")
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs == nil {
+		mmAddReply.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReplyParamPtrs{}
+	}
+	mmAddReply.defaultExpectation.paramPtrs.rootID = &rootID
+	mmAddReply.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmAddReply
+}
+
+// ExpectAtParam3 sets up expected param at for MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) ExpectAtParam3(at time.Time) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{}
+	}
+
+	if mmAddReply.defaultExpectation.params != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Expect")
+	}
+
+	if mmAddReply.defaultExpectation.paramPtrs == nil {
+		mmAddReply.defaultExpectation.paramPtrs = &MessageRepositoryMockAddReplyParamPtrs{}
+	}
+	mmAddReply.defaultExpectation.paramPtrs.at = &at
+	mmAddReply.defaultExpectation.expectationOrigins.originAt = minimock.CallerInfo(1)
+
+	return mmAddReply
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Inspect(f func(ctx context.Context, rootID int64, at time.Time)) *mMessageRepositoryMockAddReply {
+	if mmAddReply.mock.inspectFuncAddReply != nil {
+		mmAddReply.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.AddReply")
+	}
+
+	mmAddReply.mock.inspectFuncAddReply = f
+
+	return mmAddReply
+}
+
+// Return sets up results that will be returned by MessageRepository.AddReply
+func (mmAddReply *mMessageRepositoryMockAddReply) Return(err error) *MessageRepositoryMock {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	if mmAddReply.defaultExpectation == nil {
+		mmAddReply.defaultExpectation = &MessageRepositoryMockAddReplyExpectation{mock: mmAddReply.mock}
+	}
+	mmAddReply.defaultExpectation.results = &MessageRepositoryMockAddReplyResults{err}
+	mmAddReply.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReply.mock
+}
+
+// Set uses given function f to mock the MessageRepository.AddReply method
+func (mmAddReply *mMessageRepositoryMockAddReply) Set(f func(ctx context.Context, rootID int64, at time.Time) (err error)) *MessageRepositoryMock {
+	if mmAddReply.defaultExpectation != nil {
+		mmAddReply.mock.t.Fatalf("Default expectation is already set for the MessageRepository.AddReply method")
+	}
+
+	if len(mmAddReply.expectations) > 0 {
+		mmAddReply.mock.t.Fatalf("Some expectations are already set for the MessageRepository.AddReply method")
+	}
+
+	mmAddReply.mock.funcAddReply = f
+	mmAddReply.mock.funcAddReplyOrigin = minimock.CallerInfo(1)
+	return mmAddReply.mock
+}
+
+// When sets expectation for the MessageRepository.AddReply which will trigger the result defined by the following
+// Then helper
+func (mmAddReply *mMessageRepositoryMockAddReply) When(ctx context.Context, rootID int64, at time.Time) *MessageRepositoryMockAddReplyExpectation {
+	if mmAddReply.mock.funcAddReply != nil {
+		mmAddReply.mock.t.Fatalf("MessageRepositoryMock.AddReply mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockAddReplyExpectation{
+		mock:               mmAddReply.mock,
+		params:             &MessageRepositoryMockAddReplyParams{ctx, rootID, at},
+		expectationOrigins: MessageRepositoryMockAddReplyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReply.expectations = append(mmAddReply.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.AddReply return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockAddReplyExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockAddReplyResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.AddReply should be invoked
+func (mmAddReply *mMessageRepositoryMockAddReply) Times(n uint64) *mMessageRepositoryMockAddReply {
+	if n == 0 {
+		mmAddReply.mock.t.Fatalf("Times of MessageRepositoryMock.AddReply mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReply.expectedInvocations, n)
+	mmAddReply.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReply
+}
+
+func (mmAddReply *mMessageRepositoryMockAddReply) invocationsDone() bool {
+	if len(mmAddReply.expectations) == 0 && mmAddReply.defaultExpectation == nil && mmAddReply.mock.funcAddReply == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReply.mock.afterAddReplyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReply.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReply implements mm_repository.MessageRepository
+func (mmAddReply *MessageRepositoryMock) AddReply(ctx context.Context, rootID int64, at time.Time) (err error) {
+	mm_atomic.AddUint64(&mmAddReply.beforeAddReplyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReply.afterAddReplyCounter, 1)
+
+	mmAddReply.t.Helper()
+
+	if mmAddReply.inspectFuncAddReply != nil {
+		mmAddReply.inspectFuncAddReply(ctx, rootID, at)
+	}
+
+	mm_params := MessageRepositoryMockAddReplyParams{ctx, rootID, at}
+
+	// Record call args
+	mmAddReply.AddReplyMock.mutex.Lock()
+	mmAddReply.AddReplyMock.callArgs = append(mmAddReply.AddReplyMock.callArgs, &mm_params)
+	mmAddReply.AddReplyMock.mutex.Unlock()
+
+	for _, e := range mmAddReply.AddReplyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddReply.AddReplyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReply.AddReplyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReply.AddReplyMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReply.AddReplyMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockAddReplyParams{ctx, rootID, at}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+			if mm_want_ptrs.at != nil && !minimock.Equal(*mm_want_ptrs.at, mm_got.at) {
+				mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameter at, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.originAt, *mm_want_ptrs.at, mm_got.at, minimock.Diff(*mm_want_ptrs.at, mm_got.at))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReply.t.Errorf("MessageRepositoryMock.AddReply got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReply.AddReplyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReply.AddReplyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReply.t.Fatal("No results are set for the MessageRepositoryMock.AddReply")
+		}
+		return (*mm_results).err
+	}
+	if mmAddReply.funcAddReply != nil {
+		return mmAddReply.funcAddReply(ctx, rootID, at)
+	}
+	mmAddReply.t.Fatalf("Unexpected call to MessageRepositoryMock.AddReply. %v %v %v", ctx, rootID, at)
+	return
+}
+
+// AddReplyAfterCounter returns a count of finished MessageRepositoryMock.AddReply invocations
+func (mmAddReply *MessageRepositoryMock) AddReplyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReply.afterAddReplyCounter)
+}
+
+// AddReplyBeforeCounter returns a count of MessageRepositoryMock.AddReply invocations
+func (mmAddReply *MessageRepositoryMock) AddReplyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReply.beforeAddReplyCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.AddReply.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReply *mMessageRepositoryMockAddReply) Calls() []*MessageRepositoryMockAddReplyParams {
+	mmAddReply.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockAddReplyParams, len(mmAddReply.callArgs))
+	copy(argCopy, mmAddReply.callArgs)
+
+	mmAddReply.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReplyDone returns true if the count of the AddReply invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockAddReplyDone() bool {
+	if m.AddReplyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReplyMock.invocationsDone()
+}
+
+// MinimockAddReplyInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockAddReplyInspect() {
+	for _, e := range m.AddReplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReplyCounter := mm_atomic.LoadUint64(&m.afterAddReplyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReplyMock.defaultExpectation != nil && afterAddReplyCounter < 1 {
+		if m.AddReplyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s", m.AddReplyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s with params: %#v", m.AddReplyMock.defaultExpectation.expectationOrigins.origin, *m.AddReplyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReply != nil && afterAddReplyCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.AddReply at\n%s", m.funcAddReplyOrigin)
+	}
+
+	if !m.AddReplyMock.invocationsDone() && afterAddReplyCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.AddReply at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReplyMock.expectedInvocations), m.AddReplyMock.expectedInvocationsOrigin, afterAddReplyCounter)
+	}
+}
+
+type mMessageRepositoryMockCountPins struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockCountPinsExpectation
+	expectations       []*MessageRepositoryMockCountPinsExpectation
+
+	callArgs []*MessageRepositoryMockCountPinsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockCountPinsExpectation specifies expectation struct of the MessageRepository.CountPins
+type MessageRepositoryMockCountPinsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockCountPinsParams
+	paramPtrs          *MessageRepositoryMockCountPinsParamPtrs
+	expectationOrigins MessageRepositoryMockCountPinsExpectationOrigins
+	results            *MessageRepositoryMockCountPinsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockCountPinsParams contains parameters of the MessageRepository.CountPins
+type MessageRepositoryMockCountPinsParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// MessageRepositoryMockCountPinsParamPtrs contains pointers to parameters of the MessageRepository.CountPins
+type MessageRepositoryMockCountPinsParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// MessageRepositoryMockCountPinsResults contains results of the MessageRepository.CountPins
+type MessageRepositoryMockCountPinsResults struct {
+	i1  int
+	err error
+}
+
+// MessageRepositoryMockCountPinsOrigins contains origins of expectations of the MessageRepository.CountPins
+type MessageRepositoryMockCountPinsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCountPins *mMessageRepositoryMockCountPins) Optional() *mMessageRepositoryMockCountPins {
+	mmCountPins.optional = true
+	return mmCountPins
+}
+
+// Expect sets up expected params for MessageRepository.CountPins
+func (mmCountPins *mMessageRepositoryMockCountPins) Expect(ctx context.Context, chatID int64) *mMessageRepositoryMockCountPins {
+	if mmCountPins.mock.funcCountPins != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Set")
+	}
+
+	if mmCountPins.defaultExpectation == nil {
+		mmCountPins.defaultExpectation = &MessageRepositoryMockCountPinsExpectation{}
+	}
+
+	if mmCountPins.defaultExpectation.paramPtrs != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by ExpectParams functions")
+	}
+
+	mmCountPins.defaultExpectation.params = &MessageRepositoryMockCountPinsParams{ctx, chatID}
+	mmCountPins.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCountPins.expectations {
+		if minimock.Equal(e.params, mmCountPins.defaultExpectation.params) {
+			mmCountPins.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCountPins.defaultExpectation.params)
+		}
+	}
+
+	return mmCountPins
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.CountPins
+func (mmCountPins *mMessageRepositoryMockCountPins) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockCountPins {
+	if mmCountPins.mock.funcCountPins != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Set")
+	}
+
+	if mmCountPins.defaultExpectation == nil {
+		mmCountPins.defaultExpectation = &MessageRepositoryMockCountPinsExpectation{}
+	}
+
+	if mmCountPins.defaultExpectation.params != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Expect")
+	}
+
+	if mmCountPins.defaultExpectation.paramPtrs == nil {
+		mmCountPins.defaultExpectation.paramPtrs = &MessageRepositoryMockCountPinsParamPtrs{}
+	}
+	mmCountPins.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCountPins.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCountPins
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.CountPins
+func (mmCountPins *mMessageRepositoryMockCountPins) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockCountPins {
+	if mmCountPins.mock.funcCountPins != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Set")
+	}
+
+	if mmCountPins.defaultExpectation == nil {
+		mmCountPins.defaultExpectation = &MessageRepositoryMockCountPinsExpectation{}
+	}
+
+	if mmCountPins.defaultExpectation.params != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Expect")
+	}
+
+	if mmCountPins.defaultExpectation.paramPtrs == nil {
+		mmCountPins.defaultExpectation.paramPtrs = &MessageRepositoryMockCountPinsParamPtrs{}
+	}
+	mmCountPins.defaultExpectation.paramPtrs.chatID = &chatID
+	mmCountPins.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmCountPins
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.CountPins
+func (mmCountPins *mMessageRepositoryMockCountPins) Inspect(f func(ctx context.Context, chatID int64)) *mMessageRepositoryMockCountPins {
+	if mmCountPins.mock.inspectFuncCountPins != nil {
+		mmCountPins.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.CountPins")
+	}
+
+	mmCountPins.mock.inspectFuncCountPins = f
+
+	return mmCountPins
+}
+
+// Return sets up results that will be returned by MessageRepository.CountPins
+func (mmCountPins *mMessageRepositoryMockCountPins) Return(i1 int, err error) *MessageRepositoryMock {
+	if mmCountPins.mock.funcCountPins != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Set")
+	}
+
+	if mmCountPins.defaultExpectation == nil {
+		mmCountPins.defaultExpectation = &MessageRepositoryMockCountPinsExpectation{mock: mmCountPins.mock}
+	}
+	mmCountPins.defaultExpectation.results = &MessageRepositoryMockCountPinsResults{i1, err}
+	mmCountPins.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCountPins.mock
+}
+
+// Set uses given function f to mock the MessageRepository.CountPins method
+func (mmCountPins *mMessageRepositoryMockCountPins) Set(f func(ctx context.Context, chatID int64) (i1 int, err error)) *MessageRepositoryMock {
+	if mmCountPins.defaultExpectation != nil {
+		mmCountPins.mock.t.Fatalf("Default expectation is already set for the MessageRepository.CountPins method")
+	}
+
+	if len(mmCountPins.expectations) > 0 {
+		mmCountPins.mock.t.Fatalf("Some expectations are already set for the MessageRepository.CountPins method")
+	}
+
+	mmCountPins.mock.funcCountPins = f
+	mmCountPins.mock.funcCountPinsOrigin = minimock.CallerInfo(1)
+	return mmCountPins.mock
+}
+
+// When sets expectation for the MessageRepository.CountPins which will trigger the result defined by the following
+// Then helper
+func (mmCountPins *mMessageRepositoryMockCountPins) When(ctx context.Context, chatID int64) *MessageRepositoryMockCountPinsExpectation {
+	if mmCountPins.mock.funcCountPins != nil {
+		mmCountPins.mock.t.Fatalf("MessageRepositoryMock.CountPins mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockCountPinsExpectation{
+		mock:               mmCountPins.mock,
+		params:             &MessageRepositoryMockCountPinsParams{ctx, chatID},
+		expectationOrigins: MessageRepositoryMockCountPinsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCountPins.expectations = append(mmCountPins.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.CountPins return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockCountPinsExpectation) Then(i1 int, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockCountPinsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.CountPins should be invoked
+func (mmCountPins *mMessageRepositoryMockCountPins) Times(n uint64) *mMessageRepositoryMockCountPins {
+	if n == 0 {
+		mmCountPins.mock.t.Fatalf("Times of MessageRepositoryMock.CountPins mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCountPins.expectedInvocations, n)
+	mmCountPins.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCountPins
+}
+
+func (mmCountPins *mMessageRepositoryMockCountPins) invocationsDone() bool {
+	if len(mmCountPins.expectations) == 0 && mmCountPins.defaultExpectation == nil && mmCountPins.mock.funcCountPins == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCountPins.mock.afterCountPinsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCountPins.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CountPins implements mm_repository.MessageRepository
+func (mmCountPins *MessageRepositoryMock) CountPins(ctx context.Context, chatID int64) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmCountPins.beforeCountPinsCounter, 1)
+	defer mm_atomic.AddUint64(&mmCountPins.afterCountPinsCounter, 1)
+
+	mmCountPins.t.Helper()
+
+	if mmCountPins.inspectFuncCountPins != nil {
+		mmCountPins.inspectFuncCountPins(ctx, chatID)
+	}
+
+	mm_params := MessageRepositoryMockCountPinsParams{ctx, chatID}
+
+	// Record call args
+	mmCountPins.CountPinsMock.mutex.Lock()
+	mmCountPins.CountPinsMock.callArgs = append(mmCountPins.CountPinsMock.callArgs, &mm_params)
+	mmCountPins.CountPinsMock.mutex.Unlock()
+
+	for _, e := range mmCountPins.CountPinsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCountPins.CountPinsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCountPins.CountPinsMock.defaultExpectation.Counter, 1)
+		mm_want := mmCountPins.CountPinsMock.defaultExpectation.params
+		mm_want_ptrs := mmCountPins.CountPinsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockCountPinsParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCountPins.t.Errorf("MessageRepositoryMock.CountPins got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPins.CountPinsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmCountPins.t.Errorf("MessageRepositoryMock.CountPins got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCountPins.CountPinsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCountPins.t.Errorf("MessageRepositoryMock.CountPins got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCountPins.CountPinsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCountPins.CountPinsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCountPins.t.Fatal("No results are set for the MessageRepositoryMock.CountPins")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCountPins.funcCountPins != nil {
+		return mmCountPins.funcCountPins(ctx, chatID)
+	}
+	mmCountPins.t.Fatalf("Unexpected call to MessageRepositoryMock.CountPins. %v %v", ctx, chatID)
+	return
+}
+
+// CountPinsAfterCounter returns a count of finished MessageRepositoryMock.CountPins invocations
+func (mmCountPins *MessageRepositoryMock) CountPinsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPins.afterCountPinsCounter)
+}
+
+// CountPinsBeforeCounter returns a count of MessageRepositoryMock.CountPins invocations
+func (mmCountPins *MessageRepositoryMock) CountPinsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCountPins.beforeCountPinsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.CountPins.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCountPins *mMessageRepositoryMockCountPins) Calls() []*MessageRepositoryMockCountPinsParams {
+	mmCountPins.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockCountPinsParams, len(mmCountPins.callArgs))
+	copy(argCopy, mmCountPins.callArgs)
+
+	mmCountPins.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCountPinsDone returns true if the count of the CountPins invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockCountPinsDone() bool {
+	if m.CountPinsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CountPinsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CountPinsMock.invocationsDone()
+}
+
+// MinimockCountPinsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockCountPinsInspect() {
+	for _, e := range m.CountPinsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPins at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCountPinsCounter := mm_atomic.LoadUint64(&m.afterCountPinsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CountPinsMock.defaultExpectation != nil && afterCountPinsCounter < 1 {
+		if m.CountPinsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPins at\n%s", m.CountPinsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.CountPins at\n%s with params: %#v", m.CountPinsMock.defaultExpectation.expectationOrigins.origin, *m.CountPinsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCountPins != nil && afterCountPinsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.CountPins at\n%s", m.funcCountPinsOrigin)
+	}
+
+	if !m.CountPinsMock.invocationsDone() && afterCountPinsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.CountPins at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CountPinsMock.expectedInvocations), m.CountPinsMock.expectedInvocationsOrigin, afterCountPinsCounter)
+	}
+}
+
+type mMessageRepositoryMockDeleteMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockDeleteMessageExpectation
+	expectations       []*MessageRepositoryMockDeleteMessageExpectation
+
+	callArgs []*MessageRepositoryMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockDeleteMessageExpectation specifies expectation struct of the MessageRepository.DeleteMessage
+type MessageRepositoryMockDeleteMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockDeleteMessageParams
+	paramPtrs          *MessageRepositoryMockDeleteMessageParamPtrs
+	expectationOrigins MessageRepositoryMockDeleteMessageExpectationOrigins
+	results            *MessageRepositoryMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockDeleteMessageParams contains parameters of the MessageRepository.DeleteMessage
+type MessageRepositoryMockDeleteMessageParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// MessageRepositoryMockDeleteMessageParamPtrs contains pointers to parameters of the MessageRepository.DeleteMessage
+type MessageRepositoryMockDeleteMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// MessageRepositoryMockDeleteMessageResults contains results of the MessageRepository.DeleteMessage
+type MessageRepositoryMockDeleteMessageResults struct {
+	err error
+}
+
+// MessageRepositoryMockDeleteMessageOrigins contains origins of expectations of the MessageRepository.DeleteMessage
+type MessageRepositoryMockDeleteMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Optional() *mMessageRepositoryMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for MessageRepository.DeleteMessage
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Expect(ctx context.Context, messageID int64) *mMessageRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &MessageRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &MessageRepositoryMockDeleteMessageParams{ctx, messageID}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.DeleteMessage
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &MessageRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.DeleteMessage
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &MessageRepositoryMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.DeleteMessage
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Inspect(f func(ctx context.Context, messageID int64)) *mMessageRepositoryMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.DeleteMessage
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Return(err error) *MessageRepositoryMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &MessageRepositoryMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &MessageRepositoryMockDeleteMessageResults{err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.DeleteMessage method
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Set(f func(ctx context.Context, messageID int64) (err error)) *MessageRepositoryMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the MessageRepository.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) When(ctx context.Context, messageID int64) *MessageRepositoryMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("MessageRepositoryMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &MessageRepositoryMockDeleteMessageParams{ctx, messageID},
+		expectationOrigins: MessageRepositoryMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockDeleteMessageExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockDeleteMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.DeleteMessage should be invoked
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Times(n uint64) *mMessageRepositoryMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of MessageRepositoryMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_repository.MessageRepository
+func (mmDeleteMessage *MessageRepositoryMock) DeleteMessage(ctx context.Context, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, messageID)
+	}
+
+	mm_params := MessageRepositoryMockDeleteMessageParams{ctx, messageID}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockDeleteMessageParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("MessageRepositoryMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessage.t.Errorf("MessageRepositoryMock.DeleteMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("MessageRepositoryMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the MessageRepositoryMock.DeleteMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, messageID)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.DeleteMessage. %v %v", ctx, messageID)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished MessageRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *MessageRepositoryMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of MessageRepositoryMock.DeleteMessage invocations
+func (mmDeleteMessage *MessageRepositoryMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mMessageRepositoryMockDeleteMessage) Calls() []*MessageRepositoryMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockEditMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockEditMessageExpectation
+	expectations       []*MessageRepositoryMockEditMessageExpectation
+
+	callArgs []*MessageRepositoryMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockEditMessageExpectation specifies expectation struct of the MessageRepository.EditMessage
+type MessageRepositoryMockEditMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockEditMessageParams
+	paramPtrs          *MessageRepositoryMockEditMessageParamPtrs
+	expectationOrigins MessageRepositoryMockEditMessageExpectationOrigins
+	results            *MessageRepositoryMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockEditMessageParams contains parameters of the MessageRepository.EditMessage
+type MessageRepositoryMockEditMessageParams struct {
+	ctx       context.Context
+	messageID int64
+	text      string
+}
+
+// MessageRepositoryMockEditMessageParamPtrs contains pointers to parameters of the MessageRepository.EditMessage
+type MessageRepositoryMockEditMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	text      *string
+}
+
+// MessageRepositoryMockEditMessageResults contains results of the MessageRepository.EditMessage
+type MessageRepositoryMockEditMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockEditMessageOrigins contains origins of expectations of the MessageRepository.EditMessage
+type MessageRepositoryMockEditMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originText      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Optional() *mMessageRepositoryMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Expect(ctx context.Context, messageID int64, text string) *mMessageRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &MessageRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &MessageRepositoryMockEditMessageParams{ctx, messageID, text}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &MessageRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &MessageRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmEditMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectTextParam3 sets up expected param text for MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) ExpectTextParam3(text string) *mMessageRepositoryMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &MessageRepositoryMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.text = &text
+	mmEditMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Inspect(f func(ctx context.Context, messageID int64, text string)) *mMessageRepositoryMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.EditMessage
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &MessageRepositoryMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &MessageRepositoryMockEditMessageResults{mp1, err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.EditMessage method
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Set(f func(ctx context.Context, messageID int64, text string) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the MessageRepository.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mMessageRepositoryMockEditMessage) When(ctx context.Context, messageID int64, text string) *MessageRepositoryMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("MessageRepositoryMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &MessageRepositoryMockEditMessageParams{ctx, messageID, text},
+		expectationOrigins: MessageRepositoryMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.EditMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockEditMessageExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockEditMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.EditMessage should be invoked
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Times(n uint64) *mMessageRepositoryMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of MessageRepositoryMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mMessageRepositoryMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_repository.MessageRepository
+func (mmEditMessage *MessageRepositoryMock) EditMessage(ctx context.Context, messageID int64, text string) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, messageID, text)
+	}
+
+	mm_params := MessageRepositoryMockEditMessageParams{ctx, messageID, text}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockEditMessageParams{ctx, messageID, text}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("MessageRepositoryMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmEditMessage.t.Errorf("MessageRepositoryMock.EditMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEditMessage.t.Errorf("MessageRepositoryMock.EditMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("MessageRepositoryMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the MessageRepositoryMock.EditMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, messageID, text)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.EditMessage. %v %v %v", ctx, messageID, text)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished MessageRepositoryMock.EditMessage invocations
+func (mmEditMessage *MessageRepositoryMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of MessageRepositoryMock.EditMessage invocations
+func (mmEditMessage *MessageRepositoryMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mMessageRepositoryMockEditMessage) Calls() []*MessageRepositoryMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockFindByClientMessageID struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockFindByClientMessageIDExpectation
+	expectations       []*MessageRepositoryMockFindByClientMessageIDExpectation
+
+	callArgs []*MessageRepositoryMockFindByClientMessageIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockFindByClientMessageIDExpectation specifies expectation struct of the MessageRepository.FindByClientMessageID
+type MessageRepositoryMockFindByClientMessageIDExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockFindByClientMessageIDParams
+	paramPtrs          *MessageRepositoryMockFindByClientMessageIDParamPtrs
+	expectationOrigins MessageRepositoryMockFindByClientMessageIDExpectationOrigins
+	results            *MessageRepositoryMockFindByClientMessageIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockFindByClientMessageIDParams contains parameters of the MessageRepository.FindByClientMessageID
+type MessageRepositoryMockFindByClientMessageIDParams struct {
+	ctx             context.Context
+	chatID          int64
+	from            string
+	clientMessageID string
+}
+
+// MessageRepositoryMockFindByClientMessageIDParamPtrs contains pointers to parameters of the MessageRepository.FindByClientMessageID
+type MessageRepositoryMockFindByClientMessageIDParamPtrs struct {
+	ctx             *context.Context
+	chatID          *int64
+	from            *string
+	clientMessageID *string
+}
+
+// MessageRepositoryMockFindByClientMessageIDResults contains results of the MessageRepository.FindByClientMessageID
+type MessageRepositoryMockFindByClientMessageIDResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockFindByClientMessageIDOrigins contains origins of expectations of the MessageRepository.FindByClientMessageID
+type MessageRepositoryMockFindByClientMessageIDExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originChatID          string
+	originFrom            string
+	originClientMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Optional() *mMessageRepositoryMockFindByClientMessageID {
+	mmFindByClientMessageID.optional = true
+	return mmFindByClientMessageID
+}
+
+// Expect sets up expected params for MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Expect(ctx context.Context, chatID int64, from string, clientMessageID string) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{}
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.paramPtrs != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by ExpectParams functions")
+	}
+
+	mmFindByClientMessageID.defaultExpectation.params = &MessageRepositoryMockFindByClientMessageIDParams{ctx, chatID, from, clientMessageID}
+	mmFindByClientMessageID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmFindByClientMessageID.expectations {
+		if minimock.Equal(e.params, mmFindByClientMessageID.defaultExpectation.params) {
+			mmFindByClientMessageID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmFindByClientMessageID.defaultExpectation.params)
+		}
+	}
+
+	return mmFindByClientMessageID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{}
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.params != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Expect")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientMessageID.defaultExpectation.paramPtrs = &MessageRepositoryMockFindByClientMessageIDParamPtrs{}
+	}
+	mmFindByClientMessageID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmFindByClientMessageID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmFindByClientMessageID
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{}
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.params != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Expect")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientMessageID.defaultExpectation.paramPtrs = &MessageRepositoryMockFindByClientMessageIDParamPtrs{}
+	}
+	mmFindByClientMessageID.defaultExpectation.paramPtrs.chatID = &chatID
+	mmFindByClientMessageID.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmFindByClientMessageID
+}
+
+// ExpectFromParam3 sets up expected param from for MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) ExpectFromParam3(from string) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{}
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.params != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Expect")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientMessageID.defaultExpectation.paramPtrs = &MessageRepositoryMockFindByClientMessageIDParamPtrs{}
+	}
+	mmFindByClientMessageID.defaultExpectation.paramPtrs.from = &from
+	mmFindByClientMessageID.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmFindByClientMessageID
+}
+
+// ExpectClientMessageIDParam4 sets up expected param clientMessageID for MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) ExpectClientMessageIDParam4(clientMessageID string) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{}
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.params != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Expect")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation.paramPtrs == nil {
+		mmFindByClientMessageID.defaultExpectation.paramPtrs = &MessageRepositoryMockFindByClientMessageIDParamPtrs{}
+	}
+	mmFindByClientMessageID.defaultExpectation.paramPtrs.clientMessageID = &clientMessageID
+	mmFindByClientMessageID.defaultExpectation.expectationOrigins.originClientMessageID = minimock.CallerInfo(1)
+
+	return mmFindByClientMessageID
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Inspect(f func(ctx context.Context, chatID int64, from string, clientMessageID string)) *mMessageRepositoryMockFindByClientMessageID {
+	if mmFindByClientMessageID.mock.inspectFuncFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.FindByClientMessageID")
+	}
+
+	mmFindByClientMessageID.mock.inspectFuncFindByClientMessageID = f
+
+	return mmFindByClientMessageID
+}
+
+// Return sets up results that will be returned by MessageRepository.FindByClientMessageID
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	if mmFindByClientMessageID.defaultExpectation == nil {
+		mmFindByClientMessageID.defaultExpectation = &MessageRepositoryMockFindByClientMessageIDExpectation{mock: mmFindByClientMessageID.mock}
+	}
+	mmFindByClientMessageID.defaultExpectation.results = &MessageRepositoryMockFindByClientMessageIDResults{mp1, err}
+	mmFindByClientMessageID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmFindByClientMessageID.mock
+}
+
+// Set uses given function f to mock the MessageRepository.FindByClientMessageID method
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Set(f func(ctx context.Context, chatID int64, from string, clientMessageID string) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmFindByClientMessageID.defaultExpectation != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("Default expectation is already set for the MessageRepository.FindByClientMessageID method")
+	}
+
+	if len(mmFindByClientMessageID.expectations) > 0 {
+		mmFindByClientMessageID.mock.t.Fatalf("Some expectations are already set for the MessageRepository.FindByClientMessageID method")
+	}
+
+	mmFindByClientMessageID.mock.funcFindByClientMessageID = f
+	mmFindByClientMessageID.mock.funcFindByClientMessageIDOrigin = minimock.CallerInfo(1)
+	return mmFindByClientMessageID.mock
+}
+
+// When sets expectation for the MessageRepository.FindByClientMessageID which will trigger the result defined by the following
+// Then helper
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) When(ctx context.Context, chatID int64, from string, clientMessageID string) *MessageRepositoryMockFindByClientMessageIDExpectation {
+	if mmFindByClientMessageID.mock.funcFindByClientMessageID != nil {
+		mmFindByClientMessageID.mock.t.Fatalf("MessageRepositoryMock.FindByClientMessageID mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockFindByClientMessageIDExpectation{
+		mock:               mmFindByClientMessageID.mock,
+		params:             &MessageRepositoryMockFindByClientMessageIDParams{ctx, chatID, from, clientMessageID},
+		expectationOrigins: MessageRepositoryMockFindByClientMessageIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmFindByClientMessageID.expectations = append(mmFindByClientMessageID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.FindByClientMessageID return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockFindByClientMessageIDExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockFindByClientMessageIDResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.FindByClientMessageID should be invoked
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Times(n uint64) *mMessageRepositoryMockFindByClientMessageID {
+	if n == 0 {
+		mmFindByClientMessageID.mock.t.Fatalf("Times of MessageRepositoryMock.FindByClientMessageID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmFindByClientMessageID.expectedInvocations, n)
+	mmFindByClientMessageID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmFindByClientMessageID
+}
+
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) invocationsDone() bool {
+	if len(mmFindByClientMessageID.expectations) == 0 && mmFindByClientMessageID.defaultExpectation == nil && mmFindByClientMessageID.mock.funcFindByClientMessageID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmFindByClientMessageID.mock.afterFindByClientMessageIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmFindByClientMessageID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// FindByClientMessageID implements mm_repository.MessageRepository
+func (mmFindByClientMessageID *MessageRepositoryMock) FindByClientMessageID(ctx context.Context, chatID int64, from string, clientMessageID string) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmFindByClientMessageID.beforeFindByClientMessageIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmFindByClientMessageID.afterFindByClientMessageIDCounter, 1)
+
+	mmFindByClientMessageID.t.Helper()
+
+	if mmFindByClientMessageID.inspectFuncFindByClientMessageID != nil {
+		mmFindByClientMessageID.inspectFuncFindByClientMessageID(ctx, chatID, from, clientMessageID)
+	}
+
+	mm_params := MessageRepositoryMockFindByClientMessageIDParams{ctx, chatID, from, clientMessageID}
+
+	// Record call args
+	mmFindByClientMessageID.FindByClientMessageIDMock.mutex.Lock()
+	mmFindByClientMessageID.FindByClientMessageIDMock.callArgs = append(mmFindByClientMessageID.FindByClientMessageIDMock.callArgs, &mm_params)
+	mmFindByClientMessageID.FindByClientMessageIDMock.mutex.Unlock()
+
+	for _, e := range mmFindByClientMessageID.FindByClientMessageIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.params
+		mm_want_ptrs := mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockFindByClientMessageIDParams{ctx, chatID, from, clientMessageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmFindByClientMessageID.t.Errorf("MessageRepositoryMock.FindByClientMessageID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmFindByClientMessageID.t.Errorf("MessageRepositoryMock.FindByClientMessageID got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmFindByClientMessageID.t.Errorf("MessageRepositoryMock.FindByClientMessageID got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.clientMessageID != nil && !minimock.Equal(*mm_want_ptrs.clientMessageID, mm_got.clientMessageID) {
+				mmFindByClientMessageID.t.Errorf("MessageRepositoryMock.FindByClientMessageID got unexpected parameter clientMessageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.originClientMessageID, *mm_want_ptrs.clientMessageID, mm_got.clientMessageID, minimock.Diff(*mm_want_ptrs.clientMessageID, mm_got.clientMessageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmFindByClientMessageID.t.Errorf("MessageRepositoryMock.FindByClientMessageID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmFindByClientMessageID.FindByClientMessageIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmFindByClientMessageID.t.Fatal("No results are set for the MessageRepositoryMock.FindByClientMessageID")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmFindByClientMessageID.funcFindByClientMessageID != nil {
+		return mmFindByClientMessageID.funcFindByClientMessageID(ctx, chatID, from, clientMessageID)
+	}
+	mmFindByClientMessageID.t.Fatalf("Unexpected call to MessageRepositoryMock.FindByClientMessageID. %v %v %v %v", ctx, chatID, from, clientMessageID)
+	return
+}
+
+// FindByClientMessageIDAfterCounter returns a count of finished MessageRepositoryMock.FindByClientMessageID invocations
+func (mmFindByClientMessageID *MessageRepositoryMock) FindByClientMessageIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByClientMessageID.afterFindByClientMessageIDCounter)
+}
+
+// FindByClientMessageIDBeforeCounter returns a count of MessageRepositoryMock.FindByClientMessageID invocations
+func (mmFindByClientMessageID *MessageRepositoryMock) FindByClientMessageIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmFindByClientMessageID.beforeFindByClientMessageIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.FindByClientMessageID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmFindByClientMessageID *mMessageRepositoryMockFindByClientMessageID) Calls() []*MessageRepositoryMockFindByClientMessageIDParams {
+	mmFindByClientMessageID.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockFindByClientMessageIDParams, len(mmFindByClientMessageID.callArgs))
+	copy(argCopy, mmFindByClientMessageID.callArgs)
+
+	mmFindByClientMessageID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockFindByClientMessageIDDone returns true if the count of the FindByClientMessageID invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockFindByClientMessageIDDone() bool {
+	if m.FindByClientMessageIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.FindByClientMessageIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.FindByClientMessageIDMock.invocationsDone()
+}
+
+// MinimockFindByClientMessageIDInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockFindByClientMessageIDInspect() {
+	for _, e := range m.FindByClientMessageIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.FindByClientMessageID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterFindByClientMessageIDCounter := mm_atomic.LoadUint64(&m.afterFindByClientMessageIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.FindByClientMessageIDMock.defaultExpectation != nil && afterFindByClientMessageIDCounter < 1 {
+		if m.FindByClientMessageIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.FindByClientMessageID at\n%s", m.FindByClientMessageIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.FindByClientMessageID at\n%s with params: %#v", m.FindByClientMessageIDMock.defaultExpectation.expectationOrigins.origin, *m.FindByClientMessageIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcFindByClientMessageID != nil && afterFindByClientMessageIDCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.FindByClientMessageID at\n%s", m.funcFindByClientMessageIDOrigin)
+	}
+
+	if !m.FindByClientMessageIDMock.invocationsDone() && afterFindByClientMessageIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.FindByClientMessageID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.FindByClientMessageIDMock.expectedInvocations), m.FindByClientMessageIDMock.expectedInvocationsOrigin, afterFindByClientMessageIDCounter)
+	}
+}
+
+type mMessageRepositoryMockGetMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockGetMessageExpectation
+	expectations       []*MessageRepositoryMockGetMessageExpectation
+
+	callArgs []*MessageRepositoryMockGetMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockGetMessageExpectation specifies expectation struct of the MessageRepository.GetMessage
+type MessageRepositoryMockGetMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockGetMessageParams
+	paramPtrs          *MessageRepositoryMockGetMessageParamPtrs
+	expectationOrigins MessageRepositoryMockGetMessageExpectationOrigins
+	results            *MessageRepositoryMockGetMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockGetMessageParams contains parameters of the MessageRepository.GetMessage
+type MessageRepositoryMockGetMessageParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// MessageRepositoryMockGetMessageParamPtrs contains pointers to parameters of the MessageRepository.GetMessage
+type MessageRepositoryMockGetMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// MessageRepositoryMockGetMessageResults contains results of the MessageRepository.GetMessage
+type MessageRepositoryMockGetMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockGetMessageOrigins contains origins of expectations of the MessageRepository.GetMessage
+type MessageRepositoryMockGetMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Optional() *mMessageRepositoryMockGetMessage {
+	mmGetMessage.optional = true
+	return mmGetMessage
+}
+
+// Expect sets up expected params for MessageRepository.GetMessage
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Expect(ctx context.Context, messageID int64) *mMessageRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &MessageRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by ExpectParams functions")
+	}
+
+	mmGetMessage.defaultExpectation.params = &MessageRepositoryMockGetMessageParams{ctx, messageID}
+	mmGetMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetMessage.expectations {
+		if minimock.Equal(e.params, mmGetMessage.defaultExpectation.params) {
+			mmGetMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.GetMessage
+func (mmGetMessage *mMessageRepositoryMockGetMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &MessageRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.GetMessage
+func (mmGetMessage *mMessageRepositoryMockGetMessage) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockGetMessage {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &MessageRepositoryMockGetMessageExpectation{}
+	}
+
+	if mmGetMessage.defaultExpectation.params != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Expect")
+	}
+
+	if mmGetMessage.defaultExpectation.paramPtrs == nil {
+		mmGetMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockGetMessageParamPtrs{}
+	}
+	mmGetMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmGetMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmGetMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.GetMessage
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Inspect(f func(ctx context.Context, messageID int64)) *mMessageRepositoryMockGetMessage {
+	if mmGetMessage.mock.inspectFuncGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.GetMessage")
+	}
+
+	mmGetMessage.mock.inspectFuncGetMessage = f
+
+	return mmGetMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.GetMessage
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	if mmGetMessage.defaultExpectation == nil {
+		mmGetMessage.defaultExpectation = &MessageRepositoryMockGetMessageExpectation{mock: mmGetMessage.mock}
+	}
+	mmGetMessage.defaultExpectation.results = &MessageRepositoryMockGetMessageResults{mp1, err}
+	mmGetMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.GetMessage method
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Set(f func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmGetMessage.defaultExpectation != nil {
+		mmGetMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.GetMessage method")
+	}
+
+	if len(mmGetMessage.expectations) > 0 {
+		mmGetMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.GetMessage method")
+	}
+
+	mmGetMessage.mock.funcGetMessage = f
+	mmGetMessage.mock.funcGetMessageOrigin = minimock.CallerInfo(1)
+	return mmGetMessage.mock
+}
+
+// When sets expectation for the MessageRepository.GetMessage which will trigger the result defined by the following
+// Then helper
+func (mmGetMessage *mMessageRepositoryMockGetMessage) When(ctx context.Context, messageID int64) *MessageRepositoryMockGetMessageExpectation {
+	if mmGetMessage.mock.funcGetMessage != nil {
+		mmGetMessage.mock.t.Fatalf("MessageRepositoryMock.GetMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockGetMessageExpectation{
+		mock:               mmGetMessage.mock,
+		params:             &MessageRepositoryMockGetMessageParams{ctx, messageID},
+		expectationOrigins: MessageRepositoryMockGetMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetMessage.expectations = append(mmGetMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.GetMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockGetMessageExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockGetMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.GetMessage should be invoked
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Times(n uint64) *mMessageRepositoryMockGetMessage {
+	if n == 0 {
+		mmGetMessage.mock.t.Fatalf("Times of MessageRepositoryMock.GetMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetMessage.expectedInvocations, n)
+	mmGetMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetMessage
+}
+
+func (mmGetMessage *mMessageRepositoryMockGetMessage) invocationsDone() bool {
+	if len(mmGetMessage.expectations) == 0 && mmGetMessage.defaultExpectation == nil && mmGetMessage.mock.funcGetMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetMessage.mock.afterGetMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetMessage implements mm_repository.MessageRepository
+func (mmGetMessage *MessageRepositoryMock) GetMessage(ctx context.Context, messageID int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmGetMessage.beforeGetMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetMessage.afterGetMessageCounter, 1)
+
+	mmGetMessage.t.Helper()
+
+	if mmGetMessage.inspectFuncGetMessage != nil {
+		mmGetMessage.inspectFuncGetMessage(ctx, messageID)
+	}
+
+	mm_params := MessageRepositoryMockGetMessageParams{ctx, messageID}
+
+	// Record call args
+	mmGetMessage.GetMessageMock.mutex.Lock()
+	mmGetMessage.GetMessageMock.callArgs = append(mmGetMessage.GetMessageMock.callArgs, &mm_params)
+	mmGetMessage.GetMessageMock.mutex.Unlock()
+
+	for _, e := range mmGetMessage.GetMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmGetMessage.GetMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetMessage.GetMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetMessage.GetMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetMessage.GetMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockGetMessageParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetMessage.t.Errorf("MessageRepositoryMock.GetMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmGetMessage.t.Errorf("MessageRepositoryMock.GetMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetMessage.t.Errorf("MessageRepositoryMock.GetMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetMessage.GetMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetMessage.GetMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetMessage.t.Fatal("No results are set for the MessageRepositoryMock.GetMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmGetMessage.funcGetMessage != nil {
+		return mmGetMessage.funcGetMessage(ctx, messageID)
+	}
+	mmGetMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.GetMessage. %v %v", ctx, messageID)
+	return
+}
+
+// GetMessageAfterCounter returns a count of finished MessageRepositoryMock.GetMessage invocations
+func (mmGetMessage *MessageRepositoryMock) GetMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.afterGetMessageCounter)
+}
+
+// GetMessageBeforeCounter returns a count of MessageRepositoryMock.GetMessage invocations
+func (mmGetMessage *MessageRepositoryMock) GetMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetMessage.beforeGetMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.GetMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetMessage *mMessageRepositoryMockGetMessage) Calls() []*MessageRepositoryMockGetMessageParams {
+	mmGetMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockGetMessageParams, len(mmGetMessage.callArgs))
+	copy(argCopy, mmGetMessage.callArgs)
+
+	mmGetMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetMessageDone returns true if the count of the GetMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockGetMessageDone() bool {
+	if m.GetMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetMessageMock.invocationsDone()
+}
+
+// MinimockGetMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockGetMessageInspect() {
+	for _, e := range m.GetMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetMessageCounter := mm_atomic.LoadUint64(&m.afterGetMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetMessageMock.defaultExpectation != nil && afterGetMessageCounter < 1 {
+		if m.GetMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetMessage at\n%s", m.GetMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.GetMessage at\n%s with params: %#v", m.GetMessageMock.defaultExpectation.expectationOrigins.origin, *m.GetMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetMessage != nil && afterGetMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.GetMessage at\n%s", m.funcGetMessageOrigin)
+	}
+
+	if !m.GetMessageMock.invocationsDone() && afterGetMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.GetMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetMessageMock.expectedInvocations), m.GetMessageMock.expectedInvocationsOrigin, afterGetMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockListMentionedUsers struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListMentionedUsersExpectation
+	expectations       []*MessageRepositoryMockListMentionedUsersExpectation
+
+	callArgs []*MessageRepositoryMockListMentionedUsersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListMentionedUsersExpectation specifies expectation struct of the MessageRepository.ListMentionedUsers
+type MessageRepositoryMockListMentionedUsersExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListMentionedUsersParams
+	paramPtrs          *MessageRepositoryMockListMentionedUsersParamPtrs
+	expectationOrigins MessageRepositoryMockListMentionedUsersExpectationOrigins
+	results            *MessageRepositoryMockListMentionedUsersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListMentionedUsersParams contains parameters of the MessageRepository.ListMentionedUsers
+type MessageRepositoryMockListMentionedUsersParams struct {
+	ctx        context.Context
+	messageIDs []int64
+}
+
+// MessageRepositoryMockListMentionedUsersParamPtrs contains pointers to parameters of the MessageRepository.ListMentionedUsers
+type MessageRepositoryMockListMentionedUsersParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+}
+
+// MessageRepositoryMockListMentionedUsersResults contains results of the MessageRepository.ListMentionedUsers
+type MessageRepositoryMockListMentionedUsersResults struct {
+	m1  map[int64][]string
+	err error
+}
+
+// MessageRepositoryMockListMentionedUsersOrigins contains origins of expectations of the MessageRepository.ListMentionedUsers
+type MessageRepositoryMockListMentionedUsersExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Optional() *mMessageRepositoryMockListMentionedUsers {
+	mmListMentionedUsers.optional = true
+	return mmListMentionedUsers
+}
+
+// Expect sets up expected params for MessageRepository.ListMentionedUsers
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Expect(ctx context.Context, messageIDs []int64) *mMessageRepositoryMockListMentionedUsers {
+	if mmListMentionedUsers.mock.funcListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Set")
+	}
+
+	if mmListMentionedUsers.defaultExpectation == nil {
+		mmListMentionedUsers.defaultExpectation = &MessageRepositoryMockListMentionedUsersExpectation{}
+	}
+
+	if mmListMentionedUsers.defaultExpectation.paramPtrs != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by ExpectParams functions")
+	}
+
+	mmListMentionedUsers.defaultExpectation.params = &MessageRepositoryMockListMentionedUsersParams{ctx, messageIDs}
+	mmListMentionedUsers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMentionedUsers.expectations {
+		if minimock.Equal(e.params, mmListMentionedUsers.defaultExpectation.params) {
+			mmListMentionedUsers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentionedUsers.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentionedUsers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListMentionedUsers
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListMentionedUsers {
+	if mmListMentionedUsers.mock.funcListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Set")
+	}
+
+	if mmListMentionedUsers.defaultExpectation == nil {
+		mmListMentionedUsers.defaultExpectation = &MessageRepositoryMockListMentionedUsersExpectation{}
+	}
+
+	if mmListMentionedUsers.defaultExpectation.params != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Expect")
+	}
+
+	if mmListMentionedUsers.defaultExpectation.paramPtrs == nil {
+		mmListMentionedUsers.defaultExpectation.paramPtrs = &MessageRepositoryMockListMentionedUsersParamPtrs{}
+	}
+	mmListMentionedUsers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMentionedUsers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMentionedUsers
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for MessageRepository.ListMentionedUsers
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) ExpectMessageIDsParam2(messageIDs []int64) *mMessageRepositoryMockListMentionedUsers {
+	if mmListMentionedUsers.mock.funcListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Set")
+	}
+
+	if mmListMentionedUsers.defaultExpectation == nil {
+		mmListMentionedUsers.defaultExpectation = &MessageRepositoryMockListMentionedUsersExpectation{}
+	}
+
+	if mmListMentionedUsers.defaultExpectation.params != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Expect")
+	}
+
+	if mmListMentionedUsers.defaultExpectation.paramPtrs == nil {
+		mmListMentionedUsers.defaultExpectation.paramPtrs = &MessageRepositoryMockListMentionedUsersParamPtrs{}
+	}
+	mmListMentionedUsers.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListMentionedUsers.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListMentionedUsers
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListMentionedUsers
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Inspect(f func(ctx context.Context, messageIDs []int64)) *mMessageRepositoryMockListMentionedUsers {
+	if mmListMentionedUsers.mock.inspectFuncListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListMentionedUsers")
+	}
+
+	mmListMentionedUsers.mock.inspectFuncListMentionedUsers = f
+
+	return mmListMentionedUsers
+}
+
+// Return sets up results that will be returned by MessageRepository.ListMentionedUsers
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Return(m1 map[int64][]string, err error) *MessageRepositoryMock {
+	if mmListMentionedUsers.mock.funcListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Set")
+	}
+
+	if mmListMentionedUsers.defaultExpectation == nil {
+		mmListMentionedUsers.defaultExpectation = &MessageRepositoryMockListMentionedUsersExpectation{mock: mmListMentionedUsers.mock}
+	}
+	mmListMentionedUsers.defaultExpectation.results = &MessageRepositoryMockListMentionedUsersResults{m1, err}
+	mmListMentionedUsers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMentionedUsers.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListMentionedUsers method
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Set(f func(ctx context.Context, messageIDs []int64) (m1 map[int64][]string, err error)) *MessageRepositoryMock {
+	if mmListMentionedUsers.defaultExpectation != nil {
+		mmListMentionedUsers.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListMentionedUsers method")
+	}
+
+	if len(mmListMentionedUsers.expectations) > 0 {
+		mmListMentionedUsers.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListMentionedUsers method")
+	}
+
+	mmListMentionedUsers.mock.funcListMentionedUsers = f
+	mmListMentionedUsers.mock.funcListMentionedUsersOrigin = minimock.CallerInfo(1)
+	return mmListMentionedUsers.mock
+}
+
+// When sets expectation for the MessageRepository.ListMentionedUsers which will trigger the result defined by the following
+// Then helper
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) When(ctx context.Context, messageIDs []int64) *MessageRepositoryMockListMentionedUsersExpectation {
+	if mmListMentionedUsers.mock.funcListMentionedUsers != nil {
+		mmListMentionedUsers.mock.t.Fatalf("MessageRepositoryMock.ListMentionedUsers mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListMentionedUsersExpectation{
+		mock:               mmListMentionedUsers.mock,
+		params:             &MessageRepositoryMockListMentionedUsersParams{ctx, messageIDs},
+		expectationOrigins: MessageRepositoryMockListMentionedUsersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMentionedUsers.expectations = append(mmListMentionedUsers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListMentionedUsers return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListMentionedUsersExpectation) Then(m1 map[int64][]string, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListMentionedUsersResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListMentionedUsers should be invoked
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Times(n uint64) *mMessageRepositoryMockListMentionedUsers {
+	if n == 0 {
+		mmListMentionedUsers.mock.t.Fatalf("Times of MessageRepositoryMock.ListMentionedUsers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentionedUsers.expectedInvocations, n)
+	mmListMentionedUsers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMentionedUsers
+}
+
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) invocationsDone() bool {
+	if len(mmListMentionedUsers.expectations) == 0 && mmListMentionedUsers.defaultExpectation == nil && mmListMentionedUsers.mock.funcListMentionedUsers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentionedUsers.mock.afterListMentionedUsersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentionedUsers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentionedUsers implements mm_repository.MessageRepository
+func (mmListMentionedUsers *MessageRepositoryMock) ListMentionedUsers(ctx context.Context, messageIDs []int64) (m1 map[int64][]string, err error) {
+	mm_atomic.AddUint64(&mmListMentionedUsers.beforeListMentionedUsersCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentionedUsers.afterListMentionedUsersCounter, 1)
+
+	mmListMentionedUsers.t.Helper()
+
+	if mmListMentionedUsers.inspectFuncListMentionedUsers != nil {
+		mmListMentionedUsers.inspectFuncListMentionedUsers(ctx, messageIDs)
+	}
+
+	mm_params := MessageRepositoryMockListMentionedUsersParams{ctx, messageIDs}
+
+	// Record call args
+	mmListMentionedUsers.ListMentionedUsersMock.mutex.Lock()
+	mmListMentionedUsers.ListMentionedUsersMock.callArgs = append(mmListMentionedUsers.ListMentionedUsersMock.callArgs, &mm_params)
+	mmListMentionedUsers.ListMentionedUsersMock.mutex.Unlock()
+
+	for _, e := range mmListMentionedUsers.ListMentionedUsersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListMentionedUsersParams{ctx, messageIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentionedUsers.t.Errorf("MessageRepositoryMock.ListMentionedUsers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListMentionedUsers.t.Errorf("MessageRepositoryMock.ListMentionedUsers got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentionedUsers.t.Errorf("MessageRepositoryMock.ListMentionedUsers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentionedUsers.ListMentionedUsersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentionedUsers.t.Fatal("No results are set for the MessageRepositoryMock.ListMentionedUsers")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListMentionedUsers.funcListMentionedUsers != nil {
+		return mmListMentionedUsers.funcListMentionedUsers(ctx, messageIDs)
+	}
+	mmListMentionedUsers.t.Fatalf("Unexpected call to MessageRepositoryMock.ListMentionedUsers. %v %v", ctx, messageIDs)
+	return
+}
+
+// ListMentionedUsersAfterCounter returns a count of finished MessageRepositoryMock.ListMentionedUsers invocations
+func (mmListMentionedUsers *MessageRepositoryMock) ListMentionedUsersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentionedUsers.afterListMentionedUsersCounter)
+}
+
+// ListMentionedUsersBeforeCounter returns a count of MessageRepositoryMock.ListMentionedUsers invocations
+func (mmListMentionedUsers *MessageRepositoryMock) ListMentionedUsersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentionedUsers.beforeListMentionedUsersCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListMentionedUsers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentionedUsers *mMessageRepositoryMockListMentionedUsers) Calls() []*MessageRepositoryMockListMentionedUsersParams {
+	mmListMentionedUsers.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListMentionedUsersParams, len(mmListMentionedUsers.callArgs))
+	copy(argCopy, mmListMentionedUsers.callArgs)
+
+	mmListMentionedUsers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionedUsersDone returns true if the count of the ListMentionedUsers invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListMentionedUsersDone() bool {
+	if m.ListMentionedUsersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionedUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionedUsersMock.invocationsDone()
+}
+
+// MinimockListMentionedUsersInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListMentionedUsersInspect() {
+	for _, e := range m.ListMentionedUsersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentionedUsers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMentionedUsersCounter := mm_atomic.LoadUint64(&m.afterListMentionedUsersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionedUsersMock.defaultExpectation != nil && afterListMentionedUsersCounter < 1 {
+		if m.ListMentionedUsersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentionedUsers at\n%s", m.ListMentionedUsersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentionedUsers at\n%s with params: %#v", m.ListMentionedUsersMock.defaultExpectation.expectationOrigins.origin, *m.ListMentionedUsersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentionedUsers != nil && afterListMentionedUsersCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListMentionedUsers at\n%s", m.funcListMentionedUsersOrigin)
+	}
+
+	if !m.ListMentionedUsersMock.invocationsDone() && afterListMentionedUsersCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListMentionedUsers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionedUsersMock.expectedInvocations), m.ListMentionedUsersMock.expectedInvocationsOrigin, afterListMentionedUsersCounter)
+	}
+}
+
+type mMessageRepositoryMockListMentions struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListMentionsExpectation
+	expectations       []*MessageRepositoryMockListMentionsExpectation
+
+	callArgs []*MessageRepositoryMockListMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListMentionsExpectation specifies expectation struct of the MessageRepository.ListMentions
+type MessageRepositoryMockListMentionsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListMentionsParams
+	paramPtrs          *MessageRepositoryMockListMentionsParamPtrs
+	expectationOrigins MessageRepositoryMockListMentionsExpectationOrigins
+	results            *MessageRepositoryMockListMentionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListMentionsParams contains parameters of the MessageRepository.ListMentions
+type MessageRepositoryMockListMentionsParams struct {
+	ctx   context.Context
+	query *model.MentionQuery
+}
+
+// MessageRepositoryMockListMentionsParamPtrs contains pointers to parameters of the MessageRepository.ListMentions
+type MessageRepositoryMockListMentionsParamPtrs struct {
+	ctx   *context.Context
+	query **model.MentionQuery
+}
+
+// MessageRepositoryMockListMentionsResults contains results of the MessageRepository.ListMentions
+type MessageRepositoryMockListMentionsResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListMentionsOrigins contains origins of expectations of the MessageRepository.ListMentions
+type MessageRepositoryMockListMentionsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentions *mMessageRepositoryMockListMentions) Optional() *mMessageRepositoryMockListMentions {
+	mmListMentions.optional = true
+	return mmListMentions
+}
+
+// Expect sets up expected params for MessageRepository.ListMentions
+func (mmListMentions *mMessageRepositoryMockListMentions) Expect(ctx context.Context, query *model.MentionQuery) *mMessageRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MessageRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by ExpectParams functions")
+	}
+
+	mmListMentions.defaultExpectation.params = &MessageRepositoryMockListMentionsParams{ctx, query}
+	mmListMentions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMentions.expectations {
+		if minimock.Equal(e.params, mmListMentions.defaultExpectation.params) {
+			mmListMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListMentions
+func (mmListMentions *mMessageRepositoryMockListMentions) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MessageRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMentions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMentions
+}
+
+// ExpectQueryParam2 sets up expected param query for MessageRepository.ListMentions
+func (mmListMentions *mMessageRepositoryMockListMentions) ExpectQueryParam2(query *model.MentionQuery) *mMessageRepositoryMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MessageRepositoryMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.query = &query
+	mmListMentions.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListMentions
+func (mmListMentions *mMessageRepositoryMockListMentions) Inspect(f func(ctx context.Context, query *model.MentionQuery)) *mMessageRepositoryMockListMentions {
+	if mmListMentions.mock.inspectFuncListMentions != nil {
+		mmListMentions.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListMentions")
+	}
+
+	mmListMentions.mock.inspectFuncListMentions = f
+
+	return mmListMentions
+}
+
+// Return sets up results that will be returned by MessageRepository.ListMentions
+func (mmListMentions *mMessageRepositoryMockListMentions) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &MessageRepositoryMockListMentionsExpectation{mock: mmListMentions.mock}
+	}
+	mmListMentions.defaultExpectation.results = &MessageRepositoryMockListMentionsResults{mpa1, err}
+	mmListMentions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMentions.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListMentions method
+func (mmListMentions *mMessageRepositoryMockListMentions) Set(f func(ctx context.Context, query *model.MentionQuery) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListMentions.defaultExpectation != nil {
+		mmListMentions.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListMentions method")
+	}
+
+	if len(mmListMentions.expectations) > 0 {
+		mmListMentions.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListMentions method")
+	}
+
+	mmListMentions.mock.funcListMentions = f
+	mmListMentions.mock.funcListMentionsOrigin = minimock.CallerInfo(1)
+	return mmListMentions.mock
+}
+
+// When sets expectation for the MessageRepository.ListMentions which will trigger the result defined by the following
+// Then helper
+func (mmListMentions *mMessageRepositoryMockListMentions) When(ctx context.Context, query *model.MentionQuery) *MessageRepositoryMockListMentionsExpectation {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("MessageRepositoryMock.ListMentions mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListMentionsExpectation{
+		mock:               mmListMentions.mock,
+		params:             &MessageRepositoryMockListMentionsParams{ctx, query},
+		expectationOrigins: MessageRepositoryMockListMentionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMentions.expectations = append(mmListMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListMentions return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListMentionsExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListMentionsResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListMentions should be invoked
+func (mmListMentions *mMessageRepositoryMockListMentions) Times(n uint64) *mMessageRepositoryMockListMentions {
+	if n == 0 {
+		mmListMentions.mock.t.Fatalf("Times of MessageRepositoryMock.ListMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentions.expectedInvocations, n)
+	mmListMentions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMentions
+}
+
+func (mmListMentions *mMessageRepositoryMockListMentions) invocationsDone() bool {
+	if len(mmListMentions.expectations) == 0 && mmListMentions.defaultExpectation == nil && mmListMentions.mock.funcListMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentions.mock.afterListMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentions implements mm_repository.MessageRepository
+func (mmListMentions *MessageRepositoryMock) ListMentions(ctx context.Context, query *model.MentionQuery) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMentions.beforeListMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentions.afterListMentionsCounter, 1)
+
+	mmListMentions.t.Helper()
+
+	if mmListMentions.inspectFuncListMentions != nil {
+		mmListMentions.inspectFuncListMentions(ctx, query)
+	}
+
+	mm_params := MessageRepositoryMockListMentionsParams{ctx, query}
+
+	// Record call args
+	mmListMentions.ListMentionsMock.mutex.Lock()
+	mmListMentions.ListMentionsMock.callArgs = append(mmListMentions.ListMentionsMock.callArgs, &mm_params)
+	mmListMentions.ListMentionsMock.mutex.Unlock()
+
+	for _, e := range mmListMentions.ListMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMentions.ListMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentions.ListMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentions.ListMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentions.ListMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListMentionsParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentions.t.Errorf("MessageRepositoryMock.ListMentions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMentions.t.Errorf("MessageRepositoryMock.ListMentions got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentions.t.Errorf("MessageRepositoryMock.ListMentions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentions.ListMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentions.t.Fatal("No results are set for the MessageRepositoryMock.ListMentions")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMentions.funcListMentions != nil {
+		return mmListMentions.funcListMentions(ctx, query)
+	}
+	mmListMentions.t.Fatalf("Unexpected call to MessageRepositoryMock.ListMentions. %v %v", ctx, query)
+	return
+}
+
+// ListMentionsAfterCounter returns a count of finished MessageRepositoryMock.ListMentions invocations
+func (mmListMentions *MessageRepositoryMock) ListMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.afterListMentionsCounter)
+}
+
+// ListMentionsBeforeCounter returns a count of MessageRepositoryMock.ListMentions invocations
+func (mmListMentions *MessageRepositoryMock) ListMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.beforeListMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentions *mMessageRepositoryMockListMentions) Calls() []*MessageRepositoryMockListMentionsParams {
+	mmListMentions.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListMentionsParams, len(mmListMentions.callArgs))
+	copy(argCopy, mmListMentions.callArgs)
+
+	mmListMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionsDone returns true if the count of the ListMentions invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListMentionsDone() bool {
+	if m.ListMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionsMock.invocationsDone()
+}
+
+// MinimockListMentionsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListMentionsInspect() {
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMentionsCounter := mm_atomic.LoadUint64(&m.afterListMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionsMock.defaultExpectation != nil && afterListMentionsCounter < 1 {
+		if m.ListMentionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentions at\n%s", m.ListMentionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMentions at\n%s with params: %#v", m.ListMentionsMock.defaultExpectation.expectationOrigins.origin, *m.ListMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentions != nil && afterListMentionsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListMentions at\n%s", m.funcListMentionsOrigin)
+	}
+
+	if !m.ListMentionsMock.invocationsDone() && afterListMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListMentions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionsMock.expectedInvocations), m.ListMentionsMock.expectedInvocationsOrigin, afterListMentionsCounter)
+	}
+}
+
+type mMessageRepositoryMockListMessages struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListMessagesExpectation
+	expectations       []*MessageRepositoryMockListMessagesExpectation
+
+	callArgs []*MessageRepositoryMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListMessagesExpectation specifies expectation struct of the MessageRepository.ListMessages
+type MessageRepositoryMockListMessagesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListMessagesParams
+	paramPtrs          *MessageRepositoryMockListMessagesParamPtrs
+	expectationOrigins MessageRepositoryMockListMessagesExpectationOrigins
+	results            *MessageRepositoryMockListMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListMessagesParams contains parameters of the MessageRepository.ListMessages
+type MessageRepositoryMockListMessagesParams struct {
+	ctx   context.Context
+	query *model.MessageListQuery
+}
+
+// MessageRepositoryMockListMessagesParamPtrs contains pointers to parameters of the MessageRepository.ListMessages
+type MessageRepositoryMockListMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.MessageListQuery
+}
+
+// MessageRepositoryMockListMessagesResults contains results of the MessageRepository.ListMessages
+type MessageRepositoryMockListMessagesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListMessagesOrigins contains origins of expectations of the MessageRepository.ListMessages
+type MessageRepositoryMockListMessagesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mMessageRepositoryMockListMessages) Optional() *mMessageRepositoryMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for MessageRepository.ListMessages
+func (mmListMessages *mMessageRepositoryMockListMessages) Expect(ctx context.Context, query *model.MessageListQuery) *mMessageRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &MessageRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &MessageRepositoryMockListMessagesParams{ctx, query}
+	mmListMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListMessages
+func (mmListMessages *mMessageRepositoryMockListMessages) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &MessageRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for MessageRepository.ListMessages
+func (mmListMessages *mMessageRepositoryMockListMessages) ExpectQueryParam2(query *model.MessageListQuery) *mMessageRepositoryMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &MessageRepositoryMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.query = &query
+	mmListMessages.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListMessages
+func (mmListMessages *mMessageRepositoryMockListMessages) Inspect(f func(ctx context.Context, query *model.MessageListQuery)) *mMessageRepositoryMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by MessageRepository.ListMessages
+func (mmListMessages *mMessageRepositoryMockListMessages) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &MessageRepositoryMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &MessageRepositoryMockListMessagesResults{mpa1, err}
+	mmListMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListMessages method
+func (mmListMessages *mMessageRepositoryMockListMessages) Set(f func(ctx context.Context, query *model.MessageListQuery) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	mmListMessages.mock.funcListMessagesOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// When sets expectation for the MessageRepository.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mMessageRepositoryMockListMessages) When(ctx context.Context, query *model.MessageListQuery) *MessageRepositoryMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("MessageRepositoryMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListMessagesExpectation{
+		mock:               mmListMessages.mock,
+		params:             &MessageRepositoryMockListMessagesParams{ctx, query},
+		expectationOrigins: MessageRepositoryMockListMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListMessages return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListMessagesExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListMessagesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListMessages should be invoked
+func (mmListMessages *mMessageRepositoryMockListMessages) Times(n uint64) *mMessageRepositoryMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of MessageRepositoryMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	mmListMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessages
+}
+
+func (mmListMessages *mMessageRepositoryMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements mm_repository.MessageRepository
+func (mmListMessages *MessageRepositoryMock) ListMessages(ctx context.Context, query *model.MessageListQuery) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	mmListMessages.t.Helper()
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, query)
+	}
+
+	mm_params := MessageRepositoryMockListMessagesParams{ctx, query}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("MessageRepositoryMock.ListMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMessages.t.Errorf("MessageRepositoryMock.ListMessages got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("MessageRepositoryMock.ListMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the MessageRepositoryMock.ListMessages")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, query)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to MessageRepositoryMock.ListMessages. %v %v", ctx, query)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished MessageRepositoryMock.ListMessages invocations
+func (mmListMessages *MessageRepositoryMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of MessageRepositoryMock.ListMessages invocations
+func (mmListMessages *MessageRepositoryMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mMessageRepositoryMockListMessages) Calls() []*MessageRepositoryMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessages at\n%s", m.ListMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessages at\n%s with params: %#v", m.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListMessages at\n%s", m.funcListMessagesOrigin)
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), m.ListMessagesMock.expectedInvocationsOrigin, afterListMessagesCounter)
+	}
+}
+
+type mMessageRepositoryMockListMessagesByIDs struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListMessagesByIDsExpectation
+	expectations       []*MessageRepositoryMockListMessagesByIDsExpectation
+
+	callArgs []*MessageRepositoryMockListMessagesByIDsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListMessagesByIDsExpectation specifies expectation struct of the MessageRepository.ListMessagesByIDs
+type MessageRepositoryMockListMessagesByIDsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListMessagesByIDsParams
+	paramPtrs          *MessageRepositoryMockListMessagesByIDsParamPtrs
+	expectationOrigins MessageRepositoryMockListMessagesByIDsExpectationOrigins
+	results            *MessageRepositoryMockListMessagesByIDsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListMessagesByIDsParams contains parameters of the MessageRepository.ListMessagesByIDs
+type MessageRepositoryMockListMessagesByIDsParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// MessageRepositoryMockListMessagesByIDsParamPtrs contains pointers to parameters of the MessageRepository.ListMessagesByIDs
+type MessageRepositoryMockListMessagesByIDsParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// MessageRepositoryMockListMessagesByIDsResults contains results of the MessageRepository.ListMessagesByIDs
+type MessageRepositoryMockListMessagesByIDsResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListMessagesByIDsOrigins contains origins of expectations of the MessageRepository.ListMessagesByIDs
+type MessageRepositoryMockListMessagesByIDsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Optional() *mMessageRepositoryMockListMessagesByIDs {
+	mmListMessagesByIDs.optional = true
+	return mmListMessagesByIDs
+}
+
+// Expect sets up expected params for MessageRepository.ListMessagesByIDs
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Expect(ctx context.Context, ids []int64) *mMessageRepositoryMockListMessagesByIDs {
+	if mmListMessagesByIDs.mock.funcListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Set")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation == nil {
+		mmListMessagesByIDs.defaultExpectation = &MessageRepositoryMockListMessagesByIDsExpectation{}
+	}
+
+	if mmListMessagesByIDs.defaultExpectation.paramPtrs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by ExpectParams functions")
+	}
+
+	mmListMessagesByIDs.defaultExpectation.params = &MessageRepositoryMockListMessagesByIDsParams{ctx, ids}
+	mmListMessagesByIDs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessagesByIDs.expectations {
+		if minimock.Equal(e.params, mmListMessagesByIDs.defaultExpectation.params) {
+			mmListMessagesByIDs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessagesByIDs.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessagesByIDs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListMessagesByIDs
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListMessagesByIDs {
+	if mmListMessagesByIDs.mock.funcListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Set")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation == nil {
+		mmListMessagesByIDs.defaultExpectation = &MessageRepositoryMockListMessagesByIDsExpectation{}
+	}
+
+	if mmListMessagesByIDs.defaultExpectation.params != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Expect")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation.paramPtrs == nil {
+		mmListMessagesByIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesByIDsParamPtrs{}
+	}
+	mmListMessagesByIDs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessagesByIDs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessagesByIDs
+}
+
+// ExpectIdsParam2 sets up expected param ids for MessageRepository.ListMessagesByIDs
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) ExpectIdsParam2(ids []int64) *mMessageRepositoryMockListMessagesByIDs {
+	if mmListMessagesByIDs.mock.funcListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Set")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation == nil {
+		mmListMessagesByIDs.defaultExpectation = &MessageRepositoryMockListMessagesByIDsExpectation{}
+	}
+
+	if mmListMessagesByIDs.defaultExpectation.params != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Expect")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation.paramPtrs == nil {
+		mmListMessagesByIDs.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesByIDsParamPtrs{}
+	}
+	mmListMessagesByIDs.defaultExpectation.paramPtrs.ids = &ids
+	mmListMessagesByIDs.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmListMessagesByIDs
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListMessagesByIDs
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Inspect(f func(ctx context.Context, ids []int64)) *mMessageRepositoryMockListMessagesByIDs {
+	if mmListMessagesByIDs.mock.inspectFuncListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListMessagesByIDs")
+	}
+
+	mmListMessagesByIDs.mock.inspectFuncListMessagesByIDs = f
+
+	return mmListMessagesByIDs
+}
+
+// Return sets up results that will be returned by MessageRepository.ListMessagesByIDs
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListMessagesByIDs.mock.funcListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Set")
+	}
+
+	if mmListMessagesByIDs.defaultExpectation == nil {
+		mmListMessagesByIDs.defaultExpectation = &MessageRepositoryMockListMessagesByIDsExpectation{mock: mmListMessagesByIDs.mock}
+	}
+	mmListMessagesByIDs.defaultExpectation.results = &MessageRepositoryMockListMessagesByIDsResults{mpa1, err}
+	mmListMessagesByIDs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessagesByIDs.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListMessagesByIDs method
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Set(f func(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListMessagesByIDs.defaultExpectation != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListMessagesByIDs method")
+	}
+
+	if len(mmListMessagesByIDs.expectations) > 0 {
+		mmListMessagesByIDs.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListMessagesByIDs method")
+	}
+
+	mmListMessagesByIDs.mock.funcListMessagesByIDs = f
+	mmListMessagesByIDs.mock.funcListMessagesByIDsOrigin = minimock.CallerInfo(1)
+	return mmListMessagesByIDs.mock
+}
+
+// When sets expectation for the MessageRepository.ListMessagesByIDs which will trigger the result defined by the following
+// Then helper
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) When(ctx context.Context, ids []int64) *MessageRepositoryMockListMessagesByIDsExpectation {
+	if mmListMessagesByIDs.mock.funcListMessagesByIDs != nil {
+		mmListMessagesByIDs.mock.t.Fatalf("MessageRepositoryMock.ListMessagesByIDs mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListMessagesByIDsExpectation{
+		mock:               mmListMessagesByIDs.mock,
+		params:             &MessageRepositoryMockListMessagesByIDsParams{ctx, ids},
+		expectationOrigins: MessageRepositoryMockListMessagesByIDsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessagesByIDs.expectations = append(mmListMessagesByIDs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListMessagesByIDs return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListMessagesByIDsExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListMessagesByIDsResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListMessagesByIDs should be invoked
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Times(n uint64) *mMessageRepositoryMockListMessagesByIDs {
+	if n == 0 {
+		mmListMessagesByIDs.mock.t.Fatalf("Times of MessageRepositoryMock.ListMessagesByIDs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessagesByIDs.expectedInvocations, n)
+	mmListMessagesByIDs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessagesByIDs
+}
+
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) invocationsDone() bool {
+	if len(mmListMessagesByIDs.expectations) == 0 && mmListMessagesByIDs.defaultExpectation == nil && mmListMessagesByIDs.mock.funcListMessagesByIDs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessagesByIDs.mock.afterListMessagesByIDsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessagesByIDs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessagesByIDs implements mm_repository.MessageRepository
+func (mmListMessagesByIDs *MessageRepositoryMock) ListMessagesByIDs(ctx context.Context, ids []int64) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessagesByIDs.beforeListMessagesByIDsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessagesByIDs.afterListMessagesByIDsCounter, 1)
+
+	mmListMessagesByIDs.t.Helper()
+
+	if mmListMessagesByIDs.inspectFuncListMessagesByIDs != nil {
+		mmListMessagesByIDs.inspectFuncListMessagesByIDs(ctx, ids)
+	}
+
+	mm_params := MessageRepositoryMockListMessagesByIDsParams{ctx, ids}
+
+	// Record call args
+	mmListMessagesByIDs.ListMessagesByIDsMock.mutex.Lock()
+	mmListMessagesByIDs.ListMessagesByIDsMock.callArgs = append(mmListMessagesByIDs.ListMessagesByIDsMock.callArgs, &mm_params)
+	mmListMessagesByIDs.ListMessagesByIDsMock.mutex.Unlock()
+
+	for _, e := range mmListMessagesByIDs.ListMessagesByIDsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListMessagesByIDsParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessagesByIDs.t.Errorf("MessageRepositoryMock.ListMessagesByIDs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmListMessagesByIDs.t.Errorf("MessageRepositoryMock.ListMessagesByIDs got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessagesByIDs.t.Errorf("MessageRepositoryMock.ListMessagesByIDs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessagesByIDs.ListMessagesByIDsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessagesByIDs.t.Fatal("No results are set for the MessageRepositoryMock.ListMessagesByIDs")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessagesByIDs.funcListMessagesByIDs != nil {
+		return mmListMessagesByIDs.funcListMessagesByIDs(ctx, ids)
+	}
+	mmListMessagesByIDs.t.Fatalf("Unexpected call to MessageRepositoryMock.ListMessagesByIDs. %v %v", ctx, ids)
+	return
+}
+
+// ListMessagesByIDsAfterCounter returns a count of finished MessageRepositoryMock.ListMessagesByIDs invocations
+func (mmListMessagesByIDs *MessageRepositoryMock) ListMessagesByIDsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessagesByIDs.afterListMessagesByIDsCounter)
+}
+
+// ListMessagesByIDsBeforeCounter returns a count of MessageRepositoryMock.ListMessagesByIDs invocations
+func (mmListMessagesByIDs *MessageRepositoryMock) ListMessagesByIDsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessagesByIDs.beforeListMessagesByIDsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListMessagesByIDs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessagesByIDs *mMessageRepositoryMockListMessagesByIDs) Calls() []*MessageRepositoryMockListMessagesByIDsParams {
+	mmListMessagesByIDs.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListMessagesByIDsParams, len(mmListMessagesByIDs.callArgs))
+	copy(argCopy, mmListMessagesByIDs.callArgs)
+
+	mmListMessagesByIDs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesByIDsDone returns true if the count of the ListMessagesByIDs invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListMessagesByIDsDone() bool {
+	if m.ListMessagesByIDsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesByIDsMock.invocationsDone()
+}
+
+// MinimockListMessagesByIDsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListMessagesByIDsInspect() {
+	for _, e := range m.ListMessagesByIDsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesByIDs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesByIDsCounter := mm_atomic.LoadUint64(&m.afterListMessagesByIDsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesByIDsMock.defaultExpectation != nil && afterListMessagesByIDsCounter < 1 {
+		if m.ListMessagesByIDsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesByIDs at\n%s", m.ListMessagesByIDsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesByIDs at\n%s with params: %#v", m.ListMessagesByIDsMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesByIDsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessagesByIDs != nil && afterListMessagesByIDsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesByIDs at\n%s", m.funcListMessagesByIDsOrigin)
+	}
+
+	if !m.ListMessagesByIDsMock.invocationsDone() && afterListMessagesByIDsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListMessagesByIDs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesByIDsMock.expectedInvocations), m.ListMessagesByIDsMock.expectedInvocationsOrigin, afterListMessagesByIDsCounter)
+	}
+}
+
+type mMessageRepositoryMockListMessagesBySeq struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListMessagesBySeqExpectation
+	expectations       []*MessageRepositoryMockListMessagesBySeqExpectation
+
+	callArgs []*MessageRepositoryMockListMessagesBySeqParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListMessagesBySeqExpectation specifies expectation struct of the MessageRepository.ListMessagesBySeq
+type MessageRepositoryMockListMessagesBySeqExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListMessagesBySeqParams
+	paramPtrs          *MessageRepositoryMockListMessagesBySeqParamPtrs
+	expectationOrigins MessageRepositoryMockListMessagesBySeqExpectationOrigins
+	results            *MessageRepositoryMockListMessagesBySeqResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListMessagesBySeqParams contains parameters of the MessageRepository.ListMessagesBySeq
+type MessageRepositoryMockListMessagesBySeqParams struct {
+	ctx     context.Context
+	chatID  int64
+	fromSeq int64
+	toSeq   int64
+	limit   int
+}
+
+// MessageRepositoryMockListMessagesBySeqParamPtrs contains pointers to parameters of the MessageRepository.ListMessagesBySeq
+type MessageRepositoryMockListMessagesBySeqParamPtrs struct {
+	ctx     *context.Context
+	chatID  *int64
+	fromSeq *int64
+	toSeq   *int64
+	limit   *int
+}
+
+// MessageRepositoryMockListMessagesBySeqResults contains results of the MessageRepository.ListMessagesBySeq
+type MessageRepositoryMockListMessagesBySeqResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListMessagesBySeqOrigins contains origins of expectations of the MessageRepository.ListMessagesBySeq
+type MessageRepositoryMockListMessagesBySeqExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originChatID  string
+	originFromSeq string
+	originToSeq   string
+	originLimit   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Optional() *mMessageRepositoryMockListMessagesBySeq {
+	mmListMessagesBySeq.optional = true
+	return mmListMessagesBySeq
+}
+
+// Expect sets up expected params for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Expect(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by ExpectParams functions")
+	}
+
+	mmListMessagesBySeq.defaultExpectation.params = &MessageRepositoryMockListMessagesBySeqParams{ctx, chatID, fromSeq, toSeq, limit}
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessagesBySeq.expectations {
+		if minimock.Equal(e.params, mmListMessagesBySeq.defaultExpectation.params) {
+			mmListMessagesBySeq.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessagesBySeq.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessagesBySeq
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.params != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Expect")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs == nil {
+		mmListMessagesBySeq.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesBySeqParamPtrs{}
+	}
+	mmListMessagesBySeq.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessagesBySeq
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.params != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Expect")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs == nil {
+		mmListMessagesBySeq.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesBySeqParamPtrs{}
+	}
+	mmListMessagesBySeq.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListMessagesBySeq
+}
+
+// ExpectFromSeqParam3 sets up expected param fromSeq for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) ExpectFromSeqParam3(fromSeq int64) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.params != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Expect")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs == nil {
+		mmListMessagesBySeq.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesBySeqParamPtrs{}
+	}
+	mmListMessagesBySeq.defaultExpectation.paramPtrs.fromSeq = &fromSeq
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.originFromSeq = minimock.CallerInfo(1)
+
+	return mmListMessagesBySeq
+}
+
+// ExpectToSeqParam4 sets up expected param toSeq for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) ExpectToSeqParam4(toSeq int64) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.params != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Expect")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs == nil {
+		mmListMessagesBySeq.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesBySeqParamPtrs{}
+	}
+	mmListMessagesBySeq.defaultExpectation.paramPtrs.toSeq = &toSeq
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.originToSeq = minimock.CallerInfo(1)
+
+	return mmListMessagesBySeq
+}
+
+// ExpectLimitParam5 sets up expected param limit for MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) ExpectLimitParam5(limit int) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{}
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.params != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Expect")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation.paramPtrs == nil {
+		mmListMessagesBySeq.defaultExpectation.paramPtrs = &MessageRepositoryMockListMessagesBySeqParamPtrs{}
+	}
+	mmListMessagesBySeq.defaultExpectation.paramPtrs.limit = &limit
+	mmListMessagesBySeq.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListMessagesBySeq
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Inspect(f func(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int)) *mMessageRepositoryMockListMessagesBySeq {
+	if mmListMessagesBySeq.mock.inspectFuncListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListMessagesBySeq")
+	}
+
+	mmListMessagesBySeq.mock.inspectFuncListMessagesBySeq = f
+
+	return mmListMessagesBySeq
+}
+
+// Return sets up results that will be returned by MessageRepository.ListMessagesBySeq
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	if mmListMessagesBySeq.defaultExpectation == nil {
+		mmListMessagesBySeq.defaultExpectation = &MessageRepositoryMockListMessagesBySeqExpectation{mock: mmListMessagesBySeq.mock}
+	}
+	mmListMessagesBySeq.defaultExpectation.results = &MessageRepositoryMockListMessagesBySeqResults{mpa1, err}
+	mmListMessagesBySeq.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessagesBySeq.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListMessagesBySeq method
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Set(f func(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListMessagesBySeq.defaultExpectation != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListMessagesBySeq method")
+	}
+
+	if len(mmListMessagesBySeq.expectations) > 0 {
+		mmListMessagesBySeq.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListMessagesBySeq method")
+	}
+
+	mmListMessagesBySeq.mock.funcListMessagesBySeq = f
+	mmListMessagesBySeq.mock.funcListMessagesBySeqOrigin = minimock.CallerInfo(1)
+	return mmListMessagesBySeq.mock
+}
+
+// When sets expectation for the MessageRepository.ListMessagesBySeq which will trigger the result defined by the following
+// Then helper
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) When(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int) *MessageRepositoryMockListMessagesBySeqExpectation {
+	if mmListMessagesBySeq.mock.funcListMessagesBySeq != nil {
+		mmListMessagesBySeq.mock.t.Fatalf("MessageRepositoryMock.ListMessagesBySeq mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListMessagesBySeqExpectation{
+		mock:               mmListMessagesBySeq.mock,
+		params:             &MessageRepositoryMockListMessagesBySeqParams{ctx, chatID, fromSeq, toSeq, limit},
+		expectationOrigins: MessageRepositoryMockListMessagesBySeqExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessagesBySeq.expectations = append(mmListMessagesBySeq.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListMessagesBySeq return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListMessagesBySeqExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListMessagesBySeqResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListMessagesBySeq should be invoked
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Times(n uint64) *mMessageRepositoryMockListMessagesBySeq {
+	if n == 0 {
+		mmListMessagesBySeq.mock.t.Fatalf("Times of MessageRepositoryMock.ListMessagesBySeq mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessagesBySeq.expectedInvocations, n)
+	mmListMessagesBySeq.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessagesBySeq
+}
+
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) invocationsDone() bool {
+	if len(mmListMessagesBySeq.expectations) == 0 && mmListMessagesBySeq.defaultExpectation == nil && mmListMessagesBySeq.mock.funcListMessagesBySeq == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessagesBySeq.mock.afterListMessagesBySeqCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessagesBySeq.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessagesBySeq implements mm_repository.MessageRepository
+func (mmListMessagesBySeq *MessageRepositoryMock) ListMessagesBySeq(ctx context.Context, chatID int64, fromSeq int64, toSeq int64, limit int) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessagesBySeq.beforeListMessagesBySeqCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessagesBySeq.afterListMessagesBySeqCounter, 1)
+
+	mmListMessagesBySeq.t.Helper()
+
+	if mmListMessagesBySeq.inspectFuncListMessagesBySeq != nil {
+		mmListMessagesBySeq.inspectFuncListMessagesBySeq(ctx, chatID, fromSeq, toSeq, limit)
+	}
+
+	mm_params := MessageRepositoryMockListMessagesBySeqParams{ctx, chatID, fromSeq, toSeq, limit}
+
+	// Record call args
+	mmListMessagesBySeq.ListMessagesBySeqMock.mutex.Lock()
+	mmListMessagesBySeq.ListMessagesBySeqMock.callArgs = append(mmListMessagesBySeq.ListMessagesBySeqMock.callArgs, &mm_params)
+	mmListMessagesBySeq.ListMessagesBySeqMock.mutex.Unlock()
+
+	for _, e := range mmListMessagesBySeq.ListMessagesBySeqMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListMessagesBySeqParams{ctx, chatID, fromSeq, toSeq, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.fromSeq != nil && !minimock.Equal(*mm_want_ptrs.fromSeq, mm_got.fromSeq) {
+				mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameter fromSeq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.originFromSeq, *mm_want_ptrs.fromSeq, mm_got.fromSeq, minimock.Diff(*mm_want_ptrs.fromSeq, mm_got.fromSeq))
+			}
+
+			if mm_want_ptrs.toSeq != nil && !minimock.Equal(*mm_want_ptrs.toSeq, mm_got.toSeq) {
+				mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameter toSeq, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.originToSeq, *mm_want_ptrs.toSeq, mm_got.toSeq, minimock.Diff(*mm_want_ptrs.toSeq, mm_got.toSeq))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessagesBySeq.t.Errorf("MessageRepositoryMock.ListMessagesBySeq got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessagesBySeq.ListMessagesBySeqMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessagesBySeq.t.Fatal("No results are set for the MessageRepositoryMock.ListMessagesBySeq")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessagesBySeq.funcListMessagesBySeq != nil {
+		return mmListMessagesBySeq.funcListMessagesBySeq(ctx, chatID, fromSeq, toSeq, limit)
+	}
+	mmListMessagesBySeq.t.Fatalf("Unexpected call to MessageRepositoryMock.ListMessagesBySeq. %v %v %v %v %v", ctx, chatID, fromSeq, toSeq, limit)
+	return
+}
+
+// ListMessagesBySeqAfterCounter returns a count of finished MessageRepositoryMock.ListMessagesBySeq invocations
+func (mmListMessagesBySeq *MessageRepositoryMock) ListMessagesBySeqAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessagesBySeq.afterListMessagesBySeqCounter)
+}
+
+// ListMessagesBySeqBeforeCounter returns a count of MessageRepositoryMock.ListMessagesBySeq invocations
+func (mmListMessagesBySeq *MessageRepositoryMock) ListMessagesBySeqBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessagesBySeq.beforeListMessagesBySeqCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListMessagesBySeq.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessagesBySeq *mMessageRepositoryMockListMessagesBySeq) Calls() []*MessageRepositoryMockListMessagesBySeqParams {
+	mmListMessagesBySeq.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListMessagesBySeqParams, len(mmListMessagesBySeq.callArgs))
+	copy(argCopy, mmListMessagesBySeq.callArgs)
+
+	mmListMessagesBySeq.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesBySeqDone returns true if the count of the ListMessagesBySeq invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListMessagesBySeqDone() bool {
+	if m.ListMessagesBySeqMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesBySeqMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesBySeqMock.invocationsDone()
+}
+
+// MinimockListMessagesBySeqInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListMessagesBySeqInspect() {
+	for _, e := range m.ListMessagesBySeqMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesBySeq at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesBySeqCounter := mm_atomic.LoadUint64(&m.afterListMessagesBySeqCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesBySeqMock.defaultExpectation != nil && afterListMessagesBySeqCounter < 1 {
+		if m.ListMessagesBySeqMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesBySeq at\n%s", m.ListMessagesBySeqMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesBySeq at\n%s with params: %#v", m.ListMessagesBySeqMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesBySeqMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessagesBySeq != nil && afterListMessagesBySeqCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListMessagesBySeq at\n%s", m.funcListMessagesBySeqOrigin)
+	}
+
+	if !m.ListMessagesBySeqMock.invocationsDone() && afterListMessagesBySeqCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListMessagesBySeq at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesBySeqMock.expectedInvocations), m.ListMessagesBySeqMock.expectedInvocationsOrigin, afterListMessagesBySeqCounter)
+	}
+}
+
+type mMessageRepositoryMockListPinned struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListPinnedExpectation
+	expectations       []*MessageRepositoryMockListPinnedExpectation
+
+	callArgs []*MessageRepositoryMockListPinnedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListPinnedExpectation specifies expectation struct of the MessageRepository.ListPinned
+type MessageRepositoryMockListPinnedExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListPinnedParams
+	paramPtrs          *MessageRepositoryMockListPinnedParamPtrs
+	expectationOrigins MessageRepositoryMockListPinnedExpectationOrigins
+	results            *MessageRepositoryMockListPinnedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListPinnedParams contains parameters of the MessageRepository.ListPinned
+type MessageRepositoryMockListPinnedParams struct {
+	ctx    context.Context
+	chatID int64
+}
+
+// MessageRepositoryMockListPinnedParamPtrs contains pointers to parameters of the MessageRepository.ListPinned
+type MessageRepositoryMockListPinnedParamPtrs struct {
+	ctx    *context.Context
+	chatID *int64
+}
+
+// MessageRepositoryMockListPinnedResults contains results of the MessageRepository.ListPinned
+type MessageRepositoryMockListPinnedResults struct {
+	ppa1 []*model.PinnedMessage
+	err  error
+}
+
+// MessageRepositoryMockListPinnedOrigins contains origins of expectations of the MessageRepository.ListPinned
+type MessageRepositoryMockListPinnedExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originChatID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinned *mMessageRepositoryMockListPinned) Optional() *mMessageRepositoryMockListPinned {
+	mmListPinned.optional = true
+	return mmListPinned
+}
+
+// Expect sets up expected params for MessageRepository.ListPinned
+func (mmListPinned *mMessageRepositoryMockListPinned) Expect(ctx context.Context, chatID int64) *mMessageRepositoryMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &MessageRepositoryMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by ExpectParams functions")
+	}
+
+	mmListPinned.defaultExpectation.params = &MessageRepositoryMockListPinnedParams{ctx, chatID}
+	mmListPinned.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPinned.expectations {
+		if minimock.Equal(e.params, mmListPinned.defaultExpectation.params) {
+			mmListPinned.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinned.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinned
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListPinned
+func (mmListPinned *mMessageRepositoryMockListPinned) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &MessageRepositoryMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &MessageRepositoryMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPinned.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPinned
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for MessageRepository.ListPinned
+func (mmListPinned *mMessageRepositoryMockListPinned) ExpectChatIDParam2(chatID int64) *mMessageRepositoryMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &MessageRepositoryMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &MessageRepositoryMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListPinned.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListPinned
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListPinned
+func (mmListPinned *mMessageRepositoryMockListPinned) Inspect(f func(ctx context.Context, chatID int64)) *mMessageRepositoryMockListPinned {
+	if mmListPinned.mock.inspectFuncListPinned != nil {
+		mmListPinned.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListPinned")
+	}
+
+	mmListPinned.mock.inspectFuncListPinned = f
+
+	return mmListPinned
+}
+
+// Return sets up results that will be returned by MessageRepository.ListPinned
+func (mmListPinned *mMessageRepositoryMockListPinned) Return(ppa1 []*model.PinnedMessage, err error) *MessageRepositoryMock {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &MessageRepositoryMockListPinnedExpectation{mock: mmListPinned.mock}
+	}
+	mmListPinned.defaultExpectation.results = &MessageRepositoryMockListPinnedResults{ppa1, err}
+	mmListPinned.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPinned.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListPinned method
+func (mmListPinned *mMessageRepositoryMockListPinned) Set(f func(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error)) *MessageRepositoryMock {
+	if mmListPinned.defaultExpectation != nil {
+		mmListPinned.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListPinned method")
+	}
+
+	if len(mmListPinned.expectations) > 0 {
+		mmListPinned.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListPinned method")
+	}
+
+	mmListPinned.mock.funcListPinned = f
+	mmListPinned.mock.funcListPinnedOrigin = minimock.CallerInfo(1)
+	return mmListPinned.mock
+}
+
+// When sets expectation for the MessageRepository.ListPinned which will trigger the result defined by the following
+// Then helper
+func (mmListPinned *mMessageRepositoryMockListPinned) When(ctx context.Context, chatID int64) *MessageRepositoryMockListPinnedExpectation {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("MessageRepositoryMock.ListPinned mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListPinnedExpectation{
+		mock:               mmListPinned.mock,
+		params:             &MessageRepositoryMockListPinnedParams{ctx, chatID},
+		expectationOrigins: MessageRepositoryMockListPinnedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPinned.expectations = append(mmListPinned.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListPinned return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListPinnedExpectation) Then(ppa1 []*model.PinnedMessage, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListPinnedResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListPinned should be invoked
+func (mmListPinned *mMessageRepositoryMockListPinned) Times(n uint64) *mMessageRepositoryMockListPinned {
+	if n == 0 {
+		mmListPinned.mock.t.Fatalf("Times of MessageRepositoryMock.ListPinned mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinned.expectedInvocations, n)
+	mmListPinned.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPinned
+}
+
+func (mmListPinned *mMessageRepositoryMockListPinned) invocationsDone() bool {
+	if len(mmListPinned.expectations) == 0 && mmListPinned.defaultExpectation == nil && mmListPinned.mock.funcListPinned == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinned.mock.afterListPinnedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinned.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinned implements mm_repository.MessageRepository
+func (mmListPinned *MessageRepositoryMock) ListPinned(ctx context.Context, chatID int64) (ppa1 []*model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmListPinned.beforeListPinnedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinned.afterListPinnedCounter, 1)
+
+	mmListPinned.t.Helper()
+
+	if mmListPinned.inspectFuncListPinned != nil {
+		mmListPinned.inspectFuncListPinned(ctx, chatID)
+	}
+
+	mm_params := MessageRepositoryMockListPinnedParams{ctx, chatID}
+
+	// Record call args
+	mmListPinned.ListPinnedMock.mutex.Lock()
+	mmListPinned.ListPinnedMock.callArgs = append(mmListPinned.ListPinnedMock.callArgs, &mm_params)
+	mmListPinned.ListPinnedMock.mutex.Unlock()
+
+	for _, e := range mmListPinned.ListPinnedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPinned.ListPinnedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinned.ListPinnedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinned.ListPinnedMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinned.ListPinnedMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListPinnedParams{ctx, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinned.t.Errorf("MessageRepositoryMock.ListPinned got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListPinned.t.Errorf("MessageRepositoryMock.ListPinned got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinned.t.Errorf("MessageRepositoryMock.ListPinned got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinned.ListPinnedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinned.t.Fatal("No results are set for the MessageRepositoryMock.ListPinned")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPinned.funcListPinned != nil {
+		return mmListPinned.funcListPinned(ctx, chatID)
+	}
+	mmListPinned.t.Fatalf("Unexpected call to MessageRepositoryMock.ListPinned. %v %v", ctx, chatID)
+	return
+}
+
+// ListPinnedAfterCounter returns a count of finished MessageRepositoryMock.ListPinned invocations
+func (mmListPinned *MessageRepositoryMock) ListPinnedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.afterListPinnedCounter)
+}
+
+// ListPinnedBeforeCounter returns a count of MessageRepositoryMock.ListPinned invocations
+func (mmListPinned *MessageRepositoryMock) ListPinnedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.beforeListPinnedCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListPinned.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinned *mMessageRepositoryMockListPinned) Calls() []*MessageRepositoryMockListPinnedParams {
+	mmListPinned.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListPinnedParams, len(mmListPinned.callArgs))
+	copy(argCopy, mmListPinned.callArgs)
+
+	mmListPinned.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedDone returns true if the count of the ListPinned invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListPinnedDone() bool {
+	if m.ListPinnedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMock.invocationsDone()
+}
+
+// MinimockListPinnedInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListPinnedInspect() {
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListPinned at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPinnedCounter := mm_atomic.LoadUint64(&m.afterListPinnedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMock.defaultExpectation != nil && afterListPinnedCounter < 1 {
+		if m.ListPinnedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListPinned at\n%s", m.ListPinnedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListPinned at\n%s with params: %#v", m.ListPinnedMock.defaultExpectation.expectationOrigins.origin, *m.ListPinnedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinned != nil && afterListPinnedCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListPinned at\n%s", m.funcListPinnedOrigin)
+	}
+
+	if !m.ListPinnedMock.invocationsDone() && afterListPinnedCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListPinned at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMock.expectedInvocations), m.ListPinnedMock.expectedInvocationsOrigin, afterListPinnedCounter)
+	}
+}
+
+type mMessageRepositoryMockListReactions struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListReactionsExpectation
+	expectations       []*MessageRepositoryMockListReactionsExpectation
+
+	callArgs []*MessageRepositoryMockListReactionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListReactionsExpectation specifies expectation struct of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListReactionsParams
+	paramPtrs          *MessageRepositoryMockListReactionsParamPtrs
+	expectationOrigins MessageRepositoryMockListReactionsExpectationOrigins
+	results            *MessageRepositoryMockListReactionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListReactionsParams contains parameters of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsParams struct {
+	ctx        context.Context
+	messageIDs []int64
+	username   string
+}
+
+// MessageRepositoryMockListReactionsParamPtrs contains pointers to parameters of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsParamPtrs struct {
+	ctx        *context.Context
+	messageIDs *[]int64
+	username   *string
+}
+
+// MessageRepositoryMockListReactionsResults contains results of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsResults struct {
+	m1  map[int64][]*model.Reaction
+	err error
+}
+
+// MessageRepositoryMockListReactionsOrigins contains origins of expectations of the MessageRepository.ListReactions
+type MessageRepositoryMockListReactionsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originMessageIDs string
+	originUsername   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReactions *mMessageRepositoryMockListReactions) Optional() *mMessageRepositoryMockListReactions {
+	mmListReactions.optional = true
+	return mmListReactions
+}
+
+// Expect sets up expected params for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Expect(ctx context.Context, messageIDs []int64, username string) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by ExpectParams functions")
+	}
+
+	mmListReactions.defaultExpectation.params = &MessageRepositoryMockListReactionsParams{ctx, messageIDs, username}
+	mmListReactions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReactions.expectations {
+		if minimock.Equal(e.params, mmListReactions.defaultExpectation.params) {
+			mmListReactions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReactions.defaultExpectation.params)
+		}
+	}
+
+	return mmListReactions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReactions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// ExpectMessageIDsParam2 sets up expected param messageIDs for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectMessageIDsParam2(messageIDs []int64) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.messageIDs = &messageIDs
+	mmListReactions.defaultExpectation.expectationOrigins.originMessageIDs = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// ExpectUsernameParam3 sets up expected param username for MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) ExpectUsernameParam3(username string) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{}
+	}
+
+	if mmListReactions.defaultExpectation.params != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Expect")
+	}
+
+	if mmListReactions.defaultExpectation.paramPtrs == nil {
+		mmListReactions.defaultExpectation.paramPtrs = &MessageRepositoryMockListReactionsParamPtrs{}
+	}
+	mmListReactions.defaultExpectation.paramPtrs.username = &username
+	mmListReactions.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListReactions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Inspect(f func(ctx context.Context, messageIDs []int64, username string)) *mMessageRepositoryMockListReactions {
+	if mmListReactions.mock.inspectFuncListReactions != nil {
+		mmListReactions.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListReactions")
+	}
+
+	mmListReactions.mock.inspectFuncListReactions = f
+
+	return mmListReactions
+}
+
+// Return sets up results that will be returned by MessageRepository.ListReactions
+func (mmListReactions *mMessageRepositoryMockListReactions) Return(m1 map[int64][]*model.Reaction, err error) *MessageRepositoryMock {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	if mmListReactions.defaultExpectation == nil {
+		mmListReactions.defaultExpectation = &MessageRepositoryMockListReactionsExpectation{mock: mmListReactions.mock}
+	}
+	mmListReactions.defaultExpectation.results = &MessageRepositoryMockListReactionsResults{m1, err}
+	mmListReactions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReactions.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListReactions method
+func (mmListReactions *mMessageRepositoryMockListReactions) Set(f func(ctx context.Context, messageIDs []int64, username string) (m1 map[int64][]*model.Reaction, err error)) *MessageRepositoryMock {
+	if mmListReactions.defaultExpectation != nil {
+		mmListReactions.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListReactions method")
+	}
+
+	if len(mmListReactions.expectations) > 0 {
+		mmListReactions.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListReactions method")
+	}
+
+	mmListReactions.mock.funcListReactions = f
+	mmListReactions.mock.funcListReactionsOrigin = minimock.CallerInfo(1)
+	return mmListReactions.mock
+}
+
+// When sets expectation for the MessageRepository.ListReactions which will trigger the result defined by the following
+// Then helper
+func (mmListReactions *mMessageRepositoryMockListReactions) When(ctx context.Context, messageIDs []int64, username string) *MessageRepositoryMockListReactionsExpectation {
+	if mmListReactions.mock.funcListReactions != nil {
+		mmListReactions.mock.t.Fatalf("MessageRepositoryMock.ListReactions mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListReactionsExpectation{
+		mock:               mmListReactions.mock,
+		params:             &MessageRepositoryMockListReactionsParams{ctx, messageIDs, username},
+		expectationOrigins: MessageRepositoryMockListReactionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReactions.expectations = append(mmListReactions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListReactions return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListReactionsExpectation) Then(m1 map[int64][]*model.Reaction, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListReactionsResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListReactions should be invoked
+func (mmListReactions *mMessageRepositoryMockListReactions) Times(n uint64) *mMessageRepositoryMockListReactions {
+	if n == 0 {
+		mmListReactions.mock.t.Fatalf("Times of MessageRepositoryMock.ListReactions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReactions.expectedInvocations, n)
+	mmListReactions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReactions
+}
+
+func (mmListReactions *mMessageRepositoryMockListReactions) invocationsDone() bool {
+	if len(mmListReactions.expectations) == 0 && mmListReactions.defaultExpectation == nil && mmListReactions.mock.funcListReactions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReactions.mock.afterListReactionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReactions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReactions implements mm_repository.MessageRepository
+func (mmListReactions *MessageRepositoryMock) ListReactions(ctx context.Context, messageIDs []int64, username string) (m1 map[int64][]*model.Reaction, err error) {
+	mm_atomic.AddUint64(&mmListReactions.beforeListReactionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReactions.afterListReactionsCounter, 1)
+
+	mmListReactions.t.Helper()
+
+	if mmListReactions.inspectFuncListReactions != nil {
+		mmListReactions.inspectFuncListReactions(ctx, messageIDs, username)
+	}
+
+	mm_params := MessageRepositoryMockListReactionsParams{ctx, messageIDs, username}
+
+	// Record call args
+	mmListReactions.ListReactionsMock.mutex.Lock()
+	mmListReactions.ListReactionsMock.callArgs = append(mmListReactions.ListReactionsMock.callArgs, &mm_params)
+	mmListReactions.ListReactionsMock.mutex.Unlock()
+
+	for _, e := range mmListReactions.ListReactionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmListReactions.ListReactionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReactions.ListReactionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReactions.ListReactionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListReactions.ListReactionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListReactionsParams{ctx, messageIDs, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageIDs != nil && !minimock.Equal(*mm_want_ptrs.messageIDs, mm_got.messageIDs) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter messageIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originMessageIDs, *mm_want_ptrs.messageIDs, mm_got.messageIDs, minimock.Diff(*mm_want_ptrs.messageIDs, mm_got.messageIDs))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReactions.t.Errorf("MessageRepositoryMock.ListReactions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReactions.ListReactionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReactions.ListReactionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReactions.t.Fatal("No results are set for the MessageRepositoryMock.ListReactions")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmListReactions.funcListReactions != nil {
+		return mmListReactions.funcListReactions(ctx, messageIDs, username)
+	}
+	mmListReactions.t.Fatalf("Unexpected call to MessageRepositoryMock.ListReactions. %v %v %v", ctx, messageIDs, username)
+	return
+}
+
+// ListReactionsAfterCounter returns a count of finished MessageRepositoryMock.ListReactions invocations
+func (mmListReactions *MessageRepositoryMock) ListReactionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReactions.afterListReactionsCounter)
+}
+
+// ListReactionsBeforeCounter returns a count of MessageRepositoryMock.ListReactions invocations
+func (mmListReactions *MessageRepositoryMock) ListReactionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReactions.beforeListReactionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListReactions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReactions *mMessageRepositoryMockListReactions) Calls() []*MessageRepositoryMockListReactionsParams {
+	mmListReactions.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListReactionsParams, len(mmListReactions.callArgs))
+	copy(argCopy, mmListReactions.callArgs)
+
+	mmListReactions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListReactionsDone returns true if the count of the ListReactions invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListReactionsDone() bool {
+	if m.ListReactionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListReactionsMock.invocationsDone()
+}
+
+// MinimockListReactionsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListReactionsInspect() {
+	for _, e := range m.ListReactionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListReactionsCounter := mm_atomic.LoadUint64(&m.afterListReactionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListReactionsMock.defaultExpectation != nil && afterListReactionsCounter < 1 {
+		if m.ListReactionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s", m.ListReactionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s with params: %#v", m.ListReactionsMock.defaultExpectation.expectationOrigins.origin, *m.ListReactionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReactions != nil && afterListReactionsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListReactions at\n%s", m.funcListReactionsOrigin)
+	}
+
+	if !m.ListReactionsMock.invocationsDone() && afterListReactionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListReactions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListReactionsMock.expectedInvocations), m.ListReactionsMock.expectedInvocationsOrigin, afterListReactionsCounter)
+	}
+}
+
+type mMessageRepositoryMockListReplies struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockListRepliesExpectation
+	expectations       []*MessageRepositoryMockListRepliesExpectation
+
+	callArgs []*MessageRepositoryMockListRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockListRepliesExpectation specifies expectation struct of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockListRepliesParams
+	paramPtrs          *MessageRepositoryMockListRepliesParamPtrs
+	expectationOrigins MessageRepositoryMockListRepliesExpectationOrigins
+	results            *MessageRepositoryMockListRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockListRepliesParams contains parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParams struct {
+	ctx    context.Context
+	rootID int64
+	cursor int64
+	limit  int
+}
+
+// MessageRepositoryMockListRepliesParamPtrs contains pointers to parameters of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesParamPtrs struct {
+	ctx    *context.Context
+	rootID *int64
+	cursor *int64
+	limit  *int
+}
+
+// MessageRepositoryMockListRepliesResults contains results of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockListRepliesOrigins contains origins of expectations of the MessageRepository.ListReplies
+type MessageRepositoryMockListRepliesExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originRootID string
+	originCursor string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListReplies *mMessageRepositoryMockListReplies) Optional() *mMessageRepositoryMockListReplies {
+	mmListReplies.optional = true
+	return mmListReplies
+}
+
+// Expect sets up expected params for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Expect(ctx context.Context, rootID int64, cursor int64, limit int) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by ExpectParams functions")
+	}
+
+	mmListReplies.defaultExpectation.params = &MessageRepositoryMockListRepliesParams{ctx, rootID, cursor, limit}
+	mmListReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListReplies.expectations {
+		if minimock.Equal(e.params, mmListReplies.defaultExpectation.params) {
+			mmListReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmListReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectRootIDParam2 sets up expected param rootID for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectRootIDParam2(rootID int64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.rootID = &rootID
+	mmListReplies.defaultExpectation.expectationOrigins.originRootID = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectCursorParam3 sets up expected param cursor for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectCursorParam3(cursor int64) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.cursor = &cursor
+	mmListReplies.defaultExpectation.expectationOrigins.originCursor = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// ExpectLimitParam4 sets up expected param limit for MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) ExpectLimitParam4(limit int) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{}
+	}
+
+	if mmListReplies.defaultExpectation.params != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Expect")
+	}
+
+	if mmListReplies.defaultExpectation.paramPtrs == nil {
+		mmListReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockListRepliesParamPtrs{}
+	}
+	mmListReplies.defaultExpectation.paramPtrs.limit = &limit
+	mmListReplies.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmListReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Inspect(f func(ctx context.Context, rootID int64, cursor int64, limit int)) *mMessageRepositoryMockListReplies {
+	if mmListReplies.mock.inspectFuncListReplies != nil {
+		mmListReplies.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.ListReplies")
+	}
+
+	mmListReplies.mock.inspectFuncListReplies = f
+
+	return mmListReplies
+}
+
+// Return sets up results that will be returned by MessageRepository.ListReplies
+func (mmListReplies *mMessageRepositoryMockListReplies) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	if mmListReplies.defaultExpectation == nil {
+		mmListReplies.defaultExpectation = &MessageRepositoryMockListRepliesExpectation{mock: mmListReplies.mock}
+	}
+	mmListReplies.defaultExpectation.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	mmListReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// Set uses given function f to mock the MessageRepository.ListReplies method
+func (mmListReplies *mMessageRepositoryMockListReplies) Set(f func(ctx context.Context, rootID int64, cursor int64, limit int) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmListReplies.defaultExpectation != nil {
+		mmListReplies.mock.t.Fatalf("Default expectation is already set for the MessageRepository.ListReplies method")
+	}
+
+	if len(mmListReplies.expectations) > 0 {
+		mmListReplies.mock.t.Fatalf("Some expectations are already set for the MessageRepository.ListReplies method")
+	}
+
+	mmListReplies.mock.funcListReplies = f
+	mmListReplies.mock.funcListRepliesOrigin = minimock.CallerInfo(1)
+	return mmListReplies.mock
+}
+
+// When sets expectation for the MessageRepository.ListReplies which will trigger the result defined by the following
+// Then helper
+func (mmListReplies *mMessageRepositoryMockListReplies) When(ctx context.Context, rootID int64, cursor int64, limit int) *MessageRepositoryMockListRepliesExpectation {
+	if mmListReplies.mock.funcListReplies != nil {
+		mmListReplies.mock.t.Fatalf("MessageRepositoryMock.ListReplies mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockListRepliesExpectation{
+		mock:               mmListReplies.mock,
+		params:             &MessageRepositoryMockListRepliesParams{ctx, rootID, cursor, limit},
+		expectationOrigins: MessageRepositoryMockListRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListReplies.expectations = append(mmListReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.ListReplies return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockListRepliesExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockListRepliesResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.ListReplies should be invoked
+func (mmListReplies *mMessageRepositoryMockListReplies) Times(n uint64) *mMessageRepositoryMockListReplies {
+	if n == 0 {
+		mmListReplies.mock.t.Fatalf("Times of MessageRepositoryMock.ListReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListReplies.expectedInvocations, n)
+	mmListReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListReplies
+}
+
+func (mmListReplies *mMessageRepositoryMockListReplies) invocationsDone() bool {
+	if len(mmListReplies.expectations) == 0 && mmListReplies.defaultExpectation == nil && mmListReplies.mock.funcListReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListReplies.mock.afterListRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListReplies implements mm_repository.MessageRepository
+func (mmListReplies *MessageRepositoryMock) ListReplies(ctx context.Context, rootID int64, cursor int64, limit int) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListReplies.beforeListRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListReplies.afterListRepliesCounter, 1)
+
+	mmListReplies.t.Helper()
+
+	if mmListReplies.inspectFuncListReplies != nil {
+		mmListReplies.inspectFuncListReplies(ctx, rootID, cursor, limit)
+	}
+
+	mm_params := MessageRepositoryMockListRepliesParams{ctx, rootID, cursor, limit}
+
+	// Record call args
+	mmListReplies.ListRepliesMock.mutex.Lock()
+	mmListReplies.ListRepliesMock.callArgs = append(mmListReplies.ListRepliesMock.callArgs, &mm_params)
+	mmListReplies.ListRepliesMock.mutex.Unlock()
+
+	for _, e := range mmListReplies.ListRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListReplies.ListRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListReplies.ListRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListReplies.ListRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmListReplies.ListRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockListRepliesParams{ctx, rootID, cursor, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootID != nil && !minimock.Equal(*mm_want_ptrs.rootID, mm_got.rootID) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter rootID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originRootID, *mm_want_ptrs.rootID, mm_got.rootID, minimock.Diff(*mm_want_ptrs.rootID, mm_got.rootID))
+			}
+
+			if mm_want_ptrs.cursor != nil && !minimock.Equal(*mm_want_ptrs.cursor, mm_got.cursor) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter cursor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originCursor, *mm_want_ptrs.cursor, mm_got.cursor, minimock.Diff(*mm_want_ptrs.cursor, mm_got.cursor))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListReplies.t.Errorf("MessageRepositoryMock.ListReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListReplies.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListReplies.ListRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListReplies.t.Fatal("No results are set for the MessageRepositoryMock.ListReplies")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListReplies.funcListReplies != nil {
+		return mmListReplies.funcListReplies(ctx, rootID, cursor, limit)
+	}
+	mmListReplies.t.Fatalf("Unexpected call to MessageRepositoryMock.ListReplies. %v %v %v %v", ctx, rootID, cursor, limit)
+	return
+}
+
+// ListRepliesAfterCounter returns a count of finished MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.afterListRepliesCounter)
+}
+
+// ListRepliesBeforeCounter returns a count of MessageRepositoryMock.ListReplies invocations
+func (mmListReplies *MessageRepositoryMock) ListRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListReplies.beforeListRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.ListReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListReplies *mMessageRepositoryMockListReplies) Calls() []*MessageRepositoryMockListRepliesParams {
+	mmListReplies.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockListRepliesParams, len(mmListReplies.callArgs))
+	copy(argCopy, mmListReplies.callArgs)
+
+	mmListReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListRepliesDone returns true if the count of the ListReplies invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockListRepliesDone() bool {
+	if m.ListRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListRepliesMock.invocationsDone()
+}
+
+// MinimockListRepliesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockListRepliesInspect() {
+	for _, e := range m.ListRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListRepliesCounter := mm_atomic.LoadUint64(&m.afterListRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListRepliesMock.defaultExpectation != nil && afterListRepliesCounter < 1 {
+		if m.ListRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.ListRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s with params: %#v", m.ListRepliesMock.defaultExpectation.expectationOrigins.origin, *m.ListRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListReplies != nil && afterListRepliesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.ListReplies at\n%s", m.funcListRepliesOrigin)
+	}
+
+	if !m.ListRepliesMock.invocationsDone() && afterListRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.ListReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListRepliesMock.expectedInvocations), m.ListRepliesMock.expectedInvocationsOrigin, afterListRepliesCounter)
+	}
+}
+
+type mMessageRepositoryMockLockExpired struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockLockExpiredExpectation
+	expectations       []*MessageRepositoryMockLockExpiredExpectation
+
+	callArgs []*MessageRepositoryMockLockExpiredParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockLockExpiredExpectation specifies expectation struct of the MessageRepository.LockExpired
+type MessageRepositoryMockLockExpiredExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockLockExpiredParams
+	paramPtrs          *MessageRepositoryMockLockExpiredParamPtrs
+	expectationOrigins MessageRepositoryMockLockExpiredExpectationOrigins
+	results            *MessageRepositoryMockLockExpiredResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockLockExpiredParams contains parameters of the MessageRepository.LockExpired
+type MessageRepositoryMockLockExpiredParams struct {
+	ctx   context.Context
+	now   time.Time
+	limit int
+}
+
+// MessageRepositoryMockLockExpiredParamPtrs contains pointers to parameters of the MessageRepository.LockExpired
+type MessageRepositoryMockLockExpiredParamPtrs struct {
+	ctx   *context.Context
+	now   *time.Time
+	limit *int
+}
+
+// MessageRepositoryMockLockExpiredResults contains results of the MessageRepository.LockExpired
+type MessageRepositoryMockLockExpiredResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// MessageRepositoryMockLockExpiredOrigins contains origins of expectations of the MessageRepository.LockExpired
+type MessageRepositoryMockLockExpiredExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originNow   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Optional() *mMessageRepositoryMockLockExpired {
+	mmLockExpired.optional = true
+	return mmLockExpired
+}
+
+// Expect sets up expected params for MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Expect(ctx context.Context, now time.Time, limit int) *mMessageRepositoryMockLockExpired {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	if mmLockExpired.defaultExpectation == nil {
+		mmLockExpired.defaultExpectation = &MessageRepositoryMockLockExpiredExpectation{}
+	}
+
+	if mmLockExpired.defaultExpectation.paramPtrs != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by ExpectParams functions")
+	}
+
+	mmLockExpired.defaultExpectation.params = &MessageRepositoryMockLockExpiredParams{ctx, now, limit}
+	mmLockExpired.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockExpired.expectations {
+		if minimock.Equal(e.params, mmLockExpired.defaultExpectation.params) {
+			mmLockExpired.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockExpired.defaultExpectation.params)
+		}
+	}
+
+	return mmLockExpired
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockLockExpired {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	if mmLockExpired.defaultExpectation == nil {
+		mmLockExpired.defaultExpectation = &MessageRepositoryMockLockExpiredExpectation{}
+	}
+
+	if mmLockExpired.defaultExpectation.params != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Expect")
+	}
+
+	if mmLockExpired.defaultExpectation.paramPtrs == nil {
+		mmLockExpired.defaultExpectation.paramPtrs = &MessageRepositoryMockLockExpiredParamPtrs{}
+	}
+	mmLockExpired.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockExpired.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockExpired
+}
+
+// ExpectNowParam2 sets up expected param now for MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) ExpectNowParam2(now time.Time) *mMessageRepositoryMockLockExpired {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	if mmLockExpired.defaultExpectation == nil {
+		mmLockExpired.defaultExpectation = &MessageRepositoryMockLockExpiredExpectation{}
+	}
+
+	if mmLockExpired.defaultExpectation.params != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Expect")
+	}
+
+	if mmLockExpired.defaultExpectation.paramPtrs == nil {
+		mmLockExpired.defaultExpectation.paramPtrs = &MessageRepositoryMockLockExpiredParamPtrs{}
+	}
+	mmLockExpired.defaultExpectation.paramPtrs.now = &now
+	mmLockExpired.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmLockExpired
+}
+
+// ExpectLimitParam3 sets up expected param limit for MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) ExpectLimitParam3(limit int) *mMessageRepositoryMockLockExpired {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	if mmLockExpired.defaultExpectation == nil {
+		mmLockExpired.defaultExpectation = &MessageRepositoryMockLockExpiredExpectation{}
+	}
+
+	if mmLockExpired.defaultExpectation.params != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Expect")
+	}
+
+	if mmLockExpired.defaultExpectation.paramPtrs == nil {
+		mmLockExpired.defaultExpectation.paramPtrs = &MessageRepositoryMockLockExpiredParamPtrs{}
+	}
+	mmLockExpired.defaultExpectation.paramPtrs.limit = &limit
+	mmLockExpired.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmLockExpired
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Inspect(f func(ctx context.Context, now time.Time, limit int)) *mMessageRepositoryMockLockExpired {
+	if mmLockExpired.mock.inspectFuncLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.LockExpired")
+	}
+
+	mmLockExpired.mock.inspectFuncLockExpired = f
+
+	return mmLockExpired
+}
+
+// Return sets up results that will be returned by MessageRepository.LockExpired
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Return(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	if mmLockExpired.defaultExpectation == nil {
+		mmLockExpired.defaultExpectation = &MessageRepositoryMockLockExpiredExpectation{mock: mmLockExpired.mock}
+	}
+	mmLockExpired.defaultExpectation.results = &MessageRepositoryMockLockExpiredResults{mpa1, err}
+	mmLockExpired.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockExpired.mock
+}
+
+// Set uses given function f to mock the MessageRepository.LockExpired method
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Set(f func(ctx context.Context, now time.Time, limit int) (mpa1 []*model.Message, err error)) *MessageRepositoryMock {
+	if mmLockExpired.defaultExpectation != nil {
+		mmLockExpired.mock.t.Fatalf("Default expectation is already set for the MessageRepository.LockExpired method")
+	}
+
+	if len(mmLockExpired.expectations) > 0 {
+		mmLockExpired.mock.t.Fatalf("Some expectations are already set for the MessageRepository.LockExpired method")
+	}
+
+	mmLockExpired.mock.funcLockExpired = f
+	mmLockExpired.mock.funcLockExpiredOrigin = minimock.CallerInfo(1)
+	return mmLockExpired.mock
+}
+
+// When sets expectation for the MessageRepository.LockExpired which will trigger the result defined by the following
+// Then helper
+func (mmLockExpired *mMessageRepositoryMockLockExpired) When(ctx context.Context, now time.Time, limit int) *MessageRepositoryMockLockExpiredExpectation {
+	if mmLockExpired.mock.funcLockExpired != nil {
+		mmLockExpired.mock.t.Fatalf("MessageRepositoryMock.LockExpired mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockLockExpiredExpectation{
+		mock:               mmLockExpired.mock,
+		params:             &MessageRepositoryMockLockExpiredParams{ctx, now, limit},
+		expectationOrigins: MessageRepositoryMockLockExpiredExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockExpired.expectations = append(mmLockExpired.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.LockExpired return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockLockExpiredExpectation) Then(mpa1 []*model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockLockExpiredResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.LockExpired should be invoked
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Times(n uint64) *mMessageRepositoryMockLockExpired {
+	if n == 0 {
+		mmLockExpired.mock.t.Fatalf("Times of MessageRepositoryMock.LockExpired mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockExpired.expectedInvocations, n)
+	mmLockExpired.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockExpired
+}
+
+func (mmLockExpired *mMessageRepositoryMockLockExpired) invocationsDone() bool {
+	if len(mmLockExpired.expectations) == 0 && mmLockExpired.defaultExpectation == nil && mmLockExpired.mock.funcLockExpired == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockExpired.mock.afterLockExpiredCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockExpired.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockExpired implements mm_repository.MessageRepository
+func (mmLockExpired *MessageRepositoryMock) LockExpired(ctx context.Context, now time.Time, limit int) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmLockExpired.beforeLockExpiredCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockExpired.afterLockExpiredCounter, 1)
+
+	mmLockExpired.t.Helper()
+
+	if mmLockExpired.inspectFuncLockExpired != nil {
+		mmLockExpired.inspectFuncLockExpired(ctx, now, limit)
+	}
+
+	mm_params := MessageRepositoryMockLockExpiredParams{ctx, now, limit}
+
+	// Record call args
+	mmLockExpired.LockExpiredMock.mutex.Lock()
+	mmLockExpired.LockExpiredMock.callArgs = append(mmLockExpired.LockExpiredMock.callArgs, &mm_params)
+	mmLockExpired.LockExpiredMock.mutex.Unlock()
+
+	for _, e := range mmLockExpired.LockExpiredMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmLockExpired.LockExpiredMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockExpired.LockExpiredMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockExpired.LockExpiredMock.defaultExpectation.params
+		mm_want_ptrs := mmLockExpired.LockExpiredMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockLockExpiredParams{ctx, now, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockExpired.t.Errorf("MessageRepositoryMock.LockExpired got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockExpired.LockExpiredMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmLockExpired.t.Errorf("MessageRepositoryMock.LockExpired got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockExpired.LockExpiredMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmLockExpired.t.Errorf("MessageRepositoryMock.LockExpired got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockExpired.LockExpiredMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockExpired.t.Errorf("MessageRepositoryMock.LockExpired got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockExpired.LockExpiredMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockExpired.LockExpiredMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockExpired.t.Fatal("No results are set for the MessageRepositoryMock.LockExpired")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmLockExpired.funcLockExpired != nil {
+		return mmLockExpired.funcLockExpired(ctx, now, limit)
+	}
+	mmLockExpired.t.Fatalf("Unexpected call to MessageRepositoryMock.LockExpired. %v %v %v", ctx, now, limit)
+	return
+}
+
+// LockExpiredAfterCounter returns a count of finished MessageRepositoryMock.LockExpired invocations
+func (mmLockExpired *MessageRepositoryMock) LockExpiredAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockExpired.afterLockExpiredCounter)
+}
+
+// LockExpiredBeforeCounter returns a count of MessageRepositoryMock.LockExpired invocations
+func (mmLockExpired *MessageRepositoryMock) LockExpiredBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockExpired.beforeLockExpiredCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.LockExpired.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockExpired *mMessageRepositoryMockLockExpired) Calls() []*MessageRepositoryMockLockExpiredParams {
+	mmLockExpired.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockLockExpiredParams, len(mmLockExpired.callArgs))
+	copy(argCopy, mmLockExpired.callArgs)
+
+	mmLockExpired.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockExpiredDone returns true if the count of the LockExpired invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockLockExpiredDone() bool {
+	if m.LockExpiredMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockExpiredMock.invocationsDone()
+}
+
+// MinimockLockExpiredInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockLockExpiredInspect() {
+	for _, e := range m.LockExpiredMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockExpired at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockExpiredCounter := mm_atomic.LoadUint64(&m.afterLockExpiredCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockExpiredMock.defaultExpectation != nil && afterLockExpiredCounter < 1 {
+		if m.LockExpiredMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockExpired at\n%s", m.LockExpiredMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockExpired at\n%s with params: %#v", m.LockExpiredMock.defaultExpectation.expectationOrigins.origin, *m.LockExpiredMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockExpired != nil && afterLockExpiredCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.LockExpired at\n%s", m.funcLockExpiredOrigin)
+	}
+
+	if !m.LockExpiredMock.invocationsDone() && afterLockExpiredCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.LockExpired at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockExpiredMock.expectedInvocations), m.LockExpiredMock.expectedInvocationsOrigin, afterLockExpiredCounter)
+	}
+}
+
+type mMessageRepositoryMockLockMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockLockMessageExpectation
+	expectations       []*MessageRepositoryMockLockMessageExpectation
+
+	callArgs []*MessageRepositoryMockLockMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockLockMessageExpectation specifies expectation struct of the MessageRepository.LockMessage
+type MessageRepositoryMockLockMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockLockMessageParams
+	paramPtrs          *MessageRepositoryMockLockMessageParamPtrs
+	expectationOrigins MessageRepositoryMockLockMessageExpectationOrigins
+	results            *MessageRepositoryMockLockMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockLockMessageParams contains parameters of the MessageRepository.LockMessage
+type MessageRepositoryMockLockMessageParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// MessageRepositoryMockLockMessageParamPtrs contains pointers to parameters of the MessageRepository.LockMessage
+type MessageRepositoryMockLockMessageParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// MessageRepositoryMockLockMessageResults contains results of the MessageRepository.LockMessage
+type MessageRepositoryMockLockMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockLockMessageOrigins contains origins of expectations of the MessageRepository.LockMessage
+type MessageRepositoryMockLockMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Optional() *mMessageRepositoryMockLockMessage {
+	mmLockMessage.optional = true
+	return mmLockMessage
+}
+
+// Expect sets up expected params for MessageRepository.LockMessage
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Expect(ctx context.Context, messageID int64) *mMessageRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &MessageRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by ExpectParams functions")
+	}
+
+	mmLockMessage.defaultExpectation.params = &MessageRepositoryMockLockMessageParams{ctx, messageID}
+	mmLockMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockMessage.expectations {
+		if minimock.Equal(e.params, mmLockMessage.defaultExpectation.params) {
+			mmLockMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmLockMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.LockMessage
+func (mmLockMessage *mMessageRepositoryMockLockMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &MessageRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.params != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Expect")
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs == nil {
+		mmLockMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockLockMessageParamPtrs{}
+	}
+	mmLockMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockMessage
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.LockMessage
+func (mmLockMessage *mMessageRepositoryMockLockMessage) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockLockMessage {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &MessageRepositoryMockLockMessageExpectation{}
+	}
+
+	if mmLockMessage.defaultExpectation.params != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Expect")
+	}
+
+	if mmLockMessage.defaultExpectation.paramPtrs == nil {
+		mmLockMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockLockMessageParamPtrs{}
+	}
+	mmLockMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmLockMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmLockMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.LockMessage
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Inspect(f func(ctx context.Context, messageID int64)) *mMessageRepositoryMockLockMessage {
+	if mmLockMessage.mock.inspectFuncLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.LockMessage")
+	}
+
+	mmLockMessage.mock.inspectFuncLockMessage = f
+
+	return mmLockMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.LockMessage
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	if mmLockMessage.defaultExpectation == nil {
+		mmLockMessage.defaultExpectation = &MessageRepositoryMockLockMessageExpectation{mock: mmLockMessage.mock}
+	}
+	mmLockMessage.defaultExpectation.results = &MessageRepositoryMockLockMessageResults{mp1, err}
+	mmLockMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.LockMessage method
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Set(f func(ctx context.Context, messageID int64) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmLockMessage.defaultExpectation != nil {
+		mmLockMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.LockMessage method")
+	}
+
+	if len(mmLockMessage.expectations) > 0 {
+		mmLockMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.LockMessage method")
+	}
+
+	mmLockMessage.mock.funcLockMessage = f
+	mmLockMessage.mock.funcLockMessageOrigin = minimock.CallerInfo(1)
+	return mmLockMessage.mock
+}
+
+// When sets expectation for the MessageRepository.LockMessage which will trigger the result defined by the following
+// Then helper
+func (mmLockMessage *mMessageRepositoryMockLockMessage) When(ctx context.Context, messageID int64) *MessageRepositoryMockLockMessageExpectation {
+	if mmLockMessage.mock.funcLockMessage != nil {
+		mmLockMessage.mock.t.Fatalf("MessageRepositoryMock.LockMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockLockMessageExpectation{
+		mock:               mmLockMessage.mock,
+		params:             &MessageRepositoryMockLockMessageParams{ctx, messageID},
+		expectationOrigins: MessageRepositoryMockLockMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockMessage.expectations = append(mmLockMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.LockMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockLockMessageExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockLockMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.LockMessage should be invoked
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Times(n uint64) *mMessageRepositoryMockLockMessage {
+	if n == 0 {
+		mmLockMessage.mock.t.Fatalf("Times of MessageRepositoryMock.LockMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockMessage.expectedInvocations, n)
+	mmLockMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockMessage
+}
+
+func (mmLockMessage *mMessageRepositoryMockLockMessage) invocationsDone() bool {
+	if len(mmLockMessage.expectations) == 0 && mmLockMessage.defaultExpectation == nil && mmLockMessage.mock.funcLockMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockMessage.mock.afterLockMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockMessage implements mm_repository.MessageRepository
+func (mmLockMessage *MessageRepositoryMock) LockMessage(ctx context.Context, messageID int64) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmLockMessage.beforeLockMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockMessage.afterLockMessageCounter, 1)
+
+	mmLockMessage.t.Helper()
+
+	if mmLockMessage.inspectFuncLockMessage != nil {
+		mmLockMessage.inspectFuncLockMessage(ctx, messageID)
+	}
+
+	mm_params := MessageRepositoryMockLockMessageParams{ctx, messageID}
+
+	// Record call args
+	mmLockMessage.LockMessageMock.mutex.Lock()
+	mmLockMessage.LockMessageMock.callArgs = append(mmLockMessage.LockMessageMock.callArgs, &mm_params)
+	mmLockMessage.LockMessageMock.mutex.Unlock()
+
+	for _, e := range mmLockMessage.LockMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmLockMessage.LockMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockMessage.LockMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockMessage.LockMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmLockMessage.LockMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockLockMessageParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockMessage.t.Errorf("MessageRepositoryMock.LockMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmLockMessage.t.Errorf("MessageRepositoryMock.LockMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockMessage.t.Errorf("MessageRepositoryMock.LockMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockMessage.LockMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockMessage.LockMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockMessage.t.Fatal("No results are set for the MessageRepositoryMock.LockMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmLockMessage.funcLockMessage != nil {
+		return mmLockMessage.funcLockMessage(ctx, messageID)
+	}
+	mmLockMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.LockMessage. %v %v", ctx, messageID)
+	return
+}
+
+// LockMessageAfterCounter returns a count of finished MessageRepositoryMock.LockMessage invocations
+func (mmLockMessage *MessageRepositoryMock) LockMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMessage.afterLockMessageCounter)
+}
+
+// LockMessageBeforeCounter returns a count of MessageRepositoryMock.LockMessage invocations
+func (mmLockMessage *MessageRepositoryMock) LockMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockMessage.beforeLockMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.LockMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockMessage *mMessageRepositoryMockLockMessage) Calls() []*MessageRepositoryMockLockMessageParams {
+	mmLockMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockLockMessageParams, len(mmLockMessage.callArgs))
+	copy(argCopy, mmLockMessage.callArgs)
+
+	mmLockMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockMessageDone returns true if the count of the LockMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockLockMessageDone() bool {
+	if m.LockMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockMessageMock.invocationsDone()
+}
+
+// MinimockLockMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockLockMessageInspect() {
+	for _, e := range m.LockMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockMessageCounter := mm_atomic.LoadUint64(&m.afterLockMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockMessageMock.defaultExpectation != nil && afterLockMessageCounter < 1 {
+		if m.LockMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockMessage at\n%s", m.LockMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.LockMessage at\n%s with params: %#v", m.LockMessageMock.defaultExpectation.expectationOrigins.origin, *m.LockMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockMessage != nil && afterLockMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.LockMessage at\n%s", m.funcLockMessageOrigin)
+	}
+
+	if !m.LockMessageMock.invocationsDone() && afterLockMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.LockMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockMessageMock.expectedInvocations), m.LockMessageMock.expectedInvocationsOrigin, afterLockMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockPurgeMessages struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockPurgeMessagesExpectation
+	expectations       []*MessageRepositoryMockPurgeMessagesExpectation
+
+	callArgs []*MessageRepositoryMockPurgeMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockPurgeMessagesExpectation specifies expectation struct of the MessageRepository.PurgeMessages
+type MessageRepositoryMockPurgeMessagesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockPurgeMessagesParams
+	paramPtrs          *MessageRepositoryMockPurgeMessagesParamPtrs
+	expectationOrigins MessageRepositoryMockPurgeMessagesExpectationOrigins
+	results            *MessageRepositoryMockPurgeMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockPurgeMessagesParams contains parameters of the MessageRepository.PurgeMessages
+type MessageRepositoryMockPurgeMessagesParams struct {
+	ctx context.Context
+	ids []int64
+}
+
+// MessageRepositoryMockPurgeMessagesParamPtrs contains pointers to parameters of the MessageRepository.PurgeMessages
+type MessageRepositoryMockPurgeMessagesParamPtrs struct {
+	ctx *context.Context
+	ids *[]int64
+}
+
+// MessageRepositoryMockPurgeMessagesResults contains results of the MessageRepository.PurgeMessages
+type MessageRepositoryMockPurgeMessagesResults struct {
+	err error
+}
+
+// MessageRepositoryMockPurgeMessagesOrigins contains origins of expectations of the MessageRepository.PurgeMessages
+type MessageRepositoryMockPurgeMessagesExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originIds string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Optional() *mMessageRepositoryMockPurgeMessages {
+	mmPurgeMessages.optional = true
+	return mmPurgeMessages
+}
+
+// Expect sets up expected params for MessageRepository.PurgeMessages
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Expect(ctx context.Context, ids []int64) *mMessageRepositoryMockPurgeMessages {
+	if mmPurgeMessages.mock.funcPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Set")
+	}
+
+	if mmPurgeMessages.defaultExpectation == nil {
+		mmPurgeMessages.defaultExpectation = &MessageRepositoryMockPurgeMessagesExpectation{}
+	}
+
+	if mmPurgeMessages.defaultExpectation.paramPtrs != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by ExpectParams functions")
+	}
+
+	mmPurgeMessages.defaultExpectation.params = &MessageRepositoryMockPurgeMessagesParams{ctx, ids}
+	mmPurgeMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPurgeMessages.expectations {
+		if minimock.Equal(e.params, mmPurgeMessages.defaultExpectation.params) {
+			mmPurgeMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPurgeMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmPurgeMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.PurgeMessages
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockPurgeMessages {
+	if mmPurgeMessages.mock.funcPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Set")
+	}
+
+	if mmPurgeMessages.defaultExpectation == nil {
+		mmPurgeMessages.defaultExpectation = &MessageRepositoryMockPurgeMessagesExpectation{}
+	}
+
+	if mmPurgeMessages.defaultExpectation.params != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Expect")
+	}
+
+	if mmPurgeMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockPurgeMessagesParamPtrs{}
+	}
+	mmPurgeMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPurgeMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPurgeMessages
+}
+
+// ExpectIdsParam2 sets up expected param ids for MessageRepository.PurgeMessages
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) ExpectIdsParam2(ids []int64) *mMessageRepositoryMockPurgeMessages {
+	if mmPurgeMessages.mock.funcPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Set")
+	}
+
+	if mmPurgeMessages.defaultExpectation == nil {
+		mmPurgeMessages.defaultExpectation = &MessageRepositoryMockPurgeMessagesExpectation{}
+	}
+
+	if mmPurgeMessages.defaultExpectation.params != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Expect")
+	}
+
+	if mmPurgeMessages.defaultExpectation.paramPtrs == nil {
+		mmPurgeMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockPurgeMessagesParamPtrs{}
+	}
+	mmPurgeMessages.defaultExpectation.paramPtrs.ids = &ids
+	mmPurgeMessages.defaultExpectation.expectationOrigins.originIds = minimock.CallerInfo(1)
+
+	return mmPurgeMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.PurgeMessages
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Inspect(f func(ctx context.Context, ids []int64)) *mMessageRepositoryMockPurgeMessages {
+	if mmPurgeMessages.mock.inspectFuncPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.PurgeMessages")
+	}
+
+	mmPurgeMessages.mock.inspectFuncPurgeMessages = f
+
+	return mmPurgeMessages
+}
+
+// Return sets up results that will be returned by MessageRepository.PurgeMessages
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Return(err error) *MessageRepositoryMock {
+	if mmPurgeMessages.mock.funcPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Set")
+	}
+
+	if mmPurgeMessages.defaultExpectation == nil {
+		mmPurgeMessages.defaultExpectation = &MessageRepositoryMockPurgeMessagesExpectation{mock: mmPurgeMessages.mock}
+	}
+	mmPurgeMessages.defaultExpectation.results = &MessageRepositoryMockPurgeMessagesResults{err}
+	mmPurgeMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPurgeMessages.mock
+}
+
+// Set uses given function f to mock the MessageRepository.PurgeMessages method
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Set(f func(ctx context.Context, ids []int64) (err error)) *MessageRepositoryMock {
+	if mmPurgeMessages.defaultExpectation != nil {
+		mmPurgeMessages.mock.t.Fatalf("Default expectation is already set for the MessageRepository.PurgeMessages method")
+	}
+
+	if len(mmPurgeMessages.expectations) > 0 {
+		mmPurgeMessages.mock.t.Fatalf("Some expectations are already set for the MessageRepository.PurgeMessages method")
+	}
+
+	mmPurgeMessages.mock.funcPurgeMessages = f
+	mmPurgeMessages.mock.funcPurgeMessagesOrigin = minimock.CallerInfo(1)
+	return mmPurgeMessages.mock
+}
+
+// When sets expectation for the MessageRepository.PurgeMessages which will trigger the result defined by the following
+// Then helper
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) When(ctx context.Context, ids []int64) *MessageRepositoryMockPurgeMessagesExpectation {
+	if mmPurgeMessages.mock.funcPurgeMessages != nil {
+		mmPurgeMessages.mock.t.Fatalf("MessageRepositoryMock.PurgeMessages mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockPurgeMessagesExpectation{
+		mock:               mmPurgeMessages.mock,
+		params:             &MessageRepositoryMockPurgeMessagesParams{ctx, ids},
+		expectationOrigins: MessageRepositoryMockPurgeMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPurgeMessages.expectations = append(mmPurgeMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.PurgeMessages return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockPurgeMessagesExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockPurgeMessagesResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.PurgeMessages should be invoked
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Times(n uint64) *mMessageRepositoryMockPurgeMessages {
+	if n == 0 {
+		mmPurgeMessages.mock.t.Fatalf("Times of MessageRepositoryMock.PurgeMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPurgeMessages.expectedInvocations, n)
+	mmPurgeMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPurgeMessages
+}
+
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) invocationsDone() bool {
+	if len(mmPurgeMessages.expectations) == 0 && mmPurgeMessages.defaultExpectation == nil && mmPurgeMessages.mock.funcPurgeMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPurgeMessages.mock.afterPurgeMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPurgeMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PurgeMessages implements mm_repository.MessageRepository
+func (mmPurgeMessages *MessageRepositoryMock) PurgeMessages(ctx context.Context, ids []int64) (err error) {
+	mm_atomic.AddUint64(&mmPurgeMessages.beforePurgeMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPurgeMessages.afterPurgeMessagesCounter, 1)
+
+	mmPurgeMessages.t.Helper()
+
+	if mmPurgeMessages.inspectFuncPurgeMessages != nil {
+		mmPurgeMessages.inspectFuncPurgeMessages(ctx, ids)
+	}
+
+	mm_params := MessageRepositoryMockPurgeMessagesParams{ctx, ids}
+
+	// Record call args
+	mmPurgeMessages.PurgeMessagesMock.mutex.Lock()
+	mmPurgeMessages.PurgeMessagesMock.callArgs = append(mmPurgeMessages.PurgeMessagesMock.callArgs, &mm_params)
+	mmPurgeMessages.PurgeMessagesMock.mutex.Unlock()
+
+	for _, e := range mmPurgeMessages.PurgeMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPurgeMessages.PurgeMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPurgeMessages.PurgeMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPurgeMessages.PurgeMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmPurgeMessages.PurgeMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockPurgeMessagesParams{ctx, ids}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPurgeMessages.t.Errorf("MessageRepositoryMock.PurgeMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeMessages.PurgeMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.ids != nil && !minimock.Equal(*mm_want_ptrs.ids, mm_got.ids) {
+				mmPurgeMessages.t.Errorf("MessageRepositoryMock.PurgeMessages got unexpected parameter ids, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPurgeMessages.PurgeMessagesMock.defaultExpectation.expectationOrigins.originIds, *mm_want_ptrs.ids, mm_got.ids, minimock.Diff(*mm_want_ptrs.ids, mm_got.ids))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPurgeMessages.t.Errorf("MessageRepositoryMock.PurgeMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPurgeMessages.PurgeMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPurgeMessages.PurgeMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPurgeMessages.t.Fatal("No results are set for the MessageRepositoryMock.PurgeMessages")
+		}
+		return (*mm_results).err
+	}
+	if mmPurgeMessages.funcPurgeMessages != nil {
+		return mmPurgeMessages.funcPurgeMessages(ctx, ids)
+	}
+	mmPurgeMessages.t.Fatalf("Unexpected call to MessageRepositoryMock.PurgeMessages. %v %v", ctx, ids)
+	return
+}
+
+// PurgeMessagesAfterCounter returns a count of finished MessageRepositoryMock.PurgeMessages invocations
+func (mmPurgeMessages *MessageRepositoryMock) PurgeMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeMessages.afterPurgeMessagesCounter)
+}
+
+// PurgeMessagesBeforeCounter returns a count of MessageRepositoryMock.PurgeMessages invocations
+func (mmPurgeMessages *MessageRepositoryMock) PurgeMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPurgeMessages.beforePurgeMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.PurgeMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPurgeMessages *mMessageRepositoryMockPurgeMessages) Calls() []*MessageRepositoryMockPurgeMessagesParams {
+	mmPurgeMessages.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockPurgeMessagesParams, len(mmPurgeMessages.callArgs))
+	copy(argCopy, mmPurgeMessages.callArgs)
+
+	mmPurgeMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPurgeMessagesDone returns true if the count of the PurgeMessages invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockPurgeMessagesDone() bool {
+	if m.PurgeMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PurgeMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PurgeMessagesMock.invocationsDone()
+}
+
+// MinimockPurgeMessagesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockPurgeMessagesInspect() {
+	for _, e := range m.PurgeMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.PurgeMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPurgeMessagesCounter := mm_atomic.LoadUint64(&m.afterPurgeMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PurgeMessagesMock.defaultExpectation != nil && afterPurgeMessagesCounter < 1 {
+		if m.PurgeMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.PurgeMessages at\n%s", m.PurgeMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.PurgeMessages at\n%s with params: %#v", m.PurgeMessagesMock.defaultExpectation.expectationOrigins.origin, *m.PurgeMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPurgeMessages != nil && afterPurgeMessagesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.PurgeMessages at\n%s", m.funcPurgeMessagesOrigin)
+	}
+
+	if !m.PurgeMessagesMock.invocationsDone() && afterPurgeMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.PurgeMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PurgeMessagesMock.expectedInvocations), m.PurgeMessagesMock.expectedInvocationsOrigin, afterPurgeMessagesCounter)
+	}
+}
+
+type mMessageRepositoryMockRecountReplies struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockRecountRepliesExpectation
+	expectations       []*MessageRepositoryMockRecountRepliesExpectation
+
+	callArgs []*MessageRepositoryMockRecountRepliesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockRecountRepliesExpectation specifies expectation struct of the MessageRepository.RecountReplies
+type MessageRepositoryMockRecountRepliesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockRecountRepliesParams
+	paramPtrs          *MessageRepositoryMockRecountRepliesParamPtrs
+	expectationOrigins MessageRepositoryMockRecountRepliesExpectationOrigins
+	results            *MessageRepositoryMockRecountRepliesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockRecountRepliesParams contains parameters of the MessageRepository.RecountReplies
+type MessageRepositoryMockRecountRepliesParams struct {
+	ctx     context.Context
+	rootIDs []int64
+}
+
+// MessageRepositoryMockRecountRepliesParamPtrs contains pointers to parameters of the MessageRepository.RecountReplies
+type MessageRepositoryMockRecountRepliesParamPtrs struct {
+	ctx     *context.Context
+	rootIDs *[]int64
+}
+
+// MessageRepositoryMockRecountRepliesResults contains results of the MessageRepository.RecountReplies
+type MessageRepositoryMockRecountRepliesResults struct {
+	err error
+}
+
+// MessageRepositoryMockRecountRepliesOrigins contains origins of expectations of the MessageRepository.RecountReplies
+type MessageRepositoryMockRecountRepliesExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originRootIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Optional() *mMessageRepositoryMockRecountReplies {
+	mmRecountReplies.optional = true
+	return mmRecountReplies
+}
+
+// Expect sets up expected params for MessageRepository.RecountReplies
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Expect(ctx context.Context, rootIDs []int64) *mMessageRepositoryMockRecountReplies {
+	if mmRecountReplies.mock.funcRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Set")
+	}
+
+	if mmRecountReplies.defaultExpectation == nil {
+		mmRecountReplies.defaultExpectation = &MessageRepositoryMockRecountRepliesExpectation{}
+	}
+
+	if mmRecountReplies.defaultExpectation.paramPtrs != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by ExpectParams functions")
+	}
+
+	mmRecountReplies.defaultExpectation.params = &MessageRepositoryMockRecountRepliesParams{ctx, rootIDs}
+	mmRecountReplies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecountReplies.expectations {
+		if minimock.Equal(e.params, mmRecountReplies.defaultExpectation.params) {
+			mmRecountReplies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecountReplies.defaultExpectation.params)
+		}
+	}
+
+	return mmRecountReplies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.RecountReplies
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockRecountReplies {
+	if mmRecountReplies.mock.funcRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Set")
+	}
+
+	if mmRecountReplies.defaultExpectation == nil {
+		mmRecountReplies.defaultExpectation = &MessageRepositoryMockRecountRepliesExpectation{}
+	}
+
+	if mmRecountReplies.defaultExpectation.params != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Expect")
+	}
+
+	if mmRecountReplies.defaultExpectation.paramPtrs == nil {
+		mmRecountReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockRecountRepliesParamPtrs{}
+	}
+	mmRecountReplies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecountReplies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecountReplies
+}
+
+// ExpectRootIDsParam2 sets up expected param rootIDs for MessageRepository.RecountReplies
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) ExpectRootIDsParam2(rootIDs []int64) *mMessageRepositoryMockRecountReplies {
+	if mmRecountReplies.mock.funcRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Set")
+	}
+
+	if mmRecountReplies.defaultExpectation == nil {
+		mmRecountReplies.defaultExpectation = &MessageRepositoryMockRecountRepliesExpectation{}
+	}
+
+	if mmRecountReplies.defaultExpectation.params != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Expect")
+	}
+
+	if mmRecountReplies.defaultExpectation.paramPtrs == nil {
+		mmRecountReplies.defaultExpectation.paramPtrs = &MessageRepositoryMockRecountRepliesParamPtrs{}
+	}
+	mmRecountReplies.defaultExpectation.paramPtrs.rootIDs = &rootIDs
+	mmRecountReplies.defaultExpectation.expectationOrigins.originRootIDs = minimock.CallerInfo(1)
+
+	return mmRecountReplies
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.RecountReplies
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Inspect(f func(ctx context.Context, rootIDs []int64)) *mMessageRepositoryMockRecountReplies {
+	if mmRecountReplies.mock.inspectFuncRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.RecountReplies")
+	}
+
+	mmRecountReplies.mock.inspectFuncRecountReplies = f
+
+	return mmRecountReplies
+}
+
+// Return sets up results that will be returned by MessageRepository.RecountReplies
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Return(err error) *MessageRepositoryMock {
+	if mmRecountReplies.mock.funcRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Set")
+	}
+
+	if mmRecountReplies.defaultExpectation == nil {
+		mmRecountReplies.defaultExpectation = &MessageRepositoryMockRecountRepliesExpectation{mock: mmRecountReplies.mock}
+	}
+	mmRecountReplies.defaultExpectation.results = &MessageRepositoryMockRecountRepliesResults{err}
+	mmRecountReplies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecountReplies.mock
+}
+
+// Set uses given function f to mock the MessageRepository.RecountReplies method
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Set(f func(ctx context.Context, rootIDs []int64) (err error)) *MessageRepositoryMock {
+	if mmRecountReplies.defaultExpectation != nil {
+		mmRecountReplies.mock.t.Fatalf("Default expectation is already set for the MessageRepository.RecountReplies method")
+	}
+
+	if len(mmRecountReplies.expectations) > 0 {
+		mmRecountReplies.mock.t.Fatalf("Some expectations are already set for the MessageRepository.RecountReplies method")
+	}
+
+	mmRecountReplies.mock.funcRecountReplies = f
+	mmRecountReplies.mock.funcRecountRepliesOrigin = minimock.CallerInfo(1)
+	return mmRecountReplies.mock
+}
+
+// When sets expectation for the MessageRepository.RecountReplies which will trigger the result defined by the following
+// Then helper
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) When(ctx context.Context, rootIDs []int64) *MessageRepositoryMockRecountRepliesExpectation {
+	if mmRecountReplies.mock.funcRecountReplies != nil {
+		mmRecountReplies.mock.t.Fatalf("MessageRepositoryMock.RecountReplies mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockRecountRepliesExpectation{
+		mock:               mmRecountReplies.mock,
+		params:             &MessageRepositoryMockRecountRepliesParams{ctx, rootIDs},
+		expectationOrigins: MessageRepositoryMockRecountRepliesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecountReplies.expectations = append(mmRecountReplies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.RecountReplies return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockRecountRepliesExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockRecountRepliesResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.RecountReplies should be invoked
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Times(n uint64) *mMessageRepositoryMockRecountReplies {
+	if n == 0 {
+		mmRecountReplies.mock.t.Fatalf("Times of MessageRepositoryMock.RecountReplies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecountReplies.expectedInvocations, n)
+	mmRecountReplies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecountReplies
+}
+
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) invocationsDone() bool {
+	if len(mmRecountReplies.expectations) == 0 && mmRecountReplies.defaultExpectation == nil && mmRecountReplies.mock.funcRecountReplies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecountReplies.mock.afterRecountRepliesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecountReplies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RecountReplies implements mm_repository.MessageRepository
+func (mmRecountReplies *MessageRepositoryMock) RecountReplies(ctx context.Context, rootIDs []int64) (err error) {
+	mm_atomic.AddUint64(&mmRecountReplies.beforeRecountRepliesCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecountReplies.afterRecountRepliesCounter, 1)
+
+	mmRecountReplies.t.Helper()
+
+	if mmRecountReplies.inspectFuncRecountReplies != nil {
+		mmRecountReplies.inspectFuncRecountReplies(ctx, rootIDs)
+	}
+
+	mm_params := MessageRepositoryMockRecountRepliesParams{ctx, rootIDs}
+
+	// Record call args
+	mmRecountReplies.RecountRepliesMock.mutex.Lock()
+	mmRecountReplies.RecountRepliesMock.callArgs = append(mmRecountReplies.RecountRepliesMock.callArgs, &mm_params)
+	mmRecountReplies.RecountRepliesMock.mutex.Unlock()
+
+	for _, e := range mmRecountReplies.RecountRepliesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRecountReplies.RecountRepliesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecountReplies.RecountRepliesMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecountReplies.RecountRepliesMock.defaultExpectation.params
+		mm_want_ptrs := mmRecountReplies.RecountRepliesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockRecountRepliesParams{ctx, rootIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecountReplies.t.Errorf("MessageRepositoryMock.RecountReplies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecountReplies.RecountRepliesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.rootIDs != nil && !minimock.Equal(*mm_want_ptrs.rootIDs, mm_got.rootIDs) {
+				mmRecountReplies.t.Errorf("MessageRepositoryMock.RecountReplies got unexpected parameter rootIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecountReplies.RecountRepliesMock.defaultExpectation.expectationOrigins.originRootIDs, *mm_want_ptrs.rootIDs, mm_got.rootIDs, minimock.Diff(*mm_want_ptrs.rootIDs, mm_got.rootIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecountReplies.t.Errorf("MessageRepositoryMock.RecountReplies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecountReplies.RecountRepliesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecountReplies.RecountRepliesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecountReplies.t.Fatal("No results are set for the MessageRepositoryMock.RecountReplies")
+		}
+		return (*mm_results).err
+	}
+	if mmRecountReplies.funcRecountReplies != nil {
+		return mmRecountReplies.funcRecountReplies(ctx, rootIDs)
+	}
+	mmRecountReplies.t.Fatalf("Unexpected call to MessageRepositoryMock.RecountReplies. %v %v", ctx, rootIDs)
+	return
+}
+
+// RecountRepliesAfterCounter returns a count of finished MessageRepositoryMock.RecountReplies invocations
+func (mmRecountReplies *MessageRepositoryMock) RecountRepliesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecountReplies.afterRecountRepliesCounter)
+}
+
+// RecountRepliesBeforeCounter returns a count of MessageRepositoryMock.RecountReplies invocations
+func (mmRecountReplies *MessageRepositoryMock) RecountRepliesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecountReplies.beforeRecountRepliesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.RecountReplies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecountReplies *mMessageRepositoryMockRecountReplies) Calls() []*MessageRepositoryMockRecountRepliesParams {
+	mmRecountReplies.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockRecountRepliesParams, len(mmRecountReplies.callArgs))
+	copy(argCopy, mmRecountReplies.callArgs)
+
+	mmRecountReplies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecountRepliesDone returns true if the count of the RecountReplies invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockRecountRepliesDone() bool {
+	if m.RecountRepliesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecountRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecountRepliesMock.invocationsDone()
+}
+
+// MinimockRecountRepliesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockRecountRepliesInspect() {
+	for _, e := range m.RecountRepliesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RecountReplies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecountRepliesCounter := mm_atomic.LoadUint64(&m.afterRecountRepliesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecountRepliesMock.defaultExpectation != nil && afterRecountRepliesCounter < 1 {
+		if m.RecountRepliesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RecountReplies at\n%s", m.RecountRepliesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RecountReplies at\n%s with params: %#v", m.RecountRepliesMock.defaultExpectation.expectationOrigins.origin, *m.RecountRepliesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecountReplies != nil && afterRecountRepliesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.RecountReplies at\n%s", m.funcRecountRepliesOrigin)
+	}
+
+	if !m.RecountRepliesMock.invocationsDone() && afterRecountRepliesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.RecountReplies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecountRepliesMock.expectedInvocations), m.RecountRepliesMock.expectedInvocationsOrigin, afterRecountRepliesCounter)
+	}
+}
+
+type mMessageRepositoryMockRemovePin struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockRemovePinExpectation
+	expectations       []*MessageRepositoryMockRemovePinExpectation
+
+	callArgs []*MessageRepositoryMockRemovePinParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockRemovePinExpectation specifies expectation struct of the MessageRepository.RemovePin
+type MessageRepositoryMockRemovePinExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockRemovePinParams
+	paramPtrs          *MessageRepositoryMockRemovePinParamPtrs
+	expectationOrigins MessageRepositoryMockRemovePinExpectationOrigins
+	results            *MessageRepositoryMockRemovePinResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockRemovePinParams contains parameters of the MessageRepository.RemovePin
+type MessageRepositoryMockRemovePinParams struct {
+	ctx       context.Context
+	messageID int64
+}
+
+// MessageRepositoryMockRemovePinParamPtrs contains pointers to parameters of the MessageRepository.RemovePin
+type MessageRepositoryMockRemovePinParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+}
+
+// MessageRepositoryMockRemovePinResults contains results of the MessageRepository.RemovePin
+type MessageRepositoryMockRemovePinResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockRemovePinOrigins contains origins of expectations of the MessageRepository.RemovePin
+type MessageRepositoryMockRemovePinExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Optional() *mMessageRepositoryMockRemovePin {
+	mmRemovePin.optional = true
+	return mmRemovePin
+}
+
+// Expect sets up expected params for MessageRepository.RemovePin
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Expect(ctx context.Context, messageID int64) *mMessageRepositoryMockRemovePin {
+	if mmRemovePin.mock.funcRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Set")
+	}
+
+	if mmRemovePin.defaultExpectation == nil {
+		mmRemovePin.defaultExpectation = &MessageRepositoryMockRemovePinExpectation{}
+	}
+
+	if mmRemovePin.defaultExpectation.paramPtrs != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePin.defaultExpectation.params = &MessageRepositoryMockRemovePinParams{ctx, messageID}
+	mmRemovePin.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePin.expectations {
+		if minimock.Equal(e.params, mmRemovePin.defaultExpectation.params) {
+			mmRemovePin.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePin.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePin
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.RemovePin
+func (mmRemovePin *mMessageRepositoryMockRemovePin) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockRemovePin {
+	if mmRemovePin.mock.funcRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Set")
+	}
+
+	if mmRemovePin.defaultExpectation == nil {
+		mmRemovePin.defaultExpectation = &MessageRepositoryMockRemovePinExpectation{}
+	}
+
+	if mmRemovePin.defaultExpectation.params != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Expect")
+	}
+
+	if mmRemovePin.defaultExpectation.paramPtrs == nil {
+		mmRemovePin.defaultExpectation.paramPtrs = &MessageRepositoryMockRemovePinParamPtrs{}
+	}
+	mmRemovePin.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePin.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePin
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.RemovePin
+func (mmRemovePin *mMessageRepositoryMockRemovePin) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockRemovePin {
+	if mmRemovePin.mock.funcRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Set")
+	}
+
+	if mmRemovePin.defaultExpectation == nil {
+		mmRemovePin.defaultExpectation = &MessageRepositoryMockRemovePinExpectation{}
+	}
+
+	if mmRemovePin.defaultExpectation.params != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Expect")
+	}
+
+	if mmRemovePin.defaultExpectation.paramPtrs == nil {
+		mmRemovePin.defaultExpectation.paramPtrs = &MessageRepositoryMockRemovePinParamPtrs{}
+	}
+	mmRemovePin.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemovePin.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemovePin
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.RemovePin
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Inspect(f func(ctx context.Context, messageID int64)) *mMessageRepositoryMockRemovePin {
+	if mmRemovePin.mock.inspectFuncRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.RemovePin")
+	}
+
+	mmRemovePin.mock.inspectFuncRemovePin = f
+
+	return mmRemovePin
+}
+
+// Return sets up results that will be returned by MessageRepository.RemovePin
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmRemovePin.mock.funcRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Set")
+	}
+
+	if mmRemovePin.defaultExpectation == nil {
+		mmRemovePin.defaultExpectation = &MessageRepositoryMockRemovePinExpectation{mock: mmRemovePin.mock}
+	}
+	mmRemovePin.defaultExpectation.results = &MessageRepositoryMockRemovePinResults{b1, err}
+	mmRemovePin.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePin.mock
+}
+
+// Set uses given function f to mock the MessageRepository.RemovePin method
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Set(f func(ctx context.Context, messageID int64) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmRemovePin.defaultExpectation != nil {
+		mmRemovePin.mock.t.Fatalf("Default expectation is already set for the MessageRepository.RemovePin method")
+	}
+
+	if len(mmRemovePin.expectations) > 0 {
+		mmRemovePin.mock.t.Fatalf("Some expectations are already set for the MessageRepository.RemovePin method")
+	}
+
+	mmRemovePin.mock.funcRemovePin = f
+	mmRemovePin.mock.funcRemovePinOrigin = minimock.CallerInfo(1)
+	return mmRemovePin.mock
+}
+
+// When sets expectation for the MessageRepository.RemovePin which will trigger the result defined by the following
+// Then helper
+func (mmRemovePin *mMessageRepositoryMockRemovePin) When(ctx context.Context, messageID int64) *MessageRepositoryMockRemovePinExpectation {
+	if mmRemovePin.mock.funcRemovePin != nil {
+		mmRemovePin.mock.t.Fatalf("MessageRepositoryMock.RemovePin mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockRemovePinExpectation{
+		mock:               mmRemovePin.mock,
+		params:             &MessageRepositoryMockRemovePinParams{ctx, messageID},
+		expectationOrigins: MessageRepositoryMockRemovePinExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemovePin.expectations = append(mmRemovePin.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.RemovePin return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockRemovePinExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockRemovePinResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.RemovePin should be invoked
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Times(n uint64) *mMessageRepositoryMockRemovePin {
+	if n == 0 {
+		mmRemovePin.mock.t.Fatalf("Times of MessageRepositoryMock.RemovePin mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemovePin.expectedInvocations, n)
+	mmRemovePin.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePin
+}
+
+func (mmRemovePin *mMessageRepositoryMockRemovePin) invocationsDone() bool {
+	if len(mmRemovePin.expectations) == 0 && mmRemovePin.defaultExpectation == nil && mmRemovePin.mock.funcRemovePin == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePin.mock.afterRemovePinCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePin.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemovePin implements mm_repository.MessageRepository
+func (mmRemovePin *MessageRepositoryMock) RemovePin(ctx context.Context, messageID int64) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemovePin.beforeRemovePinCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePin.afterRemovePinCounter, 1)
+
+	mmRemovePin.t.Helper()
+
+	if mmRemovePin.inspectFuncRemovePin != nil {
+		mmRemovePin.inspectFuncRemovePin(ctx, messageID)
+	}
+
+	mm_params := MessageRepositoryMockRemovePinParams{ctx, messageID}
+
+	// Record call args
+	mmRemovePin.RemovePinMock.mutex.Lock()
+	mmRemovePin.RemovePinMock.callArgs = append(mmRemovePin.RemovePinMock.callArgs, &mm_params)
+	mmRemovePin.RemovePinMock.mutex.Unlock()
+
+	for _, e := range mmRemovePin.RemovePinMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemovePin.RemovePinMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePin.RemovePinMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePin.RemovePinMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePin.RemovePinMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockRemovePinParams{ctx, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemovePin.t.Errorf("MessageRepositoryMock.RemovePin got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePin.RemovePinMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemovePin.t.Errorf("MessageRepositoryMock.RemovePin got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePin.RemovePinMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemovePin.t.Errorf("MessageRepositoryMock.RemovePin got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePin.RemovePinMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemovePin.RemovePinMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemovePin.t.Fatal("No results are set for the MessageRepositoryMock.RemovePin")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemovePin.funcRemovePin != nil {
+		return mmRemovePin.funcRemovePin(ctx, messageID)
+	}
+	mmRemovePin.t.Fatalf("Unexpected call to MessageRepositoryMock.RemovePin. %v %v", ctx, messageID)
+	return
+}
+
+// RemovePinAfterCounter returns a count of finished MessageRepositoryMock.RemovePin invocations
+func (mmRemovePin *MessageRepositoryMock) RemovePinAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePin.afterRemovePinCounter)
+}
+
+// RemovePinBeforeCounter returns a count of MessageRepositoryMock.RemovePin invocations
+func (mmRemovePin *MessageRepositoryMock) RemovePinBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePin.beforeRemovePinCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.RemovePin.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemovePin *mMessageRepositoryMockRemovePin) Calls() []*MessageRepositoryMockRemovePinParams {
+	mmRemovePin.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockRemovePinParams, len(mmRemovePin.callArgs))
+	copy(argCopy, mmRemovePin.callArgs)
+
+	mmRemovePin.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemovePinDone returns true if the count of the RemovePin invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockRemovePinDone() bool {
+	if m.RemovePinMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemovePinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemovePinMock.invocationsDone()
+}
+
+// MinimockRemovePinInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockRemovePinInspect() {
+	for _, e := range m.RemovePinMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemovePin at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemovePinCounter := mm_atomic.LoadUint64(&m.afterRemovePinCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemovePinMock.defaultExpectation != nil && afterRemovePinCounter < 1 {
+		if m.RemovePinMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemovePin at\n%s", m.RemovePinMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemovePin at\n%s with params: %#v", m.RemovePinMock.defaultExpectation.expectationOrigins.origin, *m.RemovePinMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemovePin != nil && afterRemovePinCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.RemovePin at\n%s", m.funcRemovePinOrigin)
+	}
+
+	if !m.RemovePinMock.invocationsDone() && afterRemovePinCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.RemovePin at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePinMock.expectedInvocations), m.RemovePinMock.expectedInvocationsOrigin, afterRemovePinCounter)
+	}
+}
+
+type mMessageRepositoryMockRemoveReaction struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockRemoveReactionExpectation
+	expectations       []*MessageRepositoryMockRemoveReactionExpectation
+
+	callArgs []*MessageRepositoryMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockRemoveReactionExpectation specifies expectation struct of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockRemoveReactionParams
+	paramPtrs          *MessageRepositoryMockRemoveReactionParamPtrs
+	expectationOrigins MessageRepositoryMockRemoveReactionExpectationOrigins
+	results            *MessageRepositoryMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockRemoveReactionParams contains parameters of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionParams struct {
+	ctx       context.Context
+	messageID int64
+	username  string
+	emoji     string
+}
+
+// MessageRepositoryMockRemoveReactionParamPtrs contains pointers to parameters of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	username  *string
+	emoji     *string
+}
+
+// MessageRepositoryMockRemoveReactionResults contains results of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionResults struct {
+	b1  bool
+	err error
+}
+
+// MessageRepositoryMockRemoveReactionOrigins contains origins of expectations of the MessageRepository.RemoveReaction
+type MessageRepositoryMockRemoveReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originUsername  string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Optional() *mMessageRepositoryMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Expect(ctx context.Context, messageID int64, username string, emoji string) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &MessageRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectUsernameParam3 sets up expected param username for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectUsernameParam3(username string) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.username = &username
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) ExpectEmojiParam4(emoji string) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &MessageRepositoryMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Inspect(f func(ctx context.Context, messageID int64, username string, emoji string)) *mMessageRepositoryMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by MessageRepository.RemoveReaction
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Return(b1 bool, err error) *MessageRepositoryMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &MessageRepositoryMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &MessageRepositoryMockRemoveReactionResults{b1, err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the MessageRepository.RemoveReaction method
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Set(f func(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error)) *MessageRepositoryMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the MessageRepository.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the MessageRepository.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the MessageRepository.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) When(ctx context.Context, messageID int64, username string, emoji string) *MessageRepositoryMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("MessageRepositoryMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &MessageRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji},
+		expectationOrigins: MessageRepositoryMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockRemoveReactionExpectation) Then(b1 bool, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockRemoveReactionResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.RemoveReaction should be invoked
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Times(n uint64) *mMessageRepositoryMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of MessageRepositoryMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_repository.MessageRepository
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReaction(ctx context.Context, messageID int64, username string, emoji string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, messageID, username, emoji)
+	}
+
+	mm_params := MessageRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockRemoveReactionParams{ctx, messageID, username, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("MessageRepositoryMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the MessageRepositoryMock.RemoveReaction")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, messageID, username, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to MessageRepositoryMock.RemoveReaction. %v %v %v %v", ctx, messageID, username, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished MessageRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of MessageRepositoryMock.RemoveReaction invocations
+func (mmRemoveReaction *MessageRepositoryMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mMessageRepositoryMockRemoveReaction) Calls() []*MessageRepositoryMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mMessageRepositoryMockSearchMessages struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockSearchMessagesExpectation
+	expectations       []*MessageRepositoryMockSearchMessagesExpectation
+
+	callArgs []*MessageRepositoryMockSearchMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockSearchMessagesExpectation specifies expectation struct of the MessageRepository.SearchMessages
+type MessageRepositoryMockSearchMessagesExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockSearchMessagesParams
+	paramPtrs          *MessageRepositoryMockSearchMessagesParamPtrs
+	expectationOrigins MessageRepositoryMockSearchMessagesExpectationOrigins
+	results            *MessageRepositoryMockSearchMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockSearchMessagesParams contains parameters of the MessageRepository.SearchMessages
+type MessageRepositoryMockSearchMessagesParams struct {
+	ctx   context.Context
+	query *model.MessageSearchQuery
+}
+
+// MessageRepositoryMockSearchMessagesParamPtrs contains pointers to parameters of the MessageRepository.SearchMessages
+type MessageRepositoryMockSearchMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.MessageSearchQuery
+}
+
+// MessageRepositoryMockSearchMessagesResults contains results of the MessageRepository.SearchMessages
+type MessageRepositoryMockSearchMessagesResults struct {
+	spa1 []*model.SearchResult
+	err  error
+}
+
+// MessageRepositoryMockSearchMessagesOrigins contains origins of expectations of the MessageRepository.SearchMessages
+type MessageRepositoryMockSearchMessagesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Optional() *mMessageRepositoryMockSearchMessages {
+	mmSearchMessages.optional = true
+	return mmSearchMessages
+}
+
+// Expect sets up expected params for MessageRepository.SearchMessages
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Expect(ctx context.Context, query *model.MessageSearchQuery) *mMessageRepositoryMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &MessageRepositoryMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by ExpectParams functions")
+	}
+
+	mmSearchMessages.defaultExpectation.params = &MessageRepositoryMockSearchMessagesParams{ctx, query}
+	mmSearchMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchMessages.expectations {
+		if minimock.Equal(e.params, mmSearchMessages.defaultExpectation.params) {
+			mmSearchMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.SearchMessages
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &MessageRepositoryMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for MessageRepository.SearchMessages
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) ExpectQueryParam2(query *model.MessageSearchQuery) *mMessageRepositoryMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &MessageRepositoryMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &MessageRepositoryMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.query = &query
+	mmSearchMessages.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.SearchMessages
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Inspect(f func(ctx context.Context, query *model.MessageSearchQuery)) *mMessageRepositoryMockSearchMessages {
+	if mmSearchMessages.mock.inspectFuncSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.SearchMessages")
+	}
+
+	mmSearchMessages.mock.inspectFuncSearchMessages = f
+
+	return mmSearchMessages
+}
+
+// Return sets up results that will be returned by MessageRepository.SearchMessages
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Return(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &MessageRepositoryMockSearchMessagesExpectation{mock: mmSearchMessages.mock}
+	}
+	mmSearchMessages.defaultExpectation.results = &MessageRepositoryMockSearchMessagesResults{spa1, err}
+	mmSearchMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// Set uses given function f to mock the MessageRepository.SearchMessages method
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Set(f func(ctx context.Context, query *model.MessageSearchQuery) (spa1 []*model.SearchResult, err error)) *MessageRepositoryMock {
+	if mmSearchMessages.defaultExpectation != nil {
+		mmSearchMessages.mock.t.Fatalf("Default expectation is already set for the MessageRepository.SearchMessages method")
+	}
+
+	if len(mmSearchMessages.expectations) > 0 {
+		mmSearchMessages.mock.t.Fatalf("Some expectations are already set for the MessageRepository.SearchMessages method")
+	}
+
+	mmSearchMessages.mock.funcSearchMessages = f
+	mmSearchMessages.mock.funcSearchMessagesOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// When sets expectation for the MessageRepository.SearchMessages which will trigger the result defined by the following
+// Then helper
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) When(ctx context.Context, query *model.MessageSearchQuery) *MessageRepositoryMockSearchMessagesExpectation {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("MessageRepositoryMock.SearchMessages mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockSearchMessagesExpectation{
+		mock:               mmSearchMessages.mock,
+		params:             &MessageRepositoryMockSearchMessagesParams{ctx, query},
+		expectationOrigins: MessageRepositoryMockSearchMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchMessages.expectations = append(mmSearchMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.SearchMessages return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockSearchMessagesExpectation) Then(spa1 []*model.SearchResult, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSearchMessagesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.SearchMessages should be invoked
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Times(n uint64) *mMessageRepositoryMockSearchMessages {
+	if n == 0 {
+		mmSearchMessages.mock.t.Fatalf("Times of MessageRepositoryMock.SearchMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchMessages.expectedInvocations, n)
+	mmSearchMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages
+}
+
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) invocationsDone() bool {
+	if len(mmSearchMessages.expectations) == 0 && mmSearchMessages.defaultExpectation == nil && mmSearchMessages.mock.funcSearchMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchMessages.mock.afterSearchMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchMessages implements mm_repository.MessageRepository
+func (mmSearchMessages *MessageRepositoryMock) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) (spa1 []*model.SearchResult, err error) {
+	mm_atomic.AddUint64(&mmSearchMessages.beforeSearchMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchMessages.afterSearchMessagesCounter, 1)
+
+	mmSearchMessages.t.Helper()
+
+	if mmSearchMessages.inspectFuncSearchMessages != nil {
+		mmSearchMessages.inspectFuncSearchMessages(ctx, query)
+	}
+
+	mm_params := MessageRepositoryMockSearchMessagesParams{ctx, query}
+
+	// Record call args
+	mmSearchMessages.SearchMessagesMock.mutex.Lock()
+	mmSearchMessages.SearchMessagesMock.callArgs = append(mmSearchMessages.SearchMessagesMock.callArgs, &mm_params)
+	mmSearchMessages.SearchMessagesMock.mutex.Unlock()
+
+	for _, e := range mmSearchMessages.SearchMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmSearchMessages.SearchMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchMessages.SearchMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchMessages.SearchMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchMessages.SearchMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockSearchMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchMessages.t.Errorf("MessageRepositoryMock.SearchMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchMessages.t.Errorf("MessageRepositoryMock.SearchMessages got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchMessages.t.Errorf("MessageRepositoryMock.SearchMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchMessages.SearchMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchMessages.t.Fatal("No results are set for the MessageRepositoryMock.SearchMessages")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmSearchMessages.funcSearchMessages != nil {
+		return mmSearchMessages.funcSearchMessages(ctx, query)
+	}
+	mmSearchMessages.t.Fatalf("Unexpected call to MessageRepositoryMock.SearchMessages. %v %v", ctx, query)
+	return
+}
+
+// SearchMessagesAfterCounter returns a count of finished MessageRepositoryMock.SearchMessages invocations
+func (mmSearchMessages *MessageRepositoryMock) SearchMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.afterSearchMessagesCounter)
+}
+
+// SearchMessagesBeforeCounter returns a count of MessageRepositoryMock.SearchMessages invocations
+func (mmSearchMessages *MessageRepositoryMock) SearchMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.beforeSearchMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.SearchMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchMessages *mMessageRepositoryMockSearchMessages) Calls() []*MessageRepositoryMockSearchMessagesParams {
+	mmSearchMessages.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockSearchMessagesParams, len(mmSearchMessages.callArgs))
+	copy(argCopy, mmSearchMessages.callArgs)
+
+	mmSearchMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchMessagesDone returns true if the count of the SearchMessages invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockSearchMessagesDone() bool {
+	if m.SearchMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMessagesMock.invocationsDone()
+}
+
+// MinimockSearchMessagesInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockSearchMessagesInspect() {
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SearchMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchMessagesCounter := mm_atomic.LoadUint64(&m.afterSearchMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMessagesMock.defaultExpectation != nil && afterSearchMessagesCounter < 1 {
+		if m.SearchMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SearchMessages at\n%s", m.SearchMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SearchMessages at\n%s with params: %#v", m.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *m.SearchMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchMessages != nil && afterSearchMessagesCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.SearchMessages at\n%s", m.funcSearchMessagesOrigin)
+	}
+
+	if !m.SearchMessagesMock.invocationsDone() && afterSearchMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.SearchMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMessagesMock.expectedInvocations), m.SearchMessagesMock.expectedInvocationsOrigin, afterSearchMessagesCounter)
+	}
+}
+
+type mMessageRepositoryMockSendMessage struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockSendMessageExpectation
+	expectations       []*MessageRepositoryMockSendMessageExpectation
+
+	callArgs []*MessageRepositoryMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockSendMessageExpectation specifies expectation struct of the MessageRepository.SendMessage
+type MessageRepositoryMockSendMessageExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockSendMessageParams
+	paramPtrs          *MessageRepositoryMockSendMessageParamPtrs
+	expectationOrigins MessageRepositoryMockSendMessageExpectationOrigins
+	results            *MessageRepositoryMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockSendMessageParams contains parameters of the MessageRepository.SendMessage
+type MessageRepositoryMockSendMessageParams struct {
+	ctx context.Context
+	msg *model.Message
+}
+
+// MessageRepositoryMockSendMessageParamPtrs contains pointers to parameters of the MessageRepository.SendMessage
+type MessageRepositoryMockSendMessageParamPtrs struct {
+	ctx *context.Context
+	msg **model.Message
+}
+
+// MessageRepositoryMockSendMessageResults contains results of the MessageRepository.SendMessage
+type MessageRepositoryMockSendMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// MessageRepositoryMockSendMessageOrigins contains origins of expectations of the MessageRepository.SendMessage
+type MessageRepositoryMockSendMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Optional() *mMessageRepositoryMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for MessageRepository.SendMessage
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Expect(ctx context.Context, msg *model.Message) *mMessageRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &MessageRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &MessageRepositoryMockSendMessageParams{ctx, msg}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.SendMessage
+func (mmSendMessage *mMessageRepositoryMockSendMessage) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &MessageRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectMsgParam2 sets up expected param msg for MessageRepository.SendMessage
+func (mmSendMessage *mMessageRepositoryMockSendMessage) ExpectMsgParam2(msg *model.Message) *mMessageRepositoryMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &MessageRepositoryMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &MessageRepositoryMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.msg = &msg
+	mmSendMessage.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.SendMessage
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Inspect(f func(ctx context.Context, msg *model.Message)) *mMessageRepositoryMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by MessageRepository.SendMessage
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Return(mp1 *model.Message, err error) *MessageRepositoryMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &MessageRepositoryMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &MessageRepositoryMockSendMessageResults{mp1, err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the MessageRepository.SendMessage method
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Set(f func(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error)) *MessageRepositoryMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the MessageRepository.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the MessageRepository.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the MessageRepository.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mMessageRepositoryMockSendMessage) When(ctx context.Context, msg *model.Message) *MessageRepositoryMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("MessageRepositoryMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &MessageRepositoryMockSendMessageParams{ctx, msg},
+		expectationOrigins: MessageRepositoryMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.SendMessage return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockSendMessageExpectation) Then(mp1 *model.Message, err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSendMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.SendMessage should be invoked
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Times(n uint64) *mMessageRepositoryMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of MessageRepositoryMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mMessageRepositoryMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements mm_repository.MessageRepository
+func (mmSendMessage *MessageRepositoryMock) SendMessage(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, msg)
+	}
+
+	mm_params := MessageRepositoryMockSendMessageParams{ctx, msg}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockSendMessageParams{ctx, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("MessageRepositoryMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmSendMessage.t.Errorf("MessageRepositoryMock.SendMessage got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("MessageRepositoryMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the MessageRepositoryMock.SendMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, msg)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to MessageRepositoryMock.SendMessage. %v %v", ctx, msg)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished MessageRepositoryMock.SendMessage invocations
+func (mmSendMessage *MessageRepositoryMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of MessageRepositoryMock.SendMessage invocations
+func (mmSendMessage *MessageRepositoryMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mMessageRepositoryMockSendMessage) Calls() []*MessageRepositoryMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
+}
+
+type mMessageRepositoryMockSetMentions struct {
+	optional           bool
+	mock               *MessageRepositoryMock
+	defaultExpectation *MessageRepositoryMockSetMentionsExpectation
+	expectations       []*MessageRepositoryMockSetMentionsExpectation
+
+	callArgs []*MessageRepositoryMockSetMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// MessageRepositoryMockSetMentionsExpectation specifies expectation struct of the MessageRepository.SetMentions
+type MessageRepositoryMockSetMentionsExpectation struct {
+	mock               *MessageRepositoryMock
+	params             *MessageRepositoryMockSetMentionsParams
+	paramPtrs          *MessageRepositoryMockSetMentionsParamPtrs
+	expectationOrigins MessageRepositoryMockSetMentionsExpectationOrigins
+	results            *MessageRepositoryMockSetMentionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// MessageRepositoryMockSetMentionsParams contains parameters of the MessageRepository.SetMentions
+type MessageRepositoryMockSetMentionsParams struct {
+	ctx       context.Context
+	messageID int64
+	chatID    int64
+	usernames []string
+}
+
+// MessageRepositoryMockSetMentionsParamPtrs contains pointers to parameters of the MessageRepository.SetMentions
+type MessageRepositoryMockSetMentionsParamPtrs struct {
+	ctx       *context.Context
+	messageID *int64
+	chatID    *int64
+	usernames *[]string
+}
+
+// MessageRepositoryMockSetMentionsResults contains results of the MessageRepository.SetMentions
+type MessageRepositoryMockSetMentionsResults struct {
+	err error
+}
+
+// MessageRepositoryMockSetMentionsOrigins contains origins of expectations of the MessageRepository.SetMentions
+type MessageRepositoryMockSetMentionsExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originMessageID string
+	originChatID    string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Optional() *mMessageRepositoryMockSetMentions {
+	mmSetMentions.optional = true
+	return mmSetMentions
+}
+
+// Expect sets up expected params for MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Expect(ctx context.Context, messageID int64, chatID int64, usernames []string) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{}
+	}
+
+	if mmSetMentions.defaultExpectation.paramPtrs != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by ExpectParams functions")
+	}
+
+	mmSetMentions.defaultExpectation.params = &MessageRepositoryMockSetMentionsParams{ctx, messageID, chatID, usernames}
+	mmSetMentions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMentions.expectations {
+		if minimock.Equal(e.params, mmSetMentions.defaultExpectation.params) {
+			mmSetMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) ExpectCtxParam1(ctx context.Context) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{}
+	}
+
+	if mmSetMentions.defaultExpectation.params != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Expect")
+	}
+
+	if mmSetMentions.defaultExpectation.paramPtrs == nil {
+		mmSetMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockSetMentionsParamPtrs{}
+	}
+	mmSetMentions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMentions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMentions
+}
+
+// ExpectMessageIDParam2 sets up expected param messageID for MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) ExpectMessageIDParam2(messageID int64) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{}
+	}
+
+	if mmSetMentions.defaultExpectation.params != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Expect")
+	}
+
+	if mmSetMentions.defaultExpectation.paramPtrs == nil {
+		mmSetMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockSetMentionsParamPtrs{}
+	}
+	mmSetMentions.defaultExpectation.paramPtrs.messageID = &messageID
+	mmSetMentions.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmSetMentions
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) ExpectChatIDParam3(chatID int64) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{}
+	}
+
+	if mmSetMentions.defaultExpectation.params != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Expect")
+	}
+
+	if mmSetMentions.defaultExpectation.paramPtrs == nil {
+		mmSetMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockSetMentionsParamPtrs{}
+	}
+	mmSetMentions.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMentions.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSetMentions
+}
+
+// ExpectUsernamesParam4 sets up expected param usernames for MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) ExpectUsernamesParam4(usernames []string) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{}
+	}
+
+	if mmSetMentions.defaultExpectation.params != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Expect")
+	}
+
+	if mmSetMentions.defaultExpectation.paramPtrs == nil {
+		mmSetMentions.defaultExpectation.paramPtrs = &MessageRepositoryMockSetMentionsParamPtrs{}
+	}
+	mmSetMentions.defaultExpectation.paramPtrs.usernames = &usernames
+	mmSetMentions.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmSetMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Inspect(f func(ctx context.Context, messageID int64, chatID int64, usernames []string)) *mMessageRepositoryMockSetMentions {
+	if mmSetMentions.mock.inspectFuncSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("Inspect function is already set for MessageRepositoryMock.SetMentions")
+	}
+
+	mmSetMentions.mock.inspectFuncSetMentions = f
+
+	return mmSetMentions
+}
+
+// Return sets up results that will be returned by MessageRepository.SetMentions
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Return(err error) *MessageRepositoryMock {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	if mmSetMentions.defaultExpectation == nil {
+		mmSetMentions.defaultExpectation = &MessageRepositoryMockSetMentionsExpectation{mock: mmSetMentions.mock}
+	}
+	mmSetMentions.defaultExpectation.results = &MessageRepositoryMockSetMentionsResults{err}
+	mmSetMentions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMentions.mock
+}
+
+// Set uses given function f to mock the MessageRepository.SetMentions method
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Set(f func(ctx context.Context, messageID int64, chatID int64, usernames []string) (err error)) *MessageRepositoryMock {
+	if mmSetMentions.defaultExpectation != nil {
+		mmSetMentions.mock.t.Fatalf("Default expectation is already set for the MessageRepository.SetMentions method")
+	}
+
+	if len(mmSetMentions.expectations) > 0 {
+		mmSetMentions.mock.t.Fatalf("Some expectations are already set for the MessageRepository.SetMentions method")
+	}
+
+	mmSetMentions.mock.funcSetMentions = f
+	mmSetMentions.mock.funcSetMentionsOrigin = minimock.CallerInfo(1)
+	return mmSetMentions.mock
+}
+
+// When sets expectation for the MessageRepository.SetMentions which will trigger the result defined by the following
+// Then helper
+func (mmSetMentions *mMessageRepositoryMockSetMentions) When(ctx context.Context, messageID int64, chatID int64, usernames []string) *MessageRepositoryMockSetMentionsExpectation {
+	if mmSetMentions.mock.funcSetMentions != nil {
+		mmSetMentions.mock.t.Fatalf("MessageRepositoryMock.SetMentions mock is already set by Set")
+	}
+
+	expectation := &MessageRepositoryMockSetMentionsExpectation{
+		mock:               mmSetMentions.mock,
+		params:             &MessageRepositoryMockSetMentionsParams{ctx, messageID, chatID, usernames},
+		expectationOrigins: MessageRepositoryMockSetMentionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMentions.expectations = append(mmSetMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up MessageRepository.SetMentions return parameters for the expectation previously defined by the When method
+func (e *MessageRepositoryMockSetMentionsExpectation) Then(err error) *MessageRepositoryMock {
+	e.results = &MessageRepositoryMockSetMentionsResults{err}
+	return e.mock
+}
+
+// Times sets number of times MessageRepository.SetMentions should be invoked
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Times(n uint64) *mMessageRepositoryMockSetMentions {
+	if n == 0 {
+		mmSetMentions.mock.t.Fatalf("Times of MessageRepositoryMock.SetMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMentions.expectedInvocations, n)
+	mmSetMentions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMentions
+}
+
+func (mmSetMentions *mMessageRepositoryMockSetMentions) invocationsDone() bool {
+	if len(mmSetMentions.expectations) == 0 && mmSetMentions.defaultExpectation == nil && mmSetMentions.mock.funcSetMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMentions.mock.afterSetMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMentions implements mm_repository.MessageRepository
+func (mmSetMentions *MessageRepositoryMock) SetMentions(ctx context.Context, messageID int64, chatID int64, usernames []string) (err error) {
+	mm_atomic.AddUint64(&mmSetMentions.beforeSetMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMentions.afterSetMentionsCounter, 1)
+
+	mmSetMentions.t.Helper()
+
+	if mmSetMentions.inspectFuncSetMentions != nil {
+		mmSetMentions.inspectFuncSetMentions(ctx, messageID, chatID, usernames)
+	}
+
+	mm_params := MessageRepositoryMockSetMentionsParams{ctx, messageID, chatID, usernames}
+
+	// Record call args
+	mmSetMentions.SetMentionsMock.mutex.Lock()
+	mmSetMentions.SetMentionsMock.callArgs = append(mmSetMentions.SetMentionsMock.callArgs, &mm_params)
+	mmSetMentions.SetMentionsMock.mutex.Unlock()
+
+	for _, e := range mmSetMentions.SetMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMentions.SetMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMentions.SetMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMentions.SetMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMentions.SetMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := MessageRepositoryMockSetMentionsParams{ctx, messageID, chatID, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMentions.t.Errorf("MessageRepositoryMock.SetMentions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMentions.SetMentionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmSetMentions.t.Errorf("MessageRepositoryMock.SetMentions got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMentions.SetMentionsMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMentions.t.Errorf("MessageRepositoryMock.SetMentions got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMentions.SetMentionsMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmSetMentions.t.Errorf("MessageRepositoryMock.SetMentions got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMentions.SetMentionsMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMentions.t.Errorf("MessageRepositoryMock.SetMentions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMentions.SetMentionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMentions.SetMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMentions.t.Fatal("No results are set for the MessageRepositoryMock.SetMentions")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMentions.funcSetMentions != nil {
+		return mmSetMentions.funcSetMentions(ctx, messageID, chatID, usernames)
+	}
+	mmSetMentions.t.Fatalf("Unexpected call to MessageRepositoryMock.SetMentions. %v %v %v %v", ctx, messageID, chatID, usernames)
+	return
+}
+
+// SetMentionsAfterCounter returns a count of finished MessageRepositoryMock.SetMentions invocations
+func (mmSetMentions *MessageRepositoryMock) SetMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMentions.afterSetMentionsCounter)
+}
+
+// SetMentionsBeforeCounter returns a count of MessageRepositoryMock.SetMentions invocations
+func (mmSetMentions *MessageRepositoryMock) SetMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMentions.beforeSetMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to MessageRepositoryMock.SetMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMentions *mMessageRepositoryMockSetMentions) Calls() []*MessageRepositoryMockSetMentionsParams {
+	mmSetMentions.mutex.RLock()
+
+	argCopy := make([]*MessageRepositoryMockSetMentionsParams, len(mmSetMentions.callArgs))
+	copy(argCopy, mmSetMentions.callArgs)
+
+	mmSetMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMentionsDone returns true if the count of the SetMentions invocations corresponds
+// the number of defined expectations
+func (m *MessageRepositoryMock) MinimockSetMentionsDone() bool {
+	if m.SetMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMentionsMock.invocationsDone()
+}
+
+// MinimockSetMentionsInspect logs each unmet expectation
+func (m *MessageRepositoryMock) MinimockSetMentionsInspect() {
+	for _, e := range m.SetMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SetMentions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMentionsCounter := mm_atomic.LoadUint64(&m.afterSetMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMentionsMock.defaultExpectation != nil && afterSetMentionsCounter < 1 {
+		if m.SetMentionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SetMentions at\n%s", m.SetMentionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to MessageRepositoryMock.SetMentions at\n%s with params: %#v", m.SetMentionsMock.defaultExpectation.expectationOrigins.origin, *m.SetMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMentions != nil && afterSetMentionsCounter < 1 {
+		m.t.Errorf("Expected call to MessageRepositoryMock.SetMentions at\n%s", m.funcSetMentionsOrigin)
+	}
+
+	if !m.SetMentionsMock.invocationsDone() && afterSetMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to MessageRepositoryMock.SetMentions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMentionsMock.expectedInvocations), m.SetMentionsMock.expectedInvocationsOrigin, afterSetMentionsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *MessageRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddPinInspect()
+
+			m.MinimockAddReactionInspect()
+
+			m.MinimockAddReplyInspect()
+
+			m.MinimockCountPinsInspect()
+
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockFindByClientMessageIDInspect()
+
+			m.MinimockGetMessageInspect()
+
+			m.MinimockListMentionedUsersInspect()
+
+			m.MinimockListMentionsInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListMessagesByIDsInspect()
+
+			m.MinimockListMessagesBySeqInspect()
+
+			m.MinimockListPinnedInspect()
+
+			m.MinimockListReactionsInspect()
+
+			m.MinimockListRepliesInspect()
+
+			m.MinimockLockExpiredInspect()
+
+			m.MinimockLockMessageInspect()
+
+			m.MinimockPurgeMessagesInspect()
+
+			m.MinimockRecountRepliesInspect()
+
+			m.MinimockRemovePinInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockSearchMessagesInspect()
+
+			m.MinimockSendMessageInspect()
+
+			m.MinimockSetMentionsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *MessageRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *MessageRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddPinDone() &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockAddReplyDone() &&
+		m.MinimockCountPinsDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockFindByClientMessageIDDone() &&
+		m.MinimockGetMessageDone() &&
+		m.MinimockListMentionedUsersDone() &&
+		m.MinimockListMentionsDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListMessagesByIDsDone() &&
+		m.MinimockListMessagesBySeqDone() &&
+		m.MinimockListPinnedDone() &&
+		m.MinimockListReactionsDone() &&
+		m.MinimockListRepliesDone() &&
+		m.MinimockLockExpiredDone() &&
+		m.MinimockLockMessageDone() &&
+		m.MinimockPurgeMessagesDone() &&
+		m.MinimockRecountRepliesDone() &&
+		m.MinimockRemovePinDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockSearchMessagesDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSetMentionsDone()
+}
@@ -0,0 +1,1935 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.5). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i chat/chat_server/internal/repository.ScheduledRepository -o scheduled_repository_mock.go -n ScheduledRepositoryMock -p mocks
+
+import (
+	"chat/chat_server/internal/model"
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ScheduledRepositoryMock implements mm_repository.ScheduledRepository
+type ScheduledRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcClaimDue          func(ctx context.Context, now time.Time) (sp1 *model.ScheduledMessage, err error)
+	funcClaimDueOrigin    string
+	inspectFuncClaimDue   func(ctx context.Context, now time.Time)
+	afterClaimDueCounter  uint64
+	beforeClaimDueCounter uint64
+	ClaimDueMock          mScheduledRepositoryMockClaimDue
+
+	funcCreateScheduled          func(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error)
+	funcCreateScheduledOrigin    string
+	inspectFuncCreateScheduled   func(ctx context.Context, msg *model.ScheduledMessage)
+	afterCreateScheduledCounter  uint64
+	beforeCreateScheduledCounter uint64
+	CreateScheduledMock          mScheduledRepositoryMockCreateScheduled
+
+	funcDeleteScheduled          func(ctx context.Context, id int64, username string) (b1 bool, err error)
+	funcDeleteScheduledOrigin    string
+	inspectFuncDeleteScheduled   func(ctx context.Context, id int64, username string)
+	afterDeleteScheduledCounter  uint64
+	beforeDeleteScheduledCounter uint64
+	DeleteScheduledMock          mScheduledRepositoryMockDeleteScheduled
+
+	funcListScheduled          func(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error)
+	funcListScheduledOrigin    string
+	inspectFuncListScheduled   func(ctx context.Context, username string, chatID int64)
+	afterListScheduledCounter  uint64
+	beforeListScheduledCounter uint64
+	ListScheduledMock          mScheduledRepositoryMockListScheduled
+
+	funcPostponeScheduled          func(ctx context.Context, id int64, next time.Time) (err error)
+	funcPostponeScheduledOrigin    string
+	inspectFuncPostponeScheduled   func(ctx context.Context, id int64, next time.Time)
+	afterPostponeScheduledCounter  uint64
+	beforePostponeScheduledCounter uint64
+	PostponeScheduledMock          mScheduledRepositoryMockPostponeScheduled
+}
+
+// NewScheduledRepositoryMock returns a mock for mm_repository.ScheduledRepository
+func NewScheduledRepositoryMock(t minimock.Tester) *ScheduledRepositoryMock {
+	m := &ScheduledRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ClaimDueMock = mScheduledRepositoryMockClaimDue{mock: m}
+	m.ClaimDueMock.callArgs = []*ScheduledRepositoryMockClaimDueParams{}
+
+	m.CreateScheduledMock = mScheduledRepositoryMockCreateScheduled{mock: m}
+	m.CreateScheduledMock.callArgs = []*ScheduledRepositoryMockCreateScheduledParams{}
+
+	m.DeleteScheduledMock = mScheduledRepositoryMockDeleteScheduled{mock: m}
+	m.DeleteScheduledMock.callArgs = []*ScheduledRepositoryMockDeleteScheduledParams{}
+
+	m.ListScheduledMock = mScheduledRepositoryMockListScheduled{mock: m}
+	m.ListScheduledMock.callArgs = []*ScheduledRepositoryMockListScheduledParams{}
+
+	m.PostponeScheduledMock = mScheduledRepositoryMockPostponeScheduled{mock: m}
+	m.PostponeScheduledMock.callArgs = []*ScheduledRepositoryMockPostponeScheduledParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mScheduledRepositoryMockClaimDue struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockClaimDueExpectation
+	expectations       []*ScheduledRepositoryMockClaimDueExpectation
+
+	callArgs []*ScheduledRepositoryMockClaimDueParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockClaimDueExpectation specifies expectation struct of the ScheduledRepository.ClaimDue
+type ScheduledRepositoryMockClaimDueExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockClaimDueParams
+	paramPtrs          *ScheduledRepositoryMockClaimDueParamPtrs
+	expectationOrigins ScheduledRepositoryMockClaimDueExpectationOrigins
+	results            *ScheduledRepositoryMockClaimDueResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockClaimDueParams contains parameters of the ScheduledRepository.ClaimDue
+type ScheduledRepositoryMockClaimDueParams struct {
+	ctx context.Context
+	now time.Time
+}
+
+// ScheduledRepositoryMockClaimDueParamPtrs contains pointers to parameters of the ScheduledRepository.ClaimDue
+type ScheduledRepositoryMockClaimDueParamPtrs struct {
+	ctx *context.Context
+	now *time.Time
+}
+
+// ScheduledRepositoryMockClaimDueResults contains results of the ScheduledRepository.ClaimDue
+type ScheduledRepositoryMockClaimDueResults struct {
+	sp1 *model.ScheduledMessage
+	err error
+}
+
+// ScheduledRepositoryMockClaimDueOrigins contains origins of expectations of the ScheduledRepository.ClaimDue
+type ScheduledRepositoryMockClaimDueExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originNow string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Optional() *mScheduledRepositoryMockClaimDue {
+	mmClaimDue.optional = true
+	return mmClaimDue
+}
+
+// Expect sets up expected params for ScheduledRepository.ClaimDue
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Expect(ctx context.Context, now time.Time) *mScheduledRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &ScheduledRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by ExpectParams functions")
+	}
+
+	mmClaimDue.defaultExpectation.params = &ScheduledRepositoryMockClaimDueParams{ctx, now}
+	mmClaimDue.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimDue.expectations {
+		if minimock.Equal(e.params, mmClaimDue.defaultExpectation.params) {
+			mmClaimDue.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimDue.defaultExpectation.params)
+		}
+	}
+
+	return mmClaimDue
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.ClaimDue
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &ScheduledRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &ScheduledRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimDue.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// ExpectNowParam2 sets up expected param now for ScheduledRepository.ClaimDue
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) ExpectNowParam2(now time.Time) *mScheduledRepositoryMockClaimDue {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &ScheduledRepositoryMockClaimDueExpectation{}
+	}
+
+	if mmClaimDue.defaultExpectation.params != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Expect")
+	}
+
+	if mmClaimDue.defaultExpectation.paramPtrs == nil {
+		mmClaimDue.defaultExpectation.paramPtrs = &ScheduledRepositoryMockClaimDueParamPtrs{}
+	}
+	mmClaimDue.defaultExpectation.paramPtrs.now = &now
+	mmClaimDue.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmClaimDue
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.ClaimDue
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Inspect(f func(ctx context.Context, now time.Time)) *mScheduledRepositoryMockClaimDue {
+	if mmClaimDue.mock.inspectFuncClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.ClaimDue")
+	}
+
+	mmClaimDue.mock.inspectFuncClaimDue = f
+
+	return mmClaimDue
+}
+
+// Return sets up results that will be returned by ScheduledRepository.ClaimDue
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Return(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	if mmClaimDue.defaultExpectation == nil {
+		mmClaimDue.defaultExpectation = &ScheduledRepositoryMockClaimDueExpectation{mock: mmClaimDue.mock}
+	}
+	mmClaimDue.defaultExpectation.results = &ScheduledRepositoryMockClaimDueResults{sp1, err}
+	mmClaimDue.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimDue.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.ClaimDue method
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Set(f func(ctx context.Context, now time.Time) (sp1 *model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmClaimDue.defaultExpectation != nil {
+		mmClaimDue.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.ClaimDue method")
+	}
+
+	if len(mmClaimDue.expectations) > 0 {
+		mmClaimDue.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.ClaimDue method")
+	}
+
+	mmClaimDue.mock.funcClaimDue = f
+	mmClaimDue.mock.funcClaimDueOrigin = minimock.CallerInfo(1)
+	return mmClaimDue.mock
+}
+
+// When sets expectation for the ScheduledRepository.ClaimDue which will trigger the result defined by the following
+// Then helper
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) When(ctx context.Context, now time.Time) *ScheduledRepositoryMockClaimDueExpectation {
+	if mmClaimDue.mock.funcClaimDue != nil {
+		mmClaimDue.mock.t.Fatalf("ScheduledRepositoryMock.ClaimDue mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockClaimDueExpectation{
+		mock:               mmClaimDue.mock,
+		params:             &ScheduledRepositoryMockClaimDueParams{ctx, now},
+		expectationOrigins: ScheduledRepositoryMockClaimDueExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimDue.expectations = append(mmClaimDue.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.ClaimDue return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockClaimDueExpectation) Then(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockClaimDueResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.ClaimDue should be invoked
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Times(n uint64) *mScheduledRepositoryMockClaimDue {
+	if n == 0 {
+		mmClaimDue.mock.t.Fatalf("Times of ScheduledRepositoryMock.ClaimDue mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimDue.expectedInvocations, n)
+	mmClaimDue.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimDue
+}
+
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) invocationsDone() bool {
+	if len(mmClaimDue.expectations) == 0 && mmClaimDue.defaultExpectation == nil && mmClaimDue.mock.funcClaimDue == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimDue.mock.afterClaimDueCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimDue.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimDue implements mm_repository.ScheduledRepository
+func (mmClaimDue *ScheduledRepositoryMock) ClaimDue(ctx context.Context, now time.Time) (sp1 *model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmClaimDue.beforeClaimDueCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimDue.afterClaimDueCounter, 1)
+
+	mmClaimDue.t.Helper()
+
+	if mmClaimDue.inspectFuncClaimDue != nil {
+		mmClaimDue.inspectFuncClaimDue(ctx, now)
+	}
+
+	mm_params := ScheduledRepositoryMockClaimDueParams{ctx, now}
+
+	// Record call args
+	mmClaimDue.ClaimDueMock.mutex.Lock()
+	mmClaimDue.ClaimDueMock.callArgs = append(mmClaimDue.ClaimDueMock.callArgs, &mm_params)
+	mmClaimDue.ClaimDueMock.mutex.Unlock()
+
+	for _, e := range mmClaimDue.ClaimDueMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmClaimDue.ClaimDueMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimDue.ClaimDueMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimDue.ClaimDueMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimDue.ClaimDueMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockClaimDueParams{ctx, now}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimDue.t.Errorf("ScheduledRepositoryMock.ClaimDue got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmClaimDue.t.Errorf("ScheduledRepositoryMock.ClaimDue got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimDue.t.Errorf("ScheduledRepositoryMock.ClaimDue got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimDue.ClaimDueMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimDue.ClaimDueMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimDue.t.Fatal("No results are set for the ScheduledRepositoryMock.ClaimDue")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmClaimDue.funcClaimDue != nil {
+		return mmClaimDue.funcClaimDue(ctx, now)
+	}
+	mmClaimDue.t.Fatalf("Unexpected call to ScheduledRepositoryMock.ClaimDue. %v %v", ctx, now)
+	return
+}
+
+// ClaimDueAfterCounter returns a count of finished ScheduledRepositoryMock.ClaimDue invocations
+func (mmClaimDue *ScheduledRepositoryMock) ClaimDueAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDue.afterClaimDueCounter)
+}
+
+// ClaimDueBeforeCounter returns a count of ScheduledRepositoryMock.ClaimDue invocations
+func (mmClaimDue *ScheduledRepositoryMock) ClaimDueBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimDue.beforeClaimDueCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.ClaimDue.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimDue *mScheduledRepositoryMockClaimDue) Calls() []*ScheduledRepositoryMockClaimDueParams {
+	mmClaimDue.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockClaimDueParams, len(mmClaimDue.callArgs))
+	copy(argCopy, mmClaimDue.callArgs)
+
+	mmClaimDue.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimDueDone returns true if the count of the ClaimDue invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockClaimDueDone() bool {
+	if m.ClaimDueMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimDueMock.invocationsDone()
+}
+
+// MinimockClaimDueInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockClaimDueInspect() {
+	for _, e := range m.ClaimDueMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ClaimDue at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimDueCounter := mm_atomic.LoadUint64(&m.afterClaimDueCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimDueMock.defaultExpectation != nil && afterClaimDueCounter < 1 {
+		if m.ClaimDueMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ClaimDue at\n%s", m.ClaimDueMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ClaimDue at\n%s with params: %#v", m.ClaimDueMock.defaultExpectation.expectationOrigins.origin, *m.ClaimDueMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimDue != nil && afterClaimDueCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.ClaimDue at\n%s", m.funcClaimDueOrigin)
+	}
+
+	if !m.ClaimDueMock.invocationsDone() && afterClaimDueCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.ClaimDue at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimDueMock.expectedInvocations), m.ClaimDueMock.expectedInvocationsOrigin, afterClaimDueCounter)
+	}
+}
+
+type mScheduledRepositoryMockCreateScheduled struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockCreateScheduledExpectation
+	expectations       []*ScheduledRepositoryMockCreateScheduledExpectation
+
+	callArgs []*ScheduledRepositoryMockCreateScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockCreateScheduledExpectation specifies expectation struct of the ScheduledRepository.CreateScheduled
+type ScheduledRepositoryMockCreateScheduledExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockCreateScheduledParams
+	paramPtrs          *ScheduledRepositoryMockCreateScheduledParamPtrs
+	expectationOrigins ScheduledRepositoryMockCreateScheduledExpectationOrigins
+	results            *ScheduledRepositoryMockCreateScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockCreateScheduledParams contains parameters of the ScheduledRepository.CreateScheduled
+type ScheduledRepositoryMockCreateScheduledParams struct {
+	ctx context.Context
+	msg *model.ScheduledMessage
+}
+
+// ScheduledRepositoryMockCreateScheduledParamPtrs contains pointers to parameters of the ScheduledRepository.CreateScheduled
+type ScheduledRepositoryMockCreateScheduledParamPtrs struct {
+	ctx *context.Context
+	msg **model.ScheduledMessage
+}
+
+// ScheduledRepositoryMockCreateScheduledResults contains results of the ScheduledRepository.CreateScheduled
+type ScheduledRepositoryMockCreateScheduledResults struct {
+	sp1 *model.ScheduledMessage
+	err error
+}
+
+// ScheduledRepositoryMockCreateScheduledOrigins contains origins of expectations of the ScheduledRepository.CreateScheduled
+type ScheduledRepositoryMockCreateScheduledExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Optional() *mScheduledRepositoryMockCreateScheduled {
+	mmCreateScheduled.optional = true
+	return mmCreateScheduled
+}
+
+// Expect sets up expected params for ScheduledRepository.CreateScheduled
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Expect(ctx context.Context, msg *model.ScheduledMessage) *mScheduledRepositoryMockCreateScheduled {
+	if mmCreateScheduled.mock.funcCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Set")
+	}
+
+	if mmCreateScheduled.defaultExpectation == nil {
+		mmCreateScheduled.defaultExpectation = &ScheduledRepositoryMockCreateScheduledExpectation{}
+	}
+
+	if mmCreateScheduled.defaultExpectation.paramPtrs != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmCreateScheduled.defaultExpectation.params = &ScheduledRepositoryMockCreateScheduledParams{ctx, msg}
+	mmCreateScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateScheduled.expectations {
+		if minimock.Equal(e.params, mmCreateScheduled.defaultExpectation.params) {
+			mmCreateScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.CreateScheduled
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockCreateScheduled {
+	if mmCreateScheduled.mock.funcCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Set")
+	}
+
+	if mmCreateScheduled.defaultExpectation == nil {
+		mmCreateScheduled.defaultExpectation = &ScheduledRepositoryMockCreateScheduledExpectation{}
+	}
+
+	if mmCreateScheduled.defaultExpectation.params != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Expect")
+	}
+
+	if mmCreateScheduled.defaultExpectation.paramPtrs == nil {
+		mmCreateScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockCreateScheduledParamPtrs{}
+	}
+	mmCreateScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateScheduled
+}
+
+// ExpectMsgParam2 sets up expected param msg for ScheduledRepository.CreateScheduled
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) ExpectMsgParam2(msg *model.ScheduledMessage) *mScheduledRepositoryMockCreateScheduled {
+	if mmCreateScheduled.mock.funcCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Set")
+	}
+
+	if mmCreateScheduled.defaultExpectation == nil {
+		mmCreateScheduled.defaultExpectation = &ScheduledRepositoryMockCreateScheduledExpectation{}
+	}
+
+	if mmCreateScheduled.defaultExpectation.params != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Expect")
+	}
+
+	if mmCreateScheduled.defaultExpectation.paramPtrs == nil {
+		mmCreateScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockCreateScheduledParamPtrs{}
+	}
+	mmCreateScheduled.defaultExpectation.paramPtrs.msg = &msg
+	mmCreateScheduled.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmCreateScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.CreateScheduled
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Inspect(f func(ctx context.Context, msg *model.ScheduledMessage)) *mScheduledRepositoryMockCreateScheduled {
+	if mmCreateScheduled.mock.inspectFuncCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.CreateScheduled")
+	}
+
+	mmCreateScheduled.mock.inspectFuncCreateScheduled = f
+
+	return mmCreateScheduled
+}
+
+// Return sets up results that will be returned by ScheduledRepository.CreateScheduled
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Return(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmCreateScheduled.mock.funcCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Set")
+	}
+
+	if mmCreateScheduled.defaultExpectation == nil {
+		mmCreateScheduled.defaultExpectation = &ScheduledRepositoryMockCreateScheduledExpectation{mock: mmCreateScheduled.mock}
+	}
+	mmCreateScheduled.defaultExpectation.results = &ScheduledRepositoryMockCreateScheduledResults{sp1, err}
+	mmCreateScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateScheduled.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.CreateScheduled method
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Set(f func(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmCreateScheduled.defaultExpectation != nil {
+		mmCreateScheduled.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.CreateScheduled method")
+	}
+
+	if len(mmCreateScheduled.expectations) > 0 {
+		mmCreateScheduled.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.CreateScheduled method")
+	}
+
+	mmCreateScheduled.mock.funcCreateScheduled = f
+	mmCreateScheduled.mock.funcCreateScheduledOrigin = minimock.CallerInfo(1)
+	return mmCreateScheduled.mock
+}
+
+// When sets expectation for the ScheduledRepository.CreateScheduled which will trigger the result defined by the following
+// Then helper
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) When(ctx context.Context, msg *model.ScheduledMessage) *ScheduledRepositoryMockCreateScheduledExpectation {
+	if mmCreateScheduled.mock.funcCreateScheduled != nil {
+		mmCreateScheduled.mock.t.Fatalf("ScheduledRepositoryMock.CreateScheduled mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockCreateScheduledExpectation{
+		mock:               mmCreateScheduled.mock,
+		params:             &ScheduledRepositoryMockCreateScheduledParams{ctx, msg},
+		expectationOrigins: ScheduledRepositoryMockCreateScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateScheduled.expectations = append(mmCreateScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.CreateScheduled return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockCreateScheduledExpectation) Then(sp1 *model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockCreateScheduledResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.CreateScheduled should be invoked
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Times(n uint64) *mScheduledRepositoryMockCreateScheduled {
+	if n == 0 {
+		mmCreateScheduled.mock.t.Fatalf("Times of ScheduledRepositoryMock.CreateScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateScheduled.expectedInvocations, n)
+	mmCreateScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateScheduled
+}
+
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) invocationsDone() bool {
+	if len(mmCreateScheduled.expectations) == 0 && mmCreateScheduled.defaultExpectation == nil && mmCreateScheduled.mock.funcCreateScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateScheduled.mock.afterCreateScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateScheduled implements mm_repository.ScheduledRepository
+func (mmCreateScheduled *ScheduledRepositoryMock) CreateScheduled(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmCreateScheduled.beforeCreateScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateScheduled.afterCreateScheduledCounter, 1)
+
+	mmCreateScheduled.t.Helper()
+
+	if mmCreateScheduled.inspectFuncCreateScheduled != nil {
+		mmCreateScheduled.inspectFuncCreateScheduled(ctx, msg)
+	}
+
+	mm_params := ScheduledRepositoryMockCreateScheduledParams{ctx, msg}
+
+	// Record call args
+	mmCreateScheduled.CreateScheduledMock.mutex.Lock()
+	mmCreateScheduled.CreateScheduledMock.callArgs = append(mmCreateScheduled.CreateScheduledMock.callArgs, &mm_params)
+	mmCreateScheduled.CreateScheduledMock.mutex.Unlock()
+
+	for _, e := range mmCreateScheduled.CreateScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmCreateScheduled.CreateScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateScheduled.CreateScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateScheduled.CreateScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateScheduled.CreateScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockCreateScheduledParams{ctx, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateScheduled.t.Errorf("ScheduledRepositoryMock.CreateScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateScheduled.CreateScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmCreateScheduled.t.Errorf("ScheduledRepositoryMock.CreateScheduled got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateScheduled.CreateScheduledMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateScheduled.t.Errorf("ScheduledRepositoryMock.CreateScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateScheduled.CreateScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateScheduled.CreateScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateScheduled.t.Fatal("No results are set for the ScheduledRepositoryMock.CreateScheduled")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmCreateScheduled.funcCreateScheduled != nil {
+		return mmCreateScheduled.funcCreateScheduled(ctx, msg)
+	}
+	mmCreateScheduled.t.Fatalf("Unexpected call to ScheduledRepositoryMock.CreateScheduled. %v %v", ctx, msg)
+	return
+}
+
+// CreateScheduledAfterCounter returns a count of finished ScheduledRepositoryMock.CreateScheduled invocations
+func (mmCreateScheduled *ScheduledRepositoryMock) CreateScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateScheduled.afterCreateScheduledCounter)
+}
+
+// CreateScheduledBeforeCounter returns a count of ScheduledRepositoryMock.CreateScheduled invocations
+func (mmCreateScheduled *ScheduledRepositoryMock) CreateScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateScheduled.beforeCreateScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.CreateScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateScheduled *mScheduledRepositoryMockCreateScheduled) Calls() []*ScheduledRepositoryMockCreateScheduledParams {
+	mmCreateScheduled.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockCreateScheduledParams, len(mmCreateScheduled.callArgs))
+	copy(argCopy, mmCreateScheduled.callArgs)
+
+	mmCreateScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateScheduledDone returns true if the count of the CreateScheduled invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockCreateScheduledDone() bool {
+	if m.CreateScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateScheduledMock.invocationsDone()
+}
+
+// MinimockCreateScheduledInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockCreateScheduledInspect() {
+	for _, e := range m.CreateScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.CreateScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateScheduledCounter := mm_atomic.LoadUint64(&m.afterCreateScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateScheduledMock.defaultExpectation != nil && afterCreateScheduledCounter < 1 {
+		if m.CreateScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.CreateScheduled at\n%s", m.CreateScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.CreateScheduled at\n%s with params: %#v", m.CreateScheduledMock.defaultExpectation.expectationOrigins.origin, *m.CreateScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateScheduled != nil && afterCreateScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.CreateScheduled at\n%s", m.funcCreateScheduledOrigin)
+	}
+
+	if !m.CreateScheduledMock.invocationsDone() && afterCreateScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.CreateScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateScheduledMock.expectedInvocations), m.CreateScheduledMock.expectedInvocationsOrigin, afterCreateScheduledCounter)
+	}
+}
+
+type mScheduledRepositoryMockDeleteScheduled struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockDeleteScheduledExpectation
+	expectations       []*ScheduledRepositoryMockDeleteScheduledExpectation
+
+	callArgs []*ScheduledRepositoryMockDeleteScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockDeleteScheduledExpectation specifies expectation struct of the ScheduledRepository.DeleteScheduled
+type ScheduledRepositoryMockDeleteScheduledExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockDeleteScheduledParams
+	paramPtrs          *ScheduledRepositoryMockDeleteScheduledParamPtrs
+	expectationOrigins ScheduledRepositoryMockDeleteScheduledExpectationOrigins
+	results            *ScheduledRepositoryMockDeleteScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockDeleteScheduledParams contains parameters of the ScheduledRepository.DeleteScheduled
+type ScheduledRepositoryMockDeleteScheduledParams struct {
+	ctx      context.Context
+	id       int64
+	username string
+}
+
+// ScheduledRepositoryMockDeleteScheduledParamPtrs contains pointers to parameters of the ScheduledRepository.DeleteScheduled
+type ScheduledRepositoryMockDeleteScheduledParamPtrs struct {
+	ctx      *context.Context
+	id       *int64
+	username *string
+}
+
+// ScheduledRepositoryMockDeleteScheduledResults contains results of the ScheduledRepository.DeleteScheduled
+type ScheduledRepositoryMockDeleteScheduledResults struct {
+	b1  bool
+	err error
+}
+
+// ScheduledRepositoryMockDeleteScheduledOrigins contains origins of expectations of the ScheduledRepository.DeleteScheduled
+type ScheduledRepositoryMockDeleteScheduledExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Optional() *mScheduledRepositoryMockDeleteScheduled {
+	mmDeleteScheduled.optional = true
+	return mmDeleteScheduled
+}
+
+// Expect sets up expected params for ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Expect(ctx context.Context, id int64, username string) *mScheduledRepositoryMockDeleteScheduled {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	if mmDeleteScheduled.defaultExpectation == nil {
+		mmDeleteScheduled.defaultExpectation = &ScheduledRepositoryMockDeleteScheduledExpectation{}
+	}
+
+	if mmDeleteScheduled.defaultExpectation.paramPtrs != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteScheduled.defaultExpectation.params = &ScheduledRepositoryMockDeleteScheduledParams{ctx, id, username}
+	mmDeleteScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteScheduled.expectations {
+		if minimock.Equal(e.params, mmDeleteScheduled.defaultExpectation.params) {
+			mmDeleteScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockDeleteScheduled {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	if mmDeleteScheduled.defaultExpectation == nil {
+		mmDeleteScheduled.defaultExpectation = &ScheduledRepositoryMockDeleteScheduledExpectation{}
+	}
+
+	if mmDeleteScheduled.defaultExpectation.params != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Expect")
+	}
+
+	if mmDeleteScheduled.defaultExpectation.paramPtrs == nil {
+		mmDeleteScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockDeleteScheduledParamPtrs{}
+	}
+	mmDeleteScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteScheduled
+}
+
+// ExpectIdParam2 sets up expected param id for ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) ExpectIdParam2(id int64) *mScheduledRepositoryMockDeleteScheduled {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	if mmDeleteScheduled.defaultExpectation == nil {
+		mmDeleteScheduled.defaultExpectation = &ScheduledRepositoryMockDeleteScheduledExpectation{}
+	}
+
+	if mmDeleteScheduled.defaultExpectation.params != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Expect")
+	}
+
+	if mmDeleteScheduled.defaultExpectation.paramPtrs == nil {
+		mmDeleteScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockDeleteScheduledParamPtrs{}
+	}
+	mmDeleteScheduled.defaultExpectation.paramPtrs.id = &id
+	mmDeleteScheduled.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteScheduled
+}
+
+// ExpectUsernameParam3 sets up expected param username for ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) ExpectUsernameParam3(username string) *mScheduledRepositoryMockDeleteScheduled {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	if mmDeleteScheduled.defaultExpectation == nil {
+		mmDeleteScheduled.defaultExpectation = &ScheduledRepositoryMockDeleteScheduledExpectation{}
+	}
+
+	if mmDeleteScheduled.defaultExpectation.params != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Expect")
+	}
+
+	if mmDeleteScheduled.defaultExpectation.paramPtrs == nil {
+		mmDeleteScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockDeleteScheduledParamPtrs{}
+	}
+	mmDeleteScheduled.defaultExpectation.paramPtrs.username = &username
+	mmDeleteScheduled.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Inspect(f func(ctx context.Context, id int64, username string)) *mScheduledRepositoryMockDeleteScheduled {
+	if mmDeleteScheduled.mock.inspectFuncDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.DeleteScheduled")
+	}
+
+	mmDeleteScheduled.mock.inspectFuncDeleteScheduled = f
+
+	return mmDeleteScheduled
+}
+
+// Return sets up results that will be returned by ScheduledRepository.DeleteScheduled
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Return(b1 bool, err error) *ScheduledRepositoryMock {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	if mmDeleteScheduled.defaultExpectation == nil {
+		mmDeleteScheduled.defaultExpectation = &ScheduledRepositoryMockDeleteScheduledExpectation{mock: mmDeleteScheduled.mock}
+	}
+	mmDeleteScheduled.defaultExpectation.results = &ScheduledRepositoryMockDeleteScheduledResults{b1, err}
+	mmDeleteScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteScheduled.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.DeleteScheduled method
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Set(f func(ctx context.Context, id int64, username string) (b1 bool, err error)) *ScheduledRepositoryMock {
+	if mmDeleteScheduled.defaultExpectation != nil {
+		mmDeleteScheduled.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.DeleteScheduled method")
+	}
+
+	if len(mmDeleteScheduled.expectations) > 0 {
+		mmDeleteScheduled.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.DeleteScheduled method")
+	}
+
+	mmDeleteScheduled.mock.funcDeleteScheduled = f
+	mmDeleteScheduled.mock.funcDeleteScheduledOrigin = minimock.CallerInfo(1)
+	return mmDeleteScheduled.mock
+}
+
+// When sets expectation for the ScheduledRepository.DeleteScheduled which will trigger the result defined by the following
+// Then helper
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) When(ctx context.Context, id int64, username string) *ScheduledRepositoryMockDeleteScheduledExpectation {
+	if mmDeleteScheduled.mock.funcDeleteScheduled != nil {
+		mmDeleteScheduled.mock.t.Fatalf("ScheduledRepositoryMock.DeleteScheduled mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockDeleteScheduledExpectation{
+		mock:               mmDeleteScheduled.mock,
+		params:             &ScheduledRepositoryMockDeleteScheduledParams{ctx, id, username},
+		expectationOrigins: ScheduledRepositoryMockDeleteScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteScheduled.expectations = append(mmDeleteScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.DeleteScheduled return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockDeleteScheduledExpectation) Then(b1 bool, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockDeleteScheduledResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.DeleteScheduled should be invoked
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Times(n uint64) *mScheduledRepositoryMockDeleteScheduled {
+	if n == 0 {
+		mmDeleteScheduled.mock.t.Fatalf("Times of ScheduledRepositoryMock.DeleteScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteScheduled.expectedInvocations, n)
+	mmDeleteScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteScheduled
+}
+
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) invocationsDone() bool {
+	if len(mmDeleteScheduled.expectations) == 0 && mmDeleteScheduled.defaultExpectation == nil && mmDeleteScheduled.mock.funcDeleteScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteScheduled.mock.afterDeleteScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteScheduled implements mm_repository.ScheduledRepository
+func (mmDeleteScheduled *ScheduledRepositoryMock) DeleteScheduled(ctx context.Context, id int64, username string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeleteScheduled.beforeDeleteScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteScheduled.afterDeleteScheduledCounter, 1)
+
+	mmDeleteScheduled.t.Helper()
+
+	if mmDeleteScheduled.inspectFuncDeleteScheduled != nil {
+		mmDeleteScheduled.inspectFuncDeleteScheduled(ctx, id, username)
+	}
+
+	mm_params := ScheduledRepositoryMockDeleteScheduledParams{ctx, id, username}
+
+	// Record call args
+	mmDeleteScheduled.DeleteScheduledMock.mutex.Lock()
+	mmDeleteScheduled.DeleteScheduledMock.callArgs = append(mmDeleteScheduled.DeleteScheduledMock.callArgs, &mm_params)
+	mmDeleteScheduled.DeleteScheduledMock.mutex.Unlock()
+
+	for _, e := range mmDeleteScheduled.DeleteScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeleteScheduled.DeleteScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockDeleteScheduledParams{ctx, id, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteScheduled.t.Errorf("ScheduledRepositoryMock.DeleteScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteScheduled.t.Errorf("ScheduledRepositoryMock.DeleteScheduled got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteScheduled.t.Errorf("ScheduledRepositoryMock.DeleteScheduled got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteScheduled.t.Errorf("ScheduledRepositoryMock.DeleteScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteScheduled.DeleteScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteScheduled.t.Fatal("No results are set for the ScheduledRepositoryMock.DeleteScheduled")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeleteScheduled.funcDeleteScheduled != nil {
+		return mmDeleteScheduled.funcDeleteScheduled(ctx, id, username)
+	}
+	mmDeleteScheduled.t.Fatalf("Unexpected call to ScheduledRepositoryMock.DeleteScheduled. %v %v %v", ctx, id, username)
+	return
+}
+
+// DeleteScheduledAfterCounter returns a count of finished ScheduledRepositoryMock.DeleteScheduled invocations
+func (mmDeleteScheduled *ScheduledRepositoryMock) DeleteScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteScheduled.afterDeleteScheduledCounter)
+}
+
+// DeleteScheduledBeforeCounter returns a count of ScheduledRepositoryMock.DeleteScheduled invocations
+func (mmDeleteScheduled *ScheduledRepositoryMock) DeleteScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteScheduled.beforeDeleteScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.DeleteScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteScheduled *mScheduledRepositoryMockDeleteScheduled) Calls() []*ScheduledRepositoryMockDeleteScheduledParams {
+	mmDeleteScheduled.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockDeleteScheduledParams, len(mmDeleteScheduled.callArgs))
+	copy(argCopy, mmDeleteScheduled.callArgs)
+
+	mmDeleteScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteScheduledDone returns true if the count of the DeleteScheduled invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockDeleteScheduledDone() bool {
+	if m.DeleteScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteScheduledMock.invocationsDone()
+}
+
+// MinimockDeleteScheduledInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockDeleteScheduledInspect() {
+	for _, e := range m.DeleteScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.DeleteScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteScheduledCounter := mm_atomic.LoadUint64(&m.afterDeleteScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteScheduledMock.defaultExpectation != nil && afterDeleteScheduledCounter < 1 {
+		if m.DeleteScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.DeleteScheduled at\n%s", m.DeleteScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.DeleteScheduled at\n%s with params: %#v", m.DeleteScheduledMock.defaultExpectation.expectationOrigins.origin, *m.DeleteScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteScheduled != nil && afterDeleteScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.DeleteScheduled at\n%s", m.funcDeleteScheduledOrigin)
+	}
+
+	if !m.DeleteScheduledMock.invocationsDone() && afterDeleteScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.DeleteScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteScheduledMock.expectedInvocations), m.DeleteScheduledMock.expectedInvocationsOrigin, afterDeleteScheduledCounter)
+	}
+}
+
+type mScheduledRepositoryMockListScheduled struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockListScheduledExpectation
+	expectations       []*ScheduledRepositoryMockListScheduledExpectation
+
+	callArgs []*ScheduledRepositoryMockListScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockListScheduledExpectation specifies expectation struct of the ScheduledRepository.ListScheduled
+type ScheduledRepositoryMockListScheduledExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockListScheduledParams
+	paramPtrs          *ScheduledRepositoryMockListScheduledParamPtrs
+	expectationOrigins ScheduledRepositoryMockListScheduledExpectationOrigins
+	results            *ScheduledRepositoryMockListScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockListScheduledParams contains parameters of the ScheduledRepository.ListScheduled
+type ScheduledRepositoryMockListScheduledParams struct {
+	ctx      context.Context
+	username string
+	chatID   int64
+}
+
+// ScheduledRepositoryMockListScheduledParamPtrs contains pointers to parameters of the ScheduledRepository.ListScheduled
+type ScheduledRepositoryMockListScheduledParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	chatID   *int64
+}
+
+// ScheduledRepositoryMockListScheduledResults contains results of the ScheduledRepository.ListScheduled
+type ScheduledRepositoryMockListScheduledResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// ScheduledRepositoryMockListScheduledOrigins contains origins of expectations of the ScheduledRepository.ListScheduled
+type ScheduledRepositoryMockListScheduledExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originChatID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Optional() *mScheduledRepositoryMockListScheduled {
+	mmListScheduled.optional = true
+	return mmListScheduled
+}
+
+// Expect sets up expected params for ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Expect(ctx context.Context, username string, chatID int64) *mScheduledRepositoryMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ScheduledRepositoryMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduled.defaultExpectation.params = &ScheduledRepositoryMockListScheduledParams{ctx, username, chatID}
+	mmListScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListScheduled.expectations {
+		if minimock.Equal(e.params, mmListScheduled.defaultExpectation.params) {
+			mmListScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ScheduledRepositoryMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.params != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Expect")
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs == nil {
+		mmListScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListScheduledParamPtrs{}
+	}
+	mmListScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListScheduled
+}
+
+// ExpectUsernameParam2 sets up expected param username for ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) ExpectUsernameParam2(username string) *mScheduledRepositoryMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ScheduledRepositoryMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.params != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Expect")
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs == nil {
+		mmListScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListScheduledParamPtrs{}
+	}
+	mmListScheduled.defaultExpectation.paramPtrs.username = &username
+	mmListScheduled.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListScheduled
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) ExpectChatIDParam3(chatID int64) *mScheduledRepositoryMockListScheduled {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ScheduledRepositoryMockListScheduledExpectation{}
+	}
+
+	if mmListScheduled.defaultExpectation.params != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Expect")
+	}
+
+	if mmListScheduled.defaultExpectation.paramPtrs == nil {
+		mmListScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockListScheduledParamPtrs{}
+	}
+	mmListScheduled.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListScheduled.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Inspect(f func(ctx context.Context, username string, chatID int64)) *mScheduledRepositoryMockListScheduled {
+	if mmListScheduled.mock.inspectFuncListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.ListScheduled")
+	}
+
+	mmListScheduled.mock.inspectFuncListScheduled = f
+
+	return mmListScheduled
+}
+
+// Return sets up results that will be returned by ScheduledRepository.ListScheduled
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Return(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	if mmListScheduled.defaultExpectation == nil {
+		mmListScheduled.defaultExpectation = &ScheduledRepositoryMockListScheduledExpectation{mock: mmListScheduled.mock}
+	}
+	mmListScheduled.defaultExpectation.results = &ScheduledRepositoryMockListScheduledResults{spa1, err}
+	mmListScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListScheduled.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.ListScheduled method
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Set(f func(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error)) *ScheduledRepositoryMock {
+	if mmListScheduled.defaultExpectation != nil {
+		mmListScheduled.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.ListScheduled method")
+	}
+
+	if len(mmListScheduled.expectations) > 0 {
+		mmListScheduled.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.ListScheduled method")
+	}
+
+	mmListScheduled.mock.funcListScheduled = f
+	mmListScheduled.mock.funcListScheduledOrigin = minimock.CallerInfo(1)
+	return mmListScheduled.mock
+}
+
+// When sets expectation for the ScheduledRepository.ListScheduled which will trigger the result defined by the following
+// Then helper
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) When(ctx context.Context, username string, chatID int64) *ScheduledRepositoryMockListScheduledExpectation {
+	if mmListScheduled.mock.funcListScheduled != nil {
+		mmListScheduled.mock.t.Fatalf("ScheduledRepositoryMock.ListScheduled mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockListScheduledExpectation{
+		mock:               mmListScheduled.mock,
+		params:             &ScheduledRepositoryMockListScheduledParams{ctx, username, chatID},
+		expectationOrigins: ScheduledRepositoryMockListScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListScheduled.expectations = append(mmListScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.ListScheduled return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockListScheduledExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockListScheduledResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.ListScheduled should be invoked
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Times(n uint64) *mScheduledRepositoryMockListScheduled {
+	if n == 0 {
+		mmListScheduled.mock.t.Fatalf("Times of ScheduledRepositoryMock.ListScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduled.expectedInvocations, n)
+	mmListScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListScheduled
+}
+
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) invocationsDone() bool {
+	if len(mmListScheduled.expectations) == 0 && mmListScheduled.defaultExpectation == nil && mmListScheduled.mock.funcListScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduled.mock.afterListScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduled implements mm_repository.ScheduledRepository
+func (mmListScheduled *ScheduledRepositoryMock) ListScheduled(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduled.beforeListScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduled.afterListScheduledCounter, 1)
+
+	mmListScheduled.t.Helper()
+
+	if mmListScheduled.inspectFuncListScheduled != nil {
+		mmListScheduled.inspectFuncListScheduled(ctx, username, chatID)
+	}
+
+	mm_params := ScheduledRepositoryMockListScheduledParams{ctx, username, chatID}
+
+	// Record call args
+	mmListScheduled.ListScheduledMock.mutex.Lock()
+	mmListScheduled.ListScheduledMock.callArgs = append(mmListScheduled.ListScheduledMock.callArgs, &mm_params)
+	mmListScheduled.ListScheduledMock.mutex.Unlock()
+
+	for _, e := range mmListScheduled.ListScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListScheduled.ListScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduled.ListScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduled.ListScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduled.ListScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockListScheduledParams{ctx, username, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduled.t.Errorf("ScheduledRepositoryMock.ListScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListScheduled.t.Errorf("ScheduledRepositoryMock.ListScheduled got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListScheduled.t.Errorf("ScheduledRepositoryMock.ListScheduled got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduled.t.Errorf("ScheduledRepositoryMock.ListScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListScheduled.ListScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduled.ListScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduled.t.Fatal("No results are set for the ScheduledRepositoryMock.ListScheduled")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListScheduled.funcListScheduled != nil {
+		return mmListScheduled.funcListScheduled(ctx, username, chatID)
+	}
+	mmListScheduled.t.Fatalf("Unexpected call to ScheduledRepositoryMock.ListScheduled. %v %v %v", ctx, username, chatID)
+	return
+}
+
+// ListScheduledAfterCounter returns a count of finished ScheduledRepositoryMock.ListScheduled invocations
+func (mmListScheduled *ScheduledRepositoryMock) ListScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduled.afterListScheduledCounter)
+}
+
+// ListScheduledBeforeCounter returns a count of ScheduledRepositoryMock.ListScheduled invocations
+func (mmListScheduled *ScheduledRepositoryMock) ListScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduled.beforeListScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.ListScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduled *mScheduledRepositoryMockListScheduled) Calls() []*ScheduledRepositoryMockListScheduledParams {
+	mmListScheduled.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockListScheduledParams, len(mmListScheduled.callArgs))
+	copy(argCopy, mmListScheduled.callArgs)
+
+	mmListScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledDone returns true if the count of the ListScheduled invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockListScheduledDone() bool {
+	if m.ListScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMock.invocationsDone()
+}
+
+// MinimockListScheduledInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockListScheduledInspect() {
+	for _, e := range m.ListScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListScheduledCounter := mm_atomic.LoadUint64(&m.afterListScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMock.defaultExpectation != nil && afterListScheduledCounter < 1 {
+		if m.ListScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListScheduled at\n%s", m.ListScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.ListScheduled at\n%s with params: %#v", m.ListScheduledMock.defaultExpectation.expectationOrigins.origin, *m.ListScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduled != nil && afterListScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.ListScheduled at\n%s", m.funcListScheduledOrigin)
+	}
+
+	if !m.ListScheduledMock.invocationsDone() && afterListScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.ListScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMock.expectedInvocations), m.ListScheduledMock.expectedInvocationsOrigin, afterListScheduledCounter)
+	}
+}
+
+type mScheduledRepositoryMockPostponeScheduled struct {
+	optional           bool
+	mock               *ScheduledRepositoryMock
+	defaultExpectation *ScheduledRepositoryMockPostponeScheduledExpectation
+	expectations       []*ScheduledRepositoryMockPostponeScheduledExpectation
+
+	callArgs []*ScheduledRepositoryMockPostponeScheduledParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ScheduledRepositoryMockPostponeScheduledExpectation specifies expectation struct of the ScheduledRepository.PostponeScheduled
+type ScheduledRepositoryMockPostponeScheduledExpectation struct {
+	mock               *ScheduledRepositoryMock
+	params             *ScheduledRepositoryMockPostponeScheduledParams
+	paramPtrs          *ScheduledRepositoryMockPostponeScheduledParamPtrs
+	expectationOrigins ScheduledRepositoryMockPostponeScheduledExpectationOrigins
+	results            *ScheduledRepositoryMockPostponeScheduledResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ScheduledRepositoryMockPostponeScheduledParams contains parameters of the ScheduledRepository.PostponeScheduled
+type ScheduledRepositoryMockPostponeScheduledParams struct {
+	ctx  context.Context
+	id   int64
+	next time.Time
+}
+
+// ScheduledRepositoryMockPostponeScheduledParamPtrs contains pointers to parameters of the ScheduledRepository.PostponeScheduled
+type ScheduledRepositoryMockPostponeScheduledParamPtrs struct {
+	ctx  *context.Context
+	id   *int64
+	next *time.Time
+}
+
+// ScheduledRepositoryMockPostponeScheduledResults contains results of the ScheduledRepository.PostponeScheduled
+type ScheduledRepositoryMockPostponeScheduledResults struct {
+	err error
+}
+
+// ScheduledRepositoryMockPostponeScheduledOrigins contains origins of expectations of the ScheduledRepository.PostponeScheduled
+type ScheduledRepositoryMockPostponeScheduledExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originId   string
+	originNext string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Optional() *mScheduledRepositoryMockPostponeScheduled {
+	mmPostponeScheduled.optional = true
+	return mmPostponeScheduled
+}
+
+// Expect sets up expected params for ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Expect(ctx context.Context, id int64, next time.Time) *mScheduledRepositoryMockPostponeScheduled {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	if mmPostponeScheduled.defaultExpectation == nil {
+		mmPostponeScheduled.defaultExpectation = &ScheduledRepositoryMockPostponeScheduledExpectation{}
+	}
+
+	if mmPostponeScheduled.defaultExpectation.paramPtrs != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by ExpectParams functions")
+	}
+
+	mmPostponeScheduled.defaultExpectation.params = &ScheduledRepositoryMockPostponeScheduledParams{ctx, id, next}
+	mmPostponeScheduled.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPostponeScheduled.expectations {
+		if minimock.Equal(e.params, mmPostponeScheduled.defaultExpectation.params) {
+			mmPostponeScheduled.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPostponeScheduled.defaultExpectation.params)
+		}
+	}
+
+	return mmPostponeScheduled
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) ExpectCtxParam1(ctx context.Context) *mScheduledRepositoryMockPostponeScheduled {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	if mmPostponeScheduled.defaultExpectation == nil {
+		mmPostponeScheduled.defaultExpectation = &ScheduledRepositoryMockPostponeScheduledExpectation{}
+	}
+
+	if mmPostponeScheduled.defaultExpectation.params != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Expect")
+	}
+
+	if mmPostponeScheduled.defaultExpectation.paramPtrs == nil {
+		mmPostponeScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockPostponeScheduledParamPtrs{}
+	}
+	mmPostponeScheduled.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPostponeScheduled.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPostponeScheduled
+}
+
+// ExpectIdParam2 sets up expected param id for ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) ExpectIdParam2(id int64) *mScheduledRepositoryMockPostponeScheduled {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	if mmPostponeScheduled.defaultExpectation == nil {
+		mmPostponeScheduled.defaultExpectation = &ScheduledRepositoryMockPostponeScheduledExpectation{}
+	}
+
+	if mmPostponeScheduled.defaultExpectation.params != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Expect")
+	}
+
+	if mmPostponeScheduled.defaultExpectation.paramPtrs == nil {
+		mmPostponeScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockPostponeScheduledParamPtrs{}
+	}
+	mmPostponeScheduled.defaultExpectation.paramPtrs.id = &id
+	mmPostponeScheduled.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmPostponeScheduled
+}
+
+// ExpectNextParam3 sets up expected param next for ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) ExpectNextParam3(next time.Time) *mScheduledRepositoryMockPostponeScheduled {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	if mmPostponeScheduled.defaultExpectation == nil {
+		mmPostponeScheduled.defaultExpectation = &ScheduledRepositoryMockPostponeScheduledExpectation{}
+	}
+
+	if mmPostponeScheduled.defaultExpectation.params != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Expect")
+	}
+
+	if mmPostponeScheduled.defaultExpectation.paramPtrs == nil {
+		mmPostponeScheduled.defaultExpectation.paramPtrs = &ScheduledRepositoryMockPostponeScheduledParamPtrs{}
+	}
+	mmPostponeScheduled.defaultExpectation.paramPtrs.next = &next
+	mmPostponeScheduled.defaultExpectation.expectationOrigins.originNext = minimock.CallerInfo(1)
+
+	return mmPostponeScheduled
+}
+
+// Inspect accepts an inspector function that has same arguments as the ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Inspect(f func(ctx context.Context, id int64, next time.Time)) *mScheduledRepositoryMockPostponeScheduled {
+	if mmPostponeScheduled.mock.inspectFuncPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("Inspect function is already set for ScheduledRepositoryMock.PostponeScheduled")
+	}
+
+	mmPostponeScheduled.mock.inspectFuncPostponeScheduled = f
+
+	return mmPostponeScheduled
+}
+
+// Return sets up results that will be returned by ScheduledRepository.PostponeScheduled
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Return(err error) *ScheduledRepositoryMock {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	if mmPostponeScheduled.defaultExpectation == nil {
+		mmPostponeScheduled.defaultExpectation = &ScheduledRepositoryMockPostponeScheduledExpectation{mock: mmPostponeScheduled.mock}
+	}
+	mmPostponeScheduled.defaultExpectation.results = &ScheduledRepositoryMockPostponeScheduledResults{err}
+	mmPostponeScheduled.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPostponeScheduled.mock
+}
+
+// Set uses given function f to mock the ScheduledRepository.PostponeScheduled method
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Set(f func(ctx context.Context, id int64, next time.Time) (err error)) *ScheduledRepositoryMock {
+	if mmPostponeScheduled.defaultExpectation != nil {
+		mmPostponeScheduled.mock.t.Fatalf("Default expectation is already set for the ScheduledRepository.PostponeScheduled method")
+	}
+
+	if len(mmPostponeScheduled.expectations) > 0 {
+		mmPostponeScheduled.mock.t.Fatalf("Some expectations are already set for the ScheduledRepository.PostponeScheduled method")
+	}
+
+	mmPostponeScheduled.mock.funcPostponeScheduled = f
+	mmPostponeScheduled.mock.funcPostponeScheduledOrigin = minimock.CallerInfo(1)
+	return mmPostponeScheduled.mock
+}
+
+// When sets expectation for the ScheduledRepository.PostponeScheduled which will trigger the result defined by the following
+// Then helper
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) When(ctx context.Context, id int64, next time.Time) *ScheduledRepositoryMockPostponeScheduledExpectation {
+	if mmPostponeScheduled.mock.funcPostponeScheduled != nil {
+		mmPostponeScheduled.mock.t.Fatalf("ScheduledRepositoryMock.PostponeScheduled mock is already set by Set")
+	}
+
+	expectation := &ScheduledRepositoryMockPostponeScheduledExpectation{
+		mock:               mmPostponeScheduled.mock,
+		params:             &ScheduledRepositoryMockPostponeScheduledParams{ctx, id, next},
+		expectationOrigins: ScheduledRepositoryMockPostponeScheduledExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPostponeScheduled.expectations = append(mmPostponeScheduled.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ScheduledRepository.PostponeScheduled return parameters for the expectation previously defined by the When method
+func (e *ScheduledRepositoryMockPostponeScheduledExpectation) Then(err error) *ScheduledRepositoryMock {
+	e.results = &ScheduledRepositoryMockPostponeScheduledResults{err}
+	return e.mock
+}
+
+// Times sets number of times ScheduledRepository.PostponeScheduled should be invoked
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Times(n uint64) *mScheduledRepositoryMockPostponeScheduled {
+	if n == 0 {
+		mmPostponeScheduled.mock.t.Fatalf("Times of ScheduledRepositoryMock.PostponeScheduled mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPostponeScheduled.expectedInvocations, n)
+	mmPostponeScheduled.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPostponeScheduled
+}
+
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) invocationsDone() bool {
+	if len(mmPostponeScheduled.expectations) == 0 && mmPostponeScheduled.defaultExpectation == nil && mmPostponeScheduled.mock.funcPostponeScheduled == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPostponeScheduled.mock.afterPostponeScheduledCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPostponeScheduled.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PostponeScheduled implements mm_repository.ScheduledRepository
+func (mmPostponeScheduled *ScheduledRepositoryMock) PostponeScheduled(ctx context.Context, id int64, next time.Time) (err error) {
+	mm_atomic.AddUint64(&mmPostponeScheduled.beforePostponeScheduledCounter, 1)
+	defer mm_atomic.AddUint64(&mmPostponeScheduled.afterPostponeScheduledCounter, 1)
+
+	mmPostponeScheduled.t.Helper()
+
+	if mmPostponeScheduled.inspectFuncPostponeScheduled != nil {
+		mmPostponeScheduled.inspectFuncPostponeScheduled(ctx, id, next)
+	}
+
+	mm_params := ScheduledRepositoryMockPostponeScheduledParams{ctx, id, next}
+
+	// Record call args
+	mmPostponeScheduled.PostponeScheduledMock.mutex.Lock()
+	mmPostponeScheduled.PostponeScheduledMock.callArgs = append(mmPostponeScheduled.PostponeScheduledMock.callArgs, &mm_params)
+	mmPostponeScheduled.PostponeScheduledMock.mutex.Unlock()
+
+	for _, e := range mmPostponeScheduled.PostponeScheduledMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPostponeScheduled.PostponeScheduledMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.Counter, 1)
+		mm_want := mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.params
+		mm_want_ptrs := mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.paramPtrs
+
+		mm_got := ScheduledRepositoryMockPostponeScheduledParams{ctx, id, next}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPostponeScheduled.t.Errorf("ScheduledRepositoryMock.PostponeScheduled got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmPostponeScheduled.t.Errorf("ScheduledRepositoryMock.PostponeScheduled got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.next != nil && !minimock.Equal(*mm_want_ptrs.next, mm_got.next) {
+				mmPostponeScheduled.t.Errorf("ScheduledRepositoryMock.PostponeScheduled got unexpected parameter next, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.expectationOrigins.originNext, *mm_want_ptrs.next, mm_got.next, minimock.Diff(*mm_want_ptrs.next, mm_got.next))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPostponeScheduled.t.Errorf("ScheduledRepositoryMock.PostponeScheduled got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPostponeScheduled.PostponeScheduledMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPostponeScheduled.t.Fatal("No results are set for the ScheduledRepositoryMock.PostponeScheduled")
+		}
+		return (*mm_results).err
+	}
+	if mmPostponeScheduled.funcPostponeScheduled != nil {
+		return mmPostponeScheduled.funcPostponeScheduled(ctx, id, next)
+	}
+	mmPostponeScheduled.t.Fatalf("Unexpected call to ScheduledRepositoryMock.PostponeScheduled. %v %v %v", ctx, id, next)
+	return
+}
+
+// PostponeScheduledAfterCounter returns a count of finished ScheduledRepositoryMock.PostponeScheduled invocations
+func (mmPostponeScheduled *ScheduledRepositoryMock) PostponeScheduledAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostponeScheduled.afterPostponeScheduledCounter)
+}
+
+// PostponeScheduledBeforeCounter returns a count of ScheduledRepositoryMock.PostponeScheduled invocations
+func (mmPostponeScheduled *ScheduledRepositoryMock) PostponeScheduledBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPostponeScheduled.beforePostponeScheduledCounter)
+}
+
+// Calls returns a list of arguments used in each call to ScheduledRepositoryMock.PostponeScheduled.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPostponeScheduled *mScheduledRepositoryMockPostponeScheduled) Calls() []*ScheduledRepositoryMockPostponeScheduledParams {
+	mmPostponeScheduled.mutex.RLock()
+
+	argCopy := make([]*ScheduledRepositoryMockPostponeScheduledParams, len(mmPostponeScheduled.callArgs))
+	copy(argCopy, mmPostponeScheduled.callArgs)
+
+	mmPostponeScheduled.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPostponeScheduledDone returns true if the count of the PostponeScheduled invocations corresponds
+// the number of defined expectations
+func (m *ScheduledRepositoryMock) MinimockPostponeScheduledDone() bool {
+	if m.PostponeScheduledMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PostponeScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PostponeScheduledMock.invocationsDone()
+}
+
+// MinimockPostponeScheduledInspect logs each unmet expectation
+func (m *ScheduledRepositoryMock) MinimockPostponeScheduledInspect() {
+	for _, e := range m.PostponeScheduledMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.PostponeScheduled at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPostponeScheduledCounter := mm_atomic.LoadUint64(&m.afterPostponeScheduledCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PostponeScheduledMock.defaultExpectation != nil && afterPostponeScheduledCounter < 1 {
+		if m.PostponeScheduledMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.PostponeScheduled at\n%s", m.PostponeScheduledMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ScheduledRepositoryMock.PostponeScheduled at\n%s with params: %#v", m.PostponeScheduledMock.defaultExpectation.expectationOrigins.origin, *m.PostponeScheduledMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPostponeScheduled != nil && afterPostponeScheduledCounter < 1 {
+		m.t.Errorf("Expected call to ScheduledRepositoryMock.PostponeScheduled at\n%s", m.funcPostponeScheduledOrigin)
+	}
+
+	if !m.PostponeScheduledMock.invocationsDone() && afterPostponeScheduledCounter > 0 {
+		m.t.Errorf("Expected %d calls to ScheduledRepositoryMock.PostponeScheduled at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PostponeScheduledMock.expectedInvocations), m.PostponeScheduledMock.expectedInvocationsOrigin, afterPostponeScheduledCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ScheduledRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockClaimDueInspect()
+
+			m.MinimockCreateScheduledInspect()
+
+			m.MinimockDeleteScheduledInspect()
+
+			m.MinimockListScheduledInspect()
+
+			m.MinimockPostponeScheduledInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ScheduledRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ScheduledRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockClaimDueDone() &&
+		m.MinimockCreateScheduledDone() &&
+		m.MinimockDeleteScheduledDone() &&
+		m.MinimockListScheduledDone() &&
+		m.MinimockPostponeScheduledDone()
+}
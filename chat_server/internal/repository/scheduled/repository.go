@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/repository"
+	"common/database/client"
+)
+
+const scheduledColumns = `id, chat_id, from_user, text, send_at, created_at, attempts`
+
+type scheduledRepository struct {
+	db client.Client
+}
+
+func NewScheduledRepository(db client.Client) repository.ScheduledRepository {
+	return &scheduledRepository{db: db}
+}
+
+func (r *scheduledRepository) CreateScheduled(ctx context.Context, msg *model.ScheduledMessage) (*model.ScheduledMessage, error) {
+	q := client.Query{
+		Name: "scheduled_repository.CreateScheduled",
+		QueryRaw: `
+			INSERT INTO scheduled_messages (chat_id, from_user, text, send_at, created_at)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING ` + scheduledColumns,
+	}
+
+	row := r.db.DB().QueryRowContext(ctx, q, msg.ChatID, msg.From, msg.Text, msg.SendAt, time.Now())
+	stored, err := scanScheduled(row)
+	if err != nil {
+		return nil, fmt.Errorf("insert scheduled message: %w", err)
+	}
+	return stored, nil
+}
+
+// ListScheduled returns the pending messages of a user in send order. A zero
+// chatID lists the messages of every chat.
+func (r *scheduledRepository) ListScheduled(ctx context.Context, username string, chatID int64) ([]*model.ScheduledMessage, error) {
+	q := client.Query{
+		Name: "scheduled_repository.ListScheduled",
+		QueryRaw: `
+			SELECT ` + scheduledColumns + `
+			FROM scheduled_messages
+			WHERE from_user = $1 AND ($2 = 0 OR chat_id = $2)
+			ORDER BY send_at, id`,
+	}
+
+	rows, err := r.db.DB().QueryContext(ctx, q, username, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("query scheduled messages: %w", err)
+	}
+	defer rows.Close()
+
+	var res []*model.ScheduledMessage
+	for rows.Next() {
+		msg, err := scanScheduled(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan scheduled message: %w", err)
+		}
+		res = append(res, msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("read scheduled messages: %w", err)
+	}
+	return res, nil
+}
+
+// DeleteScheduled cancels a pending message of a user and reports whether it
+// was still pending. A message that is being delivered right now stays locked
+// until its delivery commits, after which it is no longer found.
+func (r *scheduledRepository) DeleteScheduled(ctx context.Context, id int64, username string) (bool, error) {
+	q := client.Query{
+		Name:     "scheduled_repository.DeleteScheduled",
+		QueryRaw: `DELETE FROM scheduled_messages WHERE id = $1 AND from_user = $2`,
+	}
+
+	tag, err := r.db.DB().ExecContext(ctx, q, id, username)
+	if err != nil {
+		return false, fmt.Errorf("delete scheduled message: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ClaimDue removes the oldest message due at now and returns it, or nil when
+// nothing is due. Messages held back after a failed delivery are skipped
+// until their next attempt. It must run in the transaction that delivers the
+// message: the row stays locked until that transaction ends, other schedulers
+// skip it meanwhile, and a rollback puts it back.
+func (r *scheduledRepository) ClaimDue(ctx context.Context, now time.Time) (*model.ScheduledMessage, error) {
+	q := client.Query{
+		Name: "scheduled_repository.ClaimDue",
+		QueryRaw: `
+			DELETE FROM scheduled_messages
+			WHERE id = (
+				SELECT id FROM scheduled_messages
+				WHERE send_at <= $1 AND (next_attempt_at IS NULL OR next_attempt_at <= $1)
+				ORDER BY send_at, id
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING ` + scheduledColumns,
+	}
+
+	msg, err := scanScheduled(r.db.DB().QueryRowContext(ctx, q, now))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("claim scheduled message: %w", err)
+	}
+	return msg, nil
+}
+
+// PostponeScheduled records a failed delivery of a pending message and holds
+// it back until next.
+func (r *scheduledRepository) PostponeScheduled(ctx context.Context, id int64, next time.Time) error {
+	q := client.Query{
+		Name:     "scheduled_repository.PostponeScheduled",
+		QueryRaw: `UPDATE scheduled_messages SET attempts = attempts + 1, next_attempt_at = $2 WHERE id = $1`,
+	}
+
+	if _, err := r.db.DB().ExecContext(ctx, q, id, next); err != nil {
+		return fmt.Errorf("postpone scheduled message: %w", err)
+	}
+	return nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanScheduled(row scanner) (*model.ScheduledMessage, error) {
+	msg := &model.ScheduledMessage{}
+	err := row.Scan(&msg.ID, &msg.ChatID, &msg.From, &msg.Text, &msg.SendAt, &msg.CreatedAt, &msg.Attempts)
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
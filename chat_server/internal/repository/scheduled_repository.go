@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"chat/chat_server/internal/model"
+)
+
+type ScheduledRepository interface {
+	CreateScheduled(ctx context.Context, msg *model.ScheduledMessage) (*model.ScheduledMessage, error)
+	ListScheduled(ctx context.Context, username string, chatID int64) ([]*model.ScheduledMessage, error)
+	DeleteScheduled(ctx context.Context, id int64, username string) (bool, error)
+	ClaimDue(ctx context.Context, now time.Time) (*model.ScheduledMessage, error)
+	PostponeScheduled(ctx context.Context, id int64, next time.Time) error
+}
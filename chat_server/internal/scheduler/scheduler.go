@@ -0,0 +1,62 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// Deliverer sends due scheduled messages one at a time.
+type Deliverer interface {
+	// DeliverScheduledMessage sends the oldest due message and reports
+	// whether there was one. A message that fails is held back and still
+	// reported, together with the error.
+	DeliverScheduledMessage(ctx context.Context) (bool, error)
+}
+
+// Scheduler polls for due scheduled messages and delivers them. Pending
+// messages live in the database, so they survive restarts, and any number of
+// replicas may run a scheduler side by side.
+type Scheduler struct {
+	deliverer Deliverer
+	interval  time.Duration
+}
+
+func New(deliverer Deliverer, interval time.Duration) *Scheduler {
+	return &Scheduler{
+		deliverer: deliverer,
+		interval:  interval,
+	}
+}
+
+// Run delivers due messages right away and then on every tick until ctx is done.
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.deliverDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// deliverDue delivers messages until none is due. A message that fails to
+// deliver is skipped; when no message could be claimed at all the rest is
+// left for the next tick.
+func (s *Scheduler) deliverDue(ctx context.Context) {
+	for ctx.Err() == nil {
+		handled, err := s.deliverer.DeliverScheduledMessage(ctx)
+		if err != nil {
+			log.Printf("failed to deliver scheduled messages: %v", err)
+		}
+
+		if !handled {
+			return
+		}
+	}
+}
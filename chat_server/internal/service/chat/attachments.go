@@ -0,0 +1,337 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+	"chat/chat_server/internal/thumbnail"
+)
+
+const (
+	maxFilenameLength         = 255
+	maxAttachmentsPerMessage  = 10
+	sniffLength               = 512
+	defaultAttachmentMimeType = "application/octet-stream"
+)
+
+// UploadAttachment stores a file read from r in the blob store and records it
+// as an attachment of the chat. The attachment is not visible to the other
+// members until it is sent with a message.
+func (s *chatService) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) (*model.Attachment, error) {
+	filename := path.Base(strings.ReplaceAll(strings.TrimSpace(upload.Filename), "\\", "/"))
+	if filename == "" || filename == "." || filename == "/" {
+		return nil, fmt.Errorf("%w: attachment filename is required", service.ErrInvalidArgument)
+	}
+
+	if len(filename) > maxFilenameLength {
+		return nil, fmt.Errorf("%w: attachment filename too long (max %d characters)", service.ErrInvalidArgument, maxFilenameLength)
+	}
+
+	if err := s.checkMembership(ctx, upload.ChatID, upload.Username); err != nil {
+		return nil, err
+	}
+
+	key, err := newStorageKey(upload.ChatID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read one byte more than allowed so that oversized uploads are detected
+	// without buffering them.
+	counter := &countingReader{r: io.LimitReader(r, s.attachmentMaxSize+1)}
+	hash := sha256.New()
+	body := bufio.NewReaderSize(io.TeeReader(counter, hash), sniffLength)
+
+	head, err := body.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	mimeType := sniffMimeType(head, filename)
+
+	if err := s.blobs.Put(ctx, key, body); err != nil {
+		return nil, fmt.Errorf("failed to store attachment: %w", err)
+	}
+
+	if counter.n > s.attachmentMaxSize {
+		s.deleteBlob(key)
+		return nil, fmt.Errorf("%w: max %d bytes", service.ErrAttachmentTooLarge, s.attachmentMaxSize)
+	}
+
+	if counter.n == 0 {
+		s.deleteBlob(key)
+		return nil, fmt.Errorf("%w: attachment is empty", service.ErrInvalidArgument)
+	}
+
+	attachment, err := s.attachmentRepo.CreateAttachment(ctx, &model.Attachment{
+		ChatID:     upload.ChatID,
+		UploadedBy: upload.Username,
+		Filename:   filename,
+		MimeType:   mimeType,
+		Size:       counter.n,
+		SHA256:     hex.EncodeToString(hash.Sum(nil)),
+		StorageKey: key,
+	})
+	if err != nil {
+		s.deleteBlob(key)
+		return nil, fmt.Errorf("failed to save attachment: %w", err)
+	}
+
+	if thumbnail.Supported(mimeType) {
+		s.addThumbnails(ctx, attachment)
+	}
+
+	return attachment, nil
+}
+
+// addThumbnails renders thumbnails of an image attachment and stores them
+// next to the original. An image that cannot be decoded is kept as a plain
+// attachment, so failures are only logged. Only a few images are decoded at a
+// time; the call waits for a free slot until ctx is done.
+func (s *chatService) addThumbnails(ctx context.Context, attachment *model.Attachment) {
+	select {
+	case s.thumbnailSlots <- struct{}{}:
+		defer func() { <-s.thumbnailSlots }()
+	case <-ctx.Done():
+		log.Printf("skipped thumbnails of attachment %d: %v", attachment.ID, ctx.Err())
+		return
+	}
+
+	content, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		log.Printf("failed to read attachment %d for thumbnails: %v", attachment.ID, err)
+		return
+	}
+	data, err := io.ReadAll(io.LimitReader(content, s.attachmentMaxSize+1))
+	_ = content.Close()
+	if err != nil {
+		log.Printf("failed to read attachment %d for thumbnails: %v", attachment.ID, err)
+		return
+	}
+	if int64(len(data)) > s.attachmentMaxSize {
+		log.Printf("skipped thumbnails of attachment %d: blob larger than %d bytes", attachment.ID, s.attachmentMaxSize)
+		return
+	}
+
+	res, err := thumbnail.Make(data)
+	if err != nil {
+		log.Printf("failed to make thumbnails of attachment %d: %v", attachment.ID, err)
+		return
+	}
+
+	thumbnails := make([]*model.Thumbnail, 0, len(res.Thumbnails))
+	for _, t := range res.Thumbnails {
+		key := fmt.Sprintf("%s.%dx%d", attachment.StorageKey, t.Width, t.Height)
+		if err := s.blobs.Put(ctx, key, bytes.NewReader(t.Data)); err != nil {
+			log.Printf("failed to store thumbnail of attachment %d: %v", attachment.ID, err)
+			s.deleteThumbnails(thumbnails)
+			return
+		}
+
+		thumbnails = append(thumbnails, &model.Thumbnail{
+			Width:      t.Width,
+			Height:     t.Height,
+			MimeType:   t.MimeType,
+			Size:       int64(len(t.Data)),
+			StorageKey: key,
+		})
+	}
+
+	if err := s.attachmentRepo.SetImageInfo(ctx, attachment.ID, res.Width, res.Height, thumbnails); err != nil {
+		log.Printf("failed to save thumbnails of attachment %d: %v", attachment.ID, err)
+		s.deleteThumbnails(thumbnails)
+		return
+	}
+
+	attachment.Width = res.Width
+	attachment.Height = res.Height
+	attachment.Thumbnails = thumbnails
+}
+
+// OpenAttachment returns an attachment with a reader of its content, or of
+// the thumbnail of the given size if it is not zero. Members of the chat may
+// read attachments that have been sent; attachments that are not sent yet are
+// only readable by the uploader.
+func (s *chatService) OpenAttachment(ctx context.Context, username string, id int64, thumbnailSize int) (*model.Attachment, io.ReadCloser, error) {
+	attachment, err := s.attachmentRepo.GetAttachment(ctx, id)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	if attachment == nil || (attachment.MessageID == 0 && attachment.UploadedBy != username) {
+		return nil, nil, fmt.Errorf("%w: %d", service.ErrAttachmentNotFound, id)
+	}
+
+	if err := s.checkMembership(ctx, attachment.ChatID, username); err != nil {
+		return nil, nil, err
+	}
+
+	key := attachment.StorageKey
+	if thumbnailSize != 0 {
+		thumb := findThumbnail(attachment.Thumbnails, thumbnailSize)
+		if thumb == nil {
+			return nil, nil, fmt.Errorf("%w: attachment %d has no %d pixel thumbnail", service.ErrAttachmentNotFound, id, thumbnailSize)
+		}
+		key = thumb.StorageKey
+	}
+
+	content, err := s.blobs.Get(ctx, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+
+	return attachment, content, nil
+}
+
+// linkAttachments sends the uploaded attachments with a new message.
+func (s *chatService) linkAttachments(ctx context.Context, msg *model.Message, ids []int64) error {
+	ids = uniqueIDs(ids)
+	if len(ids) > maxAttachmentsPerMessage {
+		return fmt.Errorf("%w: too many attachments (max %d)", service.ErrInvalidArgument, maxAttachmentsPerMessage)
+	}
+
+	linked, err := s.attachmentRepo.LinkAttachments(ctx, msg.ID, msg.ChatID, msg.From, ids)
+	if err != nil {
+		return err
+	}
+
+	if linked != int64(len(ids)) {
+		return fmt.Errorf("%w: some attachments do not exist or were already sent", service.ErrAttachmentNotFound)
+	}
+
+	return s.attachAttachments(ctx, msg)
+}
+
+// attachAttachments fills in the attachments of the messages.
+func (s *chatService) attachAttachments(ctx context.Context, messages ...*model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+
+	attachments, err := s.attachmentRepo.ListAttachments(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	for _, msg := range messages {
+		msg.Attachments = attachments[msg.ID]
+	}
+
+	return nil
+}
+
+// DeleteStaleUploads deletes up to limit attachments that were uploaded but
+// not sent within the upload TTL, and returns how many were deleted.
+func (s *chatService) DeleteStaleUploads(ctx context.Context, limit int) (int, error) {
+	var attachments []*model.Attachment
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		attachments, err = s.attachmentRepo.DeleteStaleUploads(ctx, time.Now().Add(-s.uploadTTL), limit)
+		return err
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale uploads: %w", err)
+	}
+
+	s.deleteAttachmentBlobs(attachments)
+
+	return len(attachments), nil
+}
+
+// deleteBlob removes a blob that is no longer referenced. Failures only leak
+// storage, so they are logged instead of failing the request.
+func (s *chatService) deleteBlob(key string) {
+	if err := s.blobs.Delete(context.Background(), key); err != nil {
+		log.Printf("failed to delete blob %s: %v", key, err)
+	}
+}
+
+// deleteAttachmentBlobs removes the original and the thumbnails of attachments.
+func (s *chatService) deleteAttachmentBlobs(attachments []*model.Attachment) {
+	for _, attachment := range attachments {
+		s.deleteBlob(attachment.StorageKey)
+		s.deleteThumbnails(attachment.Thumbnails)
+	}
+}
+
+func (s *chatService) deleteThumbnails(thumbnails []*model.Thumbnail) {
+	for _, t := range thumbnails {
+		s.deleteBlob(t.StorageKey)
+	}
+}
+
+// findThumbnail returns the thumbnail rendered into a size x size box. Its
+// longer side is the size, while narrow images share their width across
+// sizes.
+func findThumbnail(thumbnails []*model.Thumbnail, size int) *model.Thumbnail {
+	for _, t := range thumbnails {
+		if max(t.Width, t.Height) == size {
+			return t
+		}
+	}
+	return nil
+}
+
+func newStorageKey(chatID int64) (string, error) {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", fmt.Errorf("failed to generate storage key: %w", err)
+	}
+	return fmt.Sprintf("chats/%d/%s", chatID, hex.EncodeToString(b[:])), nil
+}
+
+// sniffMimeType detects the type from the content and only falls back to the
+// file extension when the content is not recognized.
+func sniffMimeType(head []byte, filename string) string {
+	mimeType := http.DetectContentType(head)
+	if mimeType != defaultAttachmentMimeType {
+		return mimeType
+	}
+
+	if byExt := mime.TypeByExtension(filepath.Ext(filename)); byExt != "" {
+		return byExt
+	}
+	return defaultAttachmentMimeType
+}
+
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]struct{}, len(ids))
+	res := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := seen[id]; !ok {
+			seen[id] = struct{}{}
+			res = append(res, id)
+		}
+	}
+	return res
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
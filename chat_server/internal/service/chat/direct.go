@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// GetOrCreateDirectChat returns the direct chat between caller and username,
+// creating it if the two have none, and reports whether it was created.
+func (s *chatService) GetOrCreateDirectChat(ctx context.Context, caller, username string) (*model.Chat, bool, error) {
+	if username == "" {
+		return nil, false, fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	if username == caller {
+		return nil, false, fmt.Errorf("%w: cannot start a direct chat with yourself", service.ErrInvalidArgument)
+	}
+
+	chatID, created, err := s.createChat(ctx, &model.ChatCreate{
+		Owner:     caller,
+		Usernames: []string{username},
+		Info:      &model.ChatInfo{Type: model.ChatTypeDirect},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	chat, err := s.GetChat(ctx, chatID, caller)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return chat, created, nil
+}
+
+func (s *chatService) findDirectChat(ctx context.Context, members []*model.ChatMember) (int64, error) {
+	chatID, err := s.chatRepo.FindDirectChat(ctx, members[0].Username, members[1].Username)
+	if err != nil {
+		return 0, fmt.Errorf("failed to find direct chat: %w", err)
+	}
+	return chatID, nil
+}
+
+// checkNotDirect rejects membership changes of direct chats, which always
+// have exactly their two members.
+func (s *chatService) checkNotDirect(ctx context.Context, chatID int64) error {
+	chat, err := s.chatRepo.GetChat(ctx, chatID)
+	if err != nil {
+		return err
+	}
+
+	if chat.Info.Type == model.ChatTypeDirect {
+		return fmt.Errorf("%w: members of a direct chat cannot change", service.ErrForbidden)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+const maxMessageTTL = 365 * 24 * time.Hour
+
+func validateTTL(ttl time.Duration) error {
+	if ttl < 0 {
+		return fmt.Errorf("%w: message TTL cannot be negative", service.ErrInvalidArgument)
+	}
+
+	if ttl%time.Second != 0 {
+		return fmt.Errorf("%w: message TTL must be a whole number of seconds", service.ErrInvalidArgument)
+	}
+
+	if ttl > maxMessageTTL {
+		return fmt.Errorf("%w: message TTL too long (max %s)", service.ErrInvalidArgument, maxMessageTTL)
+	}
+
+	return nil
+}
+
+// DeleteExpiredMessages deletes up to limit expired messages for good,
+// together with the replies in their threads and their attachments, and
+// returns how many messages were deleted. Several callers may run it at once:
+// each one takes messages the others have not locked.
+func (s *chatService) DeleteExpiredMessages(ctx context.Context, limit int) (int, error) {
+	var (
+		expired     []*model.Message
+		attachments []*model.Attachment
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		expired, err = s.messageRepo.LockExpired(ctx, time.Now(), limit)
+		if err != nil || len(expired) == 0 {
+			return err
+		}
+
+		ids := make([]int64, 0, len(expired))
+		changes := make([]*model.Change, 0, len(expired))
+		for _, msg := range expired {
+			deleted, err := s.attachmentRepo.DeleteMessageAttachments(ctx, msg.ID)
+			if err != nil {
+				return err
+			}
+			attachments = append(attachments, deleted...)
+
+			ids = append(ids, msg.ID)
+			changes = append(changes, &model.Change{ChatID: msg.ChatID, Kind: model.ChangeMessageDeleted, MessageID: msg.ID, Seq: msg.Seq})
+		}
+
+		if err := s.messageRepo.PurgeMessages(ctx, ids); err != nil {
+			return err
+		}
+
+		if roots := survivingRoots(expired); len(roots) > 0 {
+			if err := s.messageRepo.RecountReplies(ctx, roots); err != nil {
+				return err
+			}
+		}
+
+		return s.changeRepo.AddChanges(ctx, changes...)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired messages: %w", err)
+	}
+
+	s.deleteAttachmentBlobs(attachments)
+
+	for _, msg := range expired {
+		s.hub.Publish(&model.ChatEvent{
+			ChatID:  msg.ChatID,
+			Deleted: &model.MessageDeletedEvent{MessageID: msg.ID, Seq: msg.Seq},
+		})
+	}
+
+	return len(expired), nil
+}
+
+// survivingRoots returns the thread roots that lost replies but are not
+// deleted themselves.
+func survivingRoots(deleted []*model.Message) []int64 {
+	gone := make(map[int64]bool, len(deleted))
+	for _, msg := range deleted {
+		gone[msg.ID] = true
+	}
+
+	var roots []int64
+	seen := make(map[int64]bool)
+	for _, msg := range deleted {
+		if msg.ReplyTo != 0 && !gone[msg.ReplyTo] && !seen[msg.ReplyTo] {
+			seen[msg.ReplyTo] = true
+			roots = append(roots, msg.ReplyTo)
+		}
+	}
+	return roots
+}
+
+func ttlUpdateText(actor string, ttl time.Duration) string {
+	if ttl == 0 {
+		return fmt.Sprintf("%s turned off disappearing messages", actor)
+	}
+	return fmt.Sprintf("%s set messages to disappear after %s", actor, ttl)
+}
@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// maxClientIDLength bounds the idempotency keys clients attach to requests.
+const maxClientIDLength = 64
+
+func validateClientID(id string) error {
+	if len(id) > maxClientIDLength {
+		return fmt.Errorf("%w: client id too long (max %d characters)", service.ErrInvalidArgument, maxClientIDLength)
+	}
+	return nil
+}
+
+// findCreatedChat returns the chat an earlier attempt of a Create call has
+// stored, or zero.
+func (s *chatService) findCreatedChat(ctx context.Context, owner, clientRequestID string) (int64, error) {
+	if clientRequestID == "" {
+		return 0, nil
+	}
+
+	chatID, err := s.chatRepo.FindByClientRequestID(ctx, owner, clientRequestID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create chat: %w", err)
+	}
+	return chatID, nil
+}
+
+// findSentMessage returns the message an earlier attempt of a SendMessage call
+// has stored, or nil.
+func (s *chatService) findSentMessage(ctx context.Context, msg *model.Message) (*model.Message, error) {
+	if msg.ClientMessageID == "" {
+		return nil, nil
+	}
+
+	stored, err := s.messageRepo.FindByClientMessageID(ctx, msg.ChatID, msg.From, msg.ClientMessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+	return stored, nil
+}
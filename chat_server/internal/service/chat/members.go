@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// AddMembers adds users to a chat. Only owners and admins may add members.
+func (s *chatService) AddMembers(ctx context.Context, chatID int64, actor string, usernames []string) error {
+	if len(usernames) == 0 {
+		return fmt.Errorf("%w: at least one username is required", service.ErrInvalidArgument)
+	}
+
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		members, self, err := s.lockMembers(ctx, chatID, actor)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkNotDirect(ctx, chatID); err != nil {
+			return err
+		}
+
+		if roleRank(self.Role) < roleRank(model.RoleAdmin) {
+			return fmt.Errorf("%w: only owners and admins can add members", service.ErrForbidden)
+		}
+
+		var added []string
+		for _, u := range usernames {
+			if u != "" && findMember(members, u) == nil && !slices.Contains(added, u) {
+				added = append(added, u)
+			}
+		}
+
+		if len(added) == 0 {
+			return nil
+		}
+
+		if len(members)+len(added) > maxChatMembers {
+			return fmt.Errorf("%w: maximum %d users allowed per chat", service.ErrChatMemberLimit, maxChatMembers)
+		}
+
+		if err := s.chatRepo.AddMembers(ctx, chatID, added); err != nil {
+			return err
+		}
+
+		changes := make([]*model.Change, 0, len(added))
+		for _, u := range added {
+			changes = append(changes, &model.Change{ChatID: chatID, Kind: model.ChangeMemberAdded, Username: u, Role: model.RoleMember})
+		}
+		if err := s.changeRepo.AddChanges(ctx, changes...); err != nil {
+			return err
+		}
+
+		systemMsg, err = s.postSystemMessage(ctx, chatID, actor, fmt.Sprintf("%s added %s", actor, strings.Join(added, ", ")))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to add members: %w", err)
+	}
+
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// RemoveMember removes a user from a chat. Owners may remove anyone else,
+// admins may remove plain members.
+func (s *chatService) RemoveMember(ctx context.Context, chatID int64, actor, username string) error {
+	if username == "" {
+		return fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		members, self, err := s.lockMembers(ctx, chatID, actor)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkNotDirect(ctx, chatID); err != nil {
+			return err
+		}
+
+		target := findMember(members, username)
+		if target == nil {
+			return fmt.Errorf("%w: %s", service.ErrMemberNotFound, username)
+		}
+
+		if roleRank(self.Role) < roleRank(model.RoleAdmin) || roleRank(self.Role) <= roleRank(target.Role) {
+			return fmt.Errorf("%w: %s cannot remove %s", service.ErrForbidden, self.Role, target.Role)
+		}
+
+		if err := s.removeMember(ctx, chatID, username); err != nil {
+			return err
+		}
+
+		systemMsg, err = s.postSystemMessage(ctx, chatID, actor, fmt.Sprintf("%s removed %s", actor, username))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove member: %w", err)
+	}
+
+	s.hub.Disconnect(chatID, username)
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// LeaveChat removes the caller from a chat. The owner cannot leave.
+func (s *chatService) LeaveChat(ctx context.Context, chatID int64, username string) error {
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, self, err := s.lockMembers(ctx, chatID, username)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkNotDirect(ctx, chatID); err != nil {
+			return err
+		}
+
+		if self.Role == model.RoleOwner {
+			return service.ErrOwnerCannotLeave
+		}
+
+		if err := s.removeMember(ctx, chatID, username); err != nil {
+			return err
+		}
+
+		systemMsg, err = s.postSystemMessage(ctx, chatID, username, fmt.Sprintf("%s left the chat", username))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to leave chat: %w", err)
+	}
+
+	s.hub.Disconnect(chatID, username)
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// SetMemberRole promotes a member to admin or demotes an admin back to member.
+// Only the owner may change roles, and ownership itself cannot be reassigned.
+func (s *chatService) SetMemberRole(ctx context.Context, chatID int64, actor, username string, role model.Role) error {
+	if role != model.RoleAdmin && role != model.RoleMember {
+		return fmt.Errorf("%w: role must be %s or %s", service.ErrInvalidArgument, model.RoleAdmin, model.RoleMember)
+	}
+
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		members, self, err := s.lockMembers(ctx, chatID, actor)
+		if err != nil {
+			return err
+		}
+
+		if self.Role != model.RoleOwner {
+			return fmt.Errorf("%w: only the owner can change roles", service.ErrForbidden)
+		}
+
+		target := findMember(members, username)
+		if target == nil {
+			return fmt.Errorf("%w: %s", service.ErrMemberNotFound, username)
+		}
+
+		if target.Role == model.RoleOwner {
+			return fmt.Errorf("%w: the owner role cannot be changed", service.ErrForbidden)
+		}
+
+		if target.Role == role {
+			return nil
+		}
+
+		if _, err := s.chatRepo.SetMemberRole(ctx, chatID, username, role); err != nil {
+			return err
+		}
+
+		err = s.changeRepo.AddChanges(ctx, &model.Change{ChatID: chatID, Kind: model.ChangeMemberRole, Username: username, Role: role})
+		if err != nil {
+			return err
+		}
+
+		systemMsg, err = s.postSystemMessage(ctx, chatID, actor, fmt.Sprintf("%s made %s %s", actor, username, role))
+		return err
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set member role: %w", err)
+	}
+
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// lockMembers locks the chat for the rest of the transaction and returns its
+// members together with the actor's own membership.
+func (s *chatService) lockMembers(ctx context.Context, chatID int64, actor string) ([]*model.ChatMember, *model.ChatMember, error) {
+	exists, err := s.chatRepo.LockChat(ctx, chatID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !exists {
+		return nil, nil, service.ErrChatNotFound
+	}
+
+	members, err := s.chatRepo.GetChatMembers(ctx, chatID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	self := findMember(members, actor)
+	if self == nil {
+		return nil, nil, fmt.Errorf("%w: %s", service.ErrNotChatMember, actor)
+	}
+
+	return members, self, nil
+}
+
+func (s *chatService) removeMember(ctx context.Context, chatID int64, username string) error {
+	if _, err := s.chatRepo.RemoveMember(ctx, chatID, username); err != nil {
+		return err
+	}
+
+	return s.changeRepo.AddChanges(ctx, &model.Change{ChatID: chatID, Kind: model.ChangeMemberRemoved, Username: username})
+}
+
+func (s *chatService) postSystemMessage(ctx context.Context, chatID int64, actor, text string) (*model.Message, error) {
+	msg, err := s.messageRepo.SendMessage(ctx, &model.Message{
+		ChatID:    chatID,
+		From:      actor,
+		Text:      text,
+		Timestamp: time.Now(),
+		Kind:      model.MessageKindSystem,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.changeRepo.AddChanges(ctx, &model.Change{ChatID: chatID, Kind: model.ChangeMessageSent, MessageID: msg.ID})
+	if err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// publishMessage fans a stored message out to the chat subscribers. It must be
+// called after the transaction that stored the message has been committed.
+func (s *chatService) publishMessage(msg *model.Message) {
+	if msg == nil {
+		return
+	}
+
+	s.hub.Publish(&model.ChatEvent{ChatID: msg.ChatID, Message: msg})
+}
+
+func findMember(members []*model.ChatMember, username string) *model.ChatMember {
+	for _, m := range members {
+		if m.Username == username {
+			return m
+		}
+	}
+	return nil
+}
+
+func roleRank(role model.Role) int {
+	switch role {
+	case model.RoleOwner:
+		return 2
+	case model.RoleAdmin:
+		return 1
+	default:
+		return 0
+	}
+}
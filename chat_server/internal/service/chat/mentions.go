@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// mentionPattern matches @name where the @ does not follow a letter or digit,
+// so that e-mail addresses are not taken for mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\p{L}\p{N}_@])(@[\p{L}\p{N}_.\-]+)`)
+
+// findMentions returns the mentions of members in text, in the order they
+// appear. Trailing dots and dashes are taken as punctuation, not part of the
+// name.
+func findMentions(text string, members []string) []*model.Mention {
+	if !strings.Contains(text, "@") {
+		return nil
+	}
+
+	var res []*model.Mention
+	for _, m := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := m[2], m[3]
+		token := strings.TrimRight(text[start:end], ".-")
+		username := token[1:]
+		if username == "" || !slices.Contains(members, username) {
+			continue
+		}
+
+		res = append(res, &model.Mention{
+			Username: username,
+			Offset:   utf8.RuneCountInString(text[:start]),
+			Length:   utf8.RuneCountInString(token),
+		})
+	}
+	return res
+}
+
+// mentionedUsers returns every user mentioned at least once.
+func mentionedUsers(mentions []*model.Mention) []string {
+	var res []string
+	for _, m := range mentions {
+		if !slices.Contains(res, m.Username) {
+			res = append(res, m.Username)
+		}
+	}
+	return res
+}
+
+// saveMentions stores the members mentioned by a message and sets its
+// Mentions. It runs in the transaction that stores or edits the message.
+func (s *chatService) saveMentions(ctx context.Context, msg *model.Message) error {
+	var members []string
+	if strings.Contains(msg.Text, "@") {
+		chatMembers, err := s.chatRepo.GetChatMembers(ctx, msg.ChatID)
+		if err != nil {
+			return err
+		}
+		for _, m := range chatMembers {
+			members = append(members, m.Username)
+		}
+	}
+
+	msg.Mentions = findMentions(msg.Text, members)
+	return s.messageRepo.SetMentions(ctx, msg.ID, msg.ChatID, mentionedUsers(msg.Mentions))
+}
+
+// ListMentions returns the messages that mention a user, newest first.
+func (s *chatService) ListMentions(ctx context.Context, query *model.MentionQuery) (*model.MessagePage, error) {
+	if query.Username == "" {
+		return nil, fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	limit := pageLimit(query.Limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	messages, err := s.messageRepo.ListMentions(ctx, &model.MentionQuery{
+		Username: query.Username,
+		Cursor:   query.Cursor,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list mentions: %w", err)
+	}
+
+	page := &model.MessagePage{Messages: messages}
+	if len(messages) > limit {
+		page.Messages = messages[:limit]
+		page.NextCursor = page.Messages[limit-1].ID
+	}
+
+	if err := s.enrichMessages(ctx, query.Username, page.Messages...); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+func (s *chatService) attachMentions(ctx context.Context, messages ...*model.Message) error {
+	var ids []int64
+	for _, msg := range messages {
+		if strings.Contains(msg.Text, "@") {
+			ids = append(ids, msg.ID)
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	mentioned, err := s.messageRepo.ListMentionedUsers(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to list mentions: %w", err)
+	}
+
+	for _, msg := range messages {
+		msg.Mentions = findMentions(msg.Text, mentioned[msg.ID])
+	}
+
+	return nil
+}
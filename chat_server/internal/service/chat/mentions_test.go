@@ -0,0 +1,96 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"chat/chat_server/internal/model"
+)
+
+func TestFindMentions(t *testing.T) {
+	t.Parallel()
+
+	members := []string{"alice", "bob", "j.doe", "anna-maria", "ёжик"}
+
+	tests := []struct {
+		name string
+		text string
+		want []*model.Mention
+	}{
+		{
+			name: "no at sign",
+			text: "hello alice",
+		},
+		{
+			name: "start of text",
+			text: "@alice hi",
+			want: []*model.Mention{{Username: "alice", Offset: 0, Length: 6}},
+		},
+		{
+			name: "several in order",
+			text: "hi @bob and @alice",
+			want: []*model.Mention{
+				{Username: "bob", Offset: 3, Length: 4},
+				{Username: "alice", Offset: 12, Length: 6},
+			},
+		},
+		{
+			name: "repeated",
+			text: "@bob @bob",
+			want: []*model.Mention{
+				{Username: "bob", Offset: 0, Length: 4},
+				{Username: "bob", Offset: 5, Length: 4},
+			},
+		},
+		{
+			name: "trailing punctuation",
+			text: "thanks @bob. and @alice-",
+			want: []*model.Mention{
+				{Username: "bob", Offset: 7, Length: 4},
+				{Username: "alice", Offset: 17, Length: 6},
+			},
+		},
+		{
+			name: "dots and dashes inside the name",
+			text: "cc @j.doe, @anna-maria",
+			want: []*model.Mention{
+				{Username: "j.doe", Offset: 3, Length: 6},
+				{Username: "anna-maria", Offset: 11, Length: 11},
+			},
+		},
+		{
+			name: "after punctuation",
+			text: "(@alice)",
+			want: []*model.Mention{{Username: "alice", Offset: 1, Length: 6}},
+		},
+		{
+			name: "email address",
+			text: "write to bob@alice.com",
+		},
+		{
+			name: "double at sign",
+			text: "@@alice",
+		},
+		{
+			name: "not a member",
+			text: "@carol and @alicex",
+		},
+		{
+			name: "bare at sign",
+			text: "meet @ noon @.",
+		},
+		{
+			name: "offsets count runes",
+			text: "привет @ёжик",
+			want: []*model.Mention{{Username: "ёжик", Offset: 7, Length: 5}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.want, findMentions(tt.text, members))
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// EditMessage replaces the text of a message, keeping the previous text as a
+// revision. Only the author may edit a message.
+func (s *chatService) EditMessage(ctx context.Context, actor string, messageID int64, text string) (*model.Message, error) {
+	if err := validateText(text); err != nil {
+		return nil, err
+	}
+
+	var edited *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		msg, err := s.lockMessage(ctx, messageID)
+		if err != nil {
+			return err
+		}
+
+		if msg.Kind != model.MessageKindUser || msg.From != actor {
+			return fmt.Errorf("%w: only the author can edit a message", service.ErrForbidden)
+		}
+
+		if err := s.checkMembership(ctx, msg.ChatID, actor); err != nil {
+			return err
+		}
+
+		edited, err = s.messageRepo.EditMessage(ctx, messageID, text)
+		if err != nil {
+			return err
+		}
+
+		if err := s.saveMentions(ctx, edited); err != nil {
+			return err
+		}
+
+		return s.changeRepo.AddChanges(ctx, &model.Change{ChatID: msg.ChatID, Kind: model.ChangeMessageEdited, MessageID: messageID})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to edit message: %w", err)
+	}
+
+	s.hub.Publish(&model.ChatEvent{ChatID: edited.ChatID, Edited: edited})
+
+	return edited, nil
+}
+
+// DeleteMessage soft-deletes a message. Authors may delete their own messages,
+// owners and admins may delete any message of their chat.
+func (s *chatService) DeleteMessage(ctx context.Context, actor string, messageID int64) error {
+	var (
+		chatID, seq int64
+		attachments []*model.Attachment
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		msg, err := s.lockMessage(ctx, messageID)
+		if err != nil {
+			return err
+		}
+		chatID, seq = msg.ChatID, msg.Seq
+
+		members, err := s.chatRepo.GetChatMembers(ctx, msg.ChatID)
+		if err != nil {
+			return err
+		}
+
+		self := findMember(members, actor)
+		if self == nil {
+			return fmt.Errorf("%w: %s", service.ErrNotChatMember, actor)
+		}
+
+		isAuthor := msg.Kind == model.MessageKindUser && msg.From == actor
+		if !isAuthor && roleRank(self.Role) < roleRank(model.RoleAdmin) {
+			return fmt.Errorf("%w: only the author or an admin can delete a message", service.ErrForbidden)
+		}
+
+		attachments, err = s.attachmentRepo.DeleteMessageAttachments(ctx, messageID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.messageRepo.DeleteMessage(ctx, messageID); err != nil {
+			return err
+		}
+
+		if _, err := s.messageRepo.RemovePin(ctx, messageID); err != nil {
+			return err
+		}
+
+		return s.changeRepo.AddChanges(ctx, &model.Change{ChatID: chatID, Kind: model.ChangeMessageDeleted, MessageID: messageID, Seq: seq, Username: actor})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete message: %w", err)
+	}
+
+	s.deleteAttachmentBlobs(attachments)
+
+	s.hub.Publish(&model.ChatEvent{
+		ChatID:  chatID,
+		Deleted: &model.MessageDeletedEvent{MessageID: messageID, Seq: seq, DeletedBy: actor},
+	})
+
+	return nil
+}
+
+// lockMessage locks a message that has not been deleted yet.
+func (s *chatService) lockMessage(ctx context.Context, messageID int64) (*model.Message, error) {
+	msg, err := s.messageRepo.LockMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg == nil || msg.Deleted {
+		return nil, fmt.Errorf("%w: %d", service.ErrMessageNotFound, messageID)
+	}
+
+	return msg, nil
+}
+
+// ListThread returns the root of a thread and a page of its replies, oldest
+// first. The message id may point at the root or at any of its replies.
+func (s *chatService) ListThread(ctx context.Context, username string, query *model.ThreadQuery) (*model.ThreadPage, error) {
+	root, err := s.messageRepo.GetMessage(ctx, query.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if root != nil && root.ReplyTo != 0 {
+		root, err = s.messageRepo.GetMessage(ctx, root.ReplyTo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get thread root: %w", err)
+		}
+	}
+
+	if root == nil {
+		return nil, fmt.Errorf("%w: %d", service.ErrMessageNotFound, query.MessageID)
+	}
+
+	if err := s.checkMembership(ctx, root.ChatID, username); err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(query.Limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	replies, err := s.messageRepo.ListReplies(ctx, root.ID, query.Cursor, limit+1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list replies: %w", err)
+	}
+
+	page := &model.ThreadPage{Root: root, Replies: replies}
+	if len(replies) > limit {
+		page.Replies = replies[:limit]
+		page.NextCursor = page.Replies[limit-1].ID
+	}
+
+	if err := s.enrichMessages(ctx, username, append([]*model.Message{root}, page.Replies...)...); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// lockThreadRoot locks the message a reply is posted to. Replies to a reply
+// go to the root of its thread, so threads are never nested.
+func (s *chatService) lockThreadRoot(ctx context.Context, chatID, messageID int64) (*model.Message, error) {
+	parent, err := s.lockMessage(ctx, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	if parent.ChatID != chatID {
+		return nil, fmt.Errorf("%w: %d", service.ErrMessageNotFound, messageID)
+	}
+
+	if parent.ReplyTo == 0 {
+		return parent, nil
+	}
+
+	return s.lockMessage(ctx, parent.ReplyTo)
+}
+
+// enrichMessages fills in what clients show along with the message text:
+// reactions as seen by username, mentions and attachments.
+func (s *chatService) enrichMessages(ctx context.Context, username string, messages ...*model.Message) error {
+	if err := s.attachReactions(ctx, username, messages...); err != nil {
+		return err
+	}
+
+	if err := s.attachMentions(ctx, messages...); err != nil {
+		return err
+	}
+
+	return s.attachAttachments(ctx, messages...)
+}
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// PinMessage pins a message to the top of its chat. Only owners and admins may
+// pin, and a chat holds at most pinLimit pinned messages. Pinning a message
+// that is already pinned is a no-op.
+func (s *chatService) PinMessage(ctx context.Context, actor string, messageID int64) error {
+	systemMsg, err := s.changePin(ctx, actor, messageID, true)
+	if err != nil {
+		return fmt.Errorf("failed to pin message: %w", err)
+	}
+
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// UnpinMessage takes a message off the pinned list of its chat. Unpinning a
+// message that is not pinned is a no-op.
+func (s *chatService) UnpinMessage(ctx context.Context, actor string, messageID int64) error {
+	systemMsg, err := s.changePin(ctx, actor, messageID, false)
+	if err != nil {
+		return fmt.Errorf("failed to unpin message: %w", err)
+	}
+
+	s.publishMessage(systemMsg)
+
+	return nil
+}
+
+// changePin pins or unpins a message and posts a system message about it when
+// anything changed. The system message is returned for publishing after commit.
+func (s *chatService) changePin(ctx context.Context, actor string, messageID int64, pin bool) (*model.Message, error) {
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		msg, err := s.lockMessage(ctx, messageID)
+		if err != nil {
+			return err
+		}
+
+		_, self, err := s.lockMembers(ctx, msg.ChatID, actor)
+		if err != nil {
+			return err
+		}
+
+		if roleRank(self.Role) < roleRank(model.RoleAdmin) {
+			return fmt.Errorf("%w: only the owner or an admin can pin messages", service.ErrForbidden)
+		}
+
+		var changed bool
+		if pin {
+			changed, err = s.addPin(ctx, msg, actor)
+		} else {
+			changed, err = s.messageRepo.RemovePin(ctx, messageID)
+		}
+		if err != nil || !changed {
+			return err
+		}
+
+		verb := "unpinned"
+		if pin {
+			verb = "pinned"
+		}
+		systemMsg, err = s.postSystemMessage(ctx, msg.ChatID, actor, fmt.Sprintf("%s %s %s", actor, verb, pinSubject(msg)))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return systemMsg, nil
+}
+
+func (s *chatService) addPin(ctx context.Context, msg *model.Message, actor string) (bool, error) {
+	count, err := s.messageRepo.CountPins(ctx, msg.ChatID)
+	if err != nil {
+		return false, err
+	}
+
+	if count >= s.pinLimit {
+		return false, fmt.Errorf("%w: max %d per chat", service.ErrPinLimit, s.pinLimit)
+	}
+
+	return s.messageRepo.AddPin(ctx, msg.ChatID, msg.ID, actor)
+}
+
+// ListPinned returns the pinned messages of a chat, most recently pinned first.
+func (s *chatService) ListPinned(ctx context.Context, username string, chatID int64) ([]*model.PinnedMessage, error) {
+	if err := s.checkMembership(ctx, chatID, username); err != nil {
+		return nil, err
+	}
+
+	pins, err := s.messageRepo.ListPinned(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pinned messages: %w", err)
+	}
+
+	messages := make([]*model.Message, 0, len(pins))
+	for _, pin := range pins {
+		messages = append(messages, pin.Message)
+	}
+
+	if err := s.enrichMessages(ctx, username, messages...); err != nil {
+		return nil, err
+	}
+
+	return pins, nil
+}
+
+// pinSubject names the message in the pin notice. The text of a message that
+// will expire is left out, so that it does not outlive the message.
+func pinSubject(msg *model.Message) string {
+	if msg.Text == "" || !msg.ExpiresAt.IsZero() {
+		return "a message"
+	}
+	return fmt.Sprintf("%q", preview(msg.Text))
+}
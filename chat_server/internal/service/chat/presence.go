@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+const (
+	maxPresenceUsernames = 100
+
+	// publishPresenceTimeout bounds the lookup of a user's chats when their
+	// presence changes.
+	publishPresenceTimeout = 5 * time.Second
+
+	// presenceQueueSize is the number of presence changes waiting to be
+	// published above which new ones are dropped.
+	presenceQueueSize = 1024
+)
+
+// Heartbeat keeps the caller online while the client is in use.
+func (s *chatService) Heartbeat(_ context.Context, username string) error {
+	if username == "" {
+		return fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	s.presence.Touch(username)
+
+	return nil
+}
+
+// GetPresence returns the presence of the requested users that share a chat
+// with the caller. Other users are left out of the result.
+func (s *chatService) GetPresence(ctx context.Context, username string, usernames []string) ([]*model.Presence, error) {
+	if len(usernames) > maxPresenceUsernames {
+		return nil, fmt.Errorf("%w: too many usernames (max %d)", service.ErrInvalidArgument, maxPresenceUsernames)
+	}
+
+	if len(usernames) == 0 {
+		return nil, nil
+	}
+
+	contacts, err := s.chatRepo.FilterContacts(ctx, username, usernames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get contacts: %w", err)
+	}
+
+	visible := make(map[string]bool, len(contacts))
+	for _, c := range contacts {
+		visible[c] = true
+	}
+
+	allowed := make([]string, 0, len(contacts))
+	for _, u := range usernames {
+		if visible[u] {
+			allowed = append(allowed, u)
+			delete(visible, u)
+		}
+	}
+
+	return s.presence.Get(allowed), nil
+}
+
+// queuePresence hands a presence change to PublishPresenceChanges. It is
+// called on connect and disconnect, so it never blocks: when the queue is
+// full the change is dropped, and the next one of the user corrects it.
+func (s *chatService) queuePresence(p *model.Presence) {
+	select {
+	case s.presenceChanges <- p:
+	default:
+		log.Printf("dropping presence change of %s: queue is full", p.Username)
+	}
+}
+
+// PublishPresenceChanges sends queued presence changes to every chat of their
+// user, so that all of their contacts with an open stream learn about them.
+// It runs until ctx is done.
+func (s *chatService) PublishPresenceChanges(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case p := <-s.presenceChanges:
+			s.publishPresence(ctx, p)
+		}
+	}
+}
+
+func (s *chatService) publishPresence(ctx context.Context, p *model.Presence) {
+	ctx, cancel := context.WithTimeout(ctx, publishPresenceTimeout)
+	defer cancel()
+
+	chatIDs, err := s.chatRepo.ListChatIDs(ctx, p.Username)
+	if err != nil {
+		log.Printf("failed to list chats of %s for presence: %v", p.Username, err)
+		return
+	}
+
+	for _, chatID := range chatIDs {
+		s.hub.PublishEphemeral(&model.ChatEvent{ChatID: chatID, Presence: p})
+	}
+}
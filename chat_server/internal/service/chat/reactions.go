@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+const maxEmojiLength = 16
+
+// AddReaction reacts to a message with an emoji. Adding the same reaction
+// twice is a no-op.
+func (s *chatService) AddReaction(ctx context.Context, username string, messageID int64, emoji string) error {
+	msg, err := s.reactionTarget(ctx, username, messageID, emoji)
+	if err != nil {
+		return err
+	}
+
+	added, err := s.messageRepo.AddReaction(ctx, messageID, username, emoji)
+	if err != nil {
+		return fmt.Errorf("failed to add reaction: %w", err)
+	}
+
+	if added {
+		s.hub.Publish(&model.ChatEvent{
+			ChatID:   msg.ChatID,
+			Reaction: &model.ReactionEvent{MessageID: messageID, Username: username, Emoji: emoji},
+		})
+	}
+
+	return nil
+}
+
+// RemoveReaction takes back a reaction of the user. Removing a reaction that
+// does not exist is a no-op.
+func (s *chatService) RemoveReaction(ctx context.Context, username string, messageID int64, emoji string) error {
+	msg, err := s.reactionTarget(ctx, username, messageID, emoji)
+	if err != nil {
+		return err
+	}
+
+	removed, err := s.messageRepo.RemoveReaction(ctx, messageID, username, emoji)
+	if err != nil {
+		return fmt.Errorf("failed to remove reaction: %w", err)
+	}
+
+	if removed {
+		s.hub.Publish(&model.ChatEvent{
+			ChatID:   msg.ChatID,
+			Reaction: &model.ReactionEvent{MessageID: messageID, Username: username, Emoji: emoji, Removed: true},
+		})
+	}
+
+	return nil
+}
+
+// reactionTarget validates the emoji and returns the message being reacted
+// to, making sure the user can see it.
+func (s *chatService) reactionTarget(ctx context.Context, username string, messageID int64, emoji string) (*model.Message, error) {
+	if emoji == "" || strings.ContainsAny(emoji, " \t\r\n") {
+		return nil, fmt.Errorf("%w: invalid emoji %q", service.ErrInvalidArgument, emoji)
+	}
+
+	if utf8.RuneCountInString(emoji) > maxEmojiLength {
+		return nil, fmt.Errorf("%w: emoji too long (max %d characters)", service.ErrInvalidArgument, maxEmojiLength)
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get message: %w", err)
+	}
+
+	if msg == nil || msg.Deleted {
+		return nil, fmt.Errorf("%w: %d", service.ErrMessageNotFound, messageID)
+	}
+
+	if err := s.checkMembership(ctx, msg.ChatID, username); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// attachReactions fills in the aggregated reactions of the messages as seen
+// by username.
+func (s *chatService) attachReactions(ctx context.Context, username string, messages ...*model.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(messages))
+	for _, msg := range messages {
+		ids = append(ids, msg.ID)
+	}
+
+	reactions, err := s.messageRepo.ListReactions(ctx, ids, username)
+	if err != nil {
+		return fmt.Errorf("failed to list reactions: %w", err)
+	}
+
+	for _, msg := range messages {
+		msg.Reactions = reactions[msg.ID]
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/readstate"
+	"chat/chat_server/internal/service"
+)
+
+// MarkRead moves the read cursor of a member forward. The cursor is buffered
+// and written to the database in batches, while the read event is published
+// right away; an event is only published when the cursor moved past both the
+// buffered and the stored one. The message is not looked up, so that the call
+// stays cheap: the store never moves a cursor past the last message of its
+// chat.
+func (s *chatService) MarkRead(ctx context.Context, username string, chatID, messageID int64) error {
+	if messageID <= 0 {
+		return fmt.Errorf("%w: message id is required", service.ErrInvalidArgument)
+	}
+
+	moved, err := s.readBuffer.Mark(ctx, chatID, username, messageID)
+	if errors.Is(err, readstate.ErrNotMember) {
+		return fmt.Errorf("%w: %s", service.ErrNotChatMember, username)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to mark read: %w", err)
+	}
+
+	if moved {
+		s.hub.Publish(&model.ChatEvent{
+			ChatID: chatID,
+			Read:   &model.ReadEvent{Username: username, MessageID: messageID},
+		})
+	}
+
+	return nil
+}
+
+// GetReadState returns the read cursor of every member of a chat, including
+// updates that have not been flushed yet.
+func (s *chatService) GetReadState(ctx context.Context, username string, chatID int64) ([]*model.ReadCursor, error) {
+	if err := s.checkMembership(ctx, chatID, username); err != nil {
+		return nil, err
+	}
+
+	cursors, err := s.chatRepo.GetReadCursors(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get read cursors: %w", err)
+	}
+
+	pending := s.readBuffer.Pending(chatID)
+	for _, c := range cursors {
+		if pending[c.Username] > c.MessageID {
+			c.MessageID = pending[c.Username]
+		}
+	}
+
+	return cursors, nil
+}
@@ -0,0 +1,145 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+const (
+	maxScheduleDelay = 365 * 24 * time.Hour
+
+	// A message that fails to deliver is retried after scheduledRetryDelay,
+	// doubling with every attempt, and dropped after maxScheduledAttempts.
+	scheduledRetryDelay  = 30 * time.Second
+	maxScheduledAttempts = 5
+)
+
+// ScheduleMessage stores a message to be sent to its chat at msg.SendAt.
+func (s *chatService) ScheduleMessage(ctx context.Context, msg *model.ScheduledMessage) (*model.ScheduledMessage, error) {
+	if err := validateText(msg.Text); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if !msg.SendAt.After(now) {
+		return nil, fmt.Errorf("%w: send time must be in the future", service.ErrInvalidArgument)
+	}
+
+	if msg.SendAt.Sub(now) > maxScheduleDelay {
+		return nil, fmt.Errorf("%w: send time must be within %s", service.ErrInvalidArgument, maxScheduleDelay)
+	}
+
+	if err := s.checkMembership(ctx, msg.ChatID, msg.From); err != nil {
+		return nil, err
+	}
+
+	stored, err := s.scheduledRepo.CreateScheduled(ctx, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to schedule message: %w", err)
+	}
+
+	return stored, nil
+}
+
+// ListScheduledMessages returns the pending messages of a user in send order,
+// either in one chat or, with a zero chatID, in all of them.
+func (s *chatService) ListScheduledMessages(ctx context.Context, username string, chatID int64) ([]*model.ScheduledMessage, error) {
+	msgs, err := s.scheduledRepo.ListScheduled(ctx, username, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list scheduled messages: %w", err)
+	}
+
+	return msgs, nil
+}
+
+// CancelScheduledMessage drops a pending message of the user before it is sent.
+func (s *chatService) CancelScheduledMessage(ctx context.Context, username string, id int64) error {
+	deleted, err := s.scheduledRepo.DeleteScheduled(ctx, id, username)
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled message: %w", err)
+	}
+
+	if !deleted {
+		return fmt.Errorf("%w: %d", service.ErrScheduledMessageNotFound, id)
+	}
+
+	return nil
+}
+
+// DeliverScheduledMessage sends the oldest due scheduled message and reports
+// whether there was one. The message is claimed and sent in one transaction,
+// so it is delivered exactly once even with several schedulers running. A
+// message whose author has left the chat is dropped. A message that fails to
+// deliver is held back for a while, so that it does not block the messages
+// behind it; it still counts as handled and the error is returned.
+func (s *chatService) DeliverScheduledMessage(ctx context.Context) (bool, error) {
+	var (
+		claimed *model.ScheduledMessage
+		stored  *model.Message
+	)
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		msg, err := s.scheduledRepo.ClaimDue(ctx, time.Now())
+		if err != nil || msg == nil {
+			return err
+		}
+		claimed = msg
+
+		member, err := s.chatRepo.IsChatMember(ctx, msg.ChatID, msg.From)
+		if err != nil {
+			return err
+		}
+
+		if !member {
+			log.Printf("dropping scheduled message %d: %s is no longer a member of chat %d", msg.ID, msg.From, msg.ChatID)
+			return nil
+		}
+
+		stored, err = s.storeMessage(ctx, &model.Message{
+			ChatID:    msg.ChatID,
+			From:      msg.From,
+			Text:      msg.Text,
+			Timestamp: time.Now(),
+			Kind:      model.MessageKindUser,
+		})
+		return err
+	})
+	if err != nil {
+		if claimed == nil {
+			return false, fmt.Errorf("failed to deliver scheduled message: %w", err)
+		}
+
+		// The rollback put the message back; hold it back or give up on it.
+		if err := s.retryScheduled(ctx, claimed); err != nil {
+			return false, err
+		}
+		return true, fmt.Errorf("failed to deliver scheduled message %d: %w", claimed.ID, err)
+	}
+
+	s.publishMessage(stored)
+
+	return claimed != nil, nil
+}
+
+// retryScheduled postpones a message whose delivery failed, or drops it once
+// it has failed maxScheduledAttempts times.
+func (s *chatService) retryScheduled(ctx context.Context, msg *model.ScheduledMessage) error {
+	attempts := msg.Attempts + 1
+	if attempts >= maxScheduledAttempts {
+		log.Printf("dropping scheduled message %d after %d failed deliveries", msg.ID, attempts)
+		if _, err := s.scheduledRepo.DeleteScheduled(ctx, msg.ID, msg.From); err != nil {
+			return fmt.Errorf("failed to drop scheduled message %d: %w", msg.ID, err)
+		}
+		return nil
+	}
+
+	next := time.Now().Add(scheduledRetryDelay << msg.Attempts)
+	if err := s.scheduledRepo.PostponeScheduled(ctx, msg.ID, next); err != nil {
+		return fmt.Errorf("failed to postpone scheduled message %d: %w", msg.ID, err)
+	}
+	return nil
+}
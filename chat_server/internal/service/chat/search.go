@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+const maxSearchQueryLength = 256
+
+// SearchMessages runs a full-text search over the chats the user is a member
+// of. When the query is restricted to one chat, membership of that chat is
+// checked up front so that the caller gets a meaningful error.
+func (s *chatService) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) (*model.SearchPage, error) {
+	text := strings.TrimSpace(query.Query)
+	if text == "" {
+		return nil, fmt.Errorf("%w: search query cannot be empty", service.ErrInvalidArgument)
+	}
+
+	if len(text) > maxSearchQueryLength {
+		return nil, fmt.Errorf("%w: search query too long (max %d characters)", service.ErrInvalidArgument, maxSearchQueryLength)
+	}
+
+	if query.ChatID != 0 {
+		if err := s.checkMembership(ctx, query.ChatID, query.Username); err != nil {
+			return nil, err
+		}
+	}
+
+	limit := pageLimit(query.Limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	search := *query
+	search.Query = text
+	search.Limit = limit + 1
+	results, err := s.messageRepo.SearchMessages(ctx, &search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search messages: %w", err)
+	}
+
+	page := &model.SearchPage{Results: results}
+	if len(results) > limit {
+		page.Results = results[:limit]
+		page.NextCursor = page.Results[limit-1].Message.ID
+	}
+
+	return page, nil
+}
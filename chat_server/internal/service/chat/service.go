@@ -2,70 +2,578 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math"
+	"strings"
+	"time"
 
+	"chat/chat_server/internal/blob"
+	"chat/chat_server/internal/config"
+	"chat/chat_server/internal/hub"
 	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/presence"
+	"chat/chat_server/internal/ratelimit"
+	"chat/chat_server/internal/readstate"
 	"chat/chat_server/internal/repository"
 	"chat/chat_server/internal/service"
+	"common/database/client"
+)
+
+const (
+	defaultPageLimit = 50
+	maxPageLimit     = 100
+
+	previewLength = 100
+
+	maxChatMembers = 10
+
+	maxTitleLength = 255
 )
 
 type chatService struct {
-	chatRepo repository.ChatRepository
+	chatRepo      repository.ChatRepository
+	messageRepo   repository.MessageRepository
+	changeRepo    repository.ChangeRepository
+	scheduledRepo repository.ScheduledRepository
+	txManager     client.TxManager
+	hub           *hub.Hub
+	readBuffer    *readstate.Buffer
+	typing        *ratelimit.Limiter
+	typingTTL     time.Duration
+	presence      *presence.Tracker
+	pinLimit      int
+
+	// presenceChanges queues presence changes for PublishPresenceChanges.
+	presenceChanges chan *model.Presence
+
+	attachmentRepo    repository.AttachmentRepository
+	blobs             blob.Store
+	attachmentMaxSize int64
+	uploadTTL         time.Duration
+	// thumbnailSlots bounds the number of images decoded at once.
+	thumbnailSlots chan struct{}
 }
 
-func NewChatService(chatRepo repository.ChatRepository) service.ChatService {
-	return &chatService{
-		chatRepo: chatRepo,
+func NewChatService(
+	chatRepo repository.ChatRepository,
+	messageRepo repository.MessageRepository,
+	attachmentRepo repository.AttachmentRepository,
+	changeRepo repository.ChangeRepository,
+	scheduledRepo repository.ScheduledRepository,
+	txManager client.TxManager,
+	eventHub *hub.Hub,
+	readBuffer *readstate.Buffer,
+	typingCfg *config.TypingConfig,
+	presenceTracker *presence.Tracker,
+	blobs blob.Store,
+	attachmentCfg *config.AttachmentConfig,
+	pinCfg *config.PinConfig,
+) service.ChatService {
+	s := &chatService{
+		chatRepo:      chatRepo,
+		messageRepo:   messageRepo,
+		changeRepo:    changeRepo,
+		scheduledRepo: scheduledRepo,
+		txManager:     txManager,
+		hub:           eventHub,
+		readBuffer:    readBuffer,
+		typing:        ratelimit.New(typingCfg.Interval),
+		typingTTL:     typingCfg.TTL,
+		presence:      presenceTracker,
+		pinLimit:      pinCfg.Limit,
+
+		presenceChanges: make(chan *model.Presence, presenceQueueSize),
+
+		attachmentRepo:    attachmentRepo,
+		blobs:             blobs,
+		attachmentMaxSize: attachmentCfg.MaxSize,
+		uploadTTL:         attachmentCfg.UploadTTL,
+		thumbnailSlots:    make(chan struct{}, attachmentCfg.ThumbnailConcurrency),
 	}
+	presenceTracker.OnChange(s.queuePresence)
+
+	return s
 }
 
+// Create stores a new chat owned by req.Owner. When the request carries a
+// client request id that the owner has used before, the chat created by that
+// earlier request is returned instead. Likewise a direct chat is only created
+// if its two members do not have one yet.
 func (s *chatService) Create(ctx context.Context, req *model.ChatCreate) (int64, error) {
+	chatID, _, err := s.createChat(ctx, req)
+	return chatID, err
+}
+
+// createChat creates a chat unless an equal one exists, and reports whether
+// it did.
+func (s *chatService) createChat(ctx context.Context, req *model.ChatCreate) (int64, bool, error) {
+	if req.Owner == "" {
+		return 0, false, fmt.Errorf("%w: chat owner is required", service.ErrInvalidArgument)
+	}
+
+	if err := validateClientID(req.ClientRequestID); err != nil {
+		return 0, false, err
+	}
+
+	if chatID, err := s.findCreatedChat(ctx, req.Owner, req.ClientRequestID); err != nil || chatID != 0 {
+		return chatID, false, err
+	}
+
 	if len(req.Usernames) == 0 {
-		return 0, fmt.Errorf("at least one username is required")
+		return 0, false, fmt.Errorf("%w: at least one username is required", service.ErrInvalidArgument)
+	}
+
+	members := []*model.ChatMember{{Username: req.Owner, Role: model.RoleOwner}}
+	for _, u := range req.Usernames {
+		if u != "" && findMember(members, u) == nil {
+			members = append(members, &model.ChatMember{Username: u, Role: model.RoleMember})
+		}
+	}
+
+	if len(members) > maxChatMembers {
+		return 0, false, fmt.Errorf("%w: maximum %d users allowed per chat", service.ErrInvalidArgument, maxChatMembers)
+	}
+
+	info := &model.ChatInfo{Type: model.ChatTypeGroup}
+	if req.Info != nil {
+		info = req.Info
+	}
+
+	direct := info.Type == model.ChatTypeDirect
+	if direct && len(members) != 2 {
+		return 0, false, fmt.Errorf("%w: direct chat must have exactly 2 members", service.ErrInvalidArgument)
+	}
+
+	if len([]rune(info.Title)) > maxTitleLength {
+		return 0, false, fmt.Errorf("%w: chat title too long (max %d characters)", service.ErrInvalidArgument, maxTitleLength)
+	}
+
+	if direct {
+		if chatID, err := s.findDirectChat(ctx, members); err != nil || chatID != 0 {
+			return chatID, false, err
+		}
+	}
+
+	var chatID int64
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		var err error
+		chatID, err = s.chatRepo.CreateChat(ctx, info, members, req.ClientRequestID)
+		if err != nil {
+			return err
+		}
+
+		changes := make([]*model.Change, 0, len(members))
+		for _, m := range members {
+			changes = append(changes, &model.Change{ChatID: chatID, Kind: model.ChangeMemberAdded, Username: m.Username, Role: m.Role})
+		}
+		return s.changeRepo.AddChanges(ctx, changes...)
+	})
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		// A concurrent retry of the same request, or a concurrent request for
+		// the same direct chat, got there first.
+		chatID, err = s.findCreatedChat(ctx, req.Owner, req.ClientRequestID)
+		if err == nil && chatID == 0 && direct {
+			chatID, err = s.findDirectChat(ctx, members)
+		}
+		if err == nil && chatID == 0 {
+			err = fmt.Errorf("failed to create chat: %w", service.ErrChatNotFound)
+		}
+		return chatID, false, err
+	}
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to create chat: %w", err)
+	}
+
+	return chatID, true, nil
+}
+
+// Delete removes a chat with all its messages. Only the owner may delete a chat.
+func (s *chatService) Delete(ctx context.Context, id int64, actor string) error {
+	var attachments []*model.Attachment
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		members, self, err := s.lockMembers(ctx, id, actor)
+		if err != nil {
+			return err
+		}
+
+		if self.Role != model.RoleOwner {
+			return fmt.Errorf("%w: only the owner can delete a chat", service.ErrForbidden)
+		}
+
+		// Once the chat is gone its members can only be matched by name.
+		changes := make([]*model.Change, 0, len(members))
+		for _, m := range members {
+			changes = append(changes, &model.Change{ChatID: id, Kind: model.ChangeChatDeleted, Username: m.Username})
+		}
+		if err := s.changeRepo.AddChanges(ctx, changes...); err != nil {
+			return err
+		}
+
+		// The rows would go with the chat anyway; deleting them here tells
+		// us which blobs to remove.
+		attachments, err = s.attachmentRepo.DeleteChatAttachments(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		return s.chatRepo.DeleteChat(ctx, id)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete chat: %w", err)
+	}
+
+	s.deleteAttachmentBlobs(attachments)
+	s.hub.CloseChat(id)
+
+	return nil
+}
+
+func (s *chatService) GetChat(ctx context.Context, chatID int64, username string) (*model.Chat, error) {
+	if err := s.checkMembership(ctx, chatID, username); err != nil {
+		return nil, err
 	}
 
-	if len(req.Usernames) > 10 {
-		return 0, fmt.Errorf("maximum 10 users allowed per chat")
+	chat, err := s.chatRepo.GetChat(ctx, chatID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get chat: %w", err)
 	}
 
-	chatID, err := s.chatRepo.CreateChat(ctx, req.Usernames)
+	chat.Members, err = s.chatRepo.GetChatMembers(ctx, chatID)
 	if err != nil {
-		return 0, fmt.Errorf("failed to create chat: %w", err)
+		return nil, fmt.Errorf("failed to get chat members: %w", err)
 	}
 
-	return chatID, nil
+	return chat, nil
 }
 
-func (s *chatService) Delete(ctx context.Context, id int64) error {
-	exists, err := s.chatRepo.ChatExists(ctx, id)
+// UpdateChat changes the title, description or avatar of a chat. Only owners
+// and admins may update a chat; the chat type cannot be changed.
+func (s *chatService) UpdateChat(ctx context.Context, actor string, update *model.ChatUpdate) (*model.Chat, error) {
+	if update.Title != nil && len([]rune(*update.Title)) > maxTitleLength {
+		return nil, fmt.Errorf("%w: chat title too long (max %d characters)", service.ErrInvalidArgument, maxTitleLength)
+	}
+
+	if update.MessageTTL != nil {
+		if err := validateTTL(*update.MessageTTL); err != nil {
+			return nil, err
+		}
+	}
+
+	var systemMsg *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		_, self, err := s.lockMembers(ctx, update.ID, actor)
+		if err != nil {
+			return err
+		}
+
+		if roleRank(self.Role) < roleRank(model.RoleAdmin) {
+			return fmt.Errorf("%w: only owners and admins can update a chat", service.ErrForbidden)
+		}
+
+		if err := s.chatRepo.UpdateChat(ctx, update); err != nil {
+			return err
+		}
+
+		text := fmt.Sprintf("%s updated the chat info", actor)
+		if update.MessageTTL != nil && update.Title == nil && update.Description == nil && update.AvatarURL == nil {
+			text = ttlUpdateText(actor, *update.MessageTTL)
+		}
+
+		systemMsg, err = s.postSystemMessage(ctx, update.ID, actor, text)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update chat: %w", err)
+	}
+
+	s.publishMessage(systemMsg)
+
+	return s.GetChat(ctx, update.ID, actor)
+}
+
+// SendMessage stores a message and broadcasts it to the chat. A retry that
+// carries the client message id of a message already stored returns that
+// message without storing or broadcasting it again.
+func (s *chatService) SendMessage(ctx context.Context, msg *model.Message) (*model.Message, error) {
+	// A message with attachments may go without text.
+	if msg.Text != "" || len(msg.AttachmentIDs) == 0 {
+		if err := validateText(msg.Text); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateClientID(msg.ClientMessageID); err != nil {
+		return nil, err
+	}
+
+	if err := validateTTL(msg.TTL); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkMembership(ctx, msg.ChatID, msg.From); err != nil {
+		return nil, err
+	}
+
+	if stored, err := s.findSentMessage(ctx, msg); err != nil || stored != nil {
+		return stored, err
+	}
+
+	var stored *model.Message
+	err := s.txManager.ReadCommitted(ctx, func(ctx context.Context) error {
+		post := &model.Message{
+			ChatID:    msg.ChatID,
+			From:      msg.From,
+			Text:      msg.Text,
+			Timestamp: msg.Timestamp,
+			Kind:      model.MessageKindUser,
+			TTL:       msg.TTL,
+
+			ClientMessageID: msg.ClientMessageID,
+		}
+
+		if msg.ReplyTo != 0 {
+			root, err := s.lockThreadRoot(ctx, msg.ChatID, msg.ReplyTo)
+			if err != nil {
+				return err
+			}
+			post.ReplyTo = root.ID
+
+			if err := s.messageRepo.AddReply(ctx, root.ID, time.Now()); err != nil {
+				return err
+			}
+		}
+
+		var err error
+		stored, err = s.storeMessage(ctx, post)
+		if err != nil {
+			return err
+		}
+
+		if len(msg.AttachmentIDs) > 0 {
+			return s.linkAttachments(ctx, stored, msg.AttachmentIDs)
+		}
+		return nil
+	})
+	if errors.Is(err, repository.ErrDuplicateKey) {
+		// A concurrent retry of the same message got there first. The stored
+		// message may have expired since.
+		stored, err = s.findSentMessage(ctx, msg)
+		if err == nil && stored == nil {
+			err = fmt.Errorf("failed to send message: %w: client message id %s", service.ErrMessageNotFound, msg.ClientMessageID)
+		}
+		return stored, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", err)
+	}
+
+	s.presence.Touch(msg.From)
+	s.publishMessage(stored)
+
+	return stored, nil
+}
+
+// storeMessage inserts a user message together with its mentions and change
+// log entry. It must run in a transaction.
+func (s *chatService) storeMessage(ctx context.Context, msg *model.Message) (*model.Message, error) {
+	stored, err := s.messageRepo.SendMessage(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.Contains(stored.Text, "@") {
+		if err := s.saveMentions(ctx, stored); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.changeRepo.AddChanges(ctx, &model.Change{ChatID: stored.ChatID, Kind: model.ChangeMessageSent, MessageID: stored.ID})
+	if err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+func (s *chatService) ConnectChat(ctx context.Context, chatID int64, username string) (*hub.Subscription, error) {
+	if username == "" {
+		return nil, fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	if err := s.checkMembership(ctx, chatID, username); err != nil {
+		return nil, err
+	}
+
+	sub := s.hub.Subscribe(chatID, username)
+
+	disconnect := s.presence.Connect(username)
+	go func() {
+		<-sub.Done()
+		disconnect()
+	}()
+
+	return sub, nil
+}
+
+func (s *chatService) ListMessages(ctx context.Context, username string, query *model.MessageListQuery) (*model.MessagePage, error) {
+	if err := s.checkMembership(ctx, query.ChatID, username); err != nil {
+		return nil, err
+	}
+
+	limit := pageLimit(query.Limit)
+
+	// Fetch one extra row to find out whether there is another page.
+	messages, err := s.messageRepo.ListMessages(ctx, &model.MessageListQuery{
+		ChatID:    query.ChatID,
+		Cursor:    query.Cursor,
+		Limit:     limit + 1,
+		Direction: query.Direction,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	page := &model.MessagePage{Messages: messages}
+	if len(messages) > limit {
+		page.Messages = messages[:limit]
+		page.NextCursor = page.Messages[limit-1].ID
+	}
+
+	if err := s.enrichMessages(ctx, username, page.Messages...); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// ListMessageRange returns the messages of a chat, thread replies included,
+// whose sequence numbers lie in the query range, in sequence order.
+func (s *chatService) ListMessageRange(ctx context.Context, username string, query *model.MessageRangeQuery) ([]*model.Message, error) {
+	fromSeq, toSeq := max(query.FromSeq, 1), query.ToSeq
+	if toSeq == 0 {
+		toSeq = math.MaxInt64
+	}
+	if toSeq < fromSeq {
+		return nil, fmt.Errorf("%w: to_seq %d is before from_seq %d", service.ErrInvalidArgument, toSeq, fromSeq)
+	}
+
+	if err := s.checkMembership(ctx, query.ChatID, username); err != nil {
+		return nil, err
+	}
+
+	messages, err := s.messageRepo.ListMessagesBySeq(ctx, query.ChatID, fromSeq, toSeq, pageLimit(query.Limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	if err := s.enrichMessages(ctx, username, messages...); err != nil {
+		return nil, err
+	}
+
+	return messages, nil
+}
+
+func (s *chatService) ListChats(ctx context.Context, query *model.ChatListQuery) (*model.ChatPage, error) {
+	if query.Username == "" {
+		return nil, fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	limit := pageLimit(query.Limit)
+
+	chats, err := s.chatRepo.ListChats(ctx, &model.ChatListQuery{
+		Username: query.Username,
+		Limit:    limit + 1,
+		After:    query.After,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list chats: %w", err)
+	}
+
+	page := &model.ChatPage{Chats: chats}
+	if len(chats) > limit {
+		page.Chats = chats[:limit]
+		last := page.Chats[limit-1]
+		page.Next = &model.ChatCursor{LastActivityAt: last.LastActivityAt, ChatID: last.ID}
+	}
+
+	for _, chat := range page.Chats {
+		if chat.LastMessage != nil {
+			chat.LastMessage.Text = preview(chat.LastMessage.Text)
+		}
+	}
+
+	return page, nil
+}
+
+// AckMessage broadcasts that a member has received a message. The message
+// must belong to the chat.
+func (s *chatService) AckMessage(ctx context.Context, chatID, messageID int64, username string) error {
+	if messageID <= 0 {
+		return fmt.Errorf("%w: message id is required", service.ErrInvalidArgument)
+	}
+
+	msg, err := s.messageRepo.GetMessage(ctx, messageID)
+	if err != nil {
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	if msg == nil || msg.ChatID != chatID {
+		return fmt.Errorf("%w: %d", service.ErrMessageNotFound, messageID)
+	}
+
+	s.hub.Publish(&model.ChatEvent{
+		ChatID:   chatID,
+		Delivery: &model.DeliveryEvent{MessageID: messageID, Username: username},
+	})
+
+	return nil
+}
+
+// checkMembership makes sure the chat exists and the user is one of its members.
+func (s *chatService) checkMembership(ctx context.Context, chatID int64, username string) error {
+	exists, err := s.chatRepo.ChatExists(ctx, chatID)
 	if err != nil {
 		return fmt.Errorf("failed to check if chat exists: %w", err)
 	}
 
 	if !exists {
-		return fmt.Errorf("chat not found")
+		return service.ErrChatNotFound
 	}
 
-	err = s.chatRepo.DeleteChat(ctx, id)
+	member, err := s.chatRepo.IsChatMember(ctx, chatID, username)
 	if err != nil {
-		return fmt.Errorf("failed to delete chat: %w", err)
+		return fmt.Errorf("failed to check chat membership: %w", err)
+	}
+
+	if !member {
+		return fmt.Errorf("%w: %s", service.ErrNotChatMember, username)
 	}
 
 	return nil
 }
 
-func (s *chatService) SendMessage(ctx context.Context, msg *model.Message) error {
-	if msg.Text == "" {
-		return fmt.Errorf("message text cannot be empty")
+func pageLimit(limit int) int {
+	if limit <= 0 {
+		return defaultPageLimit
 	}
+	return min(limit, maxPageLimit)
+}
 
-	if len(msg.Text) > 1000 {
-		return fmt.Errorf("message text too long (max 1000 characters)")
+// preview shortens a message text to previewLength characters.
+func preview(text string) string {
+	runes := []rune(text)
+	if len(runes) <= previewLength {
+		return text
 	}
+	return string(runes[:previewLength]) + "…"
+}
 
-	err := s.chatRepo.SendMessage(ctx, msg.From, msg.Text, msg.Timestamp)
-	if err != nil {
-		return fmt.Errorf("failed to send message: %w", err)
+func validateText(text string) error {
+	if text == "" {
+		return fmt.Errorf("%w: message text cannot be empty", service.ErrInvalidArgument)
+	}
+
+	if len(text) > 1000 {
+		return fmt.Errorf("%w: message text too long (max 1000 characters)", service.ErrInvalidArgument)
 	}
 
 	return nil
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"chat/chat_server/internal/model"
+)
+
+const (
+	defaultSyncLimit = 500
+	maxSyncLimit     = 1000
+)
+
+// Sync returns what changed for a user since token, up to limit changes. A
+// nil token starts syncing from now: the result is empty apart from the token
+// to pass next time. When HasMore is set the client should call again right
+// away with the returned token.
+func (s *chatService) Sync(ctx context.Context, username string, token *model.SyncToken, limit int) (*model.SyncResult, error) {
+	horizon, err := s.changeRepo.Horizon(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync: %w", err)
+	}
+
+	if token == nil {
+		return &model.SyncResult{Next: &model.SyncToken{From: horizon}}, nil
+	}
+
+	to := token.To
+	if to == 0 {
+		to = horizon
+	}
+
+	limit = syncLimit(limit)
+
+	// Fetch one extra change to find out whether the window has more.
+	changes, err := s.changeRepo.ListChanges(ctx, &model.ChangeQuery{
+		Username: username,
+		From:     token.From,
+		To:       to,
+		After:    token.After,
+		Limit:    limit + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync: %w", err)
+	}
+
+	res := &model.SyncResult{Next: &model.SyncToken{From: to}}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		res.HasMore = true
+		res.Next = &model.SyncToken{From: token.From, To: to, After: changes[limit-1].ID}
+	}
+
+	if err := s.collectChanges(ctx, username, changes, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// collectChanges folds a batch of changes into res. Sent and edited messages
+// are loaded in their current state, and only the latest read cursor of each
+// member is kept.
+func (s *chatService) collectChanges(ctx context.Context, username string, changes []*model.Change, res *model.SyncResult) error {
+	var messageIDs []int64
+	seen := make(map[int64]bool)
+	cursors := make(map[model.ReadCursor]*model.ReadCursor)
+
+	for _, c := range changes {
+		switch c.Kind {
+		case model.ChangeMessageSent, model.ChangeMessageEdited:
+			if !seen[c.MessageID] {
+				seen[c.MessageID] = true
+				messageIDs = append(messageIDs, c.MessageID)
+			}
+		case model.ChangeMessageDeleted:
+			res.Deleted = append(res.Deleted, c)
+		case model.ChangeMemberAdded, model.ChangeMemberRemoved, model.ChangeMemberRole, model.ChangeChatDeleted:
+			res.MemberChanges = append(res.MemberChanges, c)
+		case model.ChangeRead:
+			key := model.ReadCursor{ChatID: c.ChatID, Username: c.Username}
+			if cursor, ok := cursors[key]; ok {
+				cursor.MessageID = c.MessageID
+				continue
+			}
+			cursor := &model.ReadCursor{ChatID: c.ChatID, Username: c.Username, MessageID: c.MessageID}
+			cursors[key] = cursor
+			res.ReadCursors = append(res.ReadCursors, cursor)
+		}
+	}
+
+	if len(messageIDs) == 0 {
+		return nil
+	}
+
+	messages, err := s.messageRepo.ListMessagesByIDs(ctx, messageIDs)
+	if err != nil {
+		return fmt.Errorf("failed to sync: %w", err)
+	}
+
+	// Deleted messages are reported through their delete change, in this
+	// batch or a later one.
+	for _, msg := range messages {
+		if !msg.Deleted {
+			res.Messages = append(res.Messages, msg)
+		}
+	}
+
+	return s.enrichMessages(ctx, username, res.Messages...)
+}
+
+func syncLimit(limit int) int {
+	if limit <= 0 {
+		return defaultSyncLimit
+	}
+	return min(limit, maxSyncLimit)
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"chat/chat_server/internal/model"
+	"chat/chat_server/internal/service"
+)
+
+// SendTyping tells the other members of a chat that the user is typing. The
+// notification goes through the hub's ephemeral channel, is never persisted
+// and expires after the configured TTL. Each user may send at most one
+// notification per rate limit interval, whichever chat it is for.
+func (s *chatService) SendTyping(ctx context.Context, chatID int64, username string) error {
+	if username == "" {
+		return fmt.Errorf("%w: username is required", service.ErrInvalidArgument)
+	}
+
+	if err := s.checkMembership(ctx, chatID, username); err != nil {
+		return err
+	}
+
+	if !s.typing.Allow(username) {
+		return fmt.Errorf("%w: typing notifications are rate limited", service.ErrRateLimited)
+	}
+
+	s.presence.Touch(username)
+	s.hub.PublishEphemeral(&model.ChatEvent{
+		ChatID: chatID,
+		Typing: &model.TypingEvent{Username: username, ExpiresAt: time.Now().Add(s.typingTTL)},
+	})
+
+	return nil
+}
@@ -2,12 +2,51 @@ package service
 
 import (
 	"context"
+	"io"
 
+	"chat/chat_server/internal/hub"
 	"chat/chat_server/internal/model"
 )
 
 type ChatService interface {
 	Create(ctx context.Context, req *model.ChatCreate) (int64, error)
-	Delete(ctx context.Context, id int64) error
-	SendMessage(ctx context.Context, msg *model.Message) error
+	GetOrCreateDirectChat(ctx context.Context, caller, username string) (*model.Chat, bool, error)
+	Delete(ctx context.Context, id int64, actor string) error
+	SendMessage(ctx context.Context, msg *model.Message) (*model.Message, error)
+	ConnectChat(ctx context.Context, chatID int64, username string) (*hub.Subscription, error)
+	ListMessages(ctx context.Context, username string, query *model.MessageListQuery) (*model.MessagePage, error)
+	ListMessageRange(ctx context.Context, username string, query *model.MessageRangeQuery) ([]*model.Message, error)
+	ListMentions(ctx context.Context, query *model.MentionQuery) (*model.MessagePage, error)
+	ListChats(ctx context.Context, query *model.ChatListQuery) (*model.ChatPage, error)
+	AddMembers(ctx context.Context, chatID int64, actor string, usernames []string) error
+	RemoveMember(ctx context.Context, chatID int64, actor, username string) error
+	LeaveChat(ctx context.Context, chatID int64, username string) error
+	SetMemberRole(ctx context.Context, chatID int64, actor, username string, role model.Role) error
+	GetChat(ctx context.Context, chatID int64, username string) (*model.Chat, error)
+	UpdateChat(ctx context.Context, actor string, update *model.ChatUpdate) (*model.Chat, error)
+	EditMessage(ctx context.Context, actor string, messageID int64, text string) (*model.Message, error)
+	DeleteMessage(ctx context.Context, actor string, messageID int64) error
+	ScheduleMessage(ctx context.Context, msg *model.ScheduledMessage) (*model.ScheduledMessage, error)
+	ListScheduledMessages(ctx context.Context, username string, chatID int64) ([]*model.ScheduledMessage, error)
+	CancelScheduledMessage(ctx context.Context, username string, id int64) error
+	DeliverScheduledMessage(ctx context.Context) (bool, error)
+	DeleteExpiredMessages(ctx context.Context, limit int) (int, error)
+	DeleteStaleUploads(ctx context.Context, limit int) (int, error)
+	PublishPresenceChanges(ctx context.Context)
+	SearchMessages(ctx context.Context, query *model.MessageSearchQuery) (*model.SearchPage, error)
+	ListThread(ctx context.Context, username string, query *model.ThreadQuery) (*model.ThreadPage, error)
+	AddReaction(ctx context.Context, username string, messageID int64, emoji string) error
+	RemoveReaction(ctx context.Context, username string, messageID int64, emoji string) error
+	PinMessage(ctx context.Context, actor string, messageID int64) error
+	UnpinMessage(ctx context.Context, actor string, messageID int64) error
+	ListPinned(ctx context.Context, username string, chatID int64) ([]*model.PinnedMessage, error)
+	MarkRead(ctx context.Context, username string, chatID, messageID int64) error
+	GetReadState(ctx context.Context, username string, chatID int64) ([]*model.ReadCursor, error)
+	UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) (*model.Attachment, error)
+	OpenAttachment(ctx context.Context, username string, id int64, thumbnailSize int) (*model.Attachment, io.ReadCloser, error)
+	SendTyping(ctx context.Context, chatID int64, username string) error
+	Heartbeat(ctx context.Context, username string) error
+	GetPresence(ctx context.Context, username string, usernames []string) ([]*model.Presence, error)
+	AckMessage(ctx context.Context, chatID, messageID int64, username string) error
+	Sync(ctx context.Context, username string, token *model.SyncToken, limit int) (*model.SyncResult, error)
 }
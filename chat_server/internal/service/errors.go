@@ -0,0 +1,21 @@
+package service
+
+import "errors"
+
+var (
+	ErrInvalidArgument  = errors.New("invalid argument")
+	ErrChatNotFound     = errors.New("chat not found")
+	ErrNotChatMember    = errors.New("user is not a member of the chat")
+	ErrMemberNotFound   = errors.New("member not found")
+	ErrChatMemberLimit  = errors.New("chat member limit reached")
+	ErrForbidden        = errors.New("not allowed for this chat role")
+	ErrOwnerCannotLeave = errors.New("chat owner cannot leave the chat")
+	ErrMessageNotFound  = errors.New("message not found")
+	ErrRateLimited      = errors.New("too many requests")
+	ErrPinLimit         = errors.New("pinned message limit reached")
+
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	ErrAttachmentTooLarge = errors.New("attachment too large")
+)
@@ -5,8 +5,10 @@ package mocks
 //go:generate minimock -i chat/chat_server/internal/service.ChatService -o chat_service_mock.go -n ChatServiceMock -p mocks
 
 import (
+	"chat/chat_server/internal/hub"
 	"chat/chat_server/internal/model"
 	"context"
+	"io"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -19,6 +21,41 @@ type ChatServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAckMessage          func(ctx context.Context, chatID int64, messageID int64, username string) (err error)
+	funcAckMessageOrigin    string
+	inspectFuncAckMessage   func(ctx context.Context, chatID int64, messageID int64, username string)
+	afterAckMessageCounter  uint64
+	beforeAckMessageCounter uint64
+	AckMessageMock          mChatServiceMockAckMessage
+
+	funcAddMembers          func(ctx context.Context, chatID int64, actor string, usernames []string) (err error)
+	funcAddMembersOrigin    string
+	inspectFuncAddMembers   func(ctx context.Context, chatID int64, actor string, usernames []string)
+	afterAddMembersCounter  uint64
+	beforeAddMembersCounter uint64
+	AddMembersMock          mChatServiceMockAddMembers
+
+	funcAddReaction          func(ctx context.Context, username string, messageID int64, emoji string) (err error)
+	funcAddReactionOrigin    string
+	inspectFuncAddReaction   func(ctx context.Context, username string, messageID int64, emoji string)
+	afterAddReactionCounter  uint64
+	beforeAddReactionCounter uint64
+	AddReactionMock          mChatServiceMockAddReaction
+
+	funcCancelScheduledMessage          func(ctx context.Context, username string, id int64) (err error)
+	funcCancelScheduledMessageOrigin    string
+	inspectFuncCancelScheduledMessage   func(ctx context.Context, username string, id int64)
+	afterCancelScheduledMessageCounter  uint64
+	beforeCancelScheduledMessageCounter uint64
+	CancelScheduledMessageMock          mChatServiceMockCancelScheduledMessage
+
+	funcConnectChat          func(ctx context.Context, chatID int64, username string) (sp1 *hub.Subscription, err error)
+	funcConnectChatOrigin    string
+	inspectFuncConnectChat   func(ctx context.Context, chatID int64, username string)
+	afterConnectChatCounter  uint64
+	beforeConnectChatCounter uint64
+	ConnectChatMock          mChatServiceMockConnectChat
+
 	funcCreate          func(ctx context.Context, req *model.ChatCreate) (i1 int64, err error)
 	funcCreateOrigin    string
 	inspectFuncCreate   func(ctx context.Context, req *model.ChatCreate)
@@ -26,19 +63,243 @@ type ChatServiceMock struct {
 	beforeCreateCounter uint64
 	CreateMock          mChatServiceMockCreate
 
-	funcDelete          func(ctx context.Context, id int64) (err error)
+	funcDelete          func(ctx context.Context, id int64, actor string) (err error)
 	funcDeleteOrigin    string
-	inspectFuncDelete   func(ctx context.Context, id int64)
+	inspectFuncDelete   func(ctx context.Context, id int64, actor string)
 	afterDeleteCounter  uint64
 	beforeDeleteCounter uint64
 	DeleteMock          mChatServiceMockDelete
 
-	funcSendMessage          func(ctx context.Context, msg *model.Message) (err error)
+	funcDeleteExpiredMessages          func(ctx context.Context, limit int) (i1 int, err error)
+	funcDeleteExpiredMessagesOrigin    string
+	inspectFuncDeleteExpiredMessages   func(ctx context.Context, limit int)
+	afterDeleteExpiredMessagesCounter  uint64
+	beforeDeleteExpiredMessagesCounter uint64
+	DeleteExpiredMessagesMock          mChatServiceMockDeleteExpiredMessages
+
+	funcDeleteMessage          func(ctx context.Context, actor string, messageID int64) (err error)
+	funcDeleteMessageOrigin    string
+	inspectFuncDeleteMessage   func(ctx context.Context, actor string, messageID int64)
+	afterDeleteMessageCounter  uint64
+	beforeDeleteMessageCounter uint64
+	DeleteMessageMock          mChatServiceMockDeleteMessage
+
+	funcDeleteStaleUploads          func(ctx context.Context, limit int) (i1 int, err error)
+	funcDeleteStaleUploadsOrigin    string
+	inspectFuncDeleteStaleUploads   func(ctx context.Context, limit int)
+	afterDeleteStaleUploadsCounter  uint64
+	beforeDeleteStaleUploadsCounter uint64
+	DeleteStaleUploadsMock          mChatServiceMockDeleteStaleUploads
+
+	funcDeliverScheduledMessage          func(ctx context.Context) (b1 bool, err error)
+	funcDeliverScheduledMessageOrigin    string
+	inspectFuncDeliverScheduledMessage   func(ctx context.Context)
+	afterDeliverScheduledMessageCounter  uint64
+	beforeDeliverScheduledMessageCounter uint64
+	DeliverScheduledMessageMock          mChatServiceMockDeliverScheduledMessage
+
+	funcEditMessage          func(ctx context.Context, actor string, messageID int64, text string) (mp1 *model.Message, err error)
+	funcEditMessageOrigin    string
+	inspectFuncEditMessage   func(ctx context.Context, actor string, messageID int64, text string)
+	afterEditMessageCounter  uint64
+	beforeEditMessageCounter uint64
+	EditMessageMock          mChatServiceMockEditMessage
+
+	funcGetChat          func(ctx context.Context, chatID int64, username string) (cp1 *model.Chat, err error)
+	funcGetChatOrigin    string
+	inspectFuncGetChat   func(ctx context.Context, chatID int64, username string)
+	afterGetChatCounter  uint64
+	beforeGetChatCounter uint64
+	GetChatMock          mChatServiceMockGetChat
+
+	funcGetOrCreateDirectChat          func(ctx context.Context, caller string, username string) (cp1 *model.Chat, b1 bool, err error)
+	funcGetOrCreateDirectChatOrigin    string
+	inspectFuncGetOrCreateDirectChat   func(ctx context.Context, caller string, username string)
+	afterGetOrCreateDirectChatCounter  uint64
+	beforeGetOrCreateDirectChatCounter uint64
+	GetOrCreateDirectChatMock          mChatServiceMockGetOrCreateDirectChat
+
+	funcGetPresence          func(ctx context.Context, username string, usernames []string) (ppa1 []*model.Presence, err error)
+	funcGetPresenceOrigin    string
+	inspectFuncGetPresence   func(ctx context.Context, username string, usernames []string)
+	afterGetPresenceCounter  uint64
+	beforeGetPresenceCounter uint64
+	GetPresenceMock          mChatServiceMockGetPresence
+
+	funcGetReadState          func(ctx context.Context, username string, chatID int64) (rpa1 []*model.ReadCursor, err error)
+	funcGetReadStateOrigin    string
+	inspectFuncGetReadState   func(ctx context.Context, username string, chatID int64)
+	afterGetReadStateCounter  uint64
+	beforeGetReadStateCounter uint64
+	GetReadStateMock          mChatServiceMockGetReadState
+
+	funcHeartbeat          func(ctx context.Context, username string) (err error)
+	funcHeartbeatOrigin    string
+	inspectFuncHeartbeat   func(ctx context.Context, username string)
+	afterHeartbeatCounter  uint64
+	beforeHeartbeatCounter uint64
+	HeartbeatMock          mChatServiceMockHeartbeat
+
+	funcLeaveChat          func(ctx context.Context, chatID int64, username string) (err error)
+	funcLeaveChatOrigin    string
+	inspectFuncLeaveChat   func(ctx context.Context, chatID int64, username string)
+	afterLeaveChatCounter  uint64
+	beforeLeaveChatCounter uint64
+	LeaveChatMock          mChatServiceMockLeaveChat
+
+	funcListChats          func(ctx context.Context, query *model.ChatListQuery) (cp1 *model.ChatPage, err error)
+	funcListChatsOrigin    string
+	inspectFuncListChats   func(ctx context.Context, query *model.ChatListQuery)
+	afterListChatsCounter  uint64
+	beforeListChatsCounter uint64
+	ListChatsMock          mChatServiceMockListChats
+
+	funcListMentions          func(ctx context.Context, query *model.MentionQuery) (mp1 *model.MessagePage, err error)
+	funcListMentionsOrigin    string
+	inspectFuncListMentions   func(ctx context.Context, query *model.MentionQuery)
+	afterListMentionsCounter  uint64
+	beforeListMentionsCounter uint64
+	ListMentionsMock          mChatServiceMockListMentions
+
+	funcListMessageRange          func(ctx context.Context, username string, query *model.MessageRangeQuery) (mpa1 []*model.Message, err error)
+	funcListMessageRangeOrigin    string
+	inspectFuncListMessageRange   func(ctx context.Context, username string, query *model.MessageRangeQuery)
+	afterListMessageRangeCounter  uint64
+	beforeListMessageRangeCounter uint64
+	ListMessageRangeMock          mChatServiceMockListMessageRange
+
+	funcListMessages          func(ctx context.Context, username string, query *model.MessageListQuery) (mp1 *model.MessagePage, err error)
+	funcListMessagesOrigin    string
+	inspectFuncListMessages   func(ctx context.Context, username string, query *model.MessageListQuery)
+	afterListMessagesCounter  uint64
+	beforeListMessagesCounter uint64
+	ListMessagesMock          mChatServiceMockListMessages
+
+	funcListPinned          func(ctx context.Context, username string, chatID int64) (ppa1 []*model.PinnedMessage, err error)
+	funcListPinnedOrigin    string
+	inspectFuncListPinned   func(ctx context.Context, username string, chatID int64)
+	afterListPinnedCounter  uint64
+	beforeListPinnedCounter uint64
+	ListPinnedMock          mChatServiceMockListPinned
+
+	funcListScheduledMessages          func(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error)
+	funcListScheduledMessagesOrigin    string
+	inspectFuncListScheduledMessages   func(ctx context.Context, username string, chatID int64)
+	afterListScheduledMessagesCounter  uint64
+	beforeListScheduledMessagesCounter uint64
+	ListScheduledMessagesMock          mChatServiceMockListScheduledMessages
+
+	funcListThread          func(ctx context.Context, username string, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error)
+	funcListThreadOrigin    string
+	inspectFuncListThread   func(ctx context.Context, username string, query *model.ThreadQuery)
+	afterListThreadCounter  uint64
+	beforeListThreadCounter uint64
+	ListThreadMock          mChatServiceMockListThread
+
+	funcMarkRead          func(ctx context.Context, username string, chatID int64, messageID int64) (err error)
+	funcMarkReadOrigin    string
+	inspectFuncMarkRead   func(ctx context.Context, username string, chatID int64, messageID int64)
+	afterMarkReadCounter  uint64
+	beforeMarkReadCounter uint64
+	MarkReadMock          mChatServiceMockMarkRead
+
+	funcOpenAttachment          func(ctx context.Context, username string, id int64, thumbnailSize int) (ap1 *model.Attachment, r1 io.ReadCloser, err error)
+	funcOpenAttachmentOrigin    string
+	inspectFuncOpenAttachment   func(ctx context.Context, username string, id int64, thumbnailSize int)
+	afterOpenAttachmentCounter  uint64
+	beforeOpenAttachmentCounter uint64
+	OpenAttachmentMock          mChatServiceMockOpenAttachment
+
+	funcPinMessage          func(ctx context.Context, actor string, messageID int64) (err error)
+	funcPinMessageOrigin    string
+	inspectFuncPinMessage   func(ctx context.Context, actor string, messageID int64)
+	afterPinMessageCounter  uint64
+	beforePinMessageCounter uint64
+	PinMessageMock          mChatServiceMockPinMessage
+
+	funcPublishPresenceChanges          func(ctx context.Context)
+	funcPublishPresenceChangesOrigin    string
+	inspectFuncPublishPresenceChanges   func(ctx context.Context)
+	afterPublishPresenceChangesCounter  uint64
+	beforePublishPresenceChangesCounter uint64
+	PublishPresenceChangesMock          mChatServiceMockPublishPresenceChanges
+
+	funcRemoveMember          func(ctx context.Context, chatID int64, actor string, username string) (err error)
+	funcRemoveMemberOrigin    string
+	inspectFuncRemoveMember   func(ctx context.Context, chatID int64, actor string, username string)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mChatServiceMockRemoveMember
+
+	funcRemoveReaction          func(ctx context.Context, username string, messageID int64, emoji string) (err error)
+	funcRemoveReactionOrigin    string
+	inspectFuncRemoveReaction   func(ctx context.Context, username string, messageID int64, emoji string)
+	afterRemoveReactionCounter  uint64
+	beforeRemoveReactionCounter uint64
+	RemoveReactionMock          mChatServiceMockRemoveReaction
+
+	funcScheduleMessage          func(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error)
+	funcScheduleMessageOrigin    string
+	inspectFuncScheduleMessage   func(ctx context.Context, msg *model.ScheduledMessage)
+	afterScheduleMessageCounter  uint64
+	beforeScheduleMessageCounter uint64
+	ScheduleMessageMock          mChatServiceMockScheduleMessage
+
+	funcSearchMessages          func(ctx context.Context, query *model.MessageSearchQuery) (sp1 *model.SearchPage, err error)
+	funcSearchMessagesOrigin    string
+	inspectFuncSearchMessages   func(ctx context.Context, query *model.MessageSearchQuery)
+	afterSearchMessagesCounter  uint64
+	beforeSearchMessagesCounter uint64
+	SearchMessagesMock          mChatServiceMockSearchMessages
+
+	funcSendMessage          func(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error)
 	funcSendMessageOrigin    string
 	inspectFuncSendMessage   func(ctx context.Context, msg *model.Message)
 	afterSendMessageCounter  uint64
 	beforeSendMessageCounter uint64
 	SendMessageMock          mChatServiceMockSendMessage
+
+	funcSendTyping          func(ctx context.Context, chatID int64, username string) (err error)
+	funcSendTypingOrigin    string
+	inspectFuncSendTyping   func(ctx context.Context, chatID int64, username string)
+	afterSendTypingCounter  uint64
+	beforeSendTypingCounter uint64
+	SendTypingMock          mChatServiceMockSendTyping
+
+	funcSetMemberRole          func(ctx context.Context, chatID int64, actor string, username string, role model.Role) (err error)
+	funcSetMemberRoleOrigin    string
+	inspectFuncSetMemberRole   func(ctx context.Context, chatID int64, actor string, username string, role model.Role)
+	afterSetMemberRoleCounter  uint64
+	beforeSetMemberRoleCounter uint64
+	SetMemberRoleMock          mChatServiceMockSetMemberRole
+
+	funcSync          func(ctx context.Context, username string, token *model.SyncToken, limit int) (sp1 *model.SyncResult, err error)
+	funcSyncOrigin    string
+	inspectFuncSync   func(ctx context.Context, username string, token *model.SyncToken, limit int)
+	afterSyncCounter  uint64
+	beforeSyncCounter uint64
+	SyncMock          mChatServiceMockSync
+
+	funcUnpinMessage          func(ctx context.Context, actor string, messageID int64) (err error)
+	funcUnpinMessageOrigin    string
+	inspectFuncUnpinMessage   func(ctx context.Context, actor string, messageID int64)
+	afterUnpinMessageCounter  uint64
+	beforeUnpinMessageCounter uint64
+	UnpinMessageMock          mChatServiceMockUnpinMessage
+
+	funcUpdateChat          func(ctx context.Context, actor string, update *model.ChatUpdate) (cp1 *model.Chat, err error)
+	funcUpdateChatOrigin    string
+	inspectFuncUpdateChat   func(ctx context.Context, actor string, update *model.ChatUpdate)
+	afterUpdateChatCounter  uint64
+	beforeUpdateChatCounter uint64
+	UpdateChatMock          mChatServiceMockUpdateChat
+
+	funcUploadAttachment          func(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) (ap1 *model.Attachment, err error)
+	funcUploadAttachmentOrigin    string
+	inspectFuncUploadAttachment   func(ctx context.Context, upload *model.AttachmentUpload, r io.Reader)
+	afterUploadAttachmentCounter  uint64
+	beforeUploadAttachmentCounter uint64
+	UploadAttachmentMock          mChatServiceMockUploadAttachment
 }
 
 // NewChatServiceMock returns a mock for mm_service.ChatService
@@ -49,67 +310,183 @@ func NewChatServiceMock(t minimock.Tester) *ChatServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AckMessageMock = mChatServiceMockAckMessage{mock: m}
+	m.AckMessageMock.callArgs = []*ChatServiceMockAckMessageParams{}
+
+	m.AddMembersMock = mChatServiceMockAddMembers{mock: m}
+	m.AddMembersMock.callArgs = []*ChatServiceMockAddMembersParams{}
+
+	m.AddReactionMock = mChatServiceMockAddReaction{mock: m}
+	m.AddReactionMock.callArgs = []*ChatServiceMockAddReactionParams{}
+
+	m.CancelScheduledMessageMock = mChatServiceMockCancelScheduledMessage{mock: m}
+	m.CancelScheduledMessageMock.callArgs = []*ChatServiceMockCancelScheduledMessageParams{}
+
+	m.ConnectChatMock = mChatServiceMockConnectChat{mock: m}
+	m.ConnectChatMock.callArgs = []*ChatServiceMockConnectChatParams{}
+
 	m.CreateMock = mChatServiceMockCreate{mock: m}
 	m.CreateMock.callArgs = []*ChatServiceMockCreateParams{}
 
 	m.DeleteMock = mChatServiceMockDelete{mock: m}
 	m.DeleteMock.callArgs = []*ChatServiceMockDeleteParams{}
 
+	m.DeleteExpiredMessagesMock = mChatServiceMockDeleteExpiredMessages{mock: m}
+	m.DeleteExpiredMessagesMock.callArgs = []*ChatServiceMockDeleteExpiredMessagesParams{}
+
+	m.DeleteMessageMock = mChatServiceMockDeleteMessage{mock: m}
+	m.DeleteMessageMock.callArgs = []*ChatServiceMockDeleteMessageParams{}
+
+	m.DeleteStaleUploadsMock = mChatServiceMockDeleteStaleUploads{mock: m}
+	m.DeleteStaleUploadsMock.callArgs = []*ChatServiceMockDeleteStaleUploadsParams{}
+
+	m.DeliverScheduledMessageMock = mChatServiceMockDeliverScheduledMessage{mock: m}
+	m.DeliverScheduledMessageMock.callArgs = []*ChatServiceMockDeliverScheduledMessageParams{}
+
+	m.EditMessageMock = mChatServiceMockEditMessage{mock: m}
+	m.EditMessageMock.callArgs = []*ChatServiceMockEditMessageParams{}
+
+	m.GetChatMock = mChatServiceMockGetChat{mock: m}
+	m.GetChatMock.callArgs = []*ChatServiceMockGetChatParams{}
+
+	m.GetOrCreateDirectChatMock = mChatServiceMockGetOrCreateDirectChat{mock: m}
+	m.GetOrCreateDirectChatMock.callArgs = []*ChatServiceMockGetOrCreateDirectChatParams{}
+
+	m.GetPresenceMock = mChatServiceMockGetPresence{mock: m}
+	m.GetPresenceMock.callArgs = []*ChatServiceMockGetPresenceParams{}
+
+	m.GetReadStateMock = mChatServiceMockGetReadState{mock: m}
+	m.GetReadStateMock.callArgs = []*ChatServiceMockGetReadStateParams{}
+
+	m.HeartbeatMock = mChatServiceMockHeartbeat{mock: m}
+	m.HeartbeatMock.callArgs = []*ChatServiceMockHeartbeatParams{}
+
+	m.LeaveChatMock = mChatServiceMockLeaveChat{mock: m}
+	m.LeaveChatMock.callArgs = []*ChatServiceMockLeaveChatParams{}
+
+	m.ListChatsMock = mChatServiceMockListChats{mock: m}
+	m.ListChatsMock.callArgs = []*ChatServiceMockListChatsParams{}
+
+	m.ListMentionsMock = mChatServiceMockListMentions{mock: m}
+	m.ListMentionsMock.callArgs = []*ChatServiceMockListMentionsParams{}
+
+	m.ListMessageRangeMock = mChatServiceMockListMessageRange{mock: m}
+	m.ListMessageRangeMock.callArgs = []*ChatServiceMockListMessageRangeParams{}
+
+	m.ListMessagesMock = mChatServiceMockListMessages{mock: m}
+	m.ListMessagesMock.callArgs = []*ChatServiceMockListMessagesParams{}
+
+	m.ListPinnedMock = mChatServiceMockListPinned{mock: m}
+	m.ListPinnedMock.callArgs = []*ChatServiceMockListPinnedParams{}
+
+	m.ListScheduledMessagesMock = mChatServiceMockListScheduledMessages{mock: m}
+	m.ListScheduledMessagesMock.callArgs = []*ChatServiceMockListScheduledMessagesParams{}
+
+	m.ListThreadMock = mChatServiceMockListThread{mock: m}
+	m.ListThreadMock.callArgs = []*ChatServiceMockListThreadParams{}
+
+	m.MarkReadMock = mChatServiceMockMarkRead{mock: m}
+	m.MarkReadMock.callArgs = []*ChatServiceMockMarkReadParams{}
+
+	m.OpenAttachmentMock = mChatServiceMockOpenAttachment{mock: m}
+	m.OpenAttachmentMock.callArgs = []*ChatServiceMockOpenAttachmentParams{}
+
+	m.PinMessageMock = mChatServiceMockPinMessage{mock: m}
+	m.PinMessageMock.callArgs = []*ChatServiceMockPinMessageParams{}
+
+	m.PublishPresenceChangesMock = mChatServiceMockPublishPresenceChanges{mock: m}
+	m.PublishPresenceChangesMock.callArgs = []*ChatServiceMockPublishPresenceChangesParams{}
+
+	m.RemoveMemberMock = mChatServiceMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*ChatServiceMockRemoveMemberParams{}
+
+	m.RemoveReactionMock = mChatServiceMockRemoveReaction{mock: m}
+	m.RemoveReactionMock.callArgs = []*ChatServiceMockRemoveReactionParams{}
+
+	m.ScheduleMessageMock = mChatServiceMockScheduleMessage{mock: m}
+	m.ScheduleMessageMock.callArgs = []*ChatServiceMockScheduleMessageParams{}
+
+	m.SearchMessagesMock = mChatServiceMockSearchMessages{mock: m}
+	m.SearchMessagesMock.callArgs = []*ChatServiceMockSearchMessagesParams{}
+
 	m.SendMessageMock = mChatServiceMockSendMessage{mock: m}
 	m.SendMessageMock.callArgs = []*ChatServiceMockSendMessageParams{}
 
+	m.SendTypingMock = mChatServiceMockSendTyping{mock: m}
+	m.SendTypingMock.callArgs = []*ChatServiceMockSendTypingParams{}
+
+	m.SetMemberRoleMock = mChatServiceMockSetMemberRole{mock: m}
+	m.SetMemberRoleMock.callArgs = []*ChatServiceMockSetMemberRoleParams{}
+
+	m.SyncMock = mChatServiceMockSync{mock: m}
+	m.SyncMock.callArgs = []*ChatServiceMockSyncParams{}
+
+	m.UnpinMessageMock = mChatServiceMockUnpinMessage{mock: m}
+	m.UnpinMessageMock.callArgs = []*ChatServiceMockUnpinMessageParams{}
+
+	m.UpdateChatMock = mChatServiceMockUpdateChat{mock: m}
+	m.UpdateChatMock.callArgs = []*ChatServiceMockUpdateChatParams{}
+
+	m.UploadAttachmentMock = mChatServiceMockUploadAttachment{mock: m}
+	m.UploadAttachmentMock.callArgs = []*ChatServiceMockUploadAttachmentParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
-type mChatServiceMockCreate struct {
+type mChatServiceMockAckMessage struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockCreateExpectation
-	expectations       []*ChatServiceMockCreateExpectation
+	defaultExpectation *ChatServiceMockAckMessageExpectation
+	expectations       []*ChatServiceMockAckMessageExpectation
 
-	callArgs []*ChatServiceMockCreateParams
+	callArgs []*ChatServiceMockAckMessageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockCreateExpectation specifies expectation struct of the ChatService.Create
-type ChatServiceMockCreateExpectation struct {
+// ChatServiceMockAckMessageExpectation specifies expectation struct of the ChatService.AckMessage
+type ChatServiceMockAckMessageExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockCreateParams
-	paramPtrs          *ChatServiceMockCreateParamPtrs
-	expectationOrigins ChatServiceMockCreateExpectationOrigins
-	results            *ChatServiceMockCreateResults
+	params             *ChatServiceMockAckMessageParams
+	paramPtrs          *ChatServiceMockAckMessageParamPtrs
+	expectationOrigins ChatServiceMockAckMessageExpectationOrigins
+	results            *ChatServiceMockAckMessageResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockCreateParams contains parameters of the ChatService.Create
-type ChatServiceMockCreateParams struct {
-	ctx context.Context
-	req *model.ChatCreate
+// ChatServiceMockAckMessageParams contains parameters of the ChatService.AckMessage
+type ChatServiceMockAckMessageParams struct {
+	ctx       context.Context
+	chatID    int64
+	messageID int64
+	username  string
 }
 
-// ChatServiceMockCreateParamPtrs contains pointers to parameters of the ChatService.Create
-type ChatServiceMockCreateParamPtrs struct {
-	ctx *context.Context
-	req **model.ChatCreate
+// ChatServiceMockAckMessageParamPtrs contains pointers to parameters of the ChatService.AckMessage
+type ChatServiceMockAckMessageParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	messageID *int64
+	username  *string
 }
 
-// ChatServiceMockCreateResults contains results of the ChatService.Create
-type ChatServiceMockCreateResults struct {
-	i1  int64
+// ChatServiceMockAckMessageResults contains results of the ChatService.AckMessage
+type ChatServiceMockAckMessageResults struct {
 	err error
 }
 
-// ChatServiceMockCreateOrigins contains origins of expectations of the ChatService.Create
-type ChatServiceMockCreateExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originReq string
+// ChatServiceMockAckMessageOrigins contains origins of expectations of the ChatService.AckMessage
+type ChatServiceMockAckMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originMessageID string
+	originUsername  string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -117,341 +494,14126 @@ type ChatServiceMockCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreate *mChatServiceMockCreate) Optional() *mChatServiceMockCreate {
-	mmCreate.optional = true
-	return mmCreate
+func (mmAckMessage *mChatServiceMockAckMessage) Optional() *mChatServiceMockAckMessage {
+	mmAckMessage.optional = true
+	return mmAckMessage
 }
 
-// Expect sets up expected params for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Expect(ctx context.Context, req *model.ChatCreate) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// Expect sets up expected params for ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) Expect(ctx context.Context, chatID int64, messageID int64, username string) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by ExpectParams functions")
+	if mmAckMessage.defaultExpectation.paramPtrs != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by ExpectParams functions")
 	}
 
-	mmCreate.defaultExpectation.params = &ChatServiceMockCreateParams{ctx, req}
-	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreate.expectations {
-		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
-			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+	mmAckMessage.defaultExpectation.params = &ChatServiceMockAckMessageParams{ctx, chatID, messageID, username}
+	mmAckMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAckMessage.expectations {
+		if minimock.Equal(e.params, mmAckMessage.defaultExpectation.params) {
+			mmAckMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAckMessage.defaultExpectation.params)
 		}
 	}
 
-	return mmCreate
+	return mmAckMessage
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	if mmAckMessage.defaultExpectation.params != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	if mmAckMessage.defaultExpectation.paramPtrs == nil {
+		mmAckMessage.defaultExpectation.paramPtrs = &ChatServiceMockAckMessageParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAckMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAckMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmAckMessage
 }
 
-// ExpectReqParam2 sets up expected param req for ChatService.Create
-func (mmCreate *mChatServiceMockCreate) ExpectReqParam2(req *model.ChatCreate) *mChatServiceMockCreate {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) ExpectChatIDParam2(chatID int64) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{}
+	}
+
+	if mmAckMessage.defaultExpectation.params != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Expect")
+	}
+
+	if mmAckMessage.defaultExpectation.paramPtrs == nil {
+		mmAckMessage.defaultExpectation.paramPtrs = &ChatServiceMockAckMessageParamPtrs{}
+	}
+	mmAckMessage.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAckMessage.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAckMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
+	}
+
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{}
+	}
+
+	if mmAckMessage.defaultExpectation.params != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Expect")
+	}
+
+	if mmAckMessage.defaultExpectation.paramPtrs == nil {
+		mmAckMessage.defaultExpectation.paramPtrs = &ChatServiceMockAckMessageParamPtrs{}
+	}
+	mmAckMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAckMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAckMessage
+}
+
+// ExpectUsernameParam4 sets up expected param username for ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) ExpectUsernameParam4(username string) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
+	}
+
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{}
+	}
+
+	if mmAckMessage.defaultExpectation.params != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Expect")
+	}
+
+	if mmAckMessage.defaultExpectation.paramPtrs == nil {
+		mmAckMessage.defaultExpectation.paramPtrs = &ChatServiceMockAckMessageParamPtrs{}
+	}
+	mmAckMessage.defaultExpectation.paramPtrs.username = &username
+	mmAckMessage.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAckMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) Inspect(f func(ctx context.Context, chatID int64, messageID int64, username string)) *mChatServiceMockAckMessage {
+	if mmAckMessage.mock.inspectFuncAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AckMessage")
+	}
+
+	mmAckMessage.mock.inspectFuncAckMessage = f
+
+	return mmAckMessage
+}
+
+// Return sets up results that will be returned by ChatService.AckMessage
+func (mmAckMessage *mChatServiceMockAckMessage) Return(err error) *ChatServiceMock {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
+	}
+
+	if mmAckMessage.defaultExpectation == nil {
+		mmAckMessage.defaultExpectation = &ChatServiceMockAckMessageExpectation{mock: mmAckMessage.mock}
+	}
+	mmAckMessage.defaultExpectation.results = &ChatServiceMockAckMessageResults{err}
+	mmAckMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAckMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.AckMessage method
+func (mmAckMessage *mChatServiceMockAckMessage) Set(f func(ctx context.Context, chatID int64, messageID int64, username string) (err error)) *ChatServiceMock {
+	if mmAckMessage.defaultExpectation != nil {
+		mmAckMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.AckMessage method")
+	}
+
+	if len(mmAckMessage.expectations) > 0 {
+		mmAckMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.AckMessage method")
+	}
+
+	mmAckMessage.mock.funcAckMessage = f
+	mmAckMessage.mock.funcAckMessageOrigin = minimock.CallerInfo(1)
+	return mmAckMessage.mock
+}
+
+// When sets expectation for the ChatService.AckMessage which will trigger the result defined by the following
+// Then helper
+func (mmAckMessage *mChatServiceMockAckMessage) When(ctx context.Context, chatID int64, messageID int64, username string) *ChatServiceMockAckMessageExpectation {
+	if mmAckMessage.mock.funcAckMessage != nil {
+		mmAckMessage.mock.t.Fatalf("ChatServiceMock.AckMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAckMessageExpectation{
+		mock:               mmAckMessage.mock,
+		params:             &ChatServiceMockAckMessageParams{ctx, chatID, messageID, username},
+		expectationOrigins: ChatServiceMockAckMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAckMessage.expectations = append(mmAckMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AckMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAckMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAckMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AckMessage should be invoked
+func (mmAckMessage *mChatServiceMockAckMessage) Times(n uint64) *mChatServiceMockAckMessage {
+	if n == 0 {
+		mmAckMessage.mock.t.Fatalf("Times of ChatServiceMock.AckMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAckMessage.expectedInvocations, n)
+	mmAckMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAckMessage
+}
+
+func (mmAckMessage *mChatServiceMockAckMessage) invocationsDone() bool {
+	if len(mmAckMessage.expectations) == 0 && mmAckMessage.defaultExpectation == nil && mmAckMessage.mock.funcAckMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAckMessage.mock.afterAckMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAckMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AckMessage implements mm_service.ChatService
+func (mmAckMessage *ChatServiceMock) AckMessage(ctx context.Context, chatID int64, messageID int64, username string) (err error) {
+	mm_atomic.AddUint64(&mmAckMessage.beforeAckMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmAckMessage.afterAckMessageCounter, 1)
+
+	mmAckMessage.t.Helper()
+
+	if mmAckMessage.inspectFuncAckMessage != nil {
+		mmAckMessage.inspectFuncAckMessage(ctx, chatID, messageID, username)
+	}
+
+	mm_params := ChatServiceMockAckMessageParams{ctx, chatID, messageID, username}
+
+	// Record call args
+	mmAckMessage.AckMessageMock.mutex.Lock()
+	mmAckMessage.AckMessageMock.callArgs = append(mmAckMessage.AckMessageMock.callArgs, &mm_params)
+	mmAckMessage.AckMessageMock.mutex.Unlock()
+
+	for _, e := range mmAckMessage.AckMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAckMessage.AckMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAckMessage.AckMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmAckMessage.AckMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmAckMessage.AckMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAckMessageParams{ctx, chatID, messageID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAckMessage.t.Errorf("ChatServiceMock.AckMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckMessage.AckMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAckMessage.t.Errorf("ChatServiceMock.AckMessage got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckMessage.AckMessageMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAckMessage.t.Errorf("ChatServiceMock.AckMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckMessage.AckMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAckMessage.t.Errorf("ChatServiceMock.AckMessage got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAckMessage.AckMessageMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAckMessage.t.Errorf("ChatServiceMock.AckMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAckMessage.AckMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAckMessage.AckMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAckMessage.t.Fatal("No results are set for the ChatServiceMock.AckMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmAckMessage.funcAckMessage != nil {
+		return mmAckMessage.funcAckMessage(ctx, chatID, messageID, username)
+	}
+	mmAckMessage.t.Fatalf("Unexpected call to ChatServiceMock.AckMessage. %v %v %v %v", ctx, chatID, messageID, username)
+	return
+}
+
+// AckMessageAfterCounter returns a count of finished ChatServiceMock.AckMessage invocations
+func (mmAckMessage *ChatServiceMock) AckMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAckMessage.afterAckMessageCounter)
+}
+
+// AckMessageBeforeCounter returns a count of ChatServiceMock.AckMessage invocations
+func (mmAckMessage *ChatServiceMock) AckMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAckMessage.beforeAckMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AckMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAckMessage *mChatServiceMockAckMessage) Calls() []*ChatServiceMockAckMessageParams {
+	mmAckMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAckMessageParams, len(mmAckMessage.callArgs))
+	copy(argCopy, mmAckMessage.callArgs)
+
+	mmAckMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAckMessageDone returns true if the count of the AckMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAckMessageDone() bool {
+	if m.AckMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AckMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AckMessageMock.invocationsDone()
+}
+
+// MinimockAckMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAckMessageInspect() {
+	for _, e := range m.AckMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AckMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAckMessageCounter := mm_atomic.LoadUint64(&m.afterAckMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AckMessageMock.defaultExpectation != nil && afterAckMessageCounter < 1 {
+		if m.AckMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AckMessage at\n%s", m.AckMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AckMessage at\n%s with params: %#v", m.AckMessageMock.defaultExpectation.expectationOrigins.origin, *m.AckMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAckMessage != nil && afterAckMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AckMessage at\n%s", m.funcAckMessageOrigin)
+	}
+
+	if !m.AckMessageMock.invocationsDone() && afterAckMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AckMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AckMessageMock.expectedInvocations), m.AckMessageMock.expectedInvocationsOrigin, afterAckMessageCounter)
+	}
+}
+
+type mChatServiceMockAddMembers struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockAddMembersExpectation
+	expectations       []*ChatServiceMockAddMembersExpectation
+
+	callArgs []*ChatServiceMockAddMembersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockAddMembersExpectation specifies expectation struct of the ChatService.AddMembers
+type ChatServiceMockAddMembersExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockAddMembersParams
+	paramPtrs          *ChatServiceMockAddMembersParamPtrs
+	expectationOrigins ChatServiceMockAddMembersExpectationOrigins
+	results            *ChatServiceMockAddMembersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockAddMembersParams contains parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParams struct {
+	ctx       context.Context
+	chatID    int64
+	actor     string
+	usernames []string
+}
+
+// ChatServiceMockAddMembersParamPtrs contains pointers to parameters of the ChatService.AddMembers
+type ChatServiceMockAddMembersParamPtrs struct {
+	ctx       *context.Context
+	chatID    *int64
+	actor     *string
+	usernames *[]string
+}
+
+// ChatServiceMockAddMembersResults contains results of the ChatService.AddMembers
+type ChatServiceMockAddMembersResults struct {
+	err error
+}
+
+// ChatServiceMockAddMembersOrigins contains origins of expectations of the ChatService.AddMembers
+type ChatServiceMockAddMembersExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originChatID    string
+	originActor     string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddMembers *mChatServiceMockAddMembers) Optional() *mChatServiceMockAddMembers {
+	mmAddMembers.optional = true
+	return mmAddMembers
+}
+
+// Expect sets up expected params for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Expect(ctx context.Context, chatID int64, actor string, usernames []string) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by ExpectParams functions")
+	}
+
+	mmAddMembers.defaultExpectation.params = &ChatServiceMockAddMembersParams{ctx, chatID, actor, usernames}
+	mmAddMembers.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddMembers.expectations {
+		if minimock.Equal(e.params, mmAddMembers.defaultExpectation.params) {
+			mmAddMembers.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddMembers.defaultExpectation.params)
+		}
+	}
+
+	return mmAddMembers
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddMembers.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectChatIDParam2(chatID int64) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.chatID = &chatID
+	mmAddMembers.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectActorParam3(actor string) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.actor = &actor
+	mmAddMembers.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// ExpectUsernamesParam4 sets up expected param usernames for ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) ExpectUsernamesParam4(usernames []string) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{}
+	}
+
+	if mmAddMembers.defaultExpectation.params != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Expect")
+	}
+
+	if mmAddMembers.defaultExpectation.paramPtrs == nil {
+		mmAddMembers.defaultExpectation.paramPtrs = &ChatServiceMockAddMembersParamPtrs{}
+	}
+	mmAddMembers.defaultExpectation.paramPtrs.usernames = &usernames
+	mmAddMembers.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmAddMembers
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Inspect(f func(ctx context.Context, chatID int64, actor string, usernames []string)) *mChatServiceMockAddMembers {
+	if mmAddMembers.mock.inspectFuncAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddMembers")
+	}
+
+	mmAddMembers.mock.inspectFuncAddMembers = f
+
+	return mmAddMembers
+}
+
+// Return sets up results that will be returned by ChatService.AddMembers
+func (mmAddMembers *mChatServiceMockAddMembers) Return(err error) *ChatServiceMock {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	if mmAddMembers.defaultExpectation == nil {
+		mmAddMembers.defaultExpectation = &ChatServiceMockAddMembersExpectation{mock: mmAddMembers.mock}
+	}
+	mmAddMembers.defaultExpectation.results = &ChatServiceMockAddMembersResults{err}
+	mmAddMembers.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// Set uses given function f to mock the ChatService.AddMembers method
+func (mmAddMembers *mChatServiceMockAddMembers) Set(f func(ctx context.Context, chatID int64, actor string, usernames []string) (err error)) *ChatServiceMock {
+	if mmAddMembers.defaultExpectation != nil {
+		mmAddMembers.mock.t.Fatalf("Default expectation is already set for the ChatService.AddMembers method")
+	}
+
+	if len(mmAddMembers.expectations) > 0 {
+		mmAddMembers.mock.t.Fatalf("Some expectations are already set for the ChatService.AddMembers method")
+	}
+
+	mmAddMembers.mock.funcAddMembers = f
+	mmAddMembers.mock.funcAddMembersOrigin = minimock.CallerInfo(1)
+	return mmAddMembers.mock
+}
+
+// When sets expectation for the ChatService.AddMembers which will trigger the result defined by the following
+// Then helper
+func (mmAddMembers *mChatServiceMockAddMembers) When(ctx context.Context, chatID int64, actor string, usernames []string) *ChatServiceMockAddMembersExpectation {
+	if mmAddMembers.mock.funcAddMembers != nil {
+		mmAddMembers.mock.t.Fatalf("ChatServiceMock.AddMembers mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAddMembersExpectation{
+		mock:               mmAddMembers.mock,
+		params:             &ChatServiceMockAddMembersParams{ctx, chatID, actor, usernames},
+		expectationOrigins: ChatServiceMockAddMembersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddMembers.expectations = append(mmAddMembers.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AddMembers return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddMembersExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddMembersResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AddMembers should be invoked
+func (mmAddMembers *mChatServiceMockAddMembers) Times(n uint64) *mChatServiceMockAddMembers {
+	if n == 0 {
+		mmAddMembers.mock.t.Fatalf("Times of ChatServiceMock.AddMembers mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddMembers.expectedInvocations, n)
+	mmAddMembers.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddMembers
+}
+
+func (mmAddMembers *mChatServiceMockAddMembers) invocationsDone() bool {
+	if len(mmAddMembers.expectations) == 0 && mmAddMembers.defaultExpectation == nil && mmAddMembers.mock.funcAddMembers == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddMembers.mock.afterAddMembersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddMembers.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddMembers implements mm_service.ChatService
+func (mmAddMembers *ChatServiceMock) AddMembers(ctx context.Context, chatID int64, actor string, usernames []string) (err error) {
+	mm_atomic.AddUint64(&mmAddMembers.beforeAddMembersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddMembers.afterAddMembersCounter, 1)
+
+	mmAddMembers.t.Helper()
+
+	if mmAddMembers.inspectFuncAddMembers != nil {
+		mmAddMembers.inspectFuncAddMembers(ctx, chatID, actor, usernames)
+	}
+
+	mm_params := ChatServiceMockAddMembersParams{ctx, chatID, actor, usernames}
+
+	// Record call args
+	mmAddMembers.AddMembersMock.mutex.Lock()
+	mmAddMembers.AddMembersMock.callArgs = append(mmAddMembers.AddMembersMock.callArgs, &mm_params)
+	mmAddMembers.AddMembersMock.mutex.Unlock()
+
+	for _, e := range mmAddMembers.AddMembersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddMembers.AddMembersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddMembers.AddMembersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddMembers.AddMembersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddMembers.AddMembersMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAddMembersParams{ctx, chatID, actor, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddMembers.t.Errorf("ChatServiceMock.AddMembers got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddMembers.AddMembersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddMembers.AddMembersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddMembers.t.Fatal("No results are set for the ChatServiceMock.AddMembers")
+		}
+		return (*mm_results).err
+	}
+	if mmAddMembers.funcAddMembers != nil {
+		return mmAddMembers.funcAddMembers(ctx, chatID, actor, usernames)
+	}
+	mmAddMembers.t.Fatalf("Unexpected call to ChatServiceMock.AddMembers. %v %v %v %v", ctx, chatID, actor, usernames)
+	return
+}
+
+// AddMembersAfterCounter returns a count of finished ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.afterAddMembersCounter)
+}
+
+// AddMembersBeforeCounter returns a count of ChatServiceMock.AddMembers invocations
+func (mmAddMembers *ChatServiceMock) AddMembersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddMembers.beforeAddMembersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddMembers.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddMembers *mChatServiceMockAddMembers) Calls() []*ChatServiceMockAddMembersParams {
+	mmAddMembers.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAddMembersParams, len(mmAddMembers.callArgs))
+	copy(argCopy, mmAddMembers.callArgs)
+
+	mmAddMembers.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddMembersDone returns true if the count of the AddMembers invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAddMembersDone() bool {
+	if m.AddMembersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddMembersMock.invocationsDone()
+}
+
+// MinimockAddMembersInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddMembersInspect() {
+	for _, e := range m.AddMembersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddMembersCounter := mm_atomic.LoadUint64(&m.afterAddMembersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddMembersMock.defaultExpectation != nil && afterAddMembersCounter < 1 {
+		if m.AddMembersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s", m.AddMembersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s with params: %#v", m.AddMembersMock.defaultExpectation.expectationOrigins.origin, *m.AddMembersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddMembers != nil && afterAddMembersCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddMembers at\n%s", m.funcAddMembersOrigin)
+	}
+
+	if !m.AddMembersMock.invocationsDone() && afterAddMembersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddMembers at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddMembersMock.expectedInvocations), m.AddMembersMock.expectedInvocationsOrigin, afterAddMembersCounter)
+	}
+}
+
+type mChatServiceMockAddReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockAddReactionExpectation
+	expectations       []*ChatServiceMockAddReactionExpectation
+
+	callArgs []*ChatServiceMockAddReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockAddReactionExpectation specifies expectation struct of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockAddReactionParams
+	paramPtrs          *ChatServiceMockAddReactionParamPtrs
+	expectationOrigins ChatServiceMockAddReactionExpectationOrigins
+	results            *ChatServiceMockAddReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockAddReactionParams contains parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParams struct {
+	ctx       context.Context
+	username  string
+	messageID int64
+	emoji     string
+}
+
+// ChatServiceMockAddReactionParamPtrs contains pointers to parameters of the ChatService.AddReaction
+type ChatServiceMockAddReactionParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	messageID *int64
+	emoji     *string
+}
+
+// ChatServiceMockAddReactionResults contains results of the ChatService.AddReaction
+type ChatServiceMockAddReactionResults struct {
+	err error
+}
+
+// ChatServiceMockAddReactionOrigins contains origins of expectations of the ChatService.AddReaction
+type ChatServiceMockAddReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originMessageID string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddReaction *mChatServiceMockAddReaction) Optional() *mChatServiceMockAddReaction {
+	mmAddReaction.optional = true
+	return mmAddReaction
+}
+
+// Expect sets up expected params for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Expect(ctx context.Context, username string, messageID int64, emoji string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by ExpectParams functions")
+	}
+
+	mmAddReaction.defaultExpectation.params = &ChatServiceMockAddReactionParams{ctx, username, messageID, emoji}
+	mmAddReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReaction.expectations {
+		if minimock.Equal(e.params, mmAddReaction.defaultExpectation.params) {
+			mmAddReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmAddReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectUsernameParam2(username string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.username = &username
+	mmAddReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectMessageIDParam3(messageID int64) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmAddReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) ExpectEmojiParam4(emoji string) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{}
+	}
+
+	if mmAddReaction.defaultExpectation.params != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Expect")
+	}
+
+	if mmAddReaction.defaultExpectation.paramPtrs == nil {
+		mmAddReaction.defaultExpectation.paramPtrs = &ChatServiceMockAddReactionParamPtrs{}
+	}
+	mmAddReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmAddReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmAddReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Inspect(f func(ctx context.Context, username string, messageID int64, emoji string)) *mChatServiceMockAddReaction {
+	if mmAddReaction.mock.inspectFuncAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.AddReaction")
+	}
+
+	mmAddReaction.mock.inspectFuncAddReaction = f
+
+	return mmAddReaction
+}
+
+// Return sets up results that will be returned by ChatService.AddReaction
+func (mmAddReaction *mChatServiceMockAddReaction) Return(err error) *ChatServiceMock {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	if mmAddReaction.defaultExpectation == nil {
+		mmAddReaction.defaultExpectation = &ChatServiceMockAddReactionExpectation{mock: mmAddReaction.mock}
+	}
+	mmAddReaction.defaultExpectation.results = &ChatServiceMockAddReactionResults{err}
+	mmAddReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.AddReaction method
+func (mmAddReaction *mChatServiceMockAddReaction) Set(f func(ctx context.Context, username string, messageID int64, emoji string) (err error)) *ChatServiceMock {
+	if mmAddReaction.defaultExpectation != nil {
+		mmAddReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.AddReaction method")
+	}
+
+	if len(mmAddReaction.expectations) > 0 {
+		mmAddReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.AddReaction method")
+	}
+
+	mmAddReaction.mock.funcAddReaction = f
+	mmAddReaction.mock.funcAddReactionOrigin = minimock.CallerInfo(1)
+	return mmAddReaction.mock
+}
+
+// When sets expectation for the ChatService.AddReaction which will trigger the result defined by the following
+// Then helper
+func (mmAddReaction *mChatServiceMockAddReaction) When(ctx context.Context, username string, messageID int64, emoji string) *ChatServiceMockAddReactionExpectation {
+	if mmAddReaction.mock.funcAddReaction != nil {
+		mmAddReaction.mock.t.Fatalf("ChatServiceMock.AddReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockAddReactionExpectation{
+		mock:               mmAddReaction.mock,
+		params:             &ChatServiceMockAddReactionParams{ctx, username, messageID, emoji},
+		expectationOrigins: ChatServiceMockAddReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddReaction.expectations = append(mmAddReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.AddReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockAddReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockAddReactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.AddReaction should be invoked
+func (mmAddReaction *mChatServiceMockAddReaction) Times(n uint64) *mChatServiceMockAddReaction {
+	if n == 0 {
+		mmAddReaction.mock.t.Fatalf("Times of ChatServiceMock.AddReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddReaction.expectedInvocations, n)
+	mmAddReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReaction
+}
+
+func (mmAddReaction *mChatServiceMockAddReaction) invocationsDone() bool {
+	if len(mmAddReaction.expectations) == 0 && mmAddReaction.defaultExpectation == nil && mmAddReaction.mock.funcAddReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReaction.mock.afterAddReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddReaction implements mm_service.ChatService
+func (mmAddReaction *ChatServiceMock) AddReaction(ctx context.Context, username string, messageID int64, emoji string) (err error) {
+	mm_atomic.AddUint64(&mmAddReaction.beforeAddReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReaction.afterAddReactionCounter, 1)
+
+	mmAddReaction.t.Helper()
+
+	if mmAddReaction.inspectFuncAddReaction != nil {
+		mmAddReaction.inspectFuncAddReaction(ctx, username, messageID, emoji)
+	}
+
+	mm_params := ChatServiceMockAddReactionParams{ctx, username, messageID, emoji}
+
+	// Record call args
+	mmAddReaction.AddReactionMock.mutex.Lock()
+	mmAddReaction.AddReactionMock.callArgs = append(mmAddReaction.AddReactionMock.callArgs, &mm_params)
+	mmAddReaction.AddReactionMock.mutex.Unlock()
+
+	for _, e := range mmAddReaction.AddReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddReaction.AddReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReaction.AddReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReaction.AddReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReaction.AddReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockAddReactionParams{ctx, username, messageID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddReaction.t.Errorf("ChatServiceMock.AddReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReaction.AddReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddReaction.AddReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddReaction.t.Fatal("No results are set for the ChatServiceMock.AddReaction")
+		}
+		return (*mm_results).err
+	}
+	if mmAddReaction.funcAddReaction != nil {
+		return mmAddReaction.funcAddReaction(ctx, username, messageID, emoji)
+	}
+	mmAddReaction.t.Fatalf("Unexpected call to ChatServiceMock.AddReaction. %v %v %v %v", ctx, username, messageID, emoji)
+	return
+}
+
+// AddReactionAfterCounter returns a count of finished ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.afterAddReactionCounter)
+}
+
+// AddReactionBeforeCounter returns a count of ChatServiceMock.AddReaction invocations
+func (mmAddReaction *ChatServiceMock) AddReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReaction.beforeAddReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.AddReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddReaction *mChatServiceMockAddReaction) Calls() []*ChatServiceMockAddReactionParams {
+	mmAddReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockAddReactionParams, len(mmAddReaction.callArgs))
+	copy(argCopy, mmAddReaction.callArgs)
+
+	mmAddReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddReactionDone returns true if the count of the AddReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockAddReactionDone() bool {
+	if m.AddReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddReactionMock.invocationsDone()
+}
+
+// MinimockAddReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockAddReactionInspect() {
+	for _, e := range m.AddReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddReactionCounter := mm_atomic.LoadUint64(&m.afterAddReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddReactionMock.defaultExpectation != nil && afterAddReactionCounter < 1 {
+		if m.AddReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.AddReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s with params: %#v", m.AddReactionMock.defaultExpectation.expectationOrigins.origin, *m.AddReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddReaction != nil && afterAddReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.AddReaction at\n%s", m.funcAddReactionOrigin)
+	}
+
+	if !m.AddReactionMock.invocationsDone() && afterAddReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.AddReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddReactionMock.expectedInvocations), m.AddReactionMock.expectedInvocationsOrigin, afterAddReactionCounter)
+	}
+}
+
+type mChatServiceMockCancelScheduledMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCancelScheduledMessageExpectation
+	expectations       []*ChatServiceMockCancelScheduledMessageExpectation
+
+	callArgs []*ChatServiceMockCancelScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCancelScheduledMessageExpectation specifies expectation struct of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCancelScheduledMessageParams
+	paramPtrs          *ChatServiceMockCancelScheduledMessageParamPtrs
+	expectationOrigins ChatServiceMockCancelScheduledMessageExpectationOrigins
+	results            *ChatServiceMockCancelScheduledMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCancelScheduledMessageParams contains parameters of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageParams struct {
+	ctx      context.Context
+	username string
+	id       int64
+}
+
+// ChatServiceMockCancelScheduledMessageParamPtrs contains pointers to parameters of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *int64
+}
+
+// ChatServiceMockCancelScheduledMessageResults contains results of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageResults struct {
+	err error
+}
+
+// ChatServiceMockCancelScheduledMessageOrigins contains origins of expectations of the ChatService.CancelScheduledMessage
+type ChatServiceMockCancelScheduledMessageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Optional() *mChatServiceMockCancelScheduledMessage {
+	mmCancelScheduledMessage.optional = true
+	return mmCancelScheduledMessage
+}
+
+// Expect sets up expected params for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Expect(ctx context.Context, username string, id int64) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmCancelScheduledMessage.defaultExpectation.params = &ChatServiceMockCancelScheduledMessageParams{ctx, username, id}
+	mmCancelScheduledMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmCancelScheduledMessage.defaultExpectation.params) {
+			mmCancelScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelScheduledMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectUsernameParam2(username string) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.username = &username
+	mmCancelScheduledMessage.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCancelScheduledMessage
+}
+
+// ExpectIdParam3 sets up expected param id for ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) ExpectIdParam3(id int64) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{}
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.params != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Expect")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmCancelScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockCancelScheduledMessageParamPtrs{}
+	}
+	mmCancelScheduledMessage.defaultExpectation.paramPtrs.id = &id
+	mmCancelScheduledMessage.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmCancelScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Inspect(f func(ctx context.Context, username string, id int64)) *mChatServiceMockCancelScheduledMessage {
+	if mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.CancelScheduledMessage")
+	}
+
+	mmCancelScheduledMessage.mock.inspectFuncCancelScheduledMessage = f
+
+	return mmCancelScheduledMessage
+}
+
+// Return sets up results that will be returned by ChatService.CancelScheduledMessage
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Return(err error) *ChatServiceMock {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	if mmCancelScheduledMessage.defaultExpectation == nil {
+		mmCancelScheduledMessage.defaultExpectation = &ChatServiceMockCancelScheduledMessageExpectation{mock: mmCancelScheduledMessage.mock}
+	}
+	mmCancelScheduledMessage.defaultExpectation.results = &ChatServiceMockCancelScheduledMessageResults{err}
+	mmCancelScheduledMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.CancelScheduledMessage method
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Set(f func(ctx context.Context, username string, id int64) (err error)) *ChatServiceMock {
+	if mmCancelScheduledMessage.defaultExpectation != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.CancelScheduledMessage method")
+	}
+
+	if len(mmCancelScheduledMessage.expectations) > 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.CancelScheduledMessage method")
+	}
+
+	mmCancelScheduledMessage.mock.funcCancelScheduledMessage = f
+	mmCancelScheduledMessage.mock.funcCancelScheduledMessageOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduledMessage.mock
+}
+
+// When sets expectation for the ChatService.CancelScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) When(ctx context.Context, username string, id int64) *ChatServiceMockCancelScheduledMessageExpectation {
+	if mmCancelScheduledMessage.mock.funcCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.mock.t.Fatalf("ChatServiceMock.CancelScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCancelScheduledMessageExpectation{
+		mock:               mmCancelScheduledMessage.mock,
+		params:             &ChatServiceMockCancelScheduledMessageParams{ctx, username, id},
+		expectationOrigins: ChatServiceMockCancelScheduledMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelScheduledMessage.expectations = append(mmCancelScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.CancelScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCancelScheduledMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCancelScheduledMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.CancelScheduledMessage should be invoked
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Times(n uint64) *mChatServiceMockCancelScheduledMessage {
+	if n == 0 {
+		mmCancelScheduledMessage.mock.t.Fatalf("Times of ChatServiceMock.CancelScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelScheduledMessage.expectedInvocations, n)
+	mmCancelScheduledMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelScheduledMessage
+}
+
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) invocationsDone() bool {
+	if len(mmCancelScheduledMessage.expectations) == 0 && mmCancelScheduledMessage.defaultExpectation == nil && mmCancelScheduledMessage.mock.funcCancelScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.mock.afterCancelScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelScheduledMessage implements mm_service.ChatService
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessage(ctx context.Context, username string, id int64) (err error) {
+	mm_atomic.AddUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter, 1)
+
+	mmCancelScheduledMessage.t.Helper()
+
+	if mmCancelScheduledMessage.inspectFuncCancelScheduledMessage != nil {
+		mmCancelScheduledMessage.inspectFuncCancelScheduledMessage(ctx, username, id)
+	}
+
+	mm_params := ChatServiceMockCancelScheduledMessageParams{ctx, username, id}
+
+	// Record call args
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Lock()
+	mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs = append(mmCancelScheduledMessage.CancelScheduledMessageMock.callArgs, &mm_params)
+	mmCancelScheduledMessage.CancelScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmCancelScheduledMessage.CancelScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCancelScheduledMessageParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelScheduledMessage.t.Errorf("ChatServiceMock.CancelScheduledMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelScheduledMessage.CancelScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelScheduledMessage.t.Fatal("No results are set for the ChatServiceMock.CancelScheduledMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelScheduledMessage.funcCancelScheduledMessage != nil {
+		return mmCancelScheduledMessage.funcCancelScheduledMessage(ctx, username, id)
+	}
+	mmCancelScheduledMessage.t.Fatalf("Unexpected call to ChatServiceMock.CancelScheduledMessage. %v %v %v", ctx, username, id)
+	return
+}
+
+// CancelScheduledMessageAfterCounter returns a count of finished ChatServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.afterCancelScheduledMessageCounter)
+}
+
+// CancelScheduledMessageBeforeCounter returns a count of ChatServiceMock.CancelScheduledMessage invocations
+func (mmCancelScheduledMessage *ChatServiceMock) CancelScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelScheduledMessage.beforeCancelScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.CancelScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelScheduledMessage *mChatServiceMockCancelScheduledMessage) Calls() []*ChatServiceMockCancelScheduledMessageParams {
+	mmCancelScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCancelScheduledMessageParams, len(mmCancelScheduledMessage.callArgs))
+	copy(argCopy, mmCancelScheduledMessage.callArgs)
+
+	mmCancelScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelScheduledMessageDone returns true if the count of the CancelScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCancelScheduledMessageDone() bool {
+	if m.CancelScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelScheduledMessageMock.invocationsDone()
+}
+
+// MinimockCancelScheduledMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCancelScheduledMessageInspect() {
+	for _, e := range m.CancelScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterCancelScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelScheduledMessageMock.defaultExpectation != nil && afterCancelScheduledMessageCounter < 1 {
+		if m.CancelScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage at\n%s", m.CancelScheduledMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage at\n%s with params: %#v", m.CancelScheduledMessageMock.defaultExpectation.expectationOrigins.origin, *m.CancelScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelScheduledMessage != nil && afterCancelScheduledMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.CancelScheduledMessage at\n%s", m.funcCancelScheduledMessageOrigin)
+	}
+
+	if !m.CancelScheduledMessageMock.invocationsDone() && afterCancelScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.CancelScheduledMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelScheduledMessageMock.expectedInvocations), m.CancelScheduledMessageMock.expectedInvocationsOrigin, afterCancelScheduledMessageCounter)
+	}
+}
+
+type mChatServiceMockConnectChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockConnectChatExpectation
+	expectations       []*ChatServiceMockConnectChatExpectation
+
+	callArgs []*ChatServiceMockConnectChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockConnectChatExpectation specifies expectation struct of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockConnectChatParams
+	paramPtrs          *ChatServiceMockConnectChatParamPtrs
+	expectationOrigins ChatServiceMockConnectChatExpectationOrigins
+	results            *ChatServiceMockConnectChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockConnectChatParams contains parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockConnectChatParamPtrs contains pointers to parameters of the ChatService.ConnectChat
+type ChatServiceMockConnectChatParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockConnectChatResults contains results of the ChatService.ConnectChat
+type ChatServiceMockConnectChatResults struct {
+	sp1 *hub.Subscription
+	err error
+}
+
+// ChatServiceMockConnectChatOrigins contains origins of expectations of the ChatService.ConnectChat
+type ChatServiceMockConnectChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmConnectChat *mChatServiceMockConnectChat) Optional() *mChatServiceMockConnectChat {
+	mmConnectChat.optional = true
+	return mmConnectChat
+}
+
+// Expect sets up expected params for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by ExpectParams functions")
+	}
+
+	mmConnectChat.defaultExpectation.params = &ChatServiceMockConnectChatParams{ctx, chatID, username}
+	mmConnectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmConnectChat.expectations {
+		if minimock.Equal(e.params, mmConnectChat.defaultExpectation.params) {
+			mmConnectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmConnectChat.defaultExpectation.params)
+		}
+	}
+
+	return mmConnectChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmConnectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmConnectChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) ExpectUsernameParam3(username string) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{}
+	}
+
+	if mmConnectChat.defaultExpectation.params != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Expect")
+	}
+
+	if mmConnectChat.defaultExpectation.paramPtrs == nil {
+		mmConnectChat.defaultExpectation.paramPtrs = &ChatServiceMockConnectChatParamPtrs{}
+	}
+	mmConnectChat.defaultExpectation.paramPtrs.username = &username
+	mmConnectChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmConnectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockConnectChat {
+	if mmConnectChat.mock.inspectFuncConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ConnectChat")
+	}
+
+	mmConnectChat.mock.inspectFuncConnectChat = f
+
+	return mmConnectChat
+}
+
+// Return sets up results that will be returned by ChatService.ConnectChat
+func (mmConnectChat *mChatServiceMockConnectChat) Return(sp1 *hub.Subscription, err error) *ChatServiceMock {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	if mmConnectChat.defaultExpectation == nil {
+		mmConnectChat.defaultExpectation = &ChatServiceMockConnectChatExpectation{mock: mmConnectChat.mock}
+	}
+	mmConnectChat.defaultExpectation.results = &ChatServiceMockConnectChatResults{sp1, err}
+	mmConnectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// Set uses given function f to mock the ChatService.ConnectChat method
+func (mmConnectChat *mChatServiceMockConnectChat) Set(f func(ctx context.Context, chatID int64, username string) (sp1 *hub.Subscription, err error)) *ChatServiceMock {
+	if mmConnectChat.defaultExpectation != nil {
+		mmConnectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.ConnectChat method")
+	}
+
+	if len(mmConnectChat.expectations) > 0 {
+		mmConnectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.ConnectChat method")
+	}
+
+	mmConnectChat.mock.funcConnectChat = f
+	mmConnectChat.mock.funcConnectChatOrigin = minimock.CallerInfo(1)
+	return mmConnectChat.mock
+}
+
+// When sets expectation for the ChatService.ConnectChat which will trigger the result defined by the following
+// Then helper
+func (mmConnectChat *mChatServiceMockConnectChat) When(ctx context.Context, chatID int64, username string) *ChatServiceMockConnectChatExpectation {
+	if mmConnectChat.mock.funcConnectChat != nil {
+		mmConnectChat.mock.t.Fatalf("ChatServiceMock.ConnectChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockConnectChatExpectation{
+		mock:               mmConnectChat.mock,
+		params:             &ChatServiceMockConnectChatParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockConnectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmConnectChat.expectations = append(mmConnectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ConnectChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockConnectChatExpectation) Then(sp1 *hub.Subscription, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockConnectChatResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ConnectChat should be invoked
+func (mmConnectChat *mChatServiceMockConnectChat) Times(n uint64) *mChatServiceMockConnectChat {
+	if n == 0 {
+		mmConnectChat.mock.t.Fatalf("Times of ChatServiceMock.ConnectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmConnectChat.expectedInvocations, n)
+	mmConnectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmConnectChat
+}
+
+func (mmConnectChat *mChatServiceMockConnectChat) invocationsDone() bool {
+	if len(mmConnectChat.expectations) == 0 && mmConnectChat.defaultExpectation == nil && mmConnectChat.mock.funcConnectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmConnectChat.mock.afterConnectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmConnectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ConnectChat implements mm_service.ChatService
+func (mmConnectChat *ChatServiceMock) ConnectChat(ctx context.Context, chatID int64, username string) (sp1 *hub.Subscription, err error) {
+	mm_atomic.AddUint64(&mmConnectChat.beforeConnectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmConnectChat.afterConnectChatCounter, 1)
+
+	mmConnectChat.t.Helper()
+
+	if mmConnectChat.inspectFuncConnectChat != nil {
+		mmConnectChat.inspectFuncConnectChat(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockConnectChatParams{ctx, chatID, username}
+
+	// Record call args
+	mmConnectChat.ConnectChatMock.mutex.Lock()
+	mmConnectChat.ConnectChatMock.callArgs = append(mmConnectChat.ConnectChatMock.callArgs, &mm_params)
+	mmConnectChat.ConnectChatMock.mutex.Unlock()
+
+	for _, e := range mmConnectChat.ConnectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmConnectChat.ConnectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmConnectChat.ConnectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmConnectChat.ConnectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmConnectChat.ConnectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockConnectChatParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmConnectChat.t.Errorf("ChatServiceMock.ConnectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmConnectChat.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmConnectChat.ConnectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmConnectChat.t.Fatal("No results are set for the ChatServiceMock.ConnectChat")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmConnectChat.funcConnectChat != nil {
+		return mmConnectChat.funcConnectChat(ctx, chatID, username)
+	}
+	mmConnectChat.t.Fatalf("Unexpected call to ChatServiceMock.ConnectChat. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// ConnectChatAfterCounter returns a count of finished ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.afterConnectChatCounter)
+}
+
+// ConnectChatBeforeCounter returns a count of ChatServiceMock.ConnectChat invocations
+func (mmConnectChat *ChatServiceMock) ConnectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmConnectChat.beforeConnectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ConnectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmConnectChat *mChatServiceMockConnectChat) Calls() []*ChatServiceMockConnectChatParams {
+	mmConnectChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockConnectChatParams, len(mmConnectChat.callArgs))
+	copy(argCopy, mmConnectChat.callArgs)
+
+	mmConnectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockConnectChatDone returns true if the count of the ConnectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockConnectChatDone() bool {
+	if m.ConnectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ConnectChatMock.invocationsDone()
+}
+
+// MinimockConnectChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockConnectChatInspect() {
+	for _, e := range m.ConnectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterConnectChatCounter := mm_atomic.LoadUint64(&m.afterConnectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ConnectChatMock.defaultExpectation != nil && afterConnectChatCounter < 1 {
+		if m.ConnectChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.ConnectChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s with params: %#v", m.ConnectChatMock.defaultExpectation.expectationOrigins.origin, *m.ConnectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcConnectChat != nil && afterConnectChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ConnectChat at\n%s", m.funcConnectChatOrigin)
+	}
+
+	if !m.ConnectChatMock.invocationsDone() && afterConnectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ConnectChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ConnectChatMock.expectedInvocations), m.ConnectChatMock.expectedInvocationsOrigin, afterConnectChatCounter)
+	}
+}
+
+type mChatServiceMockCreate struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockCreateExpectation
+	expectations       []*ChatServiceMockCreateExpectation
+
+	callArgs []*ChatServiceMockCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockCreateExpectation specifies expectation struct of the ChatService.Create
+type ChatServiceMockCreateExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockCreateParams
+	paramPtrs          *ChatServiceMockCreateParamPtrs
+	expectationOrigins ChatServiceMockCreateExpectationOrigins
+	results            *ChatServiceMockCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockCreateParams contains parameters of the ChatService.Create
+type ChatServiceMockCreateParams struct {
+	ctx context.Context
+	req *model.ChatCreate
+}
+
+// ChatServiceMockCreateParamPtrs contains pointers to parameters of the ChatService.Create
+type ChatServiceMockCreateParamPtrs struct {
+	ctx *context.Context
+	req **model.ChatCreate
+}
+
+// ChatServiceMockCreateResults contains results of the ChatService.Create
+type ChatServiceMockCreateResults struct {
+	i1  int64
+	err error
+}
+
+// ChatServiceMockCreateOrigins contains origins of expectations of the ChatService.Create
+type ChatServiceMockCreateExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreate *mChatServiceMockCreate) Optional() *mChatServiceMockCreate {
+	mmCreate.optional = true
+	return mmCreate
+}
+
+// Expect sets up expected params for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Expect(ctx context.Context, req *model.ChatCreate) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by ExpectParams functions")
+	}
+
+	mmCreate.defaultExpectation.params = &ChatServiceMockCreateParams{ctx, req}
+	mmCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreate.expectations {
+		if minimock.Equal(e.params, mmCreate.defaultExpectation.params) {
+			mmCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) ExpectCtxParam1(ctx context.Context) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// ExpectReqParam2 sets up expected param req for ChatService.Create
+func (mmCreate *mChatServiceMockCreate) ExpectReqParam2(req *model.ChatCreate) *mChatServiceMockCreate {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{}
+	}
+
+	if mmCreate.defaultExpectation.params != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	}
+
+	if mmCreate.defaultExpectation.paramPtrs == nil {
+		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	}
+	mmCreate.defaultExpectation.paramPtrs.req = &req
+	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Inspect(f func(ctx context.Context, req *model.ChatCreate)) *mChatServiceMockCreate {
+	if mmCreate.mock.inspectFuncCreate != nil {
+		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Create")
+	}
+
+	mmCreate.mock.inspectFuncCreate = f
+
+	return mmCreate
+}
+
+// Return sets up results that will be returned by ChatService.Create
+func (mmCreate *mChatServiceMockCreate) Return(i1 int64, err error) *ChatServiceMock {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	if mmCreate.defaultExpectation == nil {
+		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{mock: mmCreate.mock}
+	}
+	mmCreate.defaultExpectation.results = &ChatServiceMockCreateResults{i1, err}
+	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// Set uses given function f to mock the ChatService.Create method
+func (mmCreate *mChatServiceMockCreate) Set(f func(ctx context.Context, req *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
+	if mmCreate.defaultExpectation != nil {
+		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatService.Create method")
+	}
+
+	if len(mmCreate.expectations) > 0 {
+		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatService.Create method")
+	}
+
+	mmCreate.mock.funcCreate = f
+	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
+	return mmCreate.mock
+}
+
+// When sets expectation for the ChatService.Create which will trigger the result defined by the following
+// Then helper
+func (mmCreate *mChatServiceMockCreate) When(ctx context.Context, req *model.ChatCreate) *ChatServiceMockCreateExpectation {
+	if mmCreate.mock.funcCreate != nil {
+		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockCreateExpectation{
+		mock:               mmCreate.mock,
+		params:             &ChatServiceMockCreateParams{ctx, req},
+		expectationOrigins: ChatServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Create return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockCreateExpectation) Then(i1 int64, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockCreateResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Create should be invoked
+func (mmCreate *mChatServiceMockCreate) Times(n uint64) *mChatServiceMockCreate {
+	if n == 0 {
+		mmCreate.mock.t.Fatalf("Times of ChatServiceMock.Create mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
+	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreate
+}
+
+func (mmCreate *mChatServiceMockCreate) invocationsDone() bool {
+	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Create implements mm_service.ChatService
+func (mmCreate *ChatServiceMock) Create(ctx context.Context, req *model.ChatCreate) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+
+	mmCreate.t.Helper()
+
+	if mmCreate.inspectFuncCreate != nil {
+		mmCreate.inspectFuncCreate(ctx, req)
+	}
+
+	mm_params := ChatServiceMockCreateParams{ctx, req}
+
+	// Record call args
+	mmCreate.CreateMock.mutex.Lock()
+	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
+	mmCreate.CreateMock.mutex.Unlock()
+
+	for _, e := range mmCreate.CreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmCreate.CreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreate.CreateMock.defaultExpectation.params
+		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockCreateParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreate.t.Fatal("No results are set for the ChatServiceMock.Create")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmCreate.funcCreate != nil {
+		return mmCreate.funcCreate(ctx, req)
+	}
+	mmCreate.t.Fatalf("Unexpected call to ChatServiceMock.Create. %v %v", ctx, req)
+	return
+}
+
+// CreateAfterCounter returns a count of finished ChatServiceMock.Create invocations
+func (mmCreate *ChatServiceMock) CreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+}
+
+// CreateBeforeCounter returns a count of ChatServiceMock.Create invocations
+func (mmCreate *ChatServiceMock) CreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Create.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreate *mChatServiceMockCreate) Calls() []*ChatServiceMockCreateParams {
+	mmCreate.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockCreateParams, len(mmCreate.callArgs))
+	copy(argCopy, mmCreate.callArgs)
+
+	mmCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockCreateDone() bool {
+	if m.CreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateMock.invocationsDone()
+}
+
+// MinimockCreateInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockCreateInspect() {
+	for _, e := range m.CreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
+		if m.CreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreate != nil && afterCreateCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.funcCreateOrigin)
+	}
+
+	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Create at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	}
+}
+
+type mChatServiceMockDelete struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteExpectation
+	expectations       []*ChatServiceMockDeleteExpectation
+
+	callArgs []*ChatServiceMockDeleteParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteExpectation specifies expectation struct of the ChatService.Delete
+type ChatServiceMockDeleteExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteParams
+	paramPtrs          *ChatServiceMockDeleteParamPtrs
+	expectationOrigins ChatServiceMockDeleteExpectationOrigins
+	results            *ChatServiceMockDeleteResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteParams contains parameters of the ChatService.Delete
+type ChatServiceMockDeleteParams struct {
+	ctx   context.Context
+	id    int64
+	actor string
+}
+
+// ChatServiceMockDeleteParamPtrs contains pointers to parameters of the ChatService.Delete
+type ChatServiceMockDeleteParamPtrs struct {
+	ctx   *context.Context
+	id    *int64
+	actor *string
+}
+
+// ChatServiceMockDeleteResults contains results of the ChatService.Delete
+type ChatServiceMockDeleteResults struct {
+	err error
+}
+
+// ChatServiceMockDeleteOrigins contains origins of expectations of the ChatService.Delete
+type ChatServiceMockDeleteExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originId    string
+	originActor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDelete *mChatServiceMockDelete) Optional() *mChatServiceMockDelete {
+	mmDelete.optional = true
+	return mmDelete
+}
+
+// Expect sets up expected params for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Expect(ctx context.Context, id int64, actor string) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by ExpectParams functions")
+	}
+
+	mmDelete.defaultExpectation.params = &ChatServiceMockDeleteParams{ctx, id, actor}
+	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDelete.expectations {
+		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
+			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+		}
+	}
+
+	return mmDelete
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectIdParam2 sets up expected param id for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) ExpectIdParam2(id int64) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.id = &id
+	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) ExpectActorParam3(actor string) *mChatServiceMockDelete {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	}
+
+	if mmDelete.defaultExpectation.params != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	}
+
+	if mmDelete.defaultExpectation.paramPtrs == nil {
+		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	}
+	mmDelete.defaultExpectation.paramPtrs.actor = &actor
+	mmDelete.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmDelete
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Inspect(f func(ctx context.Context, id int64, actor string)) *mChatServiceMockDelete {
+	if mmDelete.mock.inspectFuncDelete != nil {
+		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Delete")
+	}
+
+	mmDelete.mock.inspectFuncDelete = f
+
+	return mmDelete
+}
+
+// Return sets up results that will be returned by ChatService.Delete
+func (mmDelete *mChatServiceMockDelete) Return(err error) *ChatServiceMock {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	if mmDelete.defaultExpectation == nil {
+		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{mock: mmDelete.mock}
+	}
+	mmDelete.defaultExpectation.results = &ChatServiceMockDeleteResults{err}
+	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// Set uses given function f to mock the ChatService.Delete method
+func (mmDelete *mChatServiceMockDelete) Set(f func(ctx context.Context, id int64, actor string) (err error)) *ChatServiceMock {
+	if mmDelete.defaultExpectation != nil {
+		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatService.Delete method")
+	}
+
+	if len(mmDelete.expectations) > 0 {
+		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatService.Delete method")
+	}
+
+	mmDelete.mock.funcDelete = f
+	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
+	return mmDelete.mock
+}
+
+// When sets expectation for the ChatService.Delete which will trigger the result defined by the following
+// Then helper
+func (mmDelete *mChatServiceMockDelete) When(ctx context.Context, id int64, actor string) *ChatServiceMockDeleteExpectation {
+	if mmDelete.mock.funcDelete != nil {
+		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteExpectation{
+		mock:               mmDelete.mock,
+		params:             &ChatServiceMockDeleteParams{ctx, id, actor},
+		expectationOrigins: ChatServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Delete return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Delete should be invoked
+func (mmDelete *mChatServiceMockDelete) Times(n uint64) *mChatServiceMockDelete {
+	if n == 0 {
+		mmDelete.mock.t.Fatalf("Times of ChatServiceMock.Delete mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
+	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDelete
+}
+
+func (mmDelete *mChatServiceMockDelete) invocationsDone() bool {
+	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Delete implements mm_service.ChatService
+func (mmDelete *ChatServiceMock) Delete(ctx context.Context, id int64, actor string) (err error) {
+	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
+	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+
+	mmDelete.t.Helper()
+
+	if mmDelete.inspectFuncDelete != nil {
+		mmDelete.inspectFuncDelete(ctx, id, actor)
+	}
+
+	mm_params := ChatServiceMockDeleteParams{ctx, id, actor}
+
+	// Record call args
+	mmDelete.DeleteMock.mutex.Lock()
+	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
+	mmDelete.DeleteMock.mutex.Unlock()
+
+	for _, e := range mmDelete.DeleteMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDelete.DeleteMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
+		mm_want := mmDelete.DeleteMock.defaultExpectation.params
+		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteParams{ctx, id, actor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDelete.t.Fatal("No results are set for the ChatServiceMock.Delete")
+		}
+		return (*mm_results).err
+	}
+	if mmDelete.funcDelete != nil {
+		return mmDelete.funcDelete(ctx, id, actor)
+	}
+	mmDelete.t.Fatalf("Unexpected call to ChatServiceMock.Delete. %v %v %v", ctx, id, actor)
+	return
+}
+
+// DeleteAfterCounter returns a count of finished ChatServiceMock.Delete invocations
+func (mmDelete *ChatServiceMock) DeleteAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+}
+
+// DeleteBeforeCounter returns a count of ChatServiceMock.Delete invocations
+func (mmDelete *ChatServiceMock) DeleteBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Delete.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDelete *mChatServiceMockDelete) Calls() []*ChatServiceMockDeleteParams {
+	mmDelete.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteParams, len(mmDelete.callArgs))
+	copy(argCopy, mmDelete.callArgs)
+
+	mmDelete.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteDone() bool {
+	if m.DeleteMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMock.invocationsDone()
+}
+
+// MinimockDeleteInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteInspect() {
+	for _, e := range m.DeleteMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
+		if m.DeleteMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDelete != nil && afterDeleteCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	}
+
+	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Delete at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	}
+}
+
+type mChatServiceMockDeleteExpiredMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteExpiredMessagesExpectation
+	expectations       []*ChatServiceMockDeleteExpiredMessagesExpectation
+
+	callArgs []*ChatServiceMockDeleteExpiredMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteExpiredMessagesExpectation specifies expectation struct of the ChatService.DeleteExpiredMessages
+type ChatServiceMockDeleteExpiredMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteExpiredMessagesParams
+	paramPtrs          *ChatServiceMockDeleteExpiredMessagesParamPtrs
+	expectationOrigins ChatServiceMockDeleteExpiredMessagesExpectationOrigins
+	results            *ChatServiceMockDeleteExpiredMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteExpiredMessagesParams contains parameters of the ChatService.DeleteExpiredMessages
+type ChatServiceMockDeleteExpiredMessagesParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// ChatServiceMockDeleteExpiredMessagesParamPtrs contains pointers to parameters of the ChatService.DeleteExpiredMessages
+type ChatServiceMockDeleteExpiredMessagesParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// ChatServiceMockDeleteExpiredMessagesResults contains results of the ChatService.DeleteExpiredMessages
+type ChatServiceMockDeleteExpiredMessagesResults struct {
+	i1  int
+	err error
+}
+
+// ChatServiceMockDeleteExpiredMessagesOrigins contains origins of expectations of the ChatService.DeleteExpiredMessages
+type ChatServiceMockDeleteExpiredMessagesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Optional() *mChatServiceMockDeleteExpiredMessages {
+	mmDeleteExpiredMessages.optional = true
+	return mmDeleteExpiredMessages
+}
+
+// Expect sets up expected params for ChatService.DeleteExpiredMessages
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Expect(ctx context.Context, limit int) *mChatServiceMockDeleteExpiredMessages {
+	if mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Set")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation == nil {
+		mmDeleteExpiredMessages.defaultExpectation = &ChatServiceMockDeleteExpiredMessagesExpectation{}
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation.paramPtrs != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteExpiredMessages.defaultExpectation.params = &ChatServiceMockDeleteExpiredMessagesParams{ctx, limit}
+	mmDeleteExpiredMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteExpiredMessages.expectations {
+		if minimock.Equal(e.params, mmDeleteExpiredMessages.defaultExpectation.params) {
+			mmDeleteExpiredMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteExpiredMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteExpiredMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteExpiredMessages
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteExpiredMessages {
+	if mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Set")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation == nil {
+		mmDeleteExpiredMessages.defaultExpectation = &ChatServiceMockDeleteExpiredMessagesExpectation{}
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation.params != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredMessages.defaultExpectation.paramPtrs = &ChatServiceMockDeleteExpiredMessagesParamPtrs{}
+	}
+	mmDeleteExpiredMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteExpiredMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredMessages
+}
+
+// ExpectLimitParam2 sets up expected param limit for ChatService.DeleteExpiredMessages
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) ExpectLimitParam2(limit int) *mChatServiceMockDeleteExpiredMessages {
+	if mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Set")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation == nil {
+		mmDeleteExpiredMessages.defaultExpectation = &ChatServiceMockDeleteExpiredMessagesExpectation{}
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation.params != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Expect")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation.paramPtrs == nil {
+		mmDeleteExpiredMessages.defaultExpectation.paramPtrs = &ChatServiceMockDeleteExpiredMessagesParamPtrs{}
+	}
+	mmDeleteExpiredMessages.defaultExpectation.paramPtrs.limit = &limit
+	mmDeleteExpiredMessages.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDeleteExpiredMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteExpiredMessages
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Inspect(f func(ctx context.Context, limit int)) *mChatServiceMockDeleteExpiredMessages {
+	if mmDeleteExpiredMessages.mock.inspectFuncDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteExpiredMessages")
+	}
+
+	mmDeleteExpiredMessages.mock.inspectFuncDeleteExpiredMessages = f
+
+	return mmDeleteExpiredMessages
+}
+
+// Return sets up results that will be returned by ChatService.DeleteExpiredMessages
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Return(i1 int, err error) *ChatServiceMock {
+	if mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Set")
+	}
+
+	if mmDeleteExpiredMessages.defaultExpectation == nil {
+		mmDeleteExpiredMessages.defaultExpectation = &ChatServiceMockDeleteExpiredMessagesExpectation{mock: mmDeleteExpiredMessages.mock}
+	}
+	mmDeleteExpiredMessages.defaultExpectation.results = &ChatServiceMockDeleteExpiredMessagesResults{i1, err}
+	mmDeleteExpiredMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteExpiredMessages method
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Set(f func(ctx context.Context, limit int) (i1 int, err error)) *ChatServiceMock {
+	if mmDeleteExpiredMessages.defaultExpectation != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteExpiredMessages method")
+	}
+
+	if len(mmDeleteExpiredMessages.expectations) > 0 {
+		mmDeleteExpiredMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteExpiredMessages method")
+	}
+
+	mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages = f
+	mmDeleteExpiredMessages.mock.funcDeleteExpiredMessagesOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredMessages.mock
+}
+
+// When sets expectation for the ChatService.DeleteExpiredMessages which will trigger the result defined by the following
+// Then helper
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) When(ctx context.Context, limit int) *ChatServiceMockDeleteExpiredMessagesExpectation {
+	if mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.mock.t.Fatalf("ChatServiceMock.DeleteExpiredMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteExpiredMessagesExpectation{
+		mock:               mmDeleteExpiredMessages.mock,
+		params:             &ChatServiceMockDeleteExpiredMessagesParams{ctx, limit},
+		expectationOrigins: ChatServiceMockDeleteExpiredMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteExpiredMessages.expectations = append(mmDeleteExpiredMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteExpiredMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteExpiredMessagesExpectation) Then(i1 int, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteExpiredMessagesResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteExpiredMessages should be invoked
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Times(n uint64) *mChatServiceMockDeleteExpiredMessages {
+	if n == 0 {
+		mmDeleteExpiredMessages.mock.t.Fatalf("Times of ChatServiceMock.DeleteExpiredMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteExpiredMessages.expectedInvocations, n)
+	mmDeleteExpiredMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteExpiredMessages
+}
+
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) invocationsDone() bool {
+	if len(mmDeleteExpiredMessages.expectations) == 0 && mmDeleteExpiredMessages.defaultExpectation == nil && mmDeleteExpiredMessages.mock.funcDeleteExpiredMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredMessages.mock.afterDeleteExpiredMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteExpiredMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteExpiredMessages implements mm_service.ChatService
+func (mmDeleteExpiredMessages *ChatServiceMock) DeleteExpiredMessages(ctx context.Context, limit int) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmDeleteExpiredMessages.beforeDeleteExpiredMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteExpiredMessages.afterDeleteExpiredMessagesCounter, 1)
+
+	mmDeleteExpiredMessages.t.Helper()
+
+	if mmDeleteExpiredMessages.inspectFuncDeleteExpiredMessages != nil {
+		mmDeleteExpiredMessages.inspectFuncDeleteExpiredMessages(ctx, limit)
+	}
+
+	mm_params := ChatServiceMockDeleteExpiredMessagesParams{ctx, limit}
+
+	// Record call args
+	mmDeleteExpiredMessages.DeleteExpiredMessagesMock.mutex.Lock()
+	mmDeleteExpiredMessages.DeleteExpiredMessagesMock.callArgs = append(mmDeleteExpiredMessages.DeleteExpiredMessagesMock.callArgs, &mm_params)
+	mmDeleteExpiredMessages.DeleteExpiredMessagesMock.mutex.Unlock()
+
+	for _, e := range mmDeleteExpiredMessages.DeleteExpiredMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteExpiredMessagesParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteExpiredMessages.t.Errorf("ChatServiceMock.DeleteExpiredMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDeleteExpiredMessages.t.Errorf("ChatServiceMock.DeleteExpiredMessages got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteExpiredMessages.t.Errorf("ChatServiceMock.DeleteExpiredMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteExpiredMessages.DeleteExpiredMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteExpiredMessages.t.Fatal("No results are set for the ChatServiceMock.DeleteExpiredMessages")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteExpiredMessages.funcDeleteExpiredMessages != nil {
+		return mmDeleteExpiredMessages.funcDeleteExpiredMessages(ctx, limit)
+	}
+	mmDeleteExpiredMessages.t.Fatalf("Unexpected call to ChatServiceMock.DeleteExpiredMessages. %v %v", ctx, limit)
+	return
+}
+
+// DeleteExpiredMessagesAfterCounter returns a count of finished ChatServiceMock.DeleteExpiredMessages invocations
+func (mmDeleteExpiredMessages *ChatServiceMock) DeleteExpiredMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredMessages.afterDeleteExpiredMessagesCounter)
+}
+
+// DeleteExpiredMessagesBeforeCounter returns a count of ChatServiceMock.DeleteExpiredMessages invocations
+func (mmDeleteExpiredMessages *ChatServiceMock) DeleteExpiredMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteExpiredMessages.beforeDeleteExpiredMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteExpiredMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteExpiredMessages *mChatServiceMockDeleteExpiredMessages) Calls() []*ChatServiceMockDeleteExpiredMessagesParams {
+	mmDeleteExpiredMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteExpiredMessagesParams, len(mmDeleteExpiredMessages.callArgs))
+	copy(argCopy, mmDeleteExpiredMessages.callArgs)
+
+	mmDeleteExpiredMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteExpiredMessagesDone returns true if the count of the DeleteExpiredMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteExpiredMessagesDone() bool {
+	if m.DeleteExpiredMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteExpiredMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteExpiredMessagesMock.invocationsDone()
+}
+
+// MinimockDeleteExpiredMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteExpiredMessagesInspect() {
+	for _, e := range m.DeleteExpiredMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteExpiredMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteExpiredMessagesCounter := mm_atomic.LoadUint64(&m.afterDeleteExpiredMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteExpiredMessagesMock.defaultExpectation != nil && afterDeleteExpiredMessagesCounter < 1 {
+		if m.DeleteExpiredMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteExpiredMessages at\n%s", m.DeleteExpiredMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteExpiredMessages at\n%s with params: %#v", m.DeleteExpiredMessagesMock.defaultExpectation.expectationOrigins.origin, *m.DeleteExpiredMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteExpiredMessages != nil && afterDeleteExpiredMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteExpiredMessages at\n%s", m.funcDeleteExpiredMessagesOrigin)
+	}
+
+	if !m.DeleteExpiredMessagesMock.invocationsDone() && afterDeleteExpiredMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteExpiredMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteExpiredMessagesMock.expectedInvocations), m.DeleteExpiredMessagesMock.expectedInvocationsOrigin, afterDeleteExpiredMessagesCounter)
+	}
+}
+
+type mChatServiceMockDeleteMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteMessageExpectation
+	expectations       []*ChatServiceMockDeleteMessageExpectation
+
+	callArgs []*ChatServiceMockDeleteMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteMessageExpectation specifies expectation struct of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteMessageParams
+	paramPtrs          *ChatServiceMockDeleteMessageParamPtrs
+	expectationOrigins ChatServiceMockDeleteMessageExpectationOrigins
+	results            *ChatServiceMockDeleteMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteMessageParams contains parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParams struct {
+	ctx       context.Context
+	actor     string
+	messageID int64
+}
+
+// ChatServiceMockDeleteMessageParamPtrs contains pointers to parameters of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageParamPtrs struct {
+	ctx       *context.Context
+	actor     *string
+	messageID *int64
+}
+
+// ChatServiceMockDeleteMessageResults contains results of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageResults struct {
+	err error
+}
+
+// ChatServiceMockDeleteMessageOrigins contains origins of expectations of the ChatService.DeleteMessage
+type ChatServiceMockDeleteMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originActor     string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Optional() *mChatServiceMockDeleteMessage {
+	mmDeleteMessage.optional = true
+	return mmDeleteMessage
+}
+
+// Expect sets up expected params for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Expect(ctx context.Context, actor string, messageID int64) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteMessage.defaultExpectation.params = &ChatServiceMockDeleteMessageParams{ctx, actor, messageID}
+	mmDeleteMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteMessage.expectations {
+		if minimock.Equal(e.params, mmDeleteMessage.defaultExpectation.params) {
+			mmDeleteMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectActorParam2 sets up expected param actor for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectActorParam2(actor string) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{}
+	}
+
+	if mmDeleteMessage.defaultExpectation.params != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Expect")
+	}
+
+	if mmDeleteMessage.defaultExpectation.paramPtrs == nil {
+		mmDeleteMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeleteMessageParamPtrs{}
+	}
+	mmDeleteMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmDeleteMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmDeleteMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Inspect(f func(ctx context.Context, actor string, messageID int64)) *mChatServiceMockDeleteMessage {
+	if mmDeleteMessage.mock.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteMessage")
+	}
+
+	mmDeleteMessage.mock.inspectFuncDeleteMessage = f
+
+	return mmDeleteMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeleteMessage
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Return(err error) *ChatServiceMock {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	if mmDeleteMessage.defaultExpectation == nil {
+		mmDeleteMessage.defaultExpectation = &ChatServiceMockDeleteMessageExpectation{mock: mmDeleteMessage.mock}
+	}
+	mmDeleteMessage.defaultExpectation.results = &ChatServiceMockDeleteMessageResults{err}
+	mmDeleteMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteMessage method
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Set(f func(ctx context.Context, actor string, messageID int64) (err error)) *ChatServiceMock {
+	if mmDeleteMessage.defaultExpectation != nil {
+		mmDeleteMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteMessage method")
+	}
+
+	if len(mmDeleteMessage.expectations) > 0 {
+		mmDeleteMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteMessage method")
+	}
+
+	mmDeleteMessage.mock.funcDeleteMessage = f
+	mmDeleteMessage.mock.funcDeleteMessageOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage.mock
+}
+
+// When sets expectation for the ChatService.DeleteMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) When(ctx context.Context, actor string, messageID int64) *ChatServiceMockDeleteMessageExpectation {
+	if mmDeleteMessage.mock.funcDeleteMessage != nil {
+		mmDeleteMessage.mock.t.Fatalf("ChatServiceMock.DeleteMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteMessageExpectation{
+		mock:               mmDeleteMessage.mock,
+		params:             &ChatServiceMockDeleteMessageParams{ctx, actor, messageID},
+		expectationOrigins: ChatServiceMockDeleteMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteMessage.expectations = append(mmDeleteMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteMessage should be invoked
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Times(n uint64) *mChatServiceMockDeleteMessage {
+	if n == 0 {
+		mmDeleteMessage.mock.t.Fatalf("Times of ChatServiceMock.DeleteMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteMessage.expectedInvocations, n)
+	mmDeleteMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteMessage
+}
+
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) invocationsDone() bool {
+	if len(mmDeleteMessage.expectations) == 0 && mmDeleteMessage.defaultExpectation == nil && mmDeleteMessage.mock.funcDeleteMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.mock.afterDeleteMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteMessage implements mm_service.ChatService
+func (mmDeleteMessage *ChatServiceMock) DeleteMessage(ctx context.Context, actor string, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteMessage.beforeDeleteMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteMessage.afterDeleteMessageCounter, 1)
+
+	mmDeleteMessage.t.Helper()
+
+	if mmDeleteMessage.inspectFuncDeleteMessage != nil {
+		mmDeleteMessage.inspectFuncDeleteMessage(ctx, actor, messageID)
+	}
+
+	mm_params := ChatServiceMockDeleteMessageParams{ctx, actor, messageID}
+
+	// Record call args
+	mmDeleteMessage.DeleteMessageMock.mutex.Lock()
+	mmDeleteMessage.DeleteMessageMock.callArgs = append(mmDeleteMessage.DeleteMessageMock.callArgs, &mm_params)
+	mmDeleteMessage.DeleteMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeleteMessage.DeleteMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteMessage.DeleteMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteMessage.DeleteMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteMessage.DeleteMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteMessage.DeleteMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteMessageParams{ctx, actor, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteMessage.t.Errorf("ChatServiceMock.DeleteMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteMessage.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteMessage.DeleteMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteMessage.t.Fatal("No results are set for the ChatServiceMock.DeleteMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteMessage.funcDeleteMessage != nil {
+		return mmDeleteMessage.funcDeleteMessage(ctx, actor, messageID)
+	}
+	mmDeleteMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeleteMessage. %v %v %v", ctx, actor, messageID)
+	return
+}
+
+// DeleteMessageAfterCounter returns a count of finished ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.afterDeleteMessageCounter)
+}
+
+// DeleteMessageBeforeCounter returns a count of ChatServiceMock.DeleteMessage invocations
+func (mmDeleteMessage *ChatServiceMock) DeleteMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteMessage.beforeDeleteMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteMessage *mChatServiceMockDeleteMessage) Calls() []*ChatServiceMockDeleteMessageParams {
+	mmDeleteMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteMessageParams, len(mmDeleteMessage.callArgs))
+	copy(argCopy, mmDeleteMessage.callArgs)
+
+	mmDeleteMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteMessageDone returns true if the count of the DeleteMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteMessageDone() bool {
+	if m.DeleteMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteMessageMock.invocationsDone()
+}
+
+// MinimockDeleteMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteMessageInspect() {
+	for _, e := range m.DeleteMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteMessageCounter := mm_atomic.LoadUint64(&m.afterDeleteMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteMessageMock.defaultExpectation != nil && afterDeleteMessageCounter < 1 {
+		if m.DeleteMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.DeleteMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s with params: %#v", m.DeleteMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteMessage != nil && afterDeleteMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteMessage at\n%s", m.funcDeleteMessageOrigin)
+	}
+
+	if !m.DeleteMessageMock.invocationsDone() && afterDeleteMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteMessageMock.expectedInvocations), m.DeleteMessageMock.expectedInvocationsOrigin, afterDeleteMessageCounter)
+	}
+}
+
+type mChatServiceMockDeleteStaleUploads struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeleteStaleUploadsExpectation
+	expectations       []*ChatServiceMockDeleteStaleUploadsExpectation
+
+	callArgs []*ChatServiceMockDeleteStaleUploadsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeleteStaleUploadsExpectation specifies expectation struct of the ChatService.DeleteStaleUploads
+type ChatServiceMockDeleteStaleUploadsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeleteStaleUploadsParams
+	paramPtrs          *ChatServiceMockDeleteStaleUploadsParamPtrs
+	expectationOrigins ChatServiceMockDeleteStaleUploadsExpectationOrigins
+	results            *ChatServiceMockDeleteStaleUploadsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeleteStaleUploadsParams contains parameters of the ChatService.DeleteStaleUploads
+type ChatServiceMockDeleteStaleUploadsParams struct {
+	ctx   context.Context
+	limit int
+}
+
+// ChatServiceMockDeleteStaleUploadsParamPtrs contains pointers to parameters of the ChatService.DeleteStaleUploads
+type ChatServiceMockDeleteStaleUploadsParamPtrs struct {
+	ctx   *context.Context
+	limit *int
+}
+
+// ChatServiceMockDeleteStaleUploadsResults contains results of the ChatService.DeleteStaleUploads
+type ChatServiceMockDeleteStaleUploadsResults struct {
+	i1  int
+	err error
+}
+
+// ChatServiceMockDeleteStaleUploadsOrigins contains origins of expectations of the ChatService.DeleteStaleUploads
+type ChatServiceMockDeleteStaleUploadsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originLimit string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Optional() *mChatServiceMockDeleteStaleUploads {
+	mmDeleteStaleUploads.optional = true
+	return mmDeleteStaleUploads
+}
+
+// Expect sets up expected params for ChatService.DeleteStaleUploads
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Expect(ctx context.Context, limit int) *mChatServiceMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &ChatServiceMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteStaleUploads.defaultExpectation.params = &ChatServiceMockDeleteStaleUploadsParams{ctx, limit}
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteStaleUploads.expectations {
+		if minimock.Equal(e.params, mmDeleteStaleUploads.defaultExpectation.params) {
+			mmDeleteStaleUploads.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteStaleUploads.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteStaleUploads
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeleteStaleUploads
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &ChatServiceMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.params != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Expect")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmDeleteStaleUploads.defaultExpectation.paramPtrs = &ChatServiceMockDeleteStaleUploadsParamPtrs{}
+	}
+	mmDeleteStaleUploads.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteStaleUploads
+}
+
+// ExpectLimitParam2 sets up expected param limit for ChatService.DeleteStaleUploads
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) ExpectLimitParam2(limit int) *mChatServiceMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &ChatServiceMockDeleteStaleUploadsExpectation{}
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.params != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Expect")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation.paramPtrs == nil {
+		mmDeleteStaleUploads.defaultExpectation.paramPtrs = &ChatServiceMockDeleteStaleUploadsParamPtrs{}
+	}
+	mmDeleteStaleUploads.defaultExpectation.paramPtrs.limit = &limit
+	mmDeleteStaleUploads.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmDeleteStaleUploads
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeleteStaleUploads
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Inspect(f func(ctx context.Context, limit int)) *mChatServiceMockDeleteStaleUploads {
+	if mmDeleteStaleUploads.mock.inspectFuncDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeleteStaleUploads")
+	}
+
+	mmDeleteStaleUploads.mock.inspectFuncDeleteStaleUploads = f
+
+	return mmDeleteStaleUploads
+}
+
+// Return sets up results that will be returned by ChatService.DeleteStaleUploads
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Return(i1 int, err error) *ChatServiceMock {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	if mmDeleteStaleUploads.defaultExpectation == nil {
+		mmDeleteStaleUploads.defaultExpectation = &ChatServiceMockDeleteStaleUploadsExpectation{mock: mmDeleteStaleUploads.mock}
+	}
+	mmDeleteStaleUploads.defaultExpectation.results = &ChatServiceMockDeleteStaleUploadsResults{i1, err}
+	mmDeleteStaleUploads.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads.mock
+}
+
+// Set uses given function f to mock the ChatService.DeleteStaleUploads method
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Set(f func(ctx context.Context, limit int) (i1 int, err error)) *ChatServiceMock {
+	if mmDeleteStaleUploads.defaultExpectation != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("Default expectation is already set for the ChatService.DeleteStaleUploads method")
+	}
+
+	if len(mmDeleteStaleUploads.expectations) > 0 {
+		mmDeleteStaleUploads.mock.t.Fatalf("Some expectations are already set for the ChatService.DeleteStaleUploads method")
+	}
+
+	mmDeleteStaleUploads.mock.funcDeleteStaleUploads = f
+	mmDeleteStaleUploads.mock.funcDeleteStaleUploadsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads.mock
+}
+
+// When sets expectation for the ChatService.DeleteStaleUploads which will trigger the result defined by the following
+// Then helper
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) When(ctx context.Context, limit int) *ChatServiceMockDeleteStaleUploadsExpectation {
+	if mmDeleteStaleUploads.mock.funcDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.mock.t.Fatalf("ChatServiceMock.DeleteStaleUploads mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeleteStaleUploadsExpectation{
+		mock:               mmDeleteStaleUploads.mock,
+		params:             &ChatServiceMockDeleteStaleUploadsParams{ctx, limit},
+		expectationOrigins: ChatServiceMockDeleteStaleUploadsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteStaleUploads.expectations = append(mmDeleteStaleUploads.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeleteStaleUploads return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeleteStaleUploadsExpectation) Then(i1 int, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeleteStaleUploadsResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeleteStaleUploads should be invoked
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Times(n uint64) *mChatServiceMockDeleteStaleUploads {
+	if n == 0 {
+		mmDeleteStaleUploads.mock.t.Fatalf("Times of ChatServiceMock.DeleteStaleUploads mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteStaleUploads.expectedInvocations, n)
+	mmDeleteStaleUploads.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteStaleUploads
+}
+
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) invocationsDone() bool {
+	if len(mmDeleteStaleUploads.expectations) == 0 && mmDeleteStaleUploads.defaultExpectation == nil && mmDeleteStaleUploads.mock.funcDeleteStaleUploads == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteStaleUploads.mock.afterDeleteStaleUploadsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteStaleUploads.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteStaleUploads implements mm_service.ChatService
+func (mmDeleteStaleUploads *ChatServiceMock) DeleteStaleUploads(ctx context.Context, limit int) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmDeleteStaleUploads.beforeDeleteStaleUploadsCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteStaleUploads.afterDeleteStaleUploadsCounter, 1)
+
+	mmDeleteStaleUploads.t.Helper()
+
+	if mmDeleteStaleUploads.inspectFuncDeleteStaleUploads != nil {
+		mmDeleteStaleUploads.inspectFuncDeleteStaleUploads(ctx, limit)
+	}
+
+	mm_params := ChatServiceMockDeleteStaleUploadsParams{ctx, limit}
+
+	// Record call args
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.mutex.Lock()
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.callArgs = append(mmDeleteStaleUploads.DeleteStaleUploadsMock.callArgs, &mm_params)
+	mmDeleteStaleUploads.DeleteStaleUploadsMock.mutex.Unlock()
+
+	for _, e := range mmDeleteStaleUploads.DeleteStaleUploadsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeleteStaleUploadsParams{ctx, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteStaleUploads.t.Errorf("ChatServiceMock.DeleteStaleUploads got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmDeleteStaleUploads.t.Errorf("ChatServiceMock.DeleteStaleUploads got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteStaleUploads.t.Errorf("ChatServiceMock.DeleteStaleUploads got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteStaleUploads.DeleteStaleUploadsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteStaleUploads.t.Fatal("No results are set for the ChatServiceMock.DeleteStaleUploads")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDeleteStaleUploads.funcDeleteStaleUploads != nil {
+		return mmDeleteStaleUploads.funcDeleteStaleUploads(ctx, limit)
+	}
+	mmDeleteStaleUploads.t.Fatalf("Unexpected call to ChatServiceMock.DeleteStaleUploads. %v %v", ctx, limit)
+	return
+}
+
+// DeleteStaleUploadsAfterCounter returns a count of finished ChatServiceMock.DeleteStaleUploads invocations
+func (mmDeleteStaleUploads *ChatServiceMock) DeleteStaleUploadsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStaleUploads.afterDeleteStaleUploadsCounter)
+}
+
+// DeleteStaleUploadsBeforeCounter returns a count of ChatServiceMock.DeleteStaleUploads invocations
+func (mmDeleteStaleUploads *ChatServiceMock) DeleteStaleUploadsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteStaleUploads.beforeDeleteStaleUploadsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeleteStaleUploads.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteStaleUploads *mChatServiceMockDeleteStaleUploads) Calls() []*ChatServiceMockDeleteStaleUploadsParams {
+	mmDeleteStaleUploads.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeleteStaleUploadsParams, len(mmDeleteStaleUploads.callArgs))
+	copy(argCopy, mmDeleteStaleUploads.callArgs)
+
+	mmDeleteStaleUploads.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteStaleUploadsDone returns true if the count of the DeleteStaleUploads invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeleteStaleUploadsDone() bool {
+	if m.DeleteStaleUploadsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteStaleUploadsMock.invocationsDone()
+}
+
+// MinimockDeleteStaleUploadsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeleteStaleUploadsInspect() {
+	for _, e := range m.DeleteStaleUploadsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteStaleUploads at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteStaleUploadsCounter := mm_atomic.LoadUint64(&m.afterDeleteStaleUploadsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteStaleUploadsMock.defaultExpectation != nil && afterDeleteStaleUploadsCounter < 1 {
+		if m.DeleteStaleUploadsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteStaleUploads at\n%s", m.DeleteStaleUploadsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeleteStaleUploads at\n%s with params: %#v", m.DeleteStaleUploadsMock.defaultExpectation.expectationOrigins.origin, *m.DeleteStaleUploadsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteStaleUploads != nil && afterDeleteStaleUploadsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeleteStaleUploads at\n%s", m.funcDeleteStaleUploadsOrigin)
+	}
+
+	if !m.DeleteStaleUploadsMock.invocationsDone() && afterDeleteStaleUploadsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeleteStaleUploads at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteStaleUploadsMock.expectedInvocations), m.DeleteStaleUploadsMock.expectedInvocationsOrigin, afterDeleteStaleUploadsCounter)
+	}
+}
+
+type mChatServiceMockDeliverScheduledMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockDeliverScheduledMessageExpectation
+	expectations       []*ChatServiceMockDeliverScheduledMessageExpectation
+
+	callArgs []*ChatServiceMockDeliverScheduledMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockDeliverScheduledMessageExpectation specifies expectation struct of the ChatService.DeliverScheduledMessage
+type ChatServiceMockDeliverScheduledMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockDeliverScheduledMessageParams
+	paramPtrs          *ChatServiceMockDeliverScheduledMessageParamPtrs
+	expectationOrigins ChatServiceMockDeliverScheduledMessageExpectationOrigins
+	results            *ChatServiceMockDeliverScheduledMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockDeliverScheduledMessageParams contains parameters of the ChatService.DeliverScheduledMessage
+type ChatServiceMockDeliverScheduledMessageParams struct {
+	ctx context.Context
+}
+
+// ChatServiceMockDeliverScheduledMessageParamPtrs contains pointers to parameters of the ChatService.DeliverScheduledMessage
+type ChatServiceMockDeliverScheduledMessageParamPtrs struct {
+	ctx *context.Context
+}
+
+// ChatServiceMockDeliverScheduledMessageResults contains results of the ChatService.DeliverScheduledMessage
+type ChatServiceMockDeliverScheduledMessageResults struct {
+	b1  bool
+	err error
+}
+
+// ChatServiceMockDeliverScheduledMessageOrigins contains origins of expectations of the ChatService.DeliverScheduledMessage
+type ChatServiceMockDeliverScheduledMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Optional() *mChatServiceMockDeliverScheduledMessage {
+	mmDeliverScheduledMessage.optional = true
+	return mmDeliverScheduledMessage
+}
+
+// Expect sets up expected params for ChatService.DeliverScheduledMessage
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Expect(ctx context.Context) *mChatServiceMockDeliverScheduledMessage {
+	if mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by Set")
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation == nil {
+		mmDeliverScheduledMessage.defaultExpectation = &ChatServiceMockDeliverScheduledMessageExpectation{}
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation.paramPtrs != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by ExpectParams functions")
+	}
+
+	mmDeliverScheduledMessage.defaultExpectation.params = &ChatServiceMockDeliverScheduledMessageParams{ctx}
+	mmDeliverScheduledMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeliverScheduledMessage.expectations {
+		if minimock.Equal(e.params, mmDeliverScheduledMessage.defaultExpectation.params) {
+			mmDeliverScheduledMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeliverScheduledMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmDeliverScheduledMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.DeliverScheduledMessage
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDeliverScheduledMessage {
+	if mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by Set")
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation == nil {
+		mmDeliverScheduledMessage.defaultExpectation = &ChatServiceMockDeliverScheduledMessageExpectation{}
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation.params != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by Expect")
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation.paramPtrs == nil {
+		mmDeliverScheduledMessage.defaultExpectation.paramPtrs = &ChatServiceMockDeliverScheduledMessageParamPtrs{}
+	}
+	mmDeliverScheduledMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeliverScheduledMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeliverScheduledMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.DeliverScheduledMessage
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Inspect(f func(ctx context.Context)) *mChatServiceMockDeliverScheduledMessage {
+	if mmDeliverScheduledMessage.mock.inspectFuncDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.DeliverScheduledMessage")
+	}
+
+	mmDeliverScheduledMessage.mock.inspectFuncDeliverScheduledMessage = f
+
+	return mmDeliverScheduledMessage
+}
+
+// Return sets up results that will be returned by ChatService.DeliverScheduledMessage
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Return(b1 bool, err error) *ChatServiceMock {
+	if mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by Set")
+	}
+
+	if mmDeliverScheduledMessage.defaultExpectation == nil {
+		mmDeliverScheduledMessage.defaultExpectation = &ChatServiceMockDeliverScheduledMessageExpectation{mock: mmDeliverScheduledMessage.mock}
+	}
+	mmDeliverScheduledMessage.defaultExpectation.results = &ChatServiceMockDeliverScheduledMessageResults{b1, err}
+	mmDeliverScheduledMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduledMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.DeliverScheduledMessage method
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Set(f func(ctx context.Context) (b1 bool, err error)) *ChatServiceMock {
+	if mmDeliverScheduledMessage.defaultExpectation != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.DeliverScheduledMessage method")
+	}
+
+	if len(mmDeliverScheduledMessage.expectations) > 0 {
+		mmDeliverScheduledMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.DeliverScheduledMessage method")
+	}
+
+	mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage = f
+	mmDeliverScheduledMessage.mock.funcDeliverScheduledMessageOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduledMessage.mock
+}
+
+// When sets expectation for the ChatService.DeliverScheduledMessage which will trigger the result defined by the following
+// Then helper
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) When(ctx context.Context) *ChatServiceMockDeliverScheduledMessageExpectation {
+	if mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.mock.t.Fatalf("ChatServiceMock.DeliverScheduledMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockDeliverScheduledMessageExpectation{
+		mock:               mmDeliverScheduledMessage.mock,
+		params:             &ChatServiceMockDeliverScheduledMessageParams{ctx},
+		expectationOrigins: ChatServiceMockDeliverScheduledMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeliverScheduledMessage.expectations = append(mmDeliverScheduledMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.DeliverScheduledMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockDeliverScheduledMessageExpectation) Then(b1 bool, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockDeliverScheduledMessageResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.DeliverScheduledMessage should be invoked
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Times(n uint64) *mChatServiceMockDeliverScheduledMessage {
+	if n == 0 {
+		mmDeliverScheduledMessage.mock.t.Fatalf("Times of ChatServiceMock.DeliverScheduledMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeliverScheduledMessage.expectedInvocations, n)
+	mmDeliverScheduledMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeliverScheduledMessage
+}
+
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) invocationsDone() bool {
+	if len(mmDeliverScheduledMessage.expectations) == 0 && mmDeliverScheduledMessage.defaultExpectation == nil && mmDeliverScheduledMessage.mock.funcDeliverScheduledMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeliverScheduledMessage.mock.afterDeliverScheduledMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeliverScheduledMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeliverScheduledMessage implements mm_service.ChatService
+func (mmDeliverScheduledMessage *ChatServiceMock) DeliverScheduledMessage(ctx context.Context) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmDeliverScheduledMessage.beforeDeliverScheduledMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeliverScheduledMessage.afterDeliverScheduledMessageCounter, 1)
+
+	mmDeliverScheduledMessage.t.Helper()
+
+	if mmDeliverScheduledMessage.inspectFuncDeliverScheduledMessage != nil {
+		mmDeliverScheduledMessage.inspectFuncDeliverScheduledMessage(ctx)
+	}
+
+	mm_params := ChatServiceMockDeliverScheduledMessageParams{ctx}
+
+	// Record call args
+	mmDeliverScheduledMessage.DeliverScheduledMessageMock.mutex.Lock()
+	mmDeliverScheduledMessage.DeliverScheduledMessageMock.callArgs = append(mmDeliverScheduledMessage.DeliverScheduledMessageMock.callArgs, &mm_params)
+	mmDeliverScheduledMessage.DeliverScheduledMessageMock.mutex.Unlock()
+
+	for _, e := range mmDeliverScheduledMessage.DeliverScheduledMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockDeliverScheduledMessageParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeliverScheduledMessage.t.Errorf("ChatServiceMock.DeliverScheduledMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeliverScheduledMessage.t.Errorf("ChatServiceMock.DeliverScheduledMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeliverScheduledMessage.DeliverScheduledMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeliverScheduledMessage.t.Fatal("No results are set for the ChatServiceMock.DeliverScheduledMessage")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmDeliverScheduledMessage.funcDeliverScheduledMessage != nil {
+		return mmDeliverScheduledMessage.funcDeliverScheduledMessage(ctx)
+	}
+	mmDeliverScheduledMessage.t.Fatalf("Unexpected call to ChatServiceMock.DeliverScheduledMessage. %v", ctx)
+	return
+}
+
+// DeliverScheduledMessageAfterCounter returns a count of finished ChatServiceMock.DeliverScheduledMessage invocations
+func (mmDeliverScheduledMessage *ChatServiceMock) DeliverScheduledMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeliverScheduledMessage.afterDeliverScheduledMessageCounter)
+}
+
+// DeliverScheduledMessageBeforeCounter returns a count of ChatServiceMock.DeliverScheduledMessage invocations
+func (mmDeliverScheduledMessage *ChatServiceMock) DeliverScheduledMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeliverScheduledMessage.beforeDeliverScheduledMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.DeliverScheduledMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeliverScheduledMessage *mChatServiceMockDeliverScheduledMessage) Calls() []*ChatServiceMockDeliverScheduledMessageParams {
+	mmDeliverScheduledMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockDeliverScheduledMessageParams, len(mmDeliverScheduledMessage.callArgs))
+	copy(argCopy, mmDeliverScheduledMessage.callArgs)
+
+	mmDeliverScheduledMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeliverScheduledMessageDone returns true if the count of the DeliverScheduledMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockDeliverScheduledMessageDone() bool {
+	if m.DeliverScheduledMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeliverScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeliverScheduledMessageMock.invocationsDone()
+}
+
+// MinimockDeliverScheduledMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockDeliverScheduledMessageInspect() {
+	for _, e := range m.DeliverScheduledMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduledMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeliverScheduledMessageCounter := mm_atomic.LoadUint64(&m.afterDeliverScheduledMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeliverScheduledMessageMock.defaultExpectation != nil && afterDeliverScheduledMessageCounter < 1 {
+		if m.DeliverScheduledMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduledMessage at\n%s", m.DeliverScheduledMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduledMessage at\n%s with params: %#v", m.DeliverScheduledMessageMock.defaultExpectation.expectationOrigins.origin, *m.DeliverScheduledMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeliverScheduledMessage != nil && afterDeliverScheduledMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.DeliverScheduledMessage at\n%s", m.funcDeliverScheduledMessageOrigin)
+	}
+
+	if !m.DeliverScheduledMessageMock.invocationsDone() && afterDeliverScheduledMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.DeliverScheduledMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeliverScheduledMessageMock.expectedInvocations), m.DeliverScheduledMessageMock.expectedInvocationsOrigin, afterDeliverScheduledMessageCounter)
+	}
+}
+
+type mChatServiceMockEditMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockEditMessageExpectation
+	expectations       []*ChatServiceMockEditMessageExpectation
+
+	callArgs []*ChatServiceMockEditMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockEditMessageExpectation specifies expectation struct of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockEditMessageParams
+	paramPtrs          *ChatServiceMockEditMessageParamPtrs
+	expectationOrigins ChatServiceMockEditMessageExpectationOrigins
+	results            *ChatServiceMockEditMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockEditMessageParams contains parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParams struct {
+	ctx       context.Context
+	actor     string
+	messageID int64
+	text      string
+}
+
+// ChatServiceMockEditMessageParamPtrs contains pointers to parameters of the ChatService.EditMessage
+type ChatServiceMockEditMessageParamPtrs struct {
+	ctx       *context.Context
+	actor     *string
+	messageID *int64
+	text      *string
+}
+
+// ChatServiceMockEditMessageResults contains results of the ChatService.EditMessage
+type ChatServiceMockEditMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatServiceMockEditMessageOrigins contains origins of expectations of the ChatService.EditMessage
+type ChatServiceMockEditMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originActor     string
+	originMessageID string
+	originText      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmEditMessage *mChatServiceMockEditMessage) Optional() *mChatServiceMockEditMessage {
+	mmEditMessage.optional = true
+	return mmEditMessage
+}
+
+// Expect sets up expected params for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Expect(ctx context.Context, actor string, messageID int64, text string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by ExpectParams functions")
+	}
+
+	mmEditMessage.defaultExpectation.params = &ChatServiceMockEditMessageParams{ctx, actor, messageID, text}
+	mmEditMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEditMessage.expectations {
+		if minimock.Equal(e.params, mmEditMessage.defaultExpectation.params) {
+			mmEditMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEditMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmEditMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEditMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectActorParam2 sets up expected param actor for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectActorParam2(actor string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmEditMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmEditMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// ExpectTextParam4 sets up expected param text for ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) ExpectTextParam4(text string) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{}
+	}
+
+	if mmEditMessage.defaultExpectation.params != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Expect")
+	}
+
+	if mmEditMessage.defaultExpectation.paramPtrs == nil {
+		mmEditMessage.defaultExpectation.paramPtrs = &ChatServiceMockEditMessageParamPtrs{}
+	}
+	mmEditMessage.defaultExpectation.paramPtrs.text = &text
+	mmEditMessage.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmEditMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Inspect(f func(ctx context.Context, actor string, messageID int64, text string)) *mChatServiceMockEditMessage {
+	if mmEditMessage.mock.inspectFuncEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.EditMessage")
+	}
+
+	mmEditMessage.mock.inspectFuncEditMessage = f
+
+	return mmEditMessage
+}
+
+// Return sets up results that will be returned by ChatService.EditMessage
+func (mmEditMessage *mChatServiceMockEditMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	if mmEditMessage.defaultExpectation == nil {
+		mmEditMessage.defaultExpectation = &ChatServiceMockEditMessageExpectation{mock: mmEditMessage.mock}
+	}
+	mmEditMessage.defaultExpectation.results = &ChatServiceMockEditMessageResults{mp1, err}
+	mmEditMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.EditMessage method
+func (mmEditMessage *mChatServiceMockEditMessage) Set(f func(ctx context.Context, actor string, messageID int64, text string) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmEditMessage.defaultExpectation != nil {
+		mmEditMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.EditMessage method")
+	}
+
+	if len(mmEditMessage.expectations) > 0 {
+		mmEditMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.EditMessage method")
+	}
+
+	mmEditMessage.mock.funcEditMessage = f
+	mmEditMessage.mock.funcEditMessageOrigin = minimock.CallerInfo(1)
+	return mmEditMessage.mock
+}
+
+// When sets expectation for the ChatService.EditMessage which will trigger the result defined by the following
+// Then helper
+func (mmEditMessage *mChatServiceMockEditMessage) When(ctx context.Context, actor string, messageID int64, text string) *ChatServiceMockEditMessageExpectation {
+	if mmEditMessage.mock.funcEditMessage != nil {
+		mmEditMessage.mock.t.Fatalf("ChatServiceMock.EditMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockEditMessageExpectation{
+		mock:               mmEditMessage.mock,
+		params:             &ChatServiceMockEditMessageParams{ctx, actor, messageID, text},
+		expectationOrigins: ChatServiceMockEditMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmEditMessage.expectations = append(mmEditMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.EditMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockEditMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockEditMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.EditMessage should be invoked
+func (mmEditMessage *mChatServiceMockEditMessage) Times(n uint64) *mChatServiceMockEditMessage {
+	if n == 0 {
+		mmEditMessage.mock.t.Fatalf("Times of ChatServiceMock.EditMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmEditMessage.expectedInvocations, n)
+	mmEditMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEditMessage
+}
+
+func (mmEditMessage *mChatServiceMockEditMessage) invocationsDone() bool {
+	if len(mmEditMessage.expectations) == 0 && mmEditMessage.defaultExpectation == nil && mmEditMessage.mock.funcEditMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmEditMessage.mock.afterEditMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEditMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// EditMessage implements mm_service.ChatService
+func (mmEditMessage *ChatServiceMock) EditMessage(ctx context.Context, actor string, messageID int64, text string) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmEditMessage.beforeEditMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmEditMessage.afterEditMessageCounter, 1)
+
+	mmEditMessage.t.Helper()
+
+	if mmEditMessage.inspectFuncEditMessage != nil {
+		mmEditMessage.inspectFuncEditMessage(ctx, actor, messageID, text)
+	}
+
+	mm_params := ChatServiceMockEditMessageParams{ctx, actor, messageID, text}
+
+	// Record call args
+	mmEditMessage.EditMessageMock.mutex.Lock()
+	mmEditMessage.EditMessageMock.callArgs = append(mmEditMessage.EditMessageMock.callArgs, &mm_params)
+	mmEditMessage.EditMessageMock.mutex.Unlock()
+
+	for _, e := range mmEditMessage.EditMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmEditMessage.EditMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEditMessage.EditMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmEditMessage.EditMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmEditMessage.EditMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockEditMessageParams{ctx, actor, messageID, text}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmEditMessage.t.Errorf("ChatServiceMock.EditMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEditMessage.EditMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmEditMessage.EditMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmEditMessage.t.Fatal("No results are set for the ChatServiceMock.EditMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmEditMessage.funcEditMessage != nil {
+		return mmEditMessage.funcEditMessage(ctx, actor, messageID, text)
+	}
+	mmEditMessage.t.Fatalf("Unexpected call to ChatServiceMock.EditMessage. %v %v %v %v", ctx, actor, messageID, text)
+	return
+}
+
+// EditMessageAfterCounter returns a count of finished ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.afterEditMessageCounter)
+}
+
+// EditMessageBeforeCounter returns a count of ChatServiceMock.EditMessage invocations
+func (mmEditMessage *ChatServiceMock) EditMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEditMessage.beforeEditMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.EditMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmEditMessage *mChatServiceMockEditMessage) Calls() []*ChatServiceMockEditMessageParams {
+	mmEditMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockEditMessageParams, len(mmEditMessage.callArgs))
+	copy(argCopy, mmEditMessage.callArgs)
+
+	mmEditMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockEditMessageDone returns true if the count of the EditMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockEditMessageDone() bool {
+	if m.EditMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.EditMessageMock.invocationsDone()
+}
+
+// MinimockEditMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockEditMessageInspect() {
+	for _, e := range m.EditMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterEditMessageCounter := mm_atomic.LoadUint64(&m.afterEditMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.EditMessageMock.defaultExpectation != nil && afterEditMessageCounter < 1 {
+		if m.EditMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.EditMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s with params: %#v", m.EditMessageMock.defaultExpectation.expectationOrigins.origin, *m.EditMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcEditMessage != nil && afterEditMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.EditMessage at\n%s", m.funcEditMessageOrigin)
+	}
+
+	if !m.EditMessageMock.invocationsDone() && afterEditMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.EditMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EditMessageMock.expectedInvocations), m.EditMessageMock.expectedInvocationsOrigin, afterEditMessageCounter)
+	}
+}
+
+type mChatServiceMockGetChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetChatExpectation
+	expectations       []*ChatServiceMockGetChatExpectation
+
+	callArgs []*ChatServiceMockGetChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetChatExpectation specifies expectation struct of the ChatService.GetChat
+type ChatServiceMockGetChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetChatParams
+	paramPtrs          *ChatServiceMockGetChatParamPtrs
+	expectationOrigins ChatServiceMockGetChatExpectationOrigins
+	results            *ChatServiceMockGetChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetChatParams contains parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockGetChatParamPtrs contains pointers to parameters of the ChatService.GetChat
+type ChatServiceMockGetChatParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockGetChatResults contains results of the ChatService.GetChat
+type ChatServiceMockGetChatResults struct {
+	cp1 *model.Chat
+	err error
+}
+
+// ChatServiceMockGetChatOrigins contains origins of expectations of the ChatService.GetChat
+type ChatServiceMockGetChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChat *mChatServiceMockGetChat) Optional() *mChatServiceMockGetChat {
+	mmGetChat.optional = true
+	return mmGetChat
+}
+
+// Expect sets up expected params for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetChat.defaultExpectation.params = &ChatServiceMockGetChatParams{ctx, chatID, username}
+	mmGetChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChat.expectations {
+		if minimock.Equal(e.params, mmGetChat.defaultExpectation.params) {
+			mmGetChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) ExpectUsernameParam3(username string) *mChatServiceMockGetChat {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{}
+	}
+
+	if mmGetChat.defaultExpectation.params != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Expect")
+	}
+
+	if mmGetChat.defaultExpectation.paramPtrs == nil {
+		mmGetChat.defaultExpectation.paramPtrs = &ChatServiceMockGetChatParamPtrs{}
+	}
+	mmGetChat.defaultExpectation.paramPtrs.username = &username
+	mmGetChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockGetChat {
+	if mmGetChat.mock.inspectFuncGetChat != nil {
+		mmGetChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetChat")
+	}
+
+	mmGetChat.mock.inspectFuncGetChat = f
+
+	return mmGetChat
+}
+
+// Return sets up results that will be returned by ChatService.GetChat
+func (mmGetChat *mChatServiceMockGetChat) Return(cp1 *model.Chat, err error) *ChatServiceMock {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	if mmGetChat.defaultExpectation == nil {
+		mmGetChat.defaultExpectation = &ChatServiceMockGetChatExpectation{mock: mmGetChat.mock}
+	}
+	mmGetChat.defaultExpectation.results = &ChatServiceMockGetChatResults{cp1, err}
+	mmGetChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// Set uses given function f to mock the ChatService.GetChat method
+func (mmGetChat *mChatServiceMockGetChat) Set(f func(ctx context.Context, chatID int64, username string) (cp1 *model.Chat, err error)) *ChatServiceMock {
+	if mmGetChat.defaultExpectation != nil {
+		mmGetChat.mock.t.Fatalf("Default expectation is already set for the ChatService.GetChat method")
+	}
+
+	if len(mmGetChat.expectations) > 0 {
+		mmGetChat.mock.t.Fatalf("Some expectations are already set for the ChatService.GetChat method")
+	}
+
+	mmGetChat.mock.funcGetChat = f
+	mmGetChat.mock.funcGetChatOrigin = minimock.CallerInfo(1)
+	return mmGetChat.mock
+}
+
+// When sets expectation for the ChatService.GetChat which will trigger the result defined by the following
+// Then helper
+func (mmGetChat *mChatServiceMockGetChat) When(ctx context.Context, chatID int64, username string) *ChatServiceMockGetChatExpectation {
+	if mmGetChat.mock.funcGetChat != nil {
+		mmGetChat.mock.t.Fatalf("ChatServiceMock.GetChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetChatExpectation{
+		mock:               mmGetChat.mock,
+		params:             &ChatServiceMockGetChatParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockGetChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChat.expectations = append(mmGetChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetChatExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetChatResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetChat should be invoked
+func (mmGetChat *mChatServiceMockGetChat) Times(n uint64) *mChatServiceMockGetChat {
+	if n == 0 {
+		mmGetChat.mock.t.Fatalf("Times of ChatServiceMock.GetChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChat.expectedInvocations, n)
+	mmGetChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChat
+}
+
+func (mmGetChat *mChatServiceMockGetChat) invocationsDone() bool {
+	if len(mmGetChat.expectations) == 0 && mmGetChat.defaultExpectation == nil && mmGetChat.mock.funcGetChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChat.mock.afterGetChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChat implements mm_service.ChatService
+func (mmGetChat *ChatServiceMock) GetChat(ctx context.Context, chatID int64, username string) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmGetChat.beforeGetChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChat.afterGetChatCounter, 1)
+
+	mmGetChat.t.Helper()
+
+	if mmGetChat.inspectFuncGetChat != nil {
+		mmGetChat.inspectFuncGetChat(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockGetChatParams{ctx, chatID, username}
+
+	// Record call args
+	mmGetChat.GetChatMock.mutex.Lock()
+	mmGetChat.GetChatMock.callArgs = append(mmGetChat.GetChatMock.callArgs, &mm_params)
+	mmGetChat.GetChatMock.mutex.Unlock()
+
+	for _, e := range mmGetChat.GetChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmGetChat.GetChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChat.GetChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChat.GetChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChat.GetChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetChatParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChat.t.Errorf("ChatServiceMock.GetChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChat.GetChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChat.GetChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChat.t.Fatal("No results are set for the ChatServiceMock.GetChat")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmGetChat.funcGetChat != nil {
+		return mmGetChat.funcGetChat(ctx, chatID, username)
+	}
+	mmGetChat.t.Fatalf("Unexpected call to ChatServiceMock.GetChat. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// GetChatAfterCounter returns a count of finished ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.afterGetChatCounter)
+}
+
+// GetChatBeforeCounter returns a count of ChatServiceMock.GetChat invocations
+func (mmGetChat *ChatServiceMock) GetChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChat.beforeGetChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChat *mChatServiceMockGetChat) Calls() []*ChatServiceMockGetChatParams {
+	mmGetChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetChatParams, len(mmGetChat.callArgs))
+	copy(argCopy, mmGetChat.callArgs)
+
+	mmGetChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChatDone returns true if the count of the GetChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetChatDone() bool {
+	if m.GetChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChatMock.invocationsDone()
+}
+
+// MinimockGetChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetChatInspect() {
+	for _, e := range m.GetChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChatCounter := mm_atomic.LoadUint64(&m.afterGetChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChatMock.defaultExpectation != nil && afterGetChatCounter < 1 {
+		if m.GetChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s", m.GetChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s with params: %#v", m.GetChatMock.defaultExpectation.expectationOrigins.origin, *m.GetChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChat != nil && afterGetChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetChat at\n%s", m.funcGetChatOrigin)
+	}
+
+	if !m.GetChatMock.invocationsDone() && afterGetChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChatMock.expectedInvocations), m.GetChatMock.expectedInvocationsOrigin, afterGetChatCounter)
+	}
+}
+
+type mChatServiceMockGetOrCreateDirectChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetOrCreateDirectChatExpectation
+	expectations       []*ChatServiceMockGetOrCreateDirectChatExpectation
+
+	callArgs []*ChatServiceMockGetOrCreateDirectChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetOrCreateDirectChatExpectation specifies expectation struct of the ChatService.GetOrCreateDirectChat
+type ChatServiceMockGetOrCreateDirectChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetOrCreateDirectChatParams
+	paramPtrs          *ChatServiceMockGetOrCreateDirectChatParamPtrs
+	expectationOrigins ChatServiceMockGetOrCreateDirectChatExpectationOrigins
+	results            *ChatServiceMockGetOrCreateDirectChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetOrCreateDirectChatParams contains parameters of the ChatService.GetOrCreateDirectChat
+type ChatServiceMockGetOrCreateDirectChatParams struct {
+	ctx      context.Context
+	caller   string
+	username string
+}
+
+// ChatServiceMockGetOrCreateDirectChatParamPtrs contains pointers to parameters of the ChatService.GetOrCreateDirectChat
+type ChatServiceMockGetOrCreateDirectChatParamPtrs struct {
+	ctx      *context.Context
+	caller   *string
+	username *string
+}
+
+// ChatServiceMockGetOrCreateDirectChatResults contains results of the ChatService.GetOrCreateDirectChat
+type ChatServiceMockGetOrCreateDirectChatResults struct {
+	cp1 *model.Chat
+	b1  bool
+	err error
+}
+
+// ChatServiceMockGetOrCreateDirectChatOrigins contains origins of expectations of the ChatService.GetOrCreateDirectChat
+type ChatServiceMockGetOrCreateDirectChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originCaller   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Optional() *mChatServiceMockGetOrCreateDirectChat {
+	mmGetOrCreateDirectChat.optional = true
+	return mmGetOrCreateDirectChat
+}
+
+// Expect sets up expected params for ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Expect(ctx context.Context, caller string, username string) *mChatServiceMockGetOrCreateDirectChat {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation == nil {
+		mmGetOrCreateDirectChat.defaultExpectation = &ChatServiceMockGetOrCreateDirectChatExpectation{}
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.paramPtrs != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrCreateDirectChat.defaultExpectation.params = &ChatServiceMockGetOrCreateDirectChatParams{ctx, caller, username}
+	mmGetOrCreateDirectChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrCreateDirectChat.expectations {
+		if minimock.Equal(e.params, mmGetOrCreateDirectChat.defaultExpectation.params) {
+			mmGetOrCreateDirectChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrCreateDirectChat.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrCreateDirectChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetOrCreateDirectChat {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation == nil {
+		mmGetOrCreateDirectChat.defaultExpectation = &ChatServiceMockGetOrCreateDirectChatExpectation{}
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.params != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Expect")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.paramPtrs == nil {
+		mmGetOrCreateDirectChat.defaultExpectation.paramPtrs = &ChatServiceMockGetOrCreateDirectChatParamPtrs{}
+	}
+	mmGetOrCreateDirectChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrCreateDirectChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrCreateDirectChat
+}
+
+// ExpectCallerParam2 sets up expected param caller for ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) ExpectCallerParam2(caller string) *mChatServiceMockGetOrCreateDirectChat {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation == nil {
+		mmGetOrCreateDirectChat.defaultExpectation = &ChatServiceMockGetOrCreateDirectChatExpectation{}
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.params != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Expect")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.paramPtrs == nil {
+		mmGetOrCreateDirectChat.defaultExpectation.paramPtrs = &ChatServiceMockGetOrCreateDirectChatParamPtrs{}
+	}
+	mmGetOrCreateDirectChat.defaultExpectation.paramPtrs.caller = &caller
+	mmGetOrCreateDirectChat.defaultExpectation.expectationOrigins.originCaller = minimock.CallerInfo(1)
+
+	return mmGetOrCreateDirectChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) ExpectUsernameParam3(username string) *mChatServiceMockGetOrCreateDirectChat {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation == nil {
+		mmGetOrCreateDirectChat.defaultExpectation = &ChatServiceMockGetOrCreateDirectChatExpectation{}
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.params != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Expect")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation.paramPtrs == nil {
+		mmGetOrCreateDirectChat.defaultExpectation.paramPtrs = &ChatServiceMockGetOrCreateDirectChatParamPtrs{}
+	}
+	mmGetOrCreateDirectChat.defaultExpectation.paramPtrs.username = &username
+	mmGetOrCreateDirectChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetOrCreateDirectChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Inspect(f func(ctx context.Context, caller string, username string)) *mChatServiceMockGetOrCreateDirectChat {
+	if mmGetOrCreateDirectChat.mock.inspectFuncGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetOrCreateDirectChat")
+	}
+
+	mmGetOrCreateDirectChat.mock.inspectFuncGetOrCreateDirectChat = f
+
+	return mmGetOrCreateDirectChat
+}
+
+// Return sets up results that will be returned by ChatService.GetOrCreateDirectChat
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Return(cp1 *model.Chat, b1 bool, err error) *ChatServiceMock {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	if mmGetOrCreateDirectChat.defaultExpectation == nil {
+		mmGetOrCreateDirectChat.defaultExpectation = &ChatServiceMockGetOrCreateDirectChatExpectation{mock: mmGetOrCreateDirectChat.mock}
+	}
+	mmGetOrCreateDirectChat.defaultExpectation.results = &ChatServiceMockGetOrCreateDirectChatResults{cp1, b1, err}
+	mmGetOrCreateDirectChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrCreateDirectChat.mock
+}
+
+// Set uses given function f to mock the ChatService.GetOrCreateDirectChat method
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Set(f func(ctx context.Context, caller string, username string) (cp1 *model.Chat, b1 bool, err error)) *ChatServiceMock {
+	if mmGetOrCreateDirectChat.defaultExpectation != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("Default expectation is already set for the ChatService.GetOrCreateDirectChat method")
+	}
+
+	if len(mmGetOrCreateDirectChat.expectations) > 0 {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("Some expectations are already set for the ChatService.GetOrCreateDirectChat method")
+	}
+
+	mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat = f
+	mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChatOrigin = minimock.CallerInfo(1)
+	return mmGetOrCreateDirectChat.mock
+}
+
+// When sets expectation for the ChatService.GetOrCreateDirectChat which will trigger the result defined by the following
+// Then helper
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) When(ctx context.Context, caller string, username string) *ChatServiceMockGetOrCreateDirectChatExpectation {
+	if mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("ChatServiceMock.GetOrCreateDirectChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetOrCreateDirectChatExpectation{
+		mock:               mmGetOrCreateDirectChat.mock,
+		params:             &ChatServiceMockGetOrCreateDirectChatParams{ctx, caller, username},
+		expectationOrigins: ChatServiceMockGetOrCreateDirectChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrCreateDirectChat.expectations = append(mmGetOrCreateDirectChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetOrCreateDirectChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetOrCreateDirectChatExpectation) Then(cp1 *model.Chat, b1 bool, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetOrCreateDirectChatResults{cp1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetOrCreateDirectChat should be invoked
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Times(n uint64) *mChatServiceMockGetOrCreateDirectChat {
+	if n == 0 {
+		mmGetOrCreateDirectChat.mock.t.Fatalf("Times of ChatServiceMock.GetOrCreateDirectChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrCreateDirectChat.expectedInvocations, n)
+	mmGetOrCreateDirectChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrCreateDirectChat
+}
+
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) invocationsDone() bool {
+	if len(mmGetOrCreateDirectChat.expectations) == 0 && mmGetOrCreateDirectChat.defaultExpectation == nil && mmGetOrCreateDirectChat.mock.funcGetOrCreateDirectChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrCreateDirectChat.mock.afterGetOrCreateDirectChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrCreateDirectChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrCreateDirectChat implements mm_service.ChatService
+func (mmGetOrCreateDirectChat *ChatServiceMock) GetOrCreateDirectChat(ctx context.Context, caller string, username string) (cp1 *model.Chat, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmGetOrCreateDirectChat.beforeGetOrCreateDirectChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrCreateDirectChat.afterGetOrCreateDirectChatCounter, 1)
+
+	mmGetOrCreateDirectChat.t.Helper()
+
+	if mmGetOrCreateDirectChat.inspectFuncGetOrCreateDirectChat != nil {
+		mmGetOrCreateDirectChat.inspectFuncGetOrCreateDirectChat(ctx, caller, username)
+	}
+
+	mm_params := ChatServiceMockGetOrCreateDirectChatParams{ctx, caller, username}
+
+	// Record call args
+	mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.mutex.Lock()
+	mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.callArgs = append(mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.callArgs, &mm_params)
+	mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.mutex.Unlock()
+
+	for _, e := range mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetOrCreateDirectChatParams{ctx, caller, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrCreateDirectChat.t.Errorf("ChatServiceMock.GetOrCreateDirectChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.caller != nil && !minimock.Equal(*mm_want_ptrs.caller, mm_got.caller) {
+				mmGetOrCreateDirectChat.t.Errorf("ChatServiceMock.GetOrCreateDirectChat got unexpected parameter caller, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.expectationOrigins.originCaller, *mm_want_ptrs.caller, mm_got.caller, minimock.Diff(*mm_want_ptrs.caller, mm_got.caller))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetOrCreateDirectChat.t.Errorf("ChatServiceMock.GetOrCreateDirectChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrCreateDirectChat.t.Errorf("ChatServiceMock.GetOrCreateDirectChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrCreateDirectChat.GetOrCreateDirectChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrCreateDirectChat.t.Fatal("No results are set for the ChatServiceMock.GetOrCreateDirectChat")
+		}
+		return (*mm_results).cp1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmGetOrCreateDirectChat.funcGetOrCreateDirectChat != nil {
+		return mmGetOrCreateDirectChat.funcGetOrCreateDirectChat(ctx, caller, username)
+	}
+	mmGetOrCreateDirectChat.t.Fatalf("Unexpected call to ChatServiceMock.GetOrCreateDirectChat. %v %v %v", ctx, caller, username)
+	return
+}
+
+// GetOrCreateDirectChatAfterCounter returns a count of finished ChatServiceMock.GetOrCreateDirectChat invocations
+func (mmGetOrCreateDirectChat *ChatServiceMock) GetOrCreateDirectChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrCreateDirectChat.afterGetOrCreateDirectChatCounter)
+}
+
+// GetOrCreateDirectChatBeforeCounter returns a count of ChatServiceMock.GetOrCreateDirectChat invocations
+func (mmGetOrCreateDirectChat *ChatServiceMock) GetOrCreateDirectChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrCreateDirectChat.beforeGetOrCreateDirectChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetOrCreateDirectChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrCreateDirectChat *mChatServiceMockGetOrCreateDirectChat) Calls() []*ChatServiceMockGetOrCreateDirectChatParams {
+	mmGetOrCreateDirectChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetOrCreateDirectChatParams, len(mmGetOrCreateDirectChat.callArgs))
+	copy(argCopy, mmGetOrCreateDirectChat.callArgs)
+
+	mmGetOrCreateDirectChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrCreateDirectChatDone returns true if the count of the GetOrCreateDirectChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetOrCreateDirectChatDone() bool {
+	if m.GetOrCreateDirectChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrCreateDirectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrCreateDirectChatMock.invocationsDone()
+}
+
+// MinimockGetOrCreateDirectChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetOrCreateDirectChatInspect() {
+	for _, e := range m.GetOrCreateDirectChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetOrCreateDirectChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrCreateDirectChatCounter := mm_atomic.LoadUint64(&m.afterGetOrCreateDirectChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrCreateDirectChatMock.defaultExpectation != nil && afterGetOrCreateDirectChatCounter < 1 {
+		if m.GetOrCreateDirectChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetOrCreateDirectChat at\n%s", m.GetOrCreateDirectChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetOrCreateDirectChat at\n%s with params: %#v", m.GetOrCreateDirectChatMock.defaultExpectation.expectationOrigins.origin, *m.GetOrCreateDirectChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrCreateDirectChat != nil && afterGetOrCreateDirectChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetOrCreateDirectChat at\n%s", m.funcGetOrCreateDirectChatOrigin)
+	}
+
+	if !m.GetOrCreateDirectChatMock.invocationsDone() && afterGetOrCreateDirectChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetOrCreateDirectChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrCreateDirectChatMock.expectedInvocations), m.GetOrCreateDirectChatMock.expectedInvocationsOrigin, afterGetOrCreateDirectChatCounter)
+	}
+}
+
+type mChatServiceMockGetPresence struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetPresenceExpectation
+	expectations       []*ChatServiceMockGetPresenceExpectation
+
+	callArgs []*ChatServiceMockGetPresenceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetPresenceExpectation specifies expectation struct of the ChatService.GetPresence
+type ChatServiceMockGetPresenceExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetPresenceParams
+	paramPtrs          *ChatServiceMockGetPresenceParamPtrs
+	expectationOrigins ChatServiceMockGetPresenceExpectationOrigins
+	results            *ChatServiceMockGetPresenceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetPresenceParams contains parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParams struct {
+	ctx       context.Context
+	username  string
+	usernames []string
+}
+
+// ChatServiceMockGetPresenceParamPtrs contains pointers to parameters of the ChatService.GetPresence
+type ChatServiceMockGetPresenceParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	usernames *[]string
+}
+
+// ChatServiceMockGetPresenceResults contains results of the ChatService.GetPresence
+type ChatServiceMockGetPresenceResults struct {
+	ppa1 []*model.Presence
+	err  error
+}
+
+// ChatServiceMockGetPresenceOrigins contains origins of expectations of the ChatService.GetPresence
+type ChatServiceMockGetPresenceExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originUsernames string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPresence *mChatServiceMockGetPresence) Optional() *mChatServiceMockGetPresence {
+	mmGetPresence.optional = true
+	return mmGetPresence
+}
+
+// Expect sets up expected params for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Expect(ctx context.Context, username string, usernames []string) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by ExpectParams functions")
+	}
+
+	mmGetPresence.defaultExpectation.params = &ChatServiceMockGetPresenceParams{ctx, username, usernames}
+	mmGetPresence.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPresence.expectations {
+		if minimock.Equal(e.params, mmGetPresence.defaultExpectation.params) {
+			mmGetPresence.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPresence.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPresence
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPresence.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPresence
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectUsernameParam2(username string) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.username = &username
+	mmGetPresence.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetPresence
+}
+
+// ExpectUsernamesParam3 sets up expected param usernames for ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) ExpectUsernamesParam3(usernames []string) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{}
+	}
+
+	if mmGetPresence.defaultExpectation.params != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Expect")
+	}
+
+	if mmGetPresence.defaultExpectation.paramPtrs == nil {
+		mmGetPresence.defaultExpectation.paramPtrs = &ChatServiceMockGetPresenceParamPtrs{}
+	}
+	mmGetPresence.defaultExpectation.paramPtrs.usernames = &usernames
+	mmGetPresence.defaultExpectation.expectationOrigins.originUsernames = minimock.CallerInfo(1)
+
+	return mmGetPresence
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Inspect(f func(ctx context.Context, username string, usernames []string)) *mChatServiceMockGetPresence {
+	if mmGetPresence.mock.inspectFuncGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetPresence")
+	}
+
+	mmGetPresence.mock.inspectFuncGetPresence = f
+
+	return mmGetPresence
+}
+
+// Return sets up results that will be returned by ChatService.GetPresence
+func (mmGetPresence *mChatServiceMockGetPresence) Return(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	if mmGetPresence.defaultExpectation == nil {
+		mmGetPresence.defaultExpectation = &ChatServiceMockGetPresenceExpectation{mock: mmGetPresence.mock}
+	}
+	mmGetPresence.defaultExpectation.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	mmGetPresence.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPresence.mock
+}
+
+// Set uses given function f to mock the ChatService.GetPresence method
+func (mmGetPresence *mChatServiceMockGetPresence) Set(f func(ctx context.Context, username string, usernames []string) (ppa1 []*model.Presence, err error)) *ChatServiceMock {
+	if mmGetPresence.defaultExpectation != nil {
+		mmGetPresence.mock.t.Fatalf("Default expectation is already set for the ChatService.GetPresence method")
+	}
+
+	if len(mmGetPresence.expectations) > 0 {
+		mmGetPresence.mock.t.Fatalf("Some expectations are already set for the ChatService.GetPresence method")
+	}
+
+	mmGetPresence.mock.funcGetPresence = f
+	mmGetPresence.mock.funcGetPresenceOrigin = minimock.CallerInfo(1)
+	return mmGetPresence.mock
+}
+
+// When sets expectation for the ChatService.GetPresence which will trigger the result defined by the following
+// Then helper
+func (mmGetPresence *mChatServiceMockGetPresence) When(ctx context.Context, username string, usernames []string) *ChatServiceMockGetPresenceExpectation {
+	if mmGetPresence.mock.funcGetPresence != nil {
+		mmGetPresence.mock.t.Fatalf("ChatServiceMock.GetPresence mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetPresenceExpectation{
+		mock:               mmGetPresence.mock,
+		params:             &ChatServiceMockGetPresenceParams{ctx, username, usernames},
+		expectationOrigins: ChatServiceMockGetPresenceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPresence.expectations = append(mmGetPresence.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetPresence return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetPresenceExpectation) Then(ppa1 []*model.Presence, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetPresenceResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetPresence should be invoked
+func (mmGetPresence *mChatServiceMockGetPresence) Times(n uint64) *mChatServiceMockGetPresence {
+	if n == 0 {
+		mmGetPresence.mock.t.Fatalf("Times of ChatServiceMock.GetPresence mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPresence.expectedInvocations, n)
+	mmGetPresence.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPresence
+}
+
+func (mmGetPresence *mChatServiceMockGetPresence) invocationsDone() bool {
+	if len(mmGetPresence.expectations) == 0 && mmGetPresence.defaultExpectation == nil && mmGetPresence.mock.funcGetPresence == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPresence.mock.afterGetPresenceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPresence.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPresence implements mm_service.ChatService
+func (mmGetPresence *ChatServiceMock) GetPresence(ctx context.Context, username string, usernames []string) (ppa1 []*model.Presence, err error) {
+	mm_atomic.AddUint64(&mmGetPresence.beforeGetPresenceCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPresence.afterGetPresenceCounter, 1)
+
+	mmGetPresence.t.Helper()
+
+	if mmGetPresence.inspectFuncGetPresence != nil {
+		mmGetPresence.inspectFuncGetPresence(ctx, username, usernames)
+	}
+
+	mm_params := ChatServiceMockGetPresenceParams{ctx, username, usernames}
+
+	// Record call args
+	mmGetPresence.GetPresenceMock.mutex.Lock()
+	mmGetPresence.GetPresenceMock.callArgs = append(mmGetPresence.GetPresenceMock.callArgs, &mm_params)
+	mmGetPresence.GetPresenceMock.mutex.Unlock()
+
+	for _, e := range mmGetPresence.GetPresenceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmGetPresence.GetPresenceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPresence.GetPresenceMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPresence.GetPresenceMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPresence.GetPresenceMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetPresenceParams{ctx, username, usernames}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.usernames != nil && !minimock.Equal(*mm_want_ptrs.usernames, mm_got.usernames) {
+				mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameter usernames, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.originUsernames, *mm_want_ptrs.usernames, mm_got.usernames, minimock.Diff(*mm_want_ptrs.usernames, mm_got.usernames))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPresence.t.Errorf("ChatServiceMock.GetPresence got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPresence.GetPresenceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPresence.GetPresenceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPresence.t.Fatal("No results are set for the ChatServiceMock.GetPresence")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmGetPresence.funcGetPresence != nil {
+		return mmGetPresence.funcGetPresence(ctx, username, usernames)
+	}
+	mmGetPresence.t.Fatalf("Unexpected call to ChatServiceMock.GetPresence. %v %v %v", ctx, username, usernames)
+	return
+}
+
+// GetPresenceAfterCounter returns a count of finished ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.afterGetPresenceCounter)
+}
+
+// GetPresenceBeforeCounter returns a count of ChatServiceMock.GetPresence invocations
+func (mmGetPresence *ChatServiceMock) GetPresenceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPresence.beforeGetPresenceCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetPresence.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPresence *mChatServiceMockGetPresence) Calls() []*ChatServiceMockGetPresenceParams {
+	mmGetPresence.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetPresenceParams, len(mmGetPresence.callArgs))
+	copy(argCopy, mmGetPresence.callArgs)
+
+	mmGetPresence.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPresenceDone returns true if the count of the GetPresence invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetPresenceDone() bool {
+	if m.GetPresenceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPresenceMock.invocationsDone()
+}
+
+// MinimockGetPresenceInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetPresenceInspect() {
+	for _, e := range m.GetPresenceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPresenceCounter := mm_atomic.LoadUint64(&m.afterGetPresenceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPresenceMock.defaultExpectation != nil && afterGetPresenceCounter < 1 {
+		if m.GetPresenceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s", m.GetPresenceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s with params: %#v", m.GetPresenceMock.defaultExpectation.expectationOrigins.origin, *m.GetPresenceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPresence != nil && afterGetPresenceCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetPresence at\n%s", m.funcGetPresenceOrigin)
+	}
+
+	if !m.GetPresenceMock.invocationsDone() && afterGetPresenceCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetPresence at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPresenceMock.expectedInvocations), m.GetPresenceMock.expectedInvocationsOrigin, afterGetPresenceCounter)
+	}
+}
+
+type mChatServiceMockGetReadState struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockGetReadStateExpectation
+	expectations       []*ChatServiceMockGetReadStateExpectation
+
+	callArgs []*ChatServiceMockGetReadStateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockGetReadStateExpectation specifies expectation struct of the ChatService.GetReadState
+type ChatServiceMockGetReadStateExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockGetReadStateParams
+	paramPtrs          *ChatServiceMockGetReadStateParamPtrs
+	expectationOrigins ChatServiceMockGetReadStateExpectationOrigins
+	results            *ChatServiceMockGetReadStateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockGetReadStateParams contains parameters of the ChatService.GetReadState
+type ChatServiceMockGetReadStateParams struct {
+	ctx      context.Context
+	username string
+	chatID   int64
+}
+
+// ChatServiceMockGetReadStateParamPtrs contains pointers to parameters of the ChatService.GetReadState
+type ChatServiceMockGetReadStateParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	chatID   *int64
+}
+
+// ChatServiceMockGetReadStateResults contains results of the ChatService.GetReadState
+type ChatServiceMockGetReadStateResults struct {
+	rpa1 []*model.ReadCursor
+	err  error
+}
+
+// ChatServiceMockGetReadStateOrigins contains origins of expectations of the ChatService.GetReadState
+type ChatServiceMockGetReadStateExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originChatID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReadState *mChatServiceMockGetReadState) Optional() *mChatServiceMockGetReadState {
+	mmGetReadState.optional = true
+	return mmGetReadState
+}
+
+// Expect sets up expected params for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Expect(ctx context.Context, username string, chatID int64) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by ExpectParams functions")
+	}
+
+	mmGetReadState.defaultExpectation.params = &ChatServiceMockGetReadStateParams{ctx, username, chatID}
+	mmGetReadState.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReadState.expectations {
+		if minimock.Equal(e.params, mmGetReadState.defaultExpectation.params) {
+			mmGetReadState.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReadState.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReadState
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) ExpectCtxParam1(ctx context.Context) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.params != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Expect")
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs == nil {
+		mmGetReadState.defaultExpectation.paramPtrs = &ChatServiceMockGetReadStateParamPtrs{}
+	}
+	mmGetReadState.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReadState.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReadState
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) ExpectUsernameParam2(username string) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.params != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Expect")
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs == nil {
+		mmGetReadState.defaultExpectation.paramPtrs = &ChatServiceMockGetReadStateParamPtrs{}
+	}
+	mmGetReadState.defaultExpectation.paramPtrs.username = &username
+	mmGetReadState.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetReadState
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) ExpectChatIDParam3(chatID int64) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{}
+	}
+
+	if mmGetReadState.defaultExpectation.params != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Expect")
+	}
+
+	if mmGetReadState.defaultExpectation.paramPtrs == nil {
+		mmGetReadState.defaultExpectation.paramPtrs = &ChatServiceMockGetReadStateParamPtrs{}
+	}
+	mmGetReadState.defaultExpectation.paramPtrs.chatID = &chatID
+	mmGetReadState.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmGetReadState
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Inspect(f func(ctx context.Context, username string, chatID int64)) *mChatServiceMockGetReadState {
+	if mmGetReadState.mock.inspectFuncGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.GetReadState")
+	}
+
+	mmGetReadState.mock.inspectFuncGetReadState = f
+
+	return mmGetReadState
+}
+
+// Return sets up results that will be returned by ChatService.GetReadState
+func (mmGetReadState *mChatServiceMockGetReadState) Return(rpa1 []*model.ReadCursor, err error) *ChatServiceMock {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	if mmGetReadState.defaultExpectation == nil {
+		mmGetReadState.defaultExpectation = &ChatServiceMockGetReadStateExpectation{mock: mmGetReadState.mock}
+	}
+	mmGetReadState.defaultExpectation.results = &ChatServiceMockGetReadStateResults{rpa1, err}
+	mmGetReadState.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReadState.mock
+}
+
+// Set uses given function f to mock the ChatService.GetReadState method
+func (mmGetReadState *mChatServiceMockGetReadState) Set(f func(ctx context.Context, username string, chatID int64) (rpa1 []*model.ReadCursor, err error)) *ChatServiceMock {
+	if mmGetReadState.defaultExpectation != nil {
+		mmGetReadState.mock.t.Fatalf("Default expectation is already set for the ChatService.GetReadState method")
+	}
+
+	if len(mmGetReadState.expectations) > 0 {
+		mmGetReadState.mock.t.Fatalf("Some expectations are already set for the ChatService.GetReadState method")
+	}
+
+	mmGetReadState.mock.funcGetReadState = f
+	mmGetReadState.mock.funcGetReadStateOrigin = minimock.CallerInfo(1)
+	return mmGetReadState.mock
+}
+
+// When sets expectation for the ChatService.GetReadState which will trigger the result defined by the following
+// Then helper
+func (mmGetReadState *mChatServiceMockGetReadState) When(ctx context.Context, username string, chatID int64) *ChatServiceMockGetReadStateExpectation {
+	if mmGetReadState.mock.funcGetReadState != nil {
+		mmGetReadState.mock.t.Fatalf("ChatServiceMock.GetReadState mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockGetReadStateExpectation{
+		mock:               mmGetReadState.mock,
+		params:             &ChatServiceMockGetReadStateParams{ctx, username, chatID},
+		expectationOrigins: ChatServiceMockGetReadStateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReadState.expectations = append(mmGetReadState.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.GetReadState return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockGetReadStateExpectation) Then(rpa1 []*model.ReadCursor, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockGetReadStateResults{rpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.GetReadState should be invoked
+func (mmGetReadState *mChatServiceMockGetReadState) Times(n uint64) *mChatServiceMockGetReadState {
+	if n == 0 {
+		mmGetReadState.mock.t.Fatalf("Times of ChatServiceMock.GetReadState mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReadState.expectedInvocations, n)
+	mmGetReadState.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReadState
+}
+
+func (mmGetReadState *mChatServiceMockGetReadState) invocationsDone() bool {
+	if len(mmGetReadState.expectations) == 0 && mmGetReadState.defaultExpectation == nil && mmGetReadState.mock.funcGetReadState == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReadState.mock.afterGetReadStateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReadState.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReadState implements mm_service.ChatService
+func (mmGetReadState *ChatServiceMock) GetReadState(ctx context.Context, username string, chatID int64) (rpa1 []*model.ReadCursor, err error) {
+	mm_atomic.AddUint64(&mmGetReadState.beforeGetReadStateCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReadState.afterGetReadStateCounter, 1)
+
+	mmGetReadState.t.Helper()
+
+	if mmGetReadState.inspectFuncGetReadState != nil {
+		mmGetReadState.inspectFuncGetReadState(ctx, username, chatID)
+	}
+
+	mm_params := ChatServiceMockGetReadStateParams{ctx, username, chatID}
+
+	// Record call args
+	mmGetReadState.GetReadStateMock.mutex.Lock()
+	mmGetReadState.GetReadStateMock.callArgs = append(mmGetReadState.GetReadStateMock.callArgs, &mm_params)
+	mmGetReadState.GetReadStateMock.mutex.Unlock()
+
+	for _, e := range mmGetReadState.GetReadStateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.rpa1, e.results.err
+		}
+	}
+
+	if mmGetReadState.GetReadStateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReadState.GetReadStateMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReadState.GetReadStateMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReadState.GetReadStateMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockGetReadStateParams{ctx, username, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadState.GetReadStateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadState.GetReadStateMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReadState.GetReadStateMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReadState.t.Errorf("ChatServiceMock.GetReadState got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReadState.GetReadStateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReadState.GetReadStateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReadState.t.Fatal("No results are set for the ChatServiceMock.GetReadState")
+		}
+		return (*mm_results).rpa1, (*mm_results).err
+	}
+	if mmGetReadState.funcGetReadState != nil {
+		return mmGetReadState.funcGetReadState(ctx, username, chatID)
+	}
+	mmGetReadState.t.Fatalf("Unexpected call to ChatServiceMock.GetReadState. %v %v %v", ctx, username, chatID)
+	return
+}
+
+// GetReadStateAfterCounter returns a count of finished ChatServiceMock.GetReadState invocations
+func (mmGetReadState *ChatServiceMock) GetReadStateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadState.afterGetReadStateCounter)
+}
+
+// GetReadStateBeforeCounter returns a count of ChatServiceMock.GetReadState invocations
+func (mmGetReadState *ChatServiceMock) GetReadStateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReadState.beforeGetReadStateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.GetReadState.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReadState *mChatServiceMockGetReadState) Calls() []*ChatServiceMockGetReadStateParams {
+	mmGetReadState.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockGetReadStateParams, len(mmGetReadState.callArgs))
+	copy(argCopy, mmGetReadState.callArgs)
+
+	mmGetReadState.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReadStateDone returns true if the count of the GetReadState invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockGetReadStateDone() bool {
+	if m.GetReadStateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReadStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReadStateMock.invocationsDone()
+}
+
+// MinimockGetReadStateInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockGetReadStateInspect() {
+	for _, e := range m.GetReadStateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadState at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReadStateCounter := mm_atomic.LoadUint64(&m.afterGetReadStateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReadStateMock.defaultExpectation != nil && afterGetReadStateCounter < 1 {
+		if m.GetReadStateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadState at\n%s", m.GetReadStateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.GetReadState at\n%s with params: %#v", m.GetReadStateMock.defaultExpectation.expectationOrigins.origin, *m.GetReadStateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReadState != nil && afterGetReadStateCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.GetReadState at\n%s", m.funcGetReadStateOrigin)
+	}
+
+	if !m.GetReadStateMock.invocationsDone() && afterGetReadStateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.GetReadState at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReadStateMock.expectedInvocations), m.GetReadStateMock.expectedInvocationsOrigin, afterGetReadStateCounter)
+	}
+}
+
+type mChatServiceMockHeartbeat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockHeartbeatExpectation
+	expectations       []*ChatServiceMockHeartbeatExpectation
+
+	callArgs []*ChatServiceMockHeartbeatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockHeartbeatExpectation specifies expectation struct of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockHeartbeatParams
+	paramPtrs          *ChatServiceMockHeartbeatParamPtrs
+	expectationOrigins ChatServiceMockHeartbeatExpectationOrigins
+	results            *ChatServiceMockHeartbeatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockHeartbeatParams contains parameters of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ChatServiceMockHeartbeatParamPtrs contains pointers to parameters of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ChatServiceMockHeartbeatResults contains results of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatResults struct {
+	err error
+}
+
+// ChatServiceMockHeartbeatOrigins contains origins of expectations of the ChatService.Heartbeat
+type ChatServiceMockHeartbeatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHeartbeat *mChatServiceMockHeartbeat) Optional() *mChatServiceMockHeartbeat {
+	mmHeartbeat.optional = true
+	return mmHeartbeat
+}
+
+// Expect sets up expected params for ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Expect(ctx context.Context, username string) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by ExpectParams functions")
+	}
+
+	mmHeartbeat.defaultExpectation.params = &ChatServiceMockHeartbeatParams{ctx, username}
+	mmHeartbeat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHeartbeat.expectations {
+		if minimock.Equal(e.params, mmHeartbeat.defaultExpectation.params) {
+			mmHeartbeat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHeartbeat.defaultExpectation.params)
+		}
+	}
+
+	return mmHeartbeat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.params != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Expect")
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmHeartbeat.defaultExpectation.paramPtrs = &ChatServiceMockHeartbeatParamPtrs{}
+	}
+	mmHeartbeat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmHeartbeat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmHeartbeat
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) ExpectUsernameParam2(username string) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{}
+	}
+
+	if mmHeartbeat.defaultExpectation.params != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Expect")
+	}
+
+	if mmHeartbeat.defaultExpectation.paramPtrs == nil {
+		mmHeartbeat.defaultExpectation.paramPtrs = &ChatServiceMockHeartbeatParamPtrs{}
+	}
+	mmHeartbeat.defaultExpectation.paramPtrs.username = &username
+	mmHeartbeat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmHeartbeat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Inspect(f func(ctx context.Context, username string)) *mChatServiceMockHeartbeat {
+	if mmHeartbeat.mock.inspectFuncHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Heartbeat")
+	}
+
+	mmHeartbeat.mock.inspectFuncHeartbeat = f
+
+	return mmHeartbeat
+}
+
+// Return sets up results that will be returned by ChatService.Heartbeat
+func (mmHeartbeat *mChatServiceMockHeartbeat) Return(err error) *ChatServiceMock {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	if mmHeartbeat.defaultExpectation == nil {
+		mmHeartbeat.defaultExpectation = &ChatServiceMockHeartbeatExpectation{mock: mmHeartbeat.mock}
+	}
+	mmHeartbeat.defaultExpectation.results = &ChatServiceMockHeartbeatResults{err}
+	mmHeartbeat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHeartbeat.mock
+}
+
+// Set uses given function f to mock the ChatService.Heartbeat method
+func (mmHeartbeat *mChatServiceMockHeartbeat) Set(f func(ctx context.Context, username string) (err error)) *ChatServiceMock {
+	if mmHeartbeat.defaultExpectation != nil {
+		mmHeartbeat.mock.t.Fatalf("Default expectation is already set for the ChatService.Heartbeat method")
+	}
+
+	if len(mmHeartbeat.expectations) > 0 {
+		mmHeartbeat.mock.t.Fatalf("Some expectations are already set for the ChatService.Heartbeat method")
+	}
+
+	mmHeartbeat.mock.funcHeartbeat = f
+	mmHeartbeat.mock.funcHeartbeatOrigin = minimock.CallerInfo(1)
+	return mmHeartbeat.mock
+}
+
+// When sets expectation for the ChatService.Heartbeat which will trigger the result defined by the following
+// Then helper
+func (mmHeartbeat *mChatServiceMockHeartbeat) When(ctx context.Context, username string) *ChatServiceMockHeartbeatExpectation {
+	if mmHeartbeat.mock.funcHeartbeat != nil {
+		mmHeartbeat.mock.t.Fatalf("ChatServiceMock.Heartbeat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockHeartbeatExpectation{
+		mock:               mmHeartbeat.mock,
+		params:             &ChatServiceMockHeartbeatParams{ctx, username},
+		expectationOrigins: ChatServiceMockHeartbeatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHeartbeat.expectations = append(mmHeartbeat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Heartbeat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockHeartbeatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockHeartbeatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Heartbeat should be invoked
+func (mmHeartbeat *mChatServiceMockHeartbeat) Times(n uint64) *mChatServiceMockHeartbeat {
+	if n == 0 {
+		mmHeartbeat.mock.t.Fatalf("Times of ChatServiceMock.Heartbeat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHeartbeat.expectedInvocations, n)
+	mmHeartbeat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHeartbeat
+}
+
+func (mmHeartbeat *mChatServiceMockHeartbeat) invocationsDone() bool {
+	if len(mmHeartbeat.expectations) == 0 && mmHeartbeat.defaultExpectation == nil && mmHeartbeat.mock.funcHeartbeat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHeartbeat.mock.afterHeartbeatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHeartbeat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Heartbeat implements mm_service.ChatService
+func (mmHeartbeat *ChatServiceMock) Heartbeat(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmHeartbeat.beforeHeartbeatCounter, 1)
+	defer mm_atomic.AddUint64(&mmHeartbeat.afterHeartbeatCounter, 1)
+
+	mmHeartbeat.t.Helper()
+
+	if mmHeartbeat.inspectFuncHeartbeat != nil {
+		mmHeartbeat.inspectFuncHeartbeat(ctx, username)
+	}
+
+	mm_params := ChatServiceMockHeartbeatParams{ctx, username}
+
+	// Record call args
+	mmHeartbeat.HeartbeatMock.mutex.Lock()
+	mmHeartbeat.HeartbeatMock.callArgs = append(mmHeartbeat.HeartbeatMock.callArgs, &mm_params)
+	mmHeartbeat.HeartbeatMock.mutex.Unlock()
+
+	for _, e := range mmHeartbeat.HeartbeatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmHeartbeat.HeartbeatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHeartbeat.HeartbeatMock.defaultExpectation.Counter, 1)
+		mm_want := mmHeartbeat.HeartbeatMock.defaultExpectation.params
+		mm_want_ptrs := mmHeartbeat.HeartbeatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockHeartbeatParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmHeartbeat.t.Errorf("ChatServiceMock.Heartbeat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHeartbeat.HeartbeatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmHeartbeat.t.Errorf("ChatServiceMock.Heartbeat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHeartbeat.HeartbeatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHeartbeat.t.Errorf("ChatServiceMock.Heartbeat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHeartbeat.HeartbeatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHeartbeat.HeartbeatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHeartbeat.t.Fatal("No results are set for the ChatServiceMock.Heartbeat")
+		}
+		return (*mm_results).err
+	}
+	if mmHeartbeat.funcHeartbeat != nil {
+		return mmHeartbeat.funcHeartbeat(ctx, username)
+	}
+	mmHeartbeat.t.Fatalf("Unexpected call to ChatServiceMock.Heartbeat. %v %v", ctx, username)
+	return
+}
+
+// HeartbeatAfterCounter returns a count of finished ChatServiceMock.Heartbeat invocations
+func (mmHeartbeat *ChatServiceMock) HeartbeatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.afterHeartbeatCounter)
+}
+
+// HeartbeatBeforeCounter returns a count of ChatServiceMock.Heartbeat invocations
+func (mmHeartbeat *ChatServiceMock) HeartbeatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHeartbeat.beforeHeartbeatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Heartbeat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHeartbeat *mChatServiceMockHeartbeat) Calls() []*ChatServiceMockHeartbeatParams {
+	mmHeartbeat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockHeartbeatParams, len(mmHeartbeat.callArgs))
+	copy(argCopy, mmHeartbeat.callArgs)
+
+	mmHeartbeat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHeartbeatDone returns true if the count of the Heartbeat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockHeartbeatDone() bool {
+	if m.HeartbeatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HeartbeatMock.invocationsDone()
+}
+
+// MinimockHeartbeatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockHeartbeatInspect() {
+	for _, e := range m.HeartbeatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Heartbeat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHeartbeatCounter := mm_atomic.LoadUint64(&m.afterHeartbeatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HeartbeatMock.defaultExpectation != nil && afterHeartbeatCounter < 1 {
+		if m.HeartbeatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Heartbeat at\n%s", m.HeartbeatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Heartbeat at\n%s with params: %#v", m.HeartbeatMock.defaultExpectation.expectationOrigins.origin, *m.HeartbeatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHeartbeat != nil && afterHeartbeatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Heartbeat at\n%s", m.funcHeartbeatOrigin)
+	}
+
+	if !m.HeartbeatMock.invocationsDone() && afterHeartbeatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Heartbeat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HeartbeatMock.expectedInvocations), m.HeartbeatMock.expectedInvocationsOrigin, afterHeartbeatCounter)
+	}
+}
+
+type mChatServiceMockLeaveChat struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockLeaveChatExpectation
+	expectations       []*ChatServiceMockLeaveChatExpectation
+
+	callArgs []*ChatServiceMockLeaveChatParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockLeaveChatExpectation specifies expectation struct of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockLeaveChatParams
+	paramPtrs          *ChatServiceMockLeaveChatParamPtrs
+	expectationOrigins ChatServiceMockLeaveChatExpectationOrigins
+	results            *ChatServiceMockLeaveChatResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockLeaveChatParams contains parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockLeaveChatParamPtrs contains pointers to parameters of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockLeaveChatResults contains results of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatResults struct {
+	err error
+}
+
+// ChatServiceMockLeaveChatOrigins contains origins of expectations of the ChatService.LeaveChat
+type ChatServiceMockLeaveChatExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLeaveChat *mChatServiceMockLeaveChat) Optional() *mChatServiceMockLeaveChat {
+	mmLeaveChat.optional = true
+	return mmLeaveChat
+}
+
+// Expect sets up expected params for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by ExpectParams functions")
+	}
+
+	mmLeaveChat.defaultExpectation.params = &ChatServiceMockLeaveChatParams{ctx, chatID, username}
+	mmLeaveChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLeaveChat.expectations {
+		if minimock.Equal(e.params, mmLeaveChat.defaultExpectation.params) {
+			mmLeaveChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLeaveChat.defaultExpectation.params)
+		}
+	}
+
+	return mmLeaveChat
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLeaveChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectChatIDParam2(chatID int64) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.chatID = &chatID
+	mmLeaveChat.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) ExpectUsernameParam3(username string) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{}
+	}
+
+	if mmLeaveChat.defaultExpectation.params != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Expect")
+	}
+
+	if mmLeaveChat.defaultExpectation.paramPtrs == nil {
+		mmLeaveChat.defaultExpectation.paramPtrs = &ChatServiceMockLeaveChatParamPtrs{}
+	}
+	mmLeaveChat.defaultExpectation.paramPtrs.username = &username
+	mmLeaveChat.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmLeaveChat
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockLeaveChat {
+	if mmLeaveChat.mock.inspectFuncLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.LeaveChat")
+	}
+
+	mmLeaveChat.mock.inspectFuncLeaveChat = f
+
+	return mmLeaveChat
+}
+
+// Return sets up results that will be returned by ChatService.LeaveChat
+func (mmLeaveChat *mChatServiceMockLeaveChat) Return(err error) *ChatServiceMock {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	if mmLeaveChat.defaultExpectation == nil {
+		mmLeaveChat.defaultExpectation = &ChatServiceMockLeaveChatExpectation{mock: mmLeaveChat.mock}
+	}
+	mmLeaveChat.defaultExpectation.results = &ChatServiceMockLeaveChatResults{err}
+	mmLeaveChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// Set uses given function f to mock the ChatService.LeaveChat method
+func (mmLeaveChat *mChatServiceMockLeaveChat) Set(f func(ctx context.Context, chatID int64, username string) (err error)) *ChatServiceMock {
+	if mmLeaveChat.defaultExpectation != nil {
+		mmLeaveChat.mock.t.Fatalf("Default expectation is already set for the ChatService.LeaveChat method")
+	}
+
+	if len(mmLeaveChat.expectations) > 0 {
+		mmLeaveChat.mock.t.Fatalf("Some expectations are already set for the ChatService.LeaveChat method")
+	}
+
+	mmLeaveChat.mock.funcLeaveChat = f
+	mmLeaveChat.mock.funcLeaveChatOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat.mock
+}
+
+// When sets expectation for the ChatService.LeaveChat which will trigger the result defined by the following
+// Then helper
+func (mmLeaveChat *mChatServiceMockLeaveChat) When(ctx context.Context, chatID int64, username string) *ChatServiceMockLeaveChatExpectation {
+	if mmLeaveChat.mock.funcLeaveChat != nil {
+		mmLeaveChat.mock.t.Fatalf("ChatServiceMock.LeaveChat mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockLeaveChatExpectation{
+		mock:               mmLeaveChat.mock,
+		params:             &ChatServiceMockLeaveChatParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockLeaveChatExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLeaveChat.expectations = append(mmLeaveChat.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.LeaveChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockLeaveChatExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockLeaveChatResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.LeaveChat should be invoked
+func (mmLeaveChat *mChatServiceMockLeaveChat) Times(n uint64) *mChatServiceMockLeaveChat {
+	if n == 0 {
+		mmLeaveChat.mock.t.Fatalf("Times of ChatServiceMock.LeaveChat mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLeaveChat.expectedInvocations, n)
+	mmLeaveChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLeaveChat
+}
+
+func (mmLeaveChat *mChatServiceMockLeaveChat) invocationsDone() bool {
+	if len(mmLeaveChat.expectations) == 0 && mmLeaveChat.defaultExpectation == nil && mmLeaveChat.mock.funcLeaveChat == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLeaveChat.mock.afterLeaveChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLeaveChat.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LeaveChat implements mm_service.ChatService
+func (mmLeaveChat *ChatServiceMock) LeaveChat(ctx context.Context, chatID int64, username string) (err error) {
+	mm_atomic.AddUint64(&mmLeaveChat.beforeLeaveChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmLeaveChat.afterLeaveChatCounter, 1)
+
+	mmLeaveChat.t.Helper()
+
+	if mmLeaveChat.inspectFuncLeaveChat != nil {
+		mmLeaveChat.inspectFuncLeaveChat(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockLeaveChatParams{ctx, chatID, username}
+
+	// Record call args
+	mmLeaveChat.LeaveChatMock.mutex.Lock()
+	mmLeaveChat.LeaveChatMock.callArgs = append(mmLeaveChat.LeaveChatMock.callArgs, &mm_params)
+	mmLeaveChat.LeaveChatMock.mutex.Unlock()
+
+	for _, e := range mmLeaveChat.LeaveChatMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmLeaveChat.LeaveChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLeaveChat.LeaveChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmLeaveChat.LeaveChatMock.defaultExpectation.params
+		mm_want_ptrs := mmLeaveChat.LeaveChatMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockLeaveChatParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLeaveChat.t.Errorf("ChatServiceMock.LeaveChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLeaveChat.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLeaveChat.LeaveChatMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLeaveChat.t.Fatal("No results are set for the ChatServiceMock.LeaveChat")
+		}
+		return (*mm_results).err
+	}
+	if mmLeaveChat.funcLeaveChat != nil {
+		return mmLeaveChat.funcLeaveChat(ctx, chatID, username)
+	}
+	mmLeaveChat.t.Fatalf("Unexpected call to ChatServiceMock.LeaveChat. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// LeaveChatAfterCounter returns a count of finished ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.afterLeaveChatCounter)
+}
+
+// LeaveChatBeforeCounter returns a count of ChatServiceMock.LeaveChat invocations
+func (mmLeaveChat *ChatServiceMock) LeaveChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLeaveChat.beforeLeaveChatCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.LeaveChat.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLeaveChat *mChatServiceMockLeaveChat) Calls() []*ChatServiceMockLeaveChatParams {
+	mmLeaveChat.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockLeaveChatParams, len(mmLeaveChat.callArgs))
+	copy(argCopy, mmLeaveChat.callArgs)
+
+	mmLeaveChat.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLeaveChatDone returns true if the count of the LeaveChat invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockLeaveChatDone() bool {
+	if m.LeaveChatMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LeaveChatMock.invocationsDone()
+}
+
+// MinimockLeaveChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockLeaveChatInspect() {
+	for _, e := range m.LeaveChatMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLeaveChatCounter := mm_atomic.LoadUint64(&m.afterLeaveChatCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LeaveChatMock.defaultExpectation != nil && afterLeaveChatCounter < 1 {
+		if m.LeaveChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.LeaveChatMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s with params: %#v", m.LeaveChatMock.defaultExpectation.expectationOrigins.origin, *m.LeaveChatMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLeaveChat != nil && afterLeaveChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.LeaveChat at\n%s", m.funcLeaveChatOrigin)
+	}
+
+	if !m.LeaveChatMock.invocationsDone() && afterLeaveChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.LeaveChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LeaveChatMock.expectedInvocations), m.LeaveChatMock.expectedInvocationsOrigin, afterLeaveChatCounter)
+	}
+}
+
+type mChatServiceMockListChats struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListChatsExpectation
+	expectations       []*ChatServiceMockListChatsExpectation
+
+	callArgs []*ChatServiceMockListChatsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListChatsExpectation specifies expectation struct of the ChatService.ListChats
+type ChatServiceMockListChatsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListChatsParams
+	paramPtrs          *ChatServiceMockListChatsParamPtrs
+	expectationOrigins ChatServiceMockListChatsExpectationOrigins
+	results            *ChatServiceMockListChatsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListChatsParams contains parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParams struct {
+	ctx   context.Context
+	query *model.ChatListQuery
+}
+
+// ChatServiceMockListChatsParamPtrs contains pointers to parameters of the ChatService.ListChats
+type ChatServiceMockListChatsParamPtrs struct {
+	ctx   *context.Context
+	query **model.ChatListQuery
+}
+
+// ChatServiceMockListChatsResults contains results of the ChatService.ListChats
+type ChatServiceMockListChatsResults struct {
+	cp1 *model.ChatPage
+	err error
+}
+
+// ChatServiceMockListChatsOrigins contains origins of expectations of the ChatService.ListChats
+type ChatServiceMockListChatsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListChats *mChatServiceMockListChats) Optional() *mChatServiceMockListChats {
+	mmListChats.optional = true
+	return mmListChats
+}
+
+// Expect sets up expected params for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Expect(ctx context.Context, query *model.ChatListQuery) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by ExpectParams functions")
+	}
+
+	mmListChats.defaultExpectation.params = &ChatServiceMockListChatsParams{ctx, query}
+	mmListChats.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListChats.expectations {
+		if minimock.Equal(e.params, mmListChats.defaultExpectation.params) {
+			mmListChats.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListChats.defaultExpectation.params)
+		}
+	}
+
+	return mmListChats
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListChats.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) ExpectQueryParam2(query *model.ChatListQuery) *mChatServiceMockListChats {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{}
+	}
+
+	if mmListChats.defaultExpectation.params != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Expect")
+	}
+
+	if mmListChats.defaultExpectation.paramPtrs == nil {
+		mmListChats.defaultExpectation.paramPtrs = &ChatServiceMockListChatsParamPtrs{}
+	}
+	mmListChats.defaultExpectation.paramPtrs.query = &query
+	mmListChats.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListChats
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Inspect(f func(ctx context.Context, query *model.ChatListQuery)) *mChatServiceMockListChats {
+	if mmListChats.mock.inspectFuncListChats != nil {
+		mmListChats.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListChats")
+	}
+
+	mmListChats.mock.inspectFuncListChats = f
+
+	return mmListChats
+}
+
+// Return sets up results that will be returned by ChatService.ListChats
+func (mmListChats *mChatServiceMockListChats) Return(cp1 *model.ChatPage, err error) *ChatServiceMock {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	if mmListChats.defaultExpectation == nil {
+		mmListChats.defaultExpectation = &ChatServiceMockListChatsExpectation{mock: mmListChats.mock}
+	}
+	mmListChats.defaultExpectation.results = &ChatServiceMockListChatsResults{cp1, err}
+	mmListChats.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// Set uses given function f to mock the ChatService.ListChats method
+func (mmListChats *mChatServiceMockListChats) Set(f func(ctx context.Context, query *model.ChatListQuery) (cp1 *model.ChatPage, err error)) *ChatServiceMock {
+	if mmListChats.defaultExpectation != nil {
+		mmListChats.mock.t.Fatalf("Default expectation is already set for the ChatService.ListChats method")
+	}
+
+	if len(mmListChats.expectations) > 0 {
+		mmListChats.mock.t.Fatalf("Some expectations are already set for the ChatService.ListChats method")
+	}
+
+	mmListChats.mock.funcListChats = f
+	mmListChats.mock.funcListChatsOrigin = minimock.CallerInfo(1)
+	return mmListChats.mock
+}
+
+// When sets expectation for the ChatService.ListChats which will trigger the result defined by the following
+// Then helper
+func (mmListChats *mChatServiceMockListChats) When(ctx context.Context, query *model.ChatListQuery) *ChatServiceMockListChatsExpectation {
+	if mmListChats.mock.funcListChats != nil {
+		mmListChats.mock.t.Fatalf("ChatServiceMock.ListChats mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListChatsExpectation{
+		mock:               mmListChats.mock,
+		params:             &ChatServiceMockListChatsParams{ctx, query},
+		expectationOrigins: ChatServiceMockListChatsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListChats.expectations = append(mmListChats.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListChats return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListChatsExpectation) Then(cp1 *model.ChatPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListChatsResults{cp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListChats should be invoked
+func (mmListChats *mChatServiceMockListChats) Times(n uint64) *mChatServiceMockListChats {
+	if n == 0 {
+		mmListChats.mock.t.Fatalf("Times of ChatServiceMock.ListChats mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListChats.expectedInvocations, n)
+	mmListChats.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListChats
+}
+
+func (mmListChats *mChatServiceMockListChats) invocationsDone() bool {
+	if len(mmListChats.expectations) == 0 && mmListChats.defaultExpectation == nil && mmListChats.mock.funcListChats == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListChats.mock.afterListChatsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListChats.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListChats implements mm_service.ChatService
+func (mmListChats *ChatServiceMock) ListChats(ctx context.Context, query *model.ChatListQuery) (cp1 *model.ChatPage, err error) {
+	mm_atomic.AddUint64(&mmListChats.beforeListChatsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListChats.afterListChatsCounter, 1)
+
+	mmListChats.t.Helper()
+
+	if mmListChats.inspectFuncListChats != nil {
+		mmListChats.inspectFuncListChats(ctx, query)
+	}
+
+	mm_params := ChatServiceMockListChatsParams{ctx, query}
+
+	// Record call args
+	mmListChats.ListChatsMock.mutex.Lock()
+	mmListChats.ListChatsMock.callArgs = append(mmListChats.ListChatsMock.callArgs, &mm_params)
+	mmListChats.ListChatsMock.mutex.Unlock()
+
+	for _, e := range mmListChats.ListChatsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cp1, e.results.err
+		}
+	}
+
+	if mmListChats.ListChatsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListChats.ListChatsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListChats.ListChatsMock.defaultExpectation.params
+		mm_want_ptrs := mmListChats.ListChatsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListChatsParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListChats.t.Errorf("ChatServiceMock.ListChats got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListChats.ListChatsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListChats.ListChatsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListChats.t.Fatal("No results are set for the ChatServiceMock.ListChats")
+		}
+		return (*mm_results).cp1, (*mm_results).err
+	}
+	if mmListChats.funcListChats != nil {
+		return mmListChats.funcListChats(ctx, query)
+	}
+	mmListChats.t.Fatalf("Unexpected call to ChatServiceMock.ListChats. %v %v", ctx, query)
+	return
+}
+
+// ListChatsAfterCounter returns a count of finished ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.afterListChatsCounter)
+}
+
+// ListChatsBeforeCounter returns a count of ChatServiceMock.ListChats invocations
+func (mmListChats *ChatServiceMock) ListChatsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListChats.beforeListChatsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListChats.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListChats *mChatServiceMockListChats) Calls() []*ChatServiceMockListChatsParams {
+	mmListChats.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListChatsParams, len(mmListChats.callArgs))
+	copy(argCopy, mmListChats.callArgs)
+
+	mmListChats.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListChatsDone returns true if the count of the ListChats invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListChatsDone() bool {
+	if m.ListChatsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListChatsMock.invocationsDone()
+}
+
+// MinimockListChatsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListChatsInspect() {
+	for _, e := range m.ListChatsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListChatsCounter := mm_atomic.LoadUint64(&m.afterListChatsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListChatsMock.defaultExpectation != nil && afterListChatsCounter < 1 {
+		if m.ListChatsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s", m.ListChatsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s with params: %#v", m.ListChatsMock.defaultExpectation.expectationOrigins.origin, *m.ListChatsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListChats != nil && afterListChatsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListChats at\n%s", m.funcListChatsOrigin)
+	}
+
+	if !m.ListChatsMock.invocationsDone() && afterListChatsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListChats at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListChatsMock.expectedInvocations), m.ListChatsMock.expectedInvocationsOrigin, afterListChatsCounter)
+	}
+}
+
+type mChatServiceMockListMentions struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMentionsExpectation
+	expectations       []*ChatServiceMockListMentionsExpectation
+
+	callArgs []*ChatServiceMockListMentionsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMentionsExpectation specifies expectation struct of the ChatService.ListMentions
+type ChatServiceMockListMentionsExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMentionsParams
+	paramPtrs          *ChatServiceMockListMentionsParamPtrs
+	expectationOrigins ChatServiceMockListMentionsExpectationOrigins
+	results            *ChatServiceMockListMentionsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMentionsParams contains parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParams struct {
+	ctx   context.Context
+	query *model.MentionQuery
+}
+
+// ChatServiceMockListMentionsParamPtrs contains pointers to parameters of the ChatService.ListMentions
+type ChatServiceMockListMentionsParamPtrs struct {
+	ctx   *context.Context
+	query **model.MentionQuery
+}
+
+// ChatServiceMockListMentionsResults contains results of the ChatService.ListMentions
+type ChatServiceMockListMentionsResults struct {
+	mp1 *model.MessagePage
+	err error
+}
+
+// ChatServiceMockListMentionsOrigins contains origins of expectations of the ChatService.ListMentions
+type ChatServiceMockListMentionsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMentions *mChatServiceMockListMentions) Optional() *mChatServiceMockListMentions {
+	mmListMentions.optional = true
+	return mmListMentions
+}
+
+// Expect sets up expected params for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Expect(ctx context.Context, query *model.MentionQuery) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by ExpectParams functions")
+	}
+
+	mmListMentions.defaultExpectation.params = &ChatServiceMockListMentionsParams{ctx, query}
+	mmListMentions.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMentions.expectations {
+		if minimock.Equal(e.params, mmListMentions.defaultExpectation.params) {
+			mmListMentions.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMentions.defaultExpectation.params)
+		}
+	}
+
+	return mmListMentions
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMentions.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMentions
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) ExpectQueryParam2(query *model.MentionQuery) *mChatServiceMockListMentions {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{}
+	}
+
+	if mmListMentions.defaultExpectation.params != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Expect")
+	}
+
+	if mmListMentions.defaultExpectation.paramPtrs == nil {
+		mmListMentions.defaultExpectation.paramPtrs = &ChatServiceMockListMentionsParamPtrs{}
+	}
+	mmListMentions.defaultExpectation.paramPtrs.query = &query
+	mmListMentions.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListMentions
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Inspect(f func(ctx context.Context, query *model.MentionQuery)) *mChatServiceMockListMentions {
+	if mmListMentions.mock.inspectFuncListMentions != nil {
+		mmListMentions.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMentions")
+	}
+
+	mmListMentions.mock.inspectFuncListMentions = f
+
+	return mmListMentions
+}
+
+// Return sets up results that will be returned by ChatService.ListMentions
+func (mmListMentions *mChatServiceMockListMentions) Return(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	if mmListMentions.defaultExpectation == nil {
+		mmListMentions.defaultExpectation = &ChatServiceMockListMentionsExpectation{mock: mmListMentions.mock}
+	}
+	mmListMentions.defaultExpectation.results = &ChatServiceMockListMentionsResults{mp1, err}
+	mmListMentions.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMentions.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMentions method
+func (mmListMentions *mChatServiceMockListMentions) Set(f func(ctx context.Context, query *model.MentionQuery) (mp1 *model.MessagePage, err error)) *ChatServiceMock {
+	if mmListMentions.defaultExpectation != nil {
+		mmListMentions.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMentions method")
+	}
+
+	if len(mmListMentions.expectations) > 0 {
+		mmListMentions.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMentions method")
+	}
+
+	mmListMentions.mock.funcListMentions = f
+	mmListMentions.mock.funcListMentionsOrigin = minimock.CallerInfo(1)
+	return mmListMentions.mock
+}
+
+// When sets expectation for the ChatService.ListMentions which will trigger the result defined by the following
+// Then helper
+func (mmListMentions *mChatServiceMockListMentions) When(ctx context.Context, query *model.MentionQuery) *ChatServiceMockListMentionsExpectation {
+	if mmListMentions.mock.funcListMentions != nil {
+		mmListMentions.mock.t.Fatalf("ChatServiceMock.ListMentions mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMentionsExpectation{
+		mock:               mmListMentions.mock,
+		params:             &ChatServiceMockListMentionsParams{ctx, query},
+		expectationOrigins: ChatServiceMockListMentionsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMentions.expectations = append(mmListMentions.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMentions return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMentionsExpectation) Then(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMentionsResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMentions should be invoked
+func (mmListMentions *mChatServiceMockListMentions) Times(n uint64) *mChatServiceMockListMentions {
+	if n == 0 {
+		mmListMentions.mock.t.Fatalf("Times of ChatServiceMock.ListMentions mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMentions.expectedInvocations, n)
+	mmListMentions.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMentions
+}
+
+func (mmListMentions *mChatServiceMockListMentions) invocationsDone() bool {
+	if len(mmListMentions.expectations) == 0 && mmListMentions.defaultExpectation == nil && mmListMentions.mock.funcListMentions == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMentions.mock.afterListMentionsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMentions.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMentions implements mm_service.ChatService
+func (mmListMentions *ChatServiceMock) ListMentions(ctx context.Context, query *model.MentionQuery) (mp1 *model.MessagePage, err error) {
+	mm_atomic.AddUint64(&mmListMentions.beforeListMentionsCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMentions.afterListMentionsCounter, 1)
+
+	mmListMentions.t.Helper()
+
+	if mmListMentions.inspectFuncListMentions != nil {
+		mmListMentions.inspectFuncListMentions(ctx, query)
+	}
+
+	mm_params := ChatServiceMockListMentionsParams{ctx, query}
+
+	// Record call args
+	mmListMentions.ListMentionsMock.mutex.Lock()
+	mmListMentions.ListMentionsMock.callArgs = append(mmListMentions.ListMentionsMock.callArgs, &mm_params)
+	mmListMentions.ListMentionsMock.mutex.Unlock()
+
+	for _, e := range mmListMentions.ListMentionsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMentions.ListMentionsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMentions.ListMentionsMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMentions.ListMentionsMock.defaultExpectation.params
+		mm_want_ptrs := mmListMentions.ListMentionsMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMentionsParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMentions.t.Errorf("ChatServiceMock.ListMentions got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMentions.ListMentionsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMentions.ListMentionsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMentions.t.Fatal("No results are set for the ChatServiceMock.ListMentions")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMentions.funcListMentions != nil {
+		return mmListMentions.funcListMentions(ctx, query)
+	}
+	mmListMentions.t.Fatalf("Unexpected call to ChatServiceMock.ListMentions. %v %v", ctx, query)
+	return
+}
+
+// ListMentionsAfterCounter returns a count of finished ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.afterListMentionsCounter)
+}
+
+// ListMentionsBeforeCounter returns a count of ChatServiceMock.ListMentions invocations
+func (mmListMentions *ChatServiceMock) ListMentionsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMentions.beforeListMentionsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMentions.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMentions *mChatServiceMockListMentions) Calls() []*ChatServiceMockListMentionsParams {
+	mmListMentions.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMentionsParams, len(mmListMentions.callArgs))
+	copy(argCopy, mmListMentions.callArgs)
+
+	mmListMentions.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMentionsDone returns true if the count of the ListMentions invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMentionsDone() bool {
+	if m.ListMentionsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMentionsMock.invocationsDone()
+}
+
+// MinimockListMentionsInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMentionsInspect() {
+	for _, e := range m.ListMentionsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMentionsCounter := mm_atomic.LoadUint64(&m.afterListMentionsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMentionsMock.defaultExpectation != nil && afterListMentionsCounter < 1 {
+		if m.ListMentionsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions at\n%s", m.ListMentionsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMentions at\n%s with params: %#v", m.ListMentionsMock.defaultExpectation.expectationOrigins.origin, *m.ListMentionsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMentions != nil && afterListMentionsCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMentions at\n%s", m.funcListMentionsOrigin)
+	}
+
+	if !m.ListMentionsMock.invocationsDone() && afterListMentionsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMentions at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMentionsMock.expectedInvocations), m.ListMentionsMock.expectedInvocationsOrigin, afterListMentionsCounter)
+	}
+}
+
+type mChatServiceMockListMessageRange struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessageRangeExpectation
+	expectations       []*ChatServiceMockListMessageRangeExpectation
+
+	callArgs []*ChatServiceMockListMessageRangeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMessageRangeExpectation specifies expectation struct of the ChatService.ListMessageRange
+type ChatServiceMockListMessageRangeExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMessageRangeParams
+	paramPtrs          *ChatServiceMockListMessageRangeParamPtrs
+	expectationOrigins ChatServiceMockListMessageRangeExpectationOrigins
+	results            *ChatServiceMockListMessageRangeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMessageRangeParams contains parameters of the ChatService.ListMessageRange
+type ChatServiceMockListMessageRangeParams struct {
+	ctx      context.Context
+	username string
+	query    *model.MessageRangeQuery
+}
+
+// ChatServiceMockListMessageRangeParamPtrs contains pointers to parameters of the ChatService.ListMessageRange
+type ChatServiceMockListMessageRangeParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	query    **model.MessageRangeQuery
+}
+
+// ChatServiceMockListMessageRangeResults contains results of the ChatService.ListMessageRange
+type ChatServiceMockListMessageRangeResults struct {
+	mpa1 []*model.Message
+	err  error
+}
+
+// ChatServiceMockListMessageRangeOrigins contains origins of expectations of the ChatService.ListMessageRange
+type ChatServiceMockListMessageRangeExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originQuery    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessageRange *mChatServiceMockListMessageRange) Optional() *mChatServiceMockListMessageRange {
+	mmListMessageRange.optional = true
+	return mmListMessageRange
+}
+
+// Expect sets up expected params for ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) Expect(ctx context.Context, username string, query *model.MessageRangeQuery) *mChatServiceMockListMessageRange {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	if mmListMessageRange.defaultExpectation == nil {
+		mmListMessageRange.defaultExpectation = &ChatServiceMockListMessageRangeExpectation{}
+	}
+
+	if mmListMessageRange.defaultExpectation.paramPtrs != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by ExpectParams functions")
+	}
+
+	mmListMessageRange.defaultExpectation.params = &ChatServiceMockListMessageRangeParams{ctx, username, query}
+	mmListMessageRange.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessageRange.expectations {
+		if minimock.Equal(e.params, mmListMessageRange.defaultExpectation.params) {
+			mmListMessageRange.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessageRange.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessageRange
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessageRange {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	if mmListMessageRange.defaultExpectation == nil {
+		mmListMessageRange.defaultExpectation = &ChatServiceMockListMessageRangeExpectation{}
+	}
+
+	if mmListMessageRange.defaultExpectation.params != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Expect")
+	}
+
+	if mmListMessageRange.defaultExpectation.paramPtrs == nil {
+		mmListMessageRange.defaultExpectation.paramPtrs = &ChatServiceMockListMessageRangeParamPtrs{}
+	}
+	mmListMessageRange.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessageRange.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessageRange
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) ExpectUsernameParam2(username string) *mChatServiceMockListMessageRange {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	if mmListMessageRange.defaultExpectation == nil {
+		mmListMessageRange.defaultExpectation = &ChatServiceMockListMessageRangeExpectation{}
+	}
+
+	if mmListMessageRange.defaultExpectation.params != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Expect")
+	}
+
+	if mmListMessageRange.defaultExpectation.paramPtrs == nil {
+		mmListMessageRange.defaultExpectation.paramPtrs = &ChatServiceMockListMessageRangeParamPtrs{}
+	}
+	mmListMessageRange.defaultExpectation.paramPtrs.username = &username
+	mmListMessageRange.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListMessageRange
+}
+
+// ExpectQueryParam3 sets up expected param query for ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) ExpectQueryParam3(query *model.MessageRangeQuery) *mChatServiceMockListMessageRange {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	if mmListMessageRange.defaultExpectation == nil {
+		mmListMessageRange.defaultExpectation = &ChatServiceMockListMessageRangeExpectation{}
+	}
+
+	if mmListMessageRange.defaultExpectation.params != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Expect")
+	}
+
+	if mmListMessageRange.defaultExpectation.paramPtrs == nil {
+		mmListMessageRange.defaultExpectation.paramPtrs = &ChatServiceMockListMessageRangeParamPtrs{}
+	}
+	mmListMessageRange.defaultExpectation.paramPtrs.query = &query
+	mmListMessageRange.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListMessageRange
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) Inspect(f func(ctx context.Context, username string, query *model.MessageRangeQuery)) *mChatServiceMockListMessageRange {
+	if mmListMessageRange.mock.inspectFuncListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessageRange")
+	}
+
+	mmListMessageRange.mock.inspectFuncListMessageRange = f
+
+	return mmListMessageRange
+}
+
+// Return sets up results that will be returned by ChatService.ListMessageRange
+func (mmListMessageRange *mChatServiceMockListMessageRange) Return(mpa1 []*model.Message, err error) *ChatServiceMock {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	if mmListMessageRange.defaultExpectation == nil {
+		mmListMessageRange.defaultExpectation = &ChatServiceMockListMessageRangeExpectation{mock: mmListMessageRange.mock}
+	}
+	mmListMessageRange.defaultExpectation.results = &ChatServiceMockListMessageRangeResults{mpa1, err}
+	mmListMessageRange.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessageRange.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessageRange method
+func (mmListMessageRange *mChatServiceMockListMessageRange) Set(f func(ctx context.Context, username string, query *model.MessageRangeQuery) (mpa1 []*model.Message, err error)) *ChatServiceMock {
+	if mmListMessageRange.defaultExpectation != nil {
+		mmListMessageRange.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessageRange method")
+	}
+
+	if len(mmListMessageRange.expectations) > 0 {
+		mmListMessageRange.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessageRange method")
+	}
+
+	mmListMessageRange.mock.funcListMessageRange = f
+	mmListMessageRange.mock.funcListMessageRangeOrigin = minimock.CallerInfo(1)
+	return mmListMessageRange.mock
+}
+
+// When sets expectation for the ChatService.ListMessageRange which will trigger the result defined by the following
+// Then helper
+func (mmListMessageRange *mChatServiceMockListMessageRange) When(ctx context.Context, username string, query *model.MessageRangeQuery) *ChatServiceMockListMessageRangeExpectation {
+	if mmListMessageRange.mock.funcListMessageRange != nil {
+		mmListMessageRange.mock.t.Fatalf("ChatServiceMock.ListMessageRange mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessageRangeExpectation{
+		mock:               mmListMessageRange.mock,
+		params:             &ChatServiceMockListMessageRangeParams{ctx, username, query},
+		expectationOrigins: ChatServiceMockListMessageRangeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessageRange.expectations = append(mmListMessageRange.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessageRange return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessageRangeExpectation) Then(mpa1 []*model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessageRangeResults{mpa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessageRange should be invoked
+func (mmListMessageRange *mChatServiceMockListMessageRange) Times(n uint64) *mChatServiceMockListMessageRange {
+	if n == 0 {
+		mmListMessageRange.mock.t.Fatalf("Times of ChatServiceMock.ListMessageRange mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessageRange.expectedInvocations, n)
+	mmListMessageRange.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessageRange
+}
+
+func (mmListMessageRange *mChatServiceMockListMessageRange) invocationsDone() bool {
+	if len(mmListMessageRange.expectations) == 0 && mmListMessageRange.defaultExpectation == nil && mmListMessageRange.mock.funcListMessageRange == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessageRange.mock.afterListMessageRangeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessageRange.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessageRange implements mm_service.ChatService
+func (mmListMessageRange *ChatServiceMock) ListMessageRange(ctx context.Context, username string, query *model.MessageRangeQuery) (mpa1 []*model.Message, err error) {
+	mm_atomic.AddUint64(&mmListMessageRange.beforeListMessageRangeCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessageRange.afterListMessageRangeCounter, 1)
+
+	mmListMessageRange.t.Helper()
+
+	if mmListMessageRange.inspectFuncListMessageRange != nil {
+		mmListMessageRange.inspectFuncListMessageRange(ctx, username, query)
+	}
+
+	mm_params := ChatServiceMockListMessageRangeParams{ctx, username, query}
+
+	// Record call args
+	mmListMessageRange.ListMessageRangeMock.mutex.Lock()
+	mmListMessageRange.ListMessageRangeMock.callArgs = append(mmListMessageRange.ListMessageRangeMock.callArgs, &mm_params)
+	mmListMessageRange.ListMessageRangeMock.mutex.Unlock()
+
+	for _, e := range mmListMessageRange.ListMessageRangeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mpa1, e.results.err
+		}
+	}
+
+	if mmListMessageRange.ListMessageRangeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessageRange.ListMessageRangeMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessageRange.ListMessageRangeMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessageRange.ListMessageRangeMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessageRangeParams{ctx, username, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessageRange.t.Errorf("ChatServiceMock.ListMessageRange got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessageRange.ListMessageRangeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListMessageRange.t.Errorf("ChatServiceMock.ListMessageRange got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessageRange.ListMessageRangeMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMessageRange.t.Errorf("ChatServiceMock.ListMessageRange got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessageRange.ListMessageRangeMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessageRange.t.Errorf("ChatServiceMock.ListMessageRange got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessageRange.ListMessageRangeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessageRange.ListMessageRangeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessageRange.t.Fatal("No results are set for the ChatServiceMock.ListMessageRange")
+		}
+		return (*mm_results).mpa1, (*mm_results).err
+	}
+	if mmListMessageRange.funcListMessageRange != nil {
+		return mmListMessageRange.funcListMessageRange(ctx, username, query)
+	}
+	mmListMessageRange.t.Fatalf("Unexpected call to ChatServiceMock.ListMessageRange. %v %v %v", ctx, username, query)
+	return
+}
+
+// ListMessageRangeAfterCounter returns a count of finished ChatServiceMock.ListMessageRange invocations
+func (mmListMessageRange *ChatServiceMock) ListMessageRangeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessageRange.afterListMessageRangeCounter)
+}
+
+// ListMessageRangeBeforeCounter returns a count of ChatServiceMock.ListMessageRange invocations
+func (mmListMessageRange *ChatServiceMock) ListMessageRangeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessageRange.beforeListMessageRangeCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessageRange.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessageRange *mChatServiceMockListMessageRange) Calls() []*ChatServiceMockListMessageRangeParams {
+	mmListMessageRange.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessageRangeParams, len(mmListMessageRange.callArgs))
+	copy(argCopy, mmListMessageRange.callArgs)
+
+	mmListMessageRange.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessageRangeDone returns true if the count of the ListMessageRange invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessageRangeDone() bool {
+	if m.ListMessageRangeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessageRangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessageRangeMock.invocationsDone()
+}
+
+// MinimockListMessageRangeInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessageRangeInspect() {
+	for _, e := range m.ListMessageRangeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessageRange at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessageRangeCounter := mm_atomic.LoadUint64(&m.afterListMessageRangeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessageRangeMock.defaultExpectation != nil && afterListMessageRangeCounter < 1 {
+		if m.ListMessageRangeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessageRange at\n%s", m.ListMessageRangeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessageRange at\n%s with params: %#v", m.ListMessageRangeMock.defaultExpectation.expectationOrigins.origin, *m.ListMessageRangeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessageRange != nil && afterListMessageRangeCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMessageRange at\n%s", m.funcListMessageRangeOrigin)
+	}
+
+	if !m.ListMessageRangeMock.invocationsDone() && afterListMessageRangeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessageRange at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessageRangeMock.expectedInvocations), m.ListMessageRangeMock.expectedInvocationsOrigin, afterListMessageRangeCounter)
+	}
+}
+
+type mChatServiceMockListMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListMessagesExpectation
+	expectations       []*ChatServiceMockListMessagesExpectation
+
+	callArgs []*ChatServiceMockListMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListMessagesExpectation specifies expectation struct of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListMessagesParams
+	paramPtrs          *ChatServiceMockListMessagesParamPtrs
+	expectationOrigins ChatServiceMockListMessagesExpectationOrigins
+	results            *ChatServiceMockListMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListMessagesParams contains parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParams struct {
+	ctx      context.Context
+	username string
+	query    *model.MessageListQuery
+}
+
+// ChatServiceMockListMessagesParamPtrs contains pointers to parameters of the ChatService.ListMessages
+type ChatServiceMockListMessagesParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	query    **model.MessageListQuery
+}
+
+// ChatServiceMockListMessagesResults contains results of the ChatService.ListMessages
+type ChatServiceMockListMessagesResults struct {
+	mp1 *model.MessagePage
+	err error
+}
+
+// ChatServiceMockListMessagesOrigins contains origins of expectations of the ChatService.ListMessages
+type ChatServiceMockListMessagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originQuery    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListMessages *mChatServiceMockListMessages) Optional() *mChatServiceMockListMessages {
+	mmListMessages.optional = true
+	return mmListMessages
+}
+
+// Expect sets up expected params for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Expect(ctx context.Context, username string, query *model.MessageListQuery) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListMessages.defaultExpectation.params = &ChatServiceMockListMessagesParams{ctx, username, query}
+	mmListMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListMessages.expectations {
+		if minimock.Equal(e.params, mmListMessages.defaultExpectation.params) {
+			mmListMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectUsernameParam2(username string) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.username = &username
+	mmListMessages.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// ExpectQueryParam3 sets up expected param query for ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) ExpectQueryParam3(query *model.MessageListQuery) *mChatServiceMockListMessages {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{}
+	}
+
+	if mmListMessages.defaultExpectation.params != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Expect")
+	}
+
+	if mmListMessages.defaultExpectation.paramPtrs == nil {
+		mmListMessages.defaultExpectation.paramPtrs = &ChatServiceMockListMessagesParamPtrs{}
+	}
+	mmListMessages.defaultExpectation.paramPtrs.query = &query
+	mmListMessages.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Inspect(f func(ctx context.Context, username string, query *model.MessageListQuery)) *mChatServiceMockListMessages {
+	if mmListMessages.mock.inspectFuncListMessages != nil {
+		mmListMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListMessages")
+	}
+
+	mmListMessages.mock.inspectFuncListMessages = f
+
+	return mmListMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListMessages
+func (mmListMessages *mChatServiceMockListMessages) Return(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	if mmListMessages.defaultExpectation == nil {
+		mmListMessages.defaultExpectation = &ChatServiceMockListMessagesExpectation{mock: mmListMessages.mock}
+	}
+	mmListMessages.defaultExpectation.results = &ChatServiceMockListMessagesResults{mp1, err}
+	mmListMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListMessages method
+func (mmListMessages *mChatServiceMockListMessages) Set(f func(ctx context.Context, username string, query *model.MessageListQuery) (mp1 *model.MessagePage, err error)) *ChatServiceMock {
+	if mmListMessages.defaultExpectation != nil {
+		mmListMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListMessages method")
+	}
+
+	if len(mmListMessages.expectations) > 0 {
+		mmListMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListMessages method")
+	}
+
+	mmListMessages.mock.funcListMessages = f
+	mmListMessages.mock.funcListMessagesOrigin = minimock.CallerInfo(1)
+	return mmListMessages.mock
+}
+
+// When sets expectation for the ChatService.ListMessages which will trigger the result defined by the following
+// Then helper
+func (mmListMessages *mChatServiceMockListMessages) When(ctx context.Context, username string, query *model.MessageListQuery) *ChatServiceMockListMessagesExpectation {
+	if mmListMessages.mock.funcListMessages != nil {
+		mmListMessages.mock.t.Fatalf("ChatServiceMock.ListMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListMessagesExpectation{
+		mock:               mmListMessages.mock,
+		params:             &ChatServiceMockListMessagesParams{ctx, username, query},
+		expectationOrigins: ChatServiceMockListMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListMessages.expectations = append(mmListMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListMessagesExpectation) Then(mp1 *model.MessagePage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListMessagesResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListMessages should be invoked
+func (mmListMessages *mChatServiceMockListMessages) Times(n uint64) *mChatServiceMockListMessages {
+	if n == 0 {
+		mmListMessages.mock.t.Fatalf("Times of ChatServiceMock.ListMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListMessages.expectedInvocations, n)
+	mmListMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListMessages
+}
+
+func (mmListMessages *mChatServiceMockListMessages) invocationsDone() bool {
+	if len(mmListMessages.expectations) == 0 && mmListMessages.defaultExpectation == nil && mmListMessages.mock.funcListMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListMessages.mock.afterListMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListMessages implements mm_service.ChatService
+func (mmListMessages *ChatServiceMock) ListMessages(ctx context.Context, username string, query *model.MessageListQuery) (mp1 *model.MessagePage, err error) {
+	mm_atomic.AddUint64(&mmListMessages.beforeListMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListMessages.afterListMessagesCounter, 1)
+
+	mmListMessages.t.Helper()
+
+	if mmListMessages.inspectFuncListMessages != nil {
+		mmListMessages.inspectFuncListMessages(ctx, username, query)
+	}
+
+	mm_params := ChatServiceMockListMessagesParams{ctx, username, query}
+
+	// Record call args
+	mmListMessages.ListMessagesMock.mutex.Lock()
+	mmListMessages.ListMessagesMock.callArgs = append(mmListMessages.ListMessagesMock.callArgs, &mm_params)
+	mmListMessages.ListMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListMessages.ListMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmListMessages.ListMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListMessages.ListMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListMessages.ListMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListMessages.ListMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListMessagesParams{ctx, username, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListMessages.t.Errorf("ChatServiceMock.ListMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListMessages.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListMessages.ListMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListMessages.t.Fatal("No results are set for the ChatServiceMock.ListMessages")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmListMessages.funcListMessages != nil {
+		return mmListMessages.funcListMessages(ctx, username, query)
+	}
+	mmListMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListMessages. %v %v %v", ctx, username, query)
+	return
+}
+
+// ListMessagesAfterCounter returns a count of finished ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.afterListMessagesCounter)
+}
+
+// ListMessagesBeforeCounter returns a count of ChatServiceMock.ListMessages invocations
+func (mmListMessages *ChatServiceMock) ListMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListMessages.beforeListMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListMessages *mChatServiceMockListMessages) Calls() []*ChatServiceMockListMessagesParams {
+	mmListMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListMessagesParams, len(mmListMessages.callArgs))
+	copy(argCopy, mmListMessages.callArgs)
+
+	mmListMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListMessagesDone returns true if the count of the ListMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListMessagesDone() bool {
+	if m.ListMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListMessagesMock.invocationsDone()
+}
+
+// MinimockListMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListMessagesInspect() {
+	for _, e := range m.ListMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListMessagesCounter := mm_atomic.LoadUint64(&m.afterListMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListMessagesMock.defaultExpectation != nil && afterListMessagesCounter < 1 {
+		if m.ListMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.ListMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s with params: %#v", m.ListMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListMessages != nil && afterListMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListMessages at\n%s", m.funcListMessagesOrigin)
+	}
+
+	if !m.ListMessagesMock.invocationsDone() && afterListMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListMessagesMock.expectedInvocations), m.ListMessagesMock.expectedInvocationsOrigin, afterListMessagesCounter)
+	}
+}
+
+type mChatServiceMockListPinned struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListPinnedExpectation
+	expectations       []*ChatServiceMockListPinnedExpectation
+
+	callArgs []*ChatServiceMockListPinnedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListPinnedExpectation specifies expectation struct of the ChatService.ListPinned
+type ChatServiceMockListPinnedExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListPinnedParams
+	paramPtrs          *ChatServiceMockListPinnedParamPtrs
+	expectationOrigins ChatServiceMockListPinnedExpectationOrigins
+	results            *ChatServiceMockListPinnedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListPinnedParams contains parameters of the ChatService.ListPinned
+type ChatServiceMockListPinnedParams struct {
+	ctx      context.Context
+	username string
+	chatID   int64
+}
+
+// ChatServiceMockListPinnedParamPtrs contains pointers to parameters of the ChatService.ListPinned
+type ChatServiceMockListPinnedParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	chatID   *int64
+}
+
+// ChatServiceMockListPinnedResults contains results of the ChatService.ListPinned
+type ChatServiceMockListPinnedResults struct {
+	ppa1 []*model.PinnedMessage
+	err  error
+}
+
+// ChatServiceMockListPinnedOrigins contains origins of expectations of the ChatService.ListPinned
+type ChatServiceMockListPinnedExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originChatID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListPinned *mChatServiceMockListPinned) Optional() *mChatServiceMockListPinned {
+	mmListPinned.optional = true
+	return mmListPinned
+}
+
+// Expect sets up expected params for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Expect(ctx context.Context, username string, chatID int64) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by ExpectParams functions")
+	}
+
+	mmListPinned.defaultExpectation.params = &ChatServiceMockListPinnedParams{ctx, username, chatID}
+	mmListPinned.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListPinned.expectations {
+		if minimock.Equal(e.params, mmListPinned.defaultExpectation.params) {
+			mmListPinned.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListPinned.defaultExpectation.params)
+		}
+	}
+
+	return mmListPinned
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListPinned.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListPinned
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) ExpectUsernameParam2(username string) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.username = &username
+	mmListPinned.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListPinned
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) ExpectChatIDParam3(chatID int64) *mChatServiceMockListPinned {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{}
+	}
+
+	if mmListPinned.defaultExpectation.params != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Expect")
+	}
+
+	if mmListPinned.defaultExpectation.paramPtrs == nil {
+		mmListPinned.defaultExpectation.paramPtrs = &ChatServiceMockListPinnedParamPtrs{}
+	}
+	mmListPinned.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListPinned.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListPinned
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Inspect(f func(ctx context.Context, username string, chatID int64)) *mChatServiceMockListPinned {
+	if mmListPinned.mock.inspectFuncListPinned != nil {
+		mmListPinned.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListPinned")
+	}
+
+	mmListPinned.mock.inspectFuncListPinned = f
+
+	return mmListPinned
+}
+
+// Return sets up results that will be returned by ChatService.ListPinned
+func (mmListPinned *mChatServiceMockListPinned) Return(ppa1 []*model.PinnedMessage, err error) *ChatServiceMock {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	if mmListPinned.defaultExpectation == nil {
+		mmListPinned.defaultExpectation = &ChatServiceMockListPinnedExpectation{mock: mmListPinned.mock}
+	}
+	mmListPinned.defaultExpectation.results = &ChatServiceMockListPinnedResults{ppa1, err}
+	mmListPinned.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListPinned.mock
+}
+
+// Set uses given function f to mock the ChatService.ListPinned method
+func (mmListPinned *mChatServiceMockListPinned) Set(f func(ctx context.Context, username string, chatID int64) (ppa1 []*model.PinnedMessage, err error)) *ChatServiceMock {
+	if mmListPinned.defaultExpectation != nil {
+		mmListPinned.mock.t.Fatalf("Default expectation is already set for the ChatService.ListPinned method")
+	}
+
+	if len(mmListPinned.expectations) > 0 {
+		mmListPinned.mock.t.Fatalf("Some expectations are already set for the ChatService.ListPinned method")
+	}
+
+	mmListPinned.mock.funcListPinned = f
+	mmListPinned.mock.funcListPinnedOrigin = minimock.CallerInfo(1)
+	return mmListPinned.mock
+}
+
+// When sets expectation for the ChatService.ListPinned which will trigger the result defined by the following
+// Then helper
+func (mmListPinned *mChatServiceMockListPinned) When(ctx context.Context, username string, chatID int64) *ChatServiceMockListPinnedExpectation {
+	if mmListPinned.mock.funcListPinned != nil {
+		mmListPinned.mock.t.Fatalf("ChatServiceMock.ListPinned mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListPinnedExpectation{
+		mock:               mmListPinned.mock,
+		params:             &ChatServiceMockListPinnedParams{ctx, username, chatID},
+		expectationOrigins: ChatServiceMockListPinnedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListPinned.expectations = append(mmListPinned.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListPinned return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListPinnedExpectation) Then(ppa1 []*model.PinnedMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListPinnedResults{ppa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListPinned should be invoked
+func (mmListPinned *mChatServiceMockListPinned) Times(n uint64) *mChatServiceMockListPinned {
+	if n == 0 {
+		mmListPinned.mock.t.Fatalf("Times of ChatServiceMock.ListPinned mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListPinned.expectedInvocations, n)
+	mmListPinned.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListPinned
+}
+
+func (mmListPinned *mChatServiceMockListPinned) invocationsDone() bool {
+	if len(mmListPinned.expectations) == 0 && mmListPinned.defaultExpectation == nil && mmListPinned.mock.funcListPinned == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListPinned.mock.afterListPinnedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListPinned.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListPinned implements mm_service.ChatService
+func (mmListPinned *ChatServiceMock) ListPinned(ctx context.Context, username string, chatID int64) (ppa1 []*model.PinnedMessage, err error) {
+	mm_atomic.AddUint64(&mmListPinned.beforeListPinnedCounter, 1)
+	defer mm_atomic.AddUint64(&mmListPinned.afterListPinnedCounter, 1)
+
+	mmListPinned.t.Helper()
+
+	if mmListPinned.inspectFuncListPinned != nil {
+		mmListPinned.inspectFuncListPinned(ctx, username, chatID)
+	}
+
+	mm_params := ChatServiceMockListPinnedParams{ctx, username, chatID}
+
+	// Record call args
+	mmListPinned.ListPinnedMock.mutex.Lock()
+	mmListPinned.ListPinnedMock.callArgs = append(mmListPinned.ListPinnedMock.callArgs, &mm_params)
+	mmListPinned.ListPinnedMock.mutex.Unlock()
+
+	for _, e := range mmListPinned.ListPinnedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ppa1, e.results.err
+		}
+	}
+
+	if mmListPinned.ListPinnedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListPinned.ListPinnedMock.defaultExpectation.Counter, 1)
+		mm_want := mmListPinned.ListPinnedMock.defaultExpectation.params
+		mm_want_ptrs := mmListPinned.ListPinnedMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListPinnedParams{ctx, username, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListPinned.t.Errorf("ChatServiceMock.ListPinned got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListPinned.ListPinnedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListPinned.ListPinnedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListPinned.t.Fatal("No results are set for the ChatServiceMock.ListPinned")
+		}
+		return (*mm_results).ppa1, (*mm_results).err
+	}
+	if mmListPinned.funcListPinned != nil {
+		return mmListPinned.funcListPinned(ctx, username, chatID)
+	}
+	mmListPinned.t.Fatalf("Unexpected call to ChatServiceMock.ListPinned. %v %v %v", ctx, username, chatID)
+	return
+}
+
+// ListPinnedAfterCounter returns a count of finished ChatServiceMock.ListPinned invocations
+func (mmListPinned *ChatServiceMock) ListPinnedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.afterListPinnedCounter)
+}
+
+// ListPinnedBeforeCounter returns a count of ChatServiceMock.ListPinned invocations
+func (mmListPinned *ChatServiceMock) ListPinnedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListPinned.beforeListPinnedCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListPinned.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListPinned *mChatServiceMockListPinned) Calls() []*ChatServiceMockListPinnedParams {
+	mmListPinned.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListPinnedParams, len(mmListPinned.callArgs))
+	copy(argCopy, mmListPinned.callArgs)
+
+	mmListPinned.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListPinnedDone returns true if the count of the ListPinned invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListPinnedDone() bool {
+	if m.ListPinnedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListPinnedMock.invocationsDone()
+}
+
+// MinimockListPinnedInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListPinnedInspect() {
+	for _, e := range m.ListPinnedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinned at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListPinnedCounter := mm_atomic.LoadUint64(&m.afterListPinnedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListPinnedMock.defaultExpectation != nil && afterListPinnedCounter < 1 {
+		if m.ListPinnedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinned at\n%s", m.ListPinnedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListPinned at\n%s with params: %#v", m.ListPinnedMock.defaultExpectation.expectationOrigins.origin, *m.ListPinnedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListPinned != nil && afterListPinnedCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListPinned at\n%s", m.funcListPinnedOrigin)
+	}
+
+	if !m.ListPinnedMock.invocationsDone() && afterListPinnedCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListPinned at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListPinnedMock.expectedInvocations), m.ListPinnedMock.expectedInvocationsOrigin, afterListPinnedCounter)
+	}
+}
+
+type mChatServiceMockListScheduledMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListScheduledMessagesExpectation
+	expectations       []*ChatServiceMockListScheduledMessagesExpectation
+
+	callArgs []*ChatServiceMockListScheduledMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListScheduledMessagesExpectation specifies expectation struct of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListScheduledMessagesParams
+	paramPtrs          *ChatServiceMockListScheduledMessagesParamPtrs
+	expectationOrigins ChatServiceMockListScheduledMessagesExpectationOrigins
+	results            *ChatServiceMockListScheduledMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListScheduledMessagesParams contains parameters of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesParams struct {
+	ctx      context.Context
+	username string
+	chatID   int64
+}
+
+// ChatServiceMockListScheduledMessagesParamPtrs contains pointers to parameters of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	chatID   *int64
+}
+
+// ChatServiceMockListScheduledMessagesResults contains results of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesResults struct {
+	spa1 []*model.ScheduledMessage
+	err  error
+}
+
+// ChatServiceMockListScheduledMessagesOrigins contains origins of expectations of the ChatService.ListScheduledMessages
+type ChatServiceMockListScheduledMessagesExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originChatID   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Optional() *mChatServiceMockListScheduledMessages {
+	mmListScheduledMessages.optional = true
+	return mmListScheduledMessages
+}
+
+// Expect sets up expected params for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Expect(ctx context.Context, username string, chatID int64) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by ExpectParams functions")
+	}
+
+	mmListScheduledMessages.defaultExpectation.params = &ChatServiceMockListScheduledMessagesParams{ctx, username, chatID}
+	mmListScheduledMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListScheduledMessages.expectations {
+		if minimock.Equal(e.params, mmListScheduledMessages.defaultExpectation.params) {
+			mmListScheduledMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListScheduledMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmListScheduledMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListScheduledMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListScheduledMessages
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) ExpectUsernameParam2(username string) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.username = &username
+	mmListScheduledMessages.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListScheduledMessages
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) ExpectChatIDParam3(chatID int64) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{}
+	}
+
+	if mmListScheduledMessages.defaultExpectation.params != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Expect")
+	}
+
+	if mmListScheduledMessages.defaultExpectation.paramPtrs == nil {
+		mmListScheduledMessages.defaultExpectation.paramPtrs = &ChatServiceMockListScheduledMessagesParamPtrs{}
+	}
+	mmListScheduledMessages.defaultExpectation.paramPtrs.chatID = &chatID
+	mmListScheduledMessages.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmListScheduledMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Inspect(f func(ctx context.Context, username string, chatID int64)) *mChatServiceMockListScheduledMessages {
+	if mmListScheduledMessages.mock.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListScheduledMessages")
+	}
+
+	mmListScheduledMessages.mock.inspectFuncListScheduledMessages = f
+
+	return mmListScheduledMessages
+}
+
+// Return sets up results that will be returned by ChatService.ListScheduledMessages
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Return(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	if mmListScheduledMessages.defaultExpectation == nil {
+		mmListScheduledMessages.defaultExpectation = &ChatServiceMockListScheduledMessagesExpectation{mock: mmListScheduledMessages.mock}
+	}
+	mmListScheduledMessages.defaultExpectation.results = &ChatServiceMockListScheduledMessagesResults{spa1, err}
+	mmListScheduledMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListScheduledMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.ListScheduledMessages method
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Set(f func(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error)) *ChatServiceMock {
+	if mmListScheduledMessages.defaultExpectation != nil {
+		mmListScheduledMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.ListScheduledMessages method")
+	}
+
+	if len(mmListScheduledMessages.expectations) > 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.ListScheduledMessages method")
+	}
+
+	mmListScheduledMessages.mock.funcListScheduledMessages = f
+	mmListScheduledMessages.mock.funcListScheduledMessagesOrigin = minimock.CallerInfo(1)
+	return mmListScheduledMessages.mock
+}
+
+// When sets expectation for the ChatService.ListScheduledMessages which will trigger the result defined by the following
+// Then helper
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) When(ctx context.Context, username string, chatID int64) *ChatServiceMockListScheduledMessagesExpectation {
+	if mmListScheduledMessages.mock.funcListScheduledMessages != nil {
+		mmListScheduledMessages.mock.t.Fatalf("ChatServiceMock.ListScheduledMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListScheduledMessagesExpectation{
+		mock:               mmListScheduledMessages.mock,
+		params:             &ChatServiceMockListScheduledMessagesParams{ctx, username, chatID},
+		expectationOrigins: ChatServiceMockListScheduledMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListScheduledMessages.expectations = append(mmListScheduledMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListScheduledMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListScheduledMessagesExpectation) Then(spa1 []*model.ScheduledMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListScheduledMessagesResults{spa1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListScheduledMessages should be invoked
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Times(n uint64) *mChatServiceMockListScheduledMessages {
+	if n == 0 {
+		mmListScheduledMessages.mock.t.Fatalf("Times of ChatServiceMock.ListScheduledMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListScheduledMessages.expectedInvocations, n)
+	mmListScheduledMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListScheduledMessages
+}
+
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) invocationsDone() bool {
+	if len(mmListScheduledMessages.expectations) == 0 && mmListScheduledMessages.defaultExpectation == nil && mmListScheduledMessages.mock.funcListScheduledMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.mock.afterListScheduledMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListScheduledMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListScheduledMessages implements mm_service.ChatService
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessages(ctx context.Context, username string, chatID int64) (spa1 []*model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter, 1)
+
+	mmListScheduledMessages.t.Helper()
+
+	if mmListScheduledMessages.inspectFuncListScheduledMessages != nil {
+		mmListScheduledMessages.inspectFuncListScheduledMessages(ctx, username, chatID)
+	}
+
+	mm_params := ChatServiceMockListScheduledMessagesParams{ctx, username, chatID}
+
+	// Record call args
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Lock()
+	mmListScheduledMessages.ListScheduledMessagesMock.callArgs = append(mmListScheduledMessages.ListScheduledMessagesMock.callArgs, &mm_params)
+	mmListScheduledMessages.ListScheduledMessagesMock.mutex.Unlock()
+
+	for _, e := range mmListScheduledMessages.ListScheduledMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.spa1, e.results.err
+		}
+	}
+
+	if mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListScheduledMessagesParams{ctx, username, chatID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListScheduledMessages.t.Errorf("ChatServiceMock.ListScheduledMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListScheduledMessages.ListScheduledMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListScheduledMessages.t.Fatal("No results are set for the ChatServiceMock.ListScheduledMessages")
+		}
+		return (*mm_results).spa1, (*mm_results).err
+	}
+	if mmListScheduledMessages.funcListScheduledMessages != nil {
+		return mmListScheduledMessages.funcListScheduledMessages(ctx, username, chatID)
+	}
+	mmListScheduledMessages.t.Fatalf("Unexpected call to ChatServiceMock.ListScheduledMessages. %v %v %v", ctx, username, chatID)
+	return
+}
+
+// ListScheduledMessagesAfterCounter returns a count of finished ChatServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.afterListScheduledMessagesCounter)
+}
+
+// ListScheduledMessagesBeforeCounter returns a count of ChatServiceMock.ListScheduledMessages invocations
+func (mmListScheduledMessages *ChatServiceMock) ListScheduledMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListScheduledMessages.beforeListScheduledMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListScheduledMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListScheduledMessages *mChatServiceMockListScheduledMessages) Calls() []*ChatServiceMockListScheduledMessagesParams {
+	mmListScheduledMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListScheduledMessagesParams, len(mmListScheduledMessages.callArgs))
+	copy(argCopy, mmListScheduledMessages.callArgs)
+
+	mmListScheduledMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListScheduledMessagesDone returns true if the count of the ListScheduledMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListScheduledMessagesDone() bool {
+	if m.ListScheduledMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListScheduledMessagesMock.invocationsDone()
+}
+
+// MinimockListScheduledMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListScheduledMessagesInspect() {
+	for _, e := range m.ListScheduledMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListScheduledMessagesCounter := mm_atomic.LoadUint64(&m.afterListScheduledMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListScheduledMessagesMock.defaultExpectation != nil && afterListScheduledMessagesCounter < 1 {
+		if m.ListScheduledMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages at\n%s", m.ListScheduledMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages at\n%s with params: %#v", m.ListScheduledMessagesMock.defaultExpectation.expectationOrigins.origin, *m.ListScheduledMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListScheduledMessages != nil && afterListScheduledMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListScheduledMessages at\n%s", m.funcListScheduledMessagesOrigin)
+	}
+
+	if !m.ListScheduledMessagesMock.invocationsDone() && afterListScheduledMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListScheduledMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListScheduledMessagesMock.expectedInvocations), m.ListScheduledMessagesMock.expectedInvocationsOrigin, afterListScheduledMessagesCounter)
+	}
+}
+
+type mChatServiceMockListThread struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockListThreadExpectation
+	expectations       []*ChatServiceMockListThreadExpectation
+
+	callArgs []*ChatServiceMockListThreadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockListThreadExpectation specifies expectation struct of the ChatService.ListThread
+type ChatServiceMockListThreadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockListThreadParams
+	paramPtrs          *ChatServiceMockListThreadParamPtrs
+	expectationOrigins ChatServiceMockListThreadExpectationOrigins
+	results            *ChatServiceMockListThreadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockListThreadParams contains parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParams struct {
+	ctx      context.Context
+	username string
+	query    *model.ThreadQuery
+}
+
+// ChatServiceMockListThreadParamPtrs contains pointers to parameters of the ChatService.ListThread
+type ChatServiceMockListThreadParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	query    **model.ThreadQuery
+}
+
+// ChatServiceMockListThreadResults contains results of the ChatService.ListThread
+type ChatServiceMockListThreadResults struct {
+	tp1 *model.ThreadPage
+	err error
+}
+
+// ChatServiceMockListThreadOrigins contains origins of expectations of the ChatService.ListThread
+type ChatServiceMockListThreadExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originQuery    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmListThread *mChatServiceMockListThread) Optional() *mChatServiceMockListThread {
+	mmListThread.optional = true
+	return mmListThread
+}
+
+// Expect sets up expected params for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Expect(ctx context.Context, username string, query *model.ThreadQuery) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by ExpectParams functions")
+	}
+
+	mmListThread.defaultExpectation.params = &ChatServiceMockListThreadParams{ctx, username, query}
+	mmListThread.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmListThread.expectations {
+		if minimock.Equal(e.params, mmListThread.defaultExpectation.params) {
+			mmListThread.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmListThread.defaultExpectation.params)
+		}
+	}
+
+	return mmListThread
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectCtxParam1(ctx context.Context) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.ctx = &ctx
+	mmListThread.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectUsernameParam2(username string) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.username = &username
+	mmListThread.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// ExpectQueryParam3 sets up expected param query for ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) ExpectQueryParam3(query *model.ThreadQuery) *mChatServiceMockListThread {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{}
+	}
+
+	if mmListThread.defaultExpectation.params != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Expect")
+	}
+
+	if mmListThread.defaultExpectation.paramPtrs == nil {
+		mmListThread.defaultExpectation.paramPtrs = &ChatServiceMockListThreadParamPtrs{}
+	}
+	mmListThread.defaultExpectation.paramPtrs.query = &query
+	mmListThread.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmListThread
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Inspect(f func(ctx context.Context, username string, query *model.ThreadQuery)) *mChatServiceMockListThread {
+	if mmListThread.mock.inspectFuncListThread != nil {
+		mmListThread.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ListThread")
+	}
+
+	mmListThread.mock.inspectFuncListThread = f
+
+	return mmListThread
+}
+
+// Return sets up results that will be returned by ChatService.ListThread
+func (mmListThread *mChatServiceMockListThread) Return(tp1 *model.ThreadPage, err error) *ChatServiceMock {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	if mmListThread.defaultExpectation == nil {
+		mmListThread.defaultExpectation = &ChatServiceMockListThreadExpectation{mock: mmListThread.mock}
+	}
+	mmListThread.defaultExpectation.results = &ChatServiceMockListThreadResults{tp1, err}
+	mmListThread.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// Set uses given function f to mock the ChatService.ListThread method
+func (mmListThread *mChatServiceMockListThread) Set(f func(ctx context.Context, username string, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error)) *ChatServiceMock {
+	if mmListThread.defaultExpectation != nil {
+		mmListThread.mock.t.Fatalf("Default expectation is already set for the ChatService.ListThread method")
+	}
+
+	if len(mmListThread.expectations) > 0 {
+		mmListThread.mock.t.Fatalf("Some expectations are already set for the ChatService.ListThread method")
+	}
+
+	mmListThread.mock.funcListThread = f
+	mmListThread.mock.funcListThreadOrigin = minimock.CallerInfo(1)
+	return mmListThread.mock
+}
+
+// When sets expectation for the ChatService.ListThread which will trigger the result defined by the following
+// Then helper
+func (mmListThread *mChatServiceMockListThread) When(ctx context.Context, username string, query *model.ThreadQuery) *ChatServiceMockListThreadExpectation {
+	if mmListThread.mock.funcListThread != nil {
+		mmListThread.mock.t.Fatalf("ChatServiceMock.ListThread mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockListThreadExpectation{
+		mock:               mmListThread.mock,
+		params:             &ChatServiceMockListThreadParams{ctx, username, query},
+		expectationOrigins: ChatServiceMockListThreadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmListThread.expectations = append(mmListThread.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ListThread return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockListThreadExpectation) Then(tp1 *model.ThreadPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockListThreadResults{tp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ListThread should be invoked
+func (mmListThread *mChatServiceMockListThread) Times(n uint64) *mChatServiceMockListThread {
+	if n == 0 {
+		mmListThread.mock.t.Fatalf("Times of ChatServiceMock.ListThread mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmListThread.expectedInvocations, n)
+	mmListThread.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmListThread
+}
+
+func (mmListThread *mChatServiceMockListThread) invocationsDone() bool {
+	if len(mmListThread.expectations) == 0 && mmListThread.defaultExpectation == nil && mmListThread.mock.funcListThread == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmListThread.mock.afterListThreadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmListThread.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ListThread implements mm_service.ChatService
+func (mmListThread *ChatServiceMock) ListThread(ctx context.Context, username string, query *model.ThreadQuery) (tp1 *model.ThreadPage, err error) {
+	mm_atomic.AddUint64(&mmListThread.beforeListThreadCounter, 1)
+	defer mm_atomic.AddUint64(&mmListThread.afterListThreadCounter, 1)
+
+	mmListThread.t.Helper()
+
+	if mmListThread.inspectFuncListThread != nil {
+		mmListThread.inspectFuncListThread(ctx, username, query)
+	}
+
+	mm_params := ChatServiceMockListThreadParams{ctx, username, query}
+
+	// Record call args
+	mmListThread.ListThreadMock.mutex.Lock()
+	mmListThread.ListThreadMock.callArgs = append(mmListThread.ListThreadMock.callArgs, &mm_params)
+	mmListThread.ListThreadMock.mutex.Unlock()
+
+	for _, e := range mmListThread.ListThreadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.tp1, e.results.err
+		}
+	}
+
+	if mmListThread.ListThreadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmListThread.ListThreadMock.defaultExpectation.Counter, 1)
+		mm_want := mmListThread.ListThreadMock.defaultExpectation.params
+		mm_want_ptrs := mmListThread.ListThreadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockListThreadParams{ctx, username, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmListThread.t.Errorf("ChatServiceMock.ListThread got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmListThread.ListThreadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmListThread.ListThreadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmListThread.t.Fatal("No results are set for the ChatServiceMock.ListThread")
+		}
+		return (*mm_results).tp1, (*mm_results).err
+	}
+	if mmListThread.funcListThread != nil {
+		return mmListThread.funcListThread(ctx, username, query)
+	}
+	mmListThread.t.Fatalf("Unexpected call to ChatServiceMock.ListThread. %v %v %v", ctx, username, query)
+	return
+}
+
+// ListThreadAfterCounter returns a count of finished ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.afterListThreadCounter)
+}
+
+// ListThreadBeforeCounter returns a count of ChatServiceMock.ListThread invocations
+func (mmListThread *ChatServiceMock) ListThreadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmListThread.beforeListThreadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ListThread.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmListThread *mChatServiceMockListThread) Calls() []*ChatServiceMockListThreadParams {
+	mmListThread.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockListThreadParams, len(mmListThread.callArgs))
+	copy(argCopy, mmListThread.callArgs)
+
+	mmListThread.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockListThreadDone returns true if the count of the ListThread invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockListThreadDone() bool {
+	if m.ListThreadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ListThreadMock.invocationsDone()
+}
+
+// MinimockListThreadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockListThreadInspect() {
+	for _, e := range m.ListThreadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterListThreadCounter := mm_atomic.LoadUint64(&m.afterListThreadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ListThreadMock.defaultExpectation != nil && afterListThreadCounter < 1 {
+		if m.ListThreadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.ListThreadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s with params: %#v", m.ListThreadMock.defaultExpectation.expectationOrigins.origin, *m.ListThreadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcListThread != nil && afterListThreadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ListThread at\n%s", m.funcListThreadOrigin)
+	}
+
+	if !m.ListThreadMock.invocationsDone() && afterListThreadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ListThread at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ListThreadMock.expectedInvocations), m.ListThreadMock.expectedInvocationsOrigin, afterListThreadCounter)
+	}
+}
+
+type mChatServiceMockMarkRead struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockMarkReadExpectation
+	expectations       []*ChatServiceMockMarkReadExpectation
+
+	callArgs []*ChatServiceMockMarkReadParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockMarkReadExpectation specifies expectation struct of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockMarkReadParams
+	paramPtrs          *ChatServiceMockMarkReadParamPtrs
+	expectationOrigins ChatServiceMockMarkReadExpectationOrigins
+	results            *ChatServiceMockMarkReadResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockMarkReadParams contains parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParams struct {
+	ctx       context.Context
+	username  string
+	chatID    int64
+	messageID int64
+}
+
+// ChatServiceMockMarkReadParamPtrs contains pointers to parameters of the ChatService.MarkRead
+type ChatServiceMockMarkReadParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	chatID    *int64
+	messageID *int64
+}
+
+// ChatServiceMockMarkReadResults contains results of the ChatService.MarkRead
+type ChatServiceMockMarkReadResults struct {
+	err error
+}
+
+// ChatServiceMockMarkReadOrigins contains origins of expectations of the ChatService.MarkRead
+type ChatServiceMockMarkReadExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originChatID    string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRead *mChatServiceMockMarkRead) Optional() *mChatServiceMockMarkRead {
+	mmMarkRead.optional = true
+	return mmMarkRead
+}
+
+// Expect sets up expected params for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Expect(ctx context.Context, username string, chatID int64, messageID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRead.defaultExpectation.params = &ChatServiceMockMarkReadParams{ctx, username, chatID, messageID}
+	mmMarkRead.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRead.expectations {
+		if minimock.Equal(e.params, mmMarkRead.defaultExpectation.params) {
+			mmMarkRead.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRead.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRead
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectCtxParam1(ctx context.Context) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRead.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectUsernameParam2(username string) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.username = &username
+	mmMarkRead.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectChatIDParam3 sets up expected param chatID for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectChatIDParam3(chatID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.chatID = &chatID
+	mmMarkRead.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// ExpectMessageIDParam4 sets up expected param messageID for ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) ExpectMessageIDParam4(messageID int64) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{}
+	}
+
+	if mmMarkRead.defaultExpectation.params != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Expect")
+	}
+
+	if mmMarkRead.defaultExpectation.paramPtrs == nil {
+		mmMarkRead.defaultExpectation.paramPtrs = &ChatServiceMockMarkReadParamPtrs{}
+	}
+	mmMarkRead.defaultExpectation.paramPtrs.messageID = &messageID
+	mmMarkRead.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmMarkRead
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Inspect(f func(ctx context.Context, username string, chatID int64, messageID int64)) *mChatServiceMockMarkRead {
+	if mmMarkRead.mock.inspectFuncMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.MarkRead")
+	}
+
+	mmMarkRead.mock.inspectFuncMarkRead = f
+
+	return mmMarkRead
+}
+
+// Return sets up results that will be returned by ChatService.MarkRead
+func (mmMarkRead *mChatServiceMockMarkRead) Return(err error) *ChatServiceMock {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	if mmMarkRead.defaultExpectation == nil {
+		mmMarkRead.defaultExpectation = &ChatServiceMockMarkReadExpectation{mock: mmMarkRead.mock}
+	}
+	mmMarkRead.defaultExpectation.results = &ChatServiceMockMarkReadResults{err}
+	mmMarkRead.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// Set uses given function f to mock the ChatService.MarkRead method
+func (mmMarkRead *mChatServiceMockMarkRead) Set(f func(ctx context.Context, username string, chatID int64, messageID int64) (err error)) *ChatServiceMock {
+	if mmMarkRead.defaultExpectation != nil {
+		mmMarkRead.mock.t.Fatalf("Default expectation is already set for the ChatService.MarkRead method")
+	}
+
+	if len(mmMarkRead.expectations) > 0 {
+		mmMarkRead.mock.t.Fatalf("Some expectations are already set for the ChatService.MarkRead method")
+	}
+
+	mmMarkRead.mock.funcMarkRead = f
+	mmMarkRead.mock.funcMarkReadOrigin = minimock.CallerInfo(1)
+	return mmMarkRead.mock
+}
+
+// When sets expectation for the ChatService.MarkRead which will trigger the result defined by the following
+// Then helper
+func (mmMarkRead *mChatServiceMockMarkRead) When(ctx context.Context, username string, chatID int64, messageID int64) *ChatServiceMockMarkReadExpectation {
+	if mmMarkRead.mock.funcMarkRead != nil {
+		mmMarkRead.mock.t.Fatalf("ChatServiceMock.MarkRead mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockMarkReadExpectation{
+		mock:               mmMarkRead.mock,
+		params:             &ChatServiceMockMarkReadParams{ctx, username, chatID, messageID},
+		expectationOrigins: ChatServiceMockMarkReadExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRead.expectations = append(mmMarkRead.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.MarkRead return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockMarkReadExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockMarkReadResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.MarkRead should be invoked
+func (mmMarkRead *mChatServiceMockMarkRead) Times(n uint64) *mChatServiceMockMarkRead {
+	if n == 0 {
+		mmMarkRead.mock.t.Fatalf("Times of ChatServiceMock.MarkRead mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRead.expectedInvocations, n)
+	mmMarkRead.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRead
+}
+
+func (mmMarkRead *mChatServiceMockMarkRead) invocationsDone() bool {
+	if len(mmMarkRead.expectations) == 0 && mmMarkRead.defaultExpectation == nil && mmMarkRead.mock.funcMarkRead == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRead.mock.afterMarkReadCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRead.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRead implements mm_service.ChatService
+func (mmMarkRead *ChatServiceMock) MarkRead(ctx context.Context, username string, chatID int64, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmMarkRead.beforeMarkReadCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRead.afterMarkReadCounter, 1)
+
+	mmMarkRead.t.Helper()
+
+	if mmMarkRead.inspectFuncMarkRead != nil {
+		mmMarkRead.inspectFuncMarkRead(ctx, username, chatID, messageID)
+	}
+
+	mm_params := ChatServiceMockMarkReadParams{ctx, username, chatID, messageID}
+
+	// Record call args
+	mmMarkRead.MarkReadMock.mutex.Lock()
+	mmMarkRead.MarkReadMock.callArgs = append(mmMarkRead.MarkReadMock.callArgs, &mm_params)
+	mmMarkRead.MarkReadMock.mutex.Unlock()
+
+	for _, e := range mmMarkRead.MarkReadMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRead.MarkReadMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRead.MarkReadMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRead.MarkReadMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRead.MarkReadMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockMarkReadParams{ctx, username, chatID, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRead.t.Errorf("ChatServiceMock.MarkRead got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRead.MarkReadMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRead.MarkReadMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRead.t.Fatal("No results are set for the ChatServiceMock.MarkRead")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRead.funcMarkRead != nil {
+		return mmMarkRead.funcMarkRead(ctx, username, chatID, messageID)
+	}
+	mmMarkRead.t.Fatalf("Unexpected call to ChatServiceMock.MarkRead. %v %v %v %v", ctx, username, chatID, messageID)
+	return
+}
+
+// MarkReadAfterCounter returns a count of finished ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.afterMarkReadCounter)
+}
+
+// MarkReadBeforeCounter returns a count of ChatServiceMock.MarkRead invocations
+func (mmMarkRead *ChatServiceMock) MarkReadBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRead.beforeMarkReadCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.MarkRead.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRead *mChatServiceMockMarkRead) Calls() []*ChatServiceMockMarkReadParams {
+	mmMarkRead.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockMarkReadParams, len(mmMarkRead.callArgs))
+	copy(argCopy, mmMarkRead.callArgs)
+
+	mmMarkRead.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkReadDone returns true if the count of the MarkRead invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockMarkReadDone() bool {
+	if m.MarkReadMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkReadMock.invocationsDone()
+}
+
+// MinimockMarkReadInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockMarkReadInspect() {
+	for _, e := range m.MarkReadMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkReadCounter := mm_atomic.LoadUint64(&m.afterMarkReadCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkReadMock.defaultExpectation != nil && afterMarkReadCounter < 1 {
+		if m.MarkReadMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.MarkReadMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s with params: %#v", m.MarkReadMock.defaultExpectation.expectationOrigins.origin, *m.MarkReadMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRead != nil && afterMarkReadCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.MarkRead at\n%s", m.funcMarkReadOrigin)
+	}
+
+	if !m.MarkReadMock.invocationsDone() && afterMarkReadCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.MarkRead at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkReadMock.expectedInvocations), m.MarkReadMock.expectedInvocationsOrigin, afterMarkReadCounter)
+	}
+}
+
+type mChatServiceMockOpenAttachment struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockOpenAttachmentExpectation
+	expectations       []*ChatServiceMockOpenAttachmentExpectation
+
+	callArgs []*ChatServiceMockOpenAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockOpenAttachmentExpectation specifies expectation struct of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockOpenAttachmentParams
+	paramPtrs          *ChatServiceMockOpenAttachmentParamPtrs
+	expectationOrigins ChatServiceMockOpenAttachmentExpectationOrigins
+	results            *ChatServiceMockOpenAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockOpenAttachmentParams contains parameters of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentParams struct {
+	ctx           context.Context
+	username      string
+	id            int64
+	thumbnailSize int
+}
+
+// ChatServiceMockOpenAttachmentParamPtrs contains pointers to parameters of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentParamPtrs struct {
+	ctx           *context.Context
+	username      *string
+	id            *int64
+	thumbnailSize *int
+}
+
+// ChatServiceMockOpenAttachmentResults contains results of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentResults struct {
+	ap1 *model.Attachment
+	r1  io.ReadCloser
+	err error
+}
+
+// ChatServiceMockOpenAttachmentOrigins contains origins of expectations of the ChatService.OpenAttachment
+type ChatServiceMockOpenAttachmentExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originUsername      string
+	originId            string
+	originThumbnailSize string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Optional() *mChatServiceMockOpenAttachment {
+	mmOpenAttachment.optional = true
+	return mmOpenAttachment
+}
+
+// Expect sets up expected params for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Expect(ctx context.Context, username string, id int64, thumbnailSize int) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmOpenAttachment.defaultExpectation.params = &ChatServiceMockOpenAttachmentParams{ctx, username, id, thumbnailSize}
+	mmOpenAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenAttachment.expectations {
+		if minimock.Equal(e.params, mmOpenAttachment.defaultExpectation.params) {
+			mmOpenAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectCtxParam1(ctx context.Context) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectUsernameParam2(username string) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.username = &username
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// ExpectIdParam3 sets up expected param id for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectIdParam3(id int64) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.id = &id
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// ExpectThumbnailSizeParam4 sets up expected param thumbnailSize for ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) ExpectThumbnailSizeParam4(thumbnailSize int) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &ChatServiceMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.thumbnailSize = &thumbnailSize
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originThumbnailSize = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Inspect(f func(ctx context.Context, username string, id int64, thumbnailSize int)) *mChatServiceMockOpenAttachment {
+	if mmOpenAttachment.mock.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.OpenAttachment")
+	}
+
+	mmOpenAttachment.mock.inspectFuncOpenAttachment = f
+
+	return mmOpenAttachment
+}
+
+// Return sets up results that will be returned by ChatService.OpenAttachment
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Return(ap1 *model.Attachment, r1 io.ReadCloser, err error) *ChatServiceMock {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &ChatServiceMockOpenAttachmentExpectation{mock: mmOpenAttachment.mock}
+	}
+	mmOpenAttachment.defaultExpectation.results = &ChatServiceMockOpenAttachmentResults{ap1, r1, err}
+	mmOpenAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment.mock
+}
+
+// Set uses given function f to mock the ChatService.OpenAttachment method
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Set(f func(ctx context.Context, username string, id int64, thumbnailSize int) (ap1 *model.Attachment, r1 io.ReadCloser, err error)) *ChatServiceMock {
+	if mmOpenAttachment.defaultExpectation != nil {
+		mmOpenAttachment.mock.t.Fatalf("Default expectation is already set for the ChatService.OpenAttachment method")
+	}
+
+	if len(mmOpenAttachment.expectations) > 0 {
+		mmOpenAttachment.mock.t.Fatalf("Some expectations are already set for the ChatService.OpenAttachment method")
+	}
+
+	mmOpenAttachment.mock.funcOpenAttachment = f
+	mmOpenAttachment.mock.funcOpenAttachmentOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment.mock
+}
+
+// When sets expectation for the ChatService.OpenAttachment which will trigger the result defined by the following
+// Then helper
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) When(ctx context.Context, username string, id int64, thumbnailSize int) *ChatServiceMockOpenAttachmentExpectation {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("ChatServiceMock.OpenAttachment mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockOpenAttachmentExpectation{
+		mock:               mmOpenAttachment.mock,
+		params:             &ChatServiceMockOpenAttachmentParams{ctx, username, id, thumbnailSize},
+		expectationOrigins: ChatServiceMockOpenAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenAttachment.expectations = append(mmOpenAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.OpenAttachment return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockOpenAttachmentExpectation) Then(ap1 *model.Attachment, r1 io.ReadCloser, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockOpenAttachmentResults{ap1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.OpenAttachment should be invoked
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Times(n uint64) *mChatServiceMockOpenAttachment {
+	if n == 0 {
+		mmOpenAttachment.mock.t.Fatalf("Times of ChatServiceMock.OpenAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenAttachment.expectedInvocations, n)
+	mmOpenAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment
+}
+
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) invocationsDone() bool {
+	if len(mmOpenAttachment.expectations) == 0 && mmOpenAttachment.defaultExpectation == nil && mmOpenAttachment.mock.funcOpenAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.mock.afterOpenAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenAttachment implements mm_service.ChatService
+func (mmOpenAttachment *ChatServiceMock) OpenAttachment(ctx context.Context, username string, id int64, thumbnailSize int) (ap1 *model.Attachment, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpenAttachment.beforeOpenAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenAttachment.afterOpenAttachmentCounter, 1)
+
+	mmOpenAttachment.t.Helper()
+
+	if mmOpenAttachment.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.inspectFuncOpenAttachment(ctx, username, id, thumbnailSize)
+	}
+
+	mm_params := ChatServiceMockOpenAttachmentParams{ctx, username, id, thumbnailSize}
+
+	// Record call args
+	mmOpenAttachment.OpenAttachmentMock.mutex.Lock()
+	mmOpenAttachment.OpenAttachmentMock.callArgs = append(mmOpenAttachment.OpenAttachmentMock.callArgs, &mm_params)
+	mmOpenAttachment.OpenAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmOpenAttachment.OpenAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ap1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpenAttachment.OpenAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenAttachment.OpenAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockOpenAttachmentParams{ctx, username, id, thumbnailSize}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.thumbnailSize != nil && !minimock.Equal(*mm_want_ptrs.thumbnailSize, mm_got.thumbnailSize) {
+				mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameter thumbnailSize, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originThumbnailSize, *mm_want_ptrs.thumbnailSize, mm_got.thumbnailSize, minimock.Diff(*mm_want_ptrs.thumbnailSize, mm_got.thumbnailSize))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenAttachment.t.Errorf("ChatServiceMock.OpenAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenAttachment.t.Fatal("No results are set for the ChatServiceMock.OpenAttachment")
+		}
+		return (*mm_results).ap1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpenAttachment.funcOpenAttachment != nil {
+		return mmOpenAttachment.funcOpenAttachment(ctx, username, id, thumbnailSize)
+	}
+	mmOpenAttachment.t.Fatalf("Unexpected call to ChatServiceMock.OpenAttachment. %v %v %v %v", ctx, username, id, thumbnailSize)
+	return
+}
+
+// OpenAttachmentAfterCounter returns a count of finished ChatServiceMock.OpenAttachment invocations
+func (mmOpenAttachment *ChatServiceMock) OpenAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.afterOpenAttachmentCounter)
+}
+
+// OpenAttachmentBeforeCounter returns a count of ChatServiceMock.OpenAttachment invocations
+func (mmOpenAttachment *ChatServiceMock) OpenAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.beforeOpenAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.OpenAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenAttachment *mChatServiceMockOpenAttachment) Calls() []*ChatServiceMockOpenAttachmentParams {
+	mmOpenAttachment.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockOpenAttachmentParams, len(mmOpenAttachment.callArgs))
+	copy(argCopy, mmOpenAttachment.callArgs)
+
+	mmOpenAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenAttachmentDone returns true if the count of the OpenAttachment invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockOpenAttachmentDone() bool {
+	if m.OpenAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenAttachmentMock.invocationsDone()
+}
+
+// MinimockOpenAttachmentInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockOpenAttachmentInspect() {
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenAttachmentCounter := mm_atomic.LoadUint64(&m.afterOpenAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenAttachmentMock.defaultExpectation != nil && afterOpenAttachmentCounter < 1 {
+		if m.OpenAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment at\n%s", m.OpenAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment at\n%s with params: %#v", m.OpenAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.OpenAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenAttachment != nil && afterOpenAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.OpenAttachment at\n%s", m.funcOpenAttachmentOrigin)
+	}
+
+	if !m.OpenAttachmentMock.invocationsDone() && afterOpenAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.OpenAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenAttachmentMock.expectedInvocations), m.OpenAttachmentMock.expectedInvocationsOrigin, afterOpenAttachmentCounter)
+	}
+}
+
+type mChatServiceMockPinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPinMessageExpectation
+	expectations       []*ChatServiceMockPinMessageExpectation
+
+	callArgs []*ChatServiceMockPinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockPinMessageExpectation specifies expectation struct of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockPinMessageParams
+	paramPtrs          *ChatServiceMockPinMessageParamPtrs
+	expectationOrigins ChatServiceMockPinMessageExpectationOrigins
+	results            *ChatServiceMockPinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockPinMessageParams contains parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParams struct {
+	ctx       context.Context
+	actor     string
+	messageID int64
+}
+
+// ChatServiceMockPinMessageParamPtrs contains pointers to parameters of the ChatService.PinMessage
+type ChatServiceMockPinMessageParamPtrs struct {
+	ctx       *context.Context
+	actor     *string
+	messageID *int64
+}
+
+// ChatServiceMockPinMessageResults contains results of the ChatService.PinMessage
+type ChatServiceMockPinMessageResults struct {
+	err error
+}
+
+// ChatServiceMockPinMessageOrigins contains origins of expectations of the ChatService.PinMessage
+type ChatServiceMockPinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originActor     string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPinMessage *mChatServiceMockPinMessage) Optional() *mChatServiceMockPinMessage {
+	mmPinMessage.optional = true
+	return mmPinMessage
+}
+
+// Expect sets up expected params for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Expect(ctx context.Context, actor string, messageID int64) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmPinMessage.defaultExpectation.params = &ChatServiceMockPinMessageParams{ctx, actor, messageID}
+	mmPinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPinMessage.expectations {
+		if minimock.Equal(e.params, mmPinMessage.defaultExpectation.params) {
+			mmPinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmPinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectActorParam2 sets up expected param actor for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectActorParam2(actor string) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmPinMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{}
+	}
+
+	if mmPinMessage.defaultExpectation.params != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Expect")
+	}
+
+	if mmPinMessage.defaultExpectation.paramPtrs == nil {
+		mmPinMessage.defaultExpectation.paramPtrs = &ChatServiceMockPinMessageParamPtrs{}
+	}
+	mmPinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmPinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmPinMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Inspect(f func(ctx context.Context, actor string, messageID int64)) *mChatServiceMockPinMessage {
+	if mmPinMessage.mock.inspectFuncPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PinMessage")
+	}
+
+	mmPinMessage.mock.inspectFuncPinMessage = f
+
+	return mmPinMessage
+}
+
+// Return sets up results that will be returned by ChatService.PinMessage
+func (mmPinMessage *mChatServiceMockPinMessage) Return(err error) *ChatServiceMock {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	if mmPinMessage.defaultExpectation == nil {
+		mmPinMessage.defaultExpectation = &ChatServiceMockPinMessageExpectation{mock: mmPinMessage.mock}
+	}
+	mmPinMessage.defaultExpectation.results = &ChatServiceMockPinMessageResults{err}
+	mmPinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.PinMessage method
+func (mmPinMessage *mChatServiceMockPinMessage) Set(f func(ctx context.Context, actor string, messageID int64) (err error)) *ChatServiceMock {
+	if mmPinMessage.defaultExpectation != nil {
+		mmPinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.PinMessage method")
+	}
+
+	if len(mmPinMessage.expectations) > 0 {
+		mmPinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.PinMessage method")
+	}
+
+	mmPinMessage.mock.funcPinMessage = f
+	mmPinMessage.mock.funcPinMessageOrigin = minimock.CallerInfo(1)
+	return mmPinMessage.mock
+}
+
+// When sets expectation for the ChatService.PinMessage which will trigger the result defined by the following
+// Then helper
+func (mmPinMessage *mChatServiceMockPinMessage) When(ctx context.Context, actor string, messageID int64) *ChatServiceMockPinMessageExpectation {
+	if mmPinMessage.mock.funcPinMessage != nil {
+		mmPinMessage.mock.t.Fatalf("ChatServiceMock.PinMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPinMessageExpectation{
+		mock:               mmPinMessage.mock,
+		params:             &ChatServiceMockPinMessageParams{ctx, actor, messageID},
+		expectationOrigins: ChatServiceMockPinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPinMessage.expectations = append(mmPinMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockPinMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockPinMessageResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.PinMessage should be invoked
+func (mmPinMessage *mChatServiceMockPinMessage) Times(n uint64) *mChatServiceMockPinMessage {
+	if n == 0 {
+		mmPinMessage.mock.t.Fatalf("Times of ChatServiceMock.PinMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPinMessage.expectedInvocations, n)
+	mmPinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPinMessage
+}
+
+func (mmPinMessage *mChatServiceMockPinMessage) invocationsDone() bool {
+	if len(mmPinMessage.expectations) == 0 && mmPinMessage.defaultExpectation == nil && mmPinMessage.mock.funcPinMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPinMessage.mock.afterPinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPinMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PinMessage implements mm_service.ChatService
+func (mmPinMessage *ChatServiceMock) PinMessage(ctx context.Context, actor string, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmPinMessage.beforePinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPinMessage.afterPinMessageCounter, 1)
+
+	mmPinMessage.t.Helper()
+
+	if mmPinMessage.inspectFuncPinMessage != nil {
+		mmPinMessage.inspectFuncPinMessage(ctx, actor, messageID)
+	}
+
+	mm_params := ChatServiceMockPinMessageParams{ctx, actor, messageID}
+
+	// Record call args
+	mmPinMessage.PinMessageMock.mutex.Lock()
+	mmPinMessage.PinMessageMock.callArgs = append(mmPinMessage.PinMessageMock.callArgs, &mm_params)
+	mmPinMessage.PinMessageMock.mutex.Unlock()
+
+	for _, e := range mmPinMessage.PinMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmPinMessage.PinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPinMessage.PinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPinMessage.PinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmPinMessage.PinMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPinMessageParams{ctx, actor, messageID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPinMessage.t.Errorf("ChatServiceMock.PinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPinMessage.PinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPinMessage.PinMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPinMessage.t.Fatal("No results are set for the ChatServiceMock.PinMessage")
+		}
+		return (*mm_results).err
+	}
+	if mmPinMessage.funcPinMessage != nil {
+		return mmPinMessage.funcPinMessage(ctx, actor, messageID)
+	}
+	mmPinMessage.t.Fatalf("Unexpected call to ChatServiceMock.PinMessage. %v %v %v", ctx, actor, messageID)
+	return
+}
+
+// PinMessageAfterCounter returns a count of finished ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.afterPinMessageCounter)
+}
+
+// PinMessageBeforeCounter returns a count of ChatServiceMock.PinMessage invocations
+func (mmPinMessage *ChatServiceMock) PinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPinMessage.beforePinMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PinMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPinMessage *mChatServiceMockPinMessage) Calls() []*ChatServiceMockPinMessageParams {
+	mmPinMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPinMessageParams, len(mmPinMessage.callArgs))
+	copy(argCopy, mmPinMessage.callArgs)
+
+	mmPinMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPinMessageDone returns true if the count of the PinMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPinMessageDone() bool {
+	if m.PinMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PinMessageMock.invocationsDone()
+}
+
+// MinimockPinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPinMessageInspect() {
+	for _, e := range m.PinMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPinMessageCounter := mm_atomic.LoadUint64(&m.afterPinMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PinMessageMock.defaultExpectation != nil && afterPinMessageCounter < 1 {
+		if m.PinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.PinMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s with params: %#v", m.PinMessageMock.defaultExpectation.expectationOrigins.origin, *m.PinMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPinMessage != nil && afterPinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.PinMessage at\n%s", m.funcPinMessageOrigin)
+	}
+
+	if !m.PinMessageMock.invocationsDone() && afterPinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PinMessageMock.expectedInvocations), m.PinMessageMock.expectedInvocationsOrigin, afterPinMessageCounter)
+	}
+}
+
+type mChatServiceMockPublishPresenceChanges struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockPublishPresenceChangesExpectation
+	expectations       []*ChatServiceMockPublishPresenceChangesExpectation
+
+	callArgs []*ChatServiceMockPublishPresenceChangesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockPublishPresenceChangesExpectation specifies expectation struct of the ChatService.PublishPresenceChanges
+type ChatServiceMockPublishPresenceChangesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockPublishPresenceChangesParams
+	paramPtrs          *ChatServiceMockPublishPresenceChangesParamPtrs
+	expectationOrigins ChatServiceMockPublishPresenceChangesExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// ChatServiceMockPublishPresenceChangesParams contains parameters of the ChatService.PublishPresenceChanges
+type ChatServiceMockPublishPresenceChangesParams struct {
+	ctx context.Context
+}
+
+// ChatServiceMockPublishPresenceChangesParamPtrs contains pointers to parameters of the ChatService.PublishPresenceChanges
+type ChatServiceMockPublishPresenceChangesParamPtrs struct {
+	ctx *context.Context
+}
+
+// ChatServiceMockPublishPresenceChangesOrigins contains origins of expectations of the ChatService.PublishPresenceChanges
+type ChatServiceMockPublishPresenceChangesExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Optional() *mChatServiceMockPublishPresenceChanges {
+	mmPublishPresenceChanges.optional = true
+	return mmPublishPresenceChanges
+}
+
+// Expect sets up expected params for ChatService.PublishPresenceChanges
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Expect(ctx context.Context) *mChatServiceMockPublishPresenceChanges {
+	if mmPublishPresenceChanges.mock.funcPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by Set")
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation == nil {
+		mmPublishPresenceChanges.defaultExpectation = &ChatServiceMockPublishPresenceChangesExpectation{}
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation.paramPtrs != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by ExpectParams functions")
+	}
+
+	mmPublishPresenceChanges.defaultExpectation.params = &ChatServiceMockPublishPresenceChangesParams{ctx}
+	mmPublishPresenceChanges.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPublishPresenceChanges.expectations {
+		if minimock.Equal(e.params, mmPublishPresenceChanges.defaultExpectation.params) {
+			mmPublishPresenceChanges.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPublishPresenceChanges.defaultExpectation.params)
+		}
+	}
+
+	return mmPublishPresenceChanges
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.PublishPresenceChanges
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) ExpectCtxParam1(ctx context.Context) *mChatServiceMockPublishPresenceChanges {
+	if mmPublishPresenceChanges.mock.funcPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by Set")
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation == nil {
+		mmPublishPresenceChanges.defaultExpectation = &ChatServiceMockPublishPresenceChangesExpectation{}
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation.params != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by Expect")
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation.paramPtrs == nil {
+		mmPublishPresenceChanges.defaultExpectation.paramPtrs = &ChatServiceMockPublishPresenceChangesParamPtrs{}
+	}
+	mmPublishPresenceChanges.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPublishPresenceChanges.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPublishPresenceChanges
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.PublishPresenceChanges
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Inspect(f func(ctx context.Context)) *mChatServiceMockPublishPresenceChanges {
+	if mmPublishPresenceChanges.mock.inspectFuncPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.PublishPresenceChanges")
+	}
+
+	mmPublishPresenceChanges.mock.inspectFuncPublishPresenceChanges = f
+
+	return mmPublishPresenceChanges
+}
+
+// Return sets up results that will be returned by ChatService.PublishPresenceChanges
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Return() *ChatServiceMock {
+	if mmPublishPresenceChanges.mock.funcPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by Set")
+	}
+
+	if mmPublishPresenceChanges.defaultExpectation == nil {
+		mmPublishPresenceChanges.defaultExpectation = &ChatServiceMockPublishPresenceChangesExpectation{mock: mmPublishPresenceChanges.mock}
+	}
+
+	mmPublishPresenceChanges.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPublishPresenceChanges.mock
+}
+
+// Set uses given function f to mock the ChatService.PublishPresenceChanges method
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Set(f func(ctx context.Context)) *ChatServiceMock {
+	if mmPublishPresenceChanges.defaultExpectation != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("Default expectation is already set for the ChatService.PublishPresenceChanges method")
+	}
+
+	if len(mmPublishPresenceChanges.expectations) > 0 {
+		mmPublishPresenceChanges.mock.t.Fatalf("Some expectations are already set for the ChatService.PublishPresenceChanges method")
+	}
+
+	mmPublishPresenceChanges.mock.funcPublishPresenceChanges = f
+	mmPublishPresenceChanges.mock.funcPublishPresenceChangesOrigin = minimock.CallerInfo(1)
+	return mmPublishPresenceChanges.mock
+}
+
+// When sets expectation for the ChatService.PublishPresenceChanges which will trigger the result defined by the following
+// Then helper
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) When(ctx context.Context) *ChatServiceMockPublishPresenceChangesExpectation {
+	if mmPublishPresenceChanges.mock.funcPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.mock.t.Fatalf("ChatServiceMock.PublishPresenceChanges mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockPublishPresenceChangesExpectation{
+		mock:               mmPublishPresenceChanges.mock,
+		params:             &ChatServiceMockPublishPresenceChangesParams{ctx},
+		expectationOrigins: ChatServiceMockPublishPresenceChangesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPublishPresenceChanges.expectations = append(mmPublishPresenceChanges.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.PublishPresenceChanges return parameters for the expectation previously defined by the When method
+
+func (e *ChatServiceMockPublishPresenceChangesExpectation) Then() *ChatServiceMock {
+	return e.mock
+}
+
+// Times sets number of times ChatService.PublishPresenceChanges should be invoked
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Times(n uint64) *mChatServiceMockPublishPresenceChanges {
+	if n == 0 {
+		mmPublishPresenceChanges.mock.t.Fatalf("Times of ChatServiceMock.PublishPresenceChanges mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPublishPresenceChanges.expectedInvocations, n)
+	mmPublishPresenceChanges.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPublishPresenceChanges
+}
+
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) invocationsDone() bool {
+	if len(mmPublishPresenceChanges.expectations) == 0 && mmPublishPresenceChanges.defaultExpectation == nil && mmPublishPresenceChanges.mock.funcPublishPresenceChanges == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPublishPresenceChanges.mock.afterPublishPresenceChangesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPublishPresenceChanges.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PublishPresenceChanges implements mm_service.ChatService
+func (mmPublishPresenceChanges *ChatServiceMock) PublishPresenceChanges(ctx context.Context) {
+	mm_atomic.AddUint64(&mmPublishPresenceChanges.beforePublishPresenceChangesCounter, 1)
+	defer mm_atomic.AddUint64(&mmPublishPresenceChanges.afterPublishPresenceChangesCounter, 1)
+
+	mmPublishPresenceChanges.t.Helper()
+
+	if mmPublishPresenceChanges.inspectFuncPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.inspectFuncPublishPresenceChanges(ctx)
+	}
+
+	mm_params := ChatServiceMockPublishPresenceChangesParams{ctx}
+
+	// Record call args
+	mmPublishPresenceChanges.PublishPresenceChangesMock.mutex.Lock()
+	mmPublishPresenceChanges.PublishPresenceChangesMock.callArgs = append(mmPublishPresenceChanges.PublishPresenceChangesMock.callArgs, &mm_params)
+	mmPublishPresenceChanges.PublishPresenceChangesMock.mutex.Unlock()
+
+	for _, e := range mmPublishPresenceChanges.PublishPresenceChangesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation.Counter, 1)
+		mm_want := mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation.params
+		mm_want_ptrs := mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockPublishPresenceChangesParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPublishPresenceChanges.t.Errorf("ChatServiceMock.PublishPresenceChanges got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPublishPresenceChanges.t.Errorf("ChatServiceMock.PublishPresenceChanges got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPublishPresenceChanges.PublishPresenceChangesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmPublishPresenceChanges.funcPublishPresenceChanges != nil {
+		mmPublishPresenceChanges.funcPublishPresenceChanges(ctx)
+		return
+	}
+	mmPublishPresenceChanges.t.Fatalf("Unexpected call to ChatServiceMock.PublishPresenceChanges. %v", ctx)
+
+}
+
+// PublishPresenceChangesAfterCounter returns a count of finished ChatServiceMock.PublishPresenceChanges invocations
+func (mmPublishPresenceChanges *ChatServiceMock) PublishPresenceChangesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPresenceChanges.afterPublishPresenceChangesCounter)
+}
+
+// PublishPresenceChangesBeforeCounter returns a count of ChatServiceMock.PublishPresenceChanges invocations
+func (mmPublishPresenceChanges *ChatServiceMock) PublishPresenceChangesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPublishPresenceChanges.beforePublishPresenceChangesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.PublishPresenceChanges.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPublishPresenceChanges *mChatServiceMockPublishPresenceChanges) Calls() []*ChatServiceMockPublishPresenceChangesParams {
+	mmPublishPresenceChanges.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockPublishPresenceChangesParams, len(mmPublishPresenceChanges.callArgs))
+	copy(argCopy, mmPublishPresenceChanges.callArgs)
+
+	mmPublishPresenceChanges.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPublishPresenceChangesDone returns true if the count of the PublishPresenceChanges invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockPublishPresenceChangesDone() bool {
+	if m.PublishPresenceChangesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PublishPresenceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PublishPresenceChangesMock.invocationsDone()
+}
+
+// MinimockPublishPresenceChangesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockPublishPresenceChangesInspect() {
+	for _, e := range m.PublishPresenceChangesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishPresenceChanges at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPublishPresenceChangesCounter := mm_atomic.LoadUint64(&m.afterPublishPresenceChangesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PublishPresenceChangesMock.defaultExpectation != nil && afterPublishPresenceChangesCounter < 1 {
+		if m.PublishPresenceChangesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishPresenceChanges at\n%s", m.PublishPresenceChangesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.PublishPresenceChanges at\n%s with params: %#v", m.PublishPresenceChangesMock.defaultExpectation.expectationOrigins.origin, *m.PublishPresenceChangesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPublishPresenceChanges != nil && afterPublishPresenceChangesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.PublishPresenceChanges at\n%s", m.funcPublishPresenceChangesOrigin)
+	}
+
+	if !m.PublishPresenceChangesMock.invocationsDone() && afterPublishPresenceChangesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.PublishPresenceChanges at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PublishPresenceChangesMock.expectedInvocations), m.PublishPresenceChangesMock.expectedInvocationsOrigin, afterPublishPresenceChangesCounter)
+	}
+}
+
+type mChatServiceMockRemoveMember struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveMemberExpectation
+	expectations       []*ChatServiceMockRemoveMemberExpectation
+
+	callArgs []*ChatServiceMockRemoveMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveMemberExpectation specifies expectation struct of the ChatService.RemoveMember
+type ChatServiceMockRemoveMemberExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveMemberParams
+	paramPtrs          *ChatServiceMockRemoveMemberParamPtrs
+	expectationOrigins ChatServiceMockRemoveMemberExpectationOrigins
+	results            *ChatServiceMockRemoveMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveMemberParams contains parameters of the ChatService.RemoveMember
+type ChatServiceMockRemoveMemberParams struct {
+	ctx      context.Context
+	chatID   int64
+	actor    string
+	username string
+}
+
+// ChatServiceMockRemoveMemberParamPtrs contains pointers to parameters of the ChatService.RemoveMember
+type ChatServiceMockRemoveMemberParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	actor    *string
+	username *string
+}
+
+// ChatServiceMockRemoveMemberResults contains results of the ChatService.RemoveMember
+type ChatServiceMockRemoveMemberResults struct {
+	err error
+}
+
+// ChatServiceMockRemoveMemberOrigins contains origins of expectations of the ChatService.RemoveMember
+type ChatServiceMockRemoveMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originActor    string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMember *mChatServiceMockRemoveMember) Optional() *mChatServiceMockRemoveMember {
+	mmRemoveMember.optional = true
+	return mmRemoveMember
+}
+
+// Expect sets up expected params for ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) Expect(ctx context.Context, chatID int64, actor string, username string) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &ChatServiceMockRemoveMemberParams{ctx, chatID, actor, username}
+	mmRemoveMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) ExpectChatIDParam2(chatID int64) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.chatID = &chatID
+	mmRemoveMember.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) ExpectActorParam3(actor string) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.actor = &actor
+	mmRemoveMember.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectUsernameParam4 sets up expected param username for ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) ExpectUsernameParam4(username string) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &ChatServiceMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.username = &username
+	mmRemoveMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) Inspect(f func(ctx context.Context, chatID int64, actor string, username string)) *mChatServiceMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by ChatService.RemoveMember
+func (mmRemoveMember *mChatServiceMockRemoveMember) Return(err error) *ChatServiceMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &ChatServiceMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &ChatServiceMockRemoveMemberResults{err}
+	mmRemoveMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveMember method
+func (mmRemoveMember *mChatServiceMockRemoveMember) Set(f func(ctx context.Context, chatID int64, actor string, username string) (err error)) *ChatServiceMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	mmRemoveMember.mock.funcRemoveMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the ChatService.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mChatServiceMockRemoveMember) When(ctx context.Context, chatID int64, actor string, username string) *ChatServiceMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("ChatServiceMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveMemberExpectation{
+		mock:               mmRemoveMember.mock,
+		params:             &ChatServiceMockRemoveMemberParams{ctx, chatID, actor, username},
+		expectationOrigins: ChatServiceMockRemoveMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveMemberExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveMember should be invoked
+func (mmRemoveMember *mChatServiceMockRemoveMember) Times(n uint64) *mChatServiceMockRemoveMember {
+	if n == 0 {
+		mmRemoveMember.mock.t.Fatalf("Times of ChatServiceMock.RemoveMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMember.expectedInvocations, n)
+	mmRemoveMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember
+}
+
+func (mmRemoveMember *mChatServiceMockRemoveMember) invocationsDone() bool {
+	if len(mmRemoveMember.expectations) == 0 && mmRemoveMember.defaultExpectation == nil && mmRemoveMember.mock.funcRemoveMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMember.mock.afterRemoveMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMember implements mm_service.ChatService
+func (mmRemoveMember *ChatServiceMock) RemoveMember(ctx context.Context, chatID int64, actor string, username string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	mmRemoveMember.t.Helper()
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, chatID, actor, username)
+	}
+
+	mm_params := ChatServiceMockRemoveMemberParams{ctx, chatID, actor, username}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveMemberParams{ctx, chatID, actor, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("ChatServiceMock.RemoveMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmRemoveMember.t.Errorf("ChatServiceMock.RemoveMember got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmRemoveMember.t.Errorf("ChatServiceMock.RemoveMember got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveMember.t.Errorf("ChatServiceMock.RemoveMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("ChatServiceMock.RemoveMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the ChatServiceMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, chatID, actor, username)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to ChatServiceMock.RemoveMember. %v %v %v %v", ctx, chatID, actor, username)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished ChatServiceMock.RemoveMember invocations
+func (mmRemoveMember *ChatServiceMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of ChatServiceMock.RemoveMember invocations
+func (mmRemoveMember *ChatServiceMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mChatServiceMockRemoveMember) Calls() []*ChatServiceMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveMemberDone() bool {
+	if m.RemoveMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMemberMock.invocationsDone()
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && afterRemoveMemberCounter < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMember at\n%s", m.RemoveMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveMember at\n%s with params: %#v", m.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && afterRemoveMemberCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveMember at\n%s", m.funcRemoveMemberOrigin)
+	}
+
+	if !m.RemoveMemberMock.invocationsDone() && afterRemoveMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMemberMock.expectedInvocations), m.RemoveMemberMock.expectedInvocationsOrigin, afterRemoveMemberCounter)
+	}
+}
+
+type mChatServiceMockRemoveReaction struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockRemoveReactionExpectation
+	expectations       []*ChatServiceMockRemoveReactionExpectation
+
+	callArgs []*ChatServiceMockRemoveReactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockRemoveReactionExpectation specifies expectation struct of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockRemoveReactionParams
+	paramPtrs          *ChatServiceMockRemoveReactionParamPtrs
+	expectationOrigins ChatServiceMockRemoveReactionExpectationOrigins
+	results            *ChatServiceMockRemoveReactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockRemoveReactionParams contains parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParams struct {
+	ctx       context.Context
+	username  string
+	messageID int64
+	emoji     string
+}
+
+// ChatServiceMockRemoveReactionParamPtrs contains pointers to parameters of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	messageID *int64
+	emoji     *string
+}
+
+// ChatServiceMockRemoveReactionResults contains results of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionResults struct {
+	err error
+}
+
+// ChatServiceMockRemoveReactionOrigins contains origins of expectations of the ChatService.RemoveReaction
+type ChatServiceMockRemoveReactionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originMessageID string
+	originEmoji     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Optional() *mChatServiceMockRemoveReaction {
+	mmRemoveReaction.optional = true
+	return mmRemoveReaction
+}
+
+// Expect sets up expected params for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Expect(ctx context.Context, username string, messageID int64, emoji string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveReaction.defaultExpectation.params = &ChatServiceMockRemoveReactionParams{ctx, username, messageID, emoji}
+	mmRemoveReaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveReaction.expectations {
+		if minimock.Equal(e.params, mmRemoveReaction.defaultExpectation.params) {
+			mmRemoveReaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveReaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveReaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectCtxParam1(ctx context.Context) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectUsernameParam2(username string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.username = &username
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectMessageIDParam3(messageID int64) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.messageID = &messageID
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// ExpectEmojiParam4 sets up expected param emoji for ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) ExpectEmojiParam4(emoji string) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{}
+	}
+
+	if mmRemoveReaction.defaultExpectation.params != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Expect")
+	}
+
+	if mmRemoveReaction.defaultExpectation.paramPtrs == nil {
+		mmRemoveReaction.defaultExpectation.paramPtrs = &ChatServiceMockRemoveReactionParamPtrs{}
+	}
+	mmRemoveReaction.defaultExpectation.paramPtrs.emoji = &emoji
+	mmRemoveReaction.defaultExpectation.expectationOrigins.originEmoji = minimock.CallerInfo(1)
+
+	return mmRemoveReaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Inspect(f func(ctx context.Context, username string, messageID int64, emoji string)) *mChatServiceMockRemoveReaction {
+	if mmRemoveReaction.mock.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.RemoveReaction")
+	}
+
+	mmRemoveReaction.mock.inspectFuncRemoveReaction = f
+
+	return mmRemoveReaction
+}
+
+// Return sets up results that will be returned by ChatService.RemoveReaction
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Return(err error) *ChatServiceMock {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	if mmRemoveReaction.defaultExpectation == nil {
+		mmRemoveReaction.defaultExpectation = &ChatServiceMockRemoveReactionExpectation{mock: mmRemoveReaction.mock}
+	}
+	mmRemoveReaction.defaultExpectation.results = &ChatServiceMockRemoveReactionResults{err}
+	mmRemoveReaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// Set uses given function f to mock the ChatService.RemoveReaction method
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Set(f func(ctx context.Context, username string, messageID int64, emoji string) (err error)) *ChatServiceMock {
+	if mmRemoveReaction.defaultExpectation != nil {
+		mmRemoveReaction.mock.t.Fatalf("Default expectation is already set for the ChatService.RemoveReaction method")
+	}
+
+	if len(mmRemoveReaction.expectations) > 0 {
+		mmRemoveReaction.mock.t.Fatalf("Some expectations are already set for the ChatService.RemoveReaction method")
+	}
+
+	mmRemoveReaction.mock.funcRemoveReaction = f
+	mmRemoveReaction.mock.funcRemoveReactionOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction.mock
+}
+
+// When sets expectation for the ChatService.RemoveReaction which will trigger the result defined by the following
+// Then helper
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) When(ctx context.Context, username string, messageID int64, emoji string) *ChatServiceMockRemoveReactionExpectation {
+	if mmRemoveReaction.mock.funcRemoveReaction != nil {
+		mmRemoveReaction.mock.t.Fatalf("ChatServiceMock.RemoveReaction mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockRemoveReactionExpectation{
+		mock:               mmRemoveReaction.mock,
+		params:             &ChatServiceMockRemoveReactionParams{ctx, username, messageID, emoji},
+		expectationOrigins: ChatServiceMockRemoveReactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveReaction.expectations = append(mmRemoveReaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.RemoveReaction return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockRemoveReactionExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockRemoveReactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.RemoveReaction should be invoked
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Times(n uint64) *mChatServiceMockRemoveReaction {
+	if n == 0 {
+		mmRemoveReaction.mock.t.Fatalf("Times of ChatServiceMock.RemoveReaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveReaction.expectedInvocations, n)
+	mmRemoveReaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveReaction
+}
+
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) invocationsDone() bool {
+	if len(mmRemoveReaction.expectations) == 0 && mmRemoveReaction.defaultExpectation == nil && mmRemoveReaction.mock.funcRemoveReaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.mock.afterRemoveReactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveReaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveReaction implements mm_service.ChatService
+func (mmRemoveReaction *ChatServiceMock) RemoveReaction(ctx context.Context, username string, messageID int64, emoji string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveReaction.beforeRemoveReactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveReaction.afterRemoveReactionCounter, 1)
+
+	mmRemoveReaction.t.Helper()
+
+	if mmRemoveReaction.inspectFuncRemoveReaction != nil {
+		mmRemoveReaction.inspectFuncRemoveReaction(ctx, username, messageID, emoji)
+	}
+
+	mm_params := ChatServiceMockRemoveReactionParams{ctx, username, messageID, emoji}
+
+	// Record call args
+	mmRemoveReaction.RemoveReactionMock.mutex.Lock()
+	mmRemoveReaction.RemoveReactionMock.callArgs = append(mmRemoveReaction.RemoveReactionMock.callArgs, &mm_params)
+	mmRemoveReaction.RemoveReactionMock.mutex.Unlock()
+
+	for _, e := range mmRemoveReaction.RemoveReactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveReaction.RemoveReactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveReaction.RemoveReactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveReaction.RemoveReactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveReaction.RemoveReactionMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockRemoveReactionParams{ctx, username, messageID, emoji}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
+			}
+
+			if mm_want_ptrs.emoji != nil && !minimock.Equal(*mm_want_ptrs.emoji, mm_got.emoji) {
+				mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameter emoji, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.originEmoji, *mm_want_ptrs.emoji, mm_got.emoji, minimock.Diff(*mm_want_ptrs.emoji, mm_got.emoji))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveReaction.t.Errorf("ChatServiceMock.RemoveReaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveReaction.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveReaction.RemoveReactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveReaction.t.Fatal("No results are set for the ChatServiceMock.RemoveReaction")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveReaction.funcRemoveReaction != nil {
+		return mmRemoveReaction.funcRemoveReaction(ctx, username, messageID, emoji)
+	}
+	mmRemoveReaction.t.Fatalf("Unexpected call to ChatServiceMock.RemoveReaction. %v %v %v %v", ctx, username, messageID, emoji)
+	return
+}
+
+// RemoveReactionAfterCounter returns a count of finished ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.afterRemoveReactionCounter)
+}
+
+// RemoveReactionBeforeCounter returns a count of ChatServiceMock.RemoveReaction invocations
+func (mmRemoveReaction *ChatServiceMock) RemoveReactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveReaction.beforeRemoveReactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.RemoveReaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveReaction *mChatServiceMockRemoveReaction) Calls() []*ChatServiceMockRemoveReactionParams {
+	mmRemoveReaction.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockRemoveReactionParams, len(mmRemoveReaction.callArgs))
+	copy(argCopy, mmRemoveReaction.callArgs)
+
+	mmRemoveReaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveReactionDone returns true if the count of the RemoveReaction invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockRemoveReactionDone() bool {
+	if m.RemoveReactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveReactionMock.invocationsDone()
+}
+
+// MinimockRemoveReactionInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockRemoveReactionInspect() {
+	for _, e := range m.RemoveReactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveReactionCounter := mm_atomic.LoadUint64(&m.afterRemoveReactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveReactionMock.defaultExpectation != nil && afterRemoveReactionCounter < 1 {
+		if m.RemoveReactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.RemoveReactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s with params: %#v", m.RemoveReactionMock.defaultExpectation.expectationOrigins.origin, *m.RemoveReactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveReaction != nil && afterRemoveReactionCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.RemoveReaction at\n%s", m.funcRemoveReactionOrigin)
+	}
+
+	if !m.RemoveReactionMock.invocationsDone() && afterRemoveReactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.RemoveReaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveReactionMock.expectedInvocations), m.RemoveReactionMock.expectedInvocationsOrigin, afterRemoveReactionCounter)
+	}
+}
+
+type mChatServiceMockScheduleMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockScheduleMessageExpectation
+	expectations       []*ChatServiceMockScheduleMessageExpectation
+
+	callArgs []*ChatServiceMockScheduleMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockScheduleMessageExpectation specifies expectation struct of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockScheduleMessageParams
+	paramPtrs          *ChatServiceMockScheduleMessageParamPtrs
+	expectationOrigins ChatServiceMockScheduleMessageExpectationOrigins
+	results            *ChatServiceMockScheduleMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockScheduleMessageParams contains parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParams struct {
+	ctx context.Context
+	msg *model.ScheduledMessage
+}
+
+// ChatServiceMockScheduleMessageParamPtrs contains pointers to parameters of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageParamPtrs struct {
+	ctx *context.Context
+	msg **model.ScheduledMessage
+}
+
+// ChatServiceMockScheduleMessageResults contains results of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageResults struct {
+	sp1 *model.ScheduledMessage
+	err error
+}
+
+// ChatServiceMockScheduleMessageOrigins contains origins of expectations of the ChatService.ScheduleMessage
+type ChatServiceMockScheduleMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Optional() *mChatServiceMockScheduleMessage {
+	mmScheduleMessage.optional = true
+	return mmScheduleMessage
+}
+
+// Expect sets up expected params for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Expect(ctx context.Context, msg *model.ScheduledMessage) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by ExpectParams functions")
+	}
+
+	mmScheduleMessage.defaultExpectation.params = &ChatServiceMockScheduleMessageParams{ctx, msg}
+	mmScheduleMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmScheduleMessage.expectations {
+		if minimock.Equal(e.params, mmScheduleMessage.defaultExpectation.params) {
+			mmScheduleMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScheduleMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmScheduleMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmScheduleMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmScheduleMessage
+}
+
+// ExpectMsgParam2 sets up expected param msg for ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) ExpectMsgParam2(msg *model.ScheduledMessage) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{}
+	}
+
+	if mmScheduleMessage.defaultExpectation.params != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Expect")
+	}
+
+	if mmScheduleMessage.defaultExpectation.paramPtrs == nil {
+		mmScheduleMessage.defaultExpectation.paramPtrs = &ChatServiceMockScheduleMessageParamPtrs{}
+	}
+	mmScheduleMessage.defaultExpectation.paramPtrs.msg = &msg
+	mmScheduleMessage.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmScheduleMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Inspect(f func(ctx context.Context, msg *model.ScheduledMessage)) *mChatServiceMockScheduleMessage {
+	if mmScheduleMessage.mock.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.ScheduleMessage")
+	}
+
+	mmScheduleMessage.mock.inspectFuncScheduleMessage = f
+
+	return mmScheduleMessage
+}
+
+// Return sets up results that will be returned by ChatService.ScheduleMessage
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Return(sp1 *model.ScheduledMessage, err error) *ChatServiceMock {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	if mmScheduleMessage.defaultExpectation == nil {
+		mmScheduleMessage.defaultExpectation = &ChatServiceMockScheduleMessageExpectation{mock: mmScheduleMessage.mock}
+	}
+	mmScheduleMessage.defaultExpectation.results = &ChatServiceMockScheduleMessageResults{sp1, err}
+	mmScheduleMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.ScheduleMessage method
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Set(f func(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error)) *ChatServiceMock {
+	if mmScheduleMessage.defaultExpectation != nil {
+		mmScheduleMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.ScheduleMessage method")
+	}
+
+	if len(mmScheduleMessage.expectations) > 0 {
+		mmScheduleMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.ScheduleMessage method")
+	}
+
+	mmScheduleMessage.mock.funcScheduleMessage = f
+	mmScheduleMessage.mock.funcScheduleMessageOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage.mock
+}
+
+// When sets expectation for the ChatService.ScheduleMessage which will trigger the result defined by the following
+// Then helper
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) When(ctx context.Context, msg *model.ScheduledMessage) *ChatServiceMockScheduleMessageExpectation {
+	if mmScheduleMessage.mock.funcScheduleMessage != nil {
+		mmScheduleMessage.mock.t.Fatalf("ChatServiceMock.ScheduleMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockScheduleMessageExpectation{
+		mock:               mmScheduleMessage.mock,
+		params:             &ChatServiceMockScheduleMessageParams{ctx, msg},
+		expectationOrigins: ChatServiceMockScheduleMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmScheduleMessage.expectations = append(mmScheduleMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.ScheduleMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockScheduleMessageExpectation) Then(sp1 *model.ScheduledMessage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockScheduleMessageResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.ScheduleMessage should be invoked
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Times(n uint64) *mChatServiceMockScheduleMessage {
+	if n == 0 {
+		mmScheduleMessage.mock.t.Fatalf("Times of ChatServiceMock.ScheduleMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScheduleMessage.expectedInvocations, n)
+	mmScheduleMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmScheduleMessage
+}
+
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) invocationsDone() bool {
+	if len(mmScheduleMessage.expectations) == 0 && mmScheduleMessage.defaultExpectation == nil && mmScheduleMessage.mock.funcScheduleMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.mock.afterScheduleMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScheduleMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScheduleMessage implements mm_service.ChatService
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessage(ctx context.Context, msg *model.ScheduledMessage) (sp1 *model.ScheduledMessage, err error) {
+	mm_atomic.AddUint64(&mmScheduleMessage.beforeScheduleMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmScheduleMessage.afterScheduleMessageCounter, 1)
+
+	mmScheduleMessage.t.Helper()
+
+	if mmScheduleMessage.inspectFuncScheduleMessage != nil {
+		mmScheduleMessage.inspectFuncScheduleMessage(ctx, msg)
+	}
+
+	mm_params := ChatServiceMockScheduleMessageParams{ctx, msg}
+
+	// Record call args
+	mmScheduleMessage.ScheduleMessageMock.mutex.Lock()
+	mmScheduleMessage.ScheduleMessageMock.callArgs = append(mmScheduleMessage.ScheduleMessageMock.callArgs, &mm_params)
+	mmScheduleMessage.ScheduleMessageMock.mutex.Unlock()
+
+	for _, e := range mmScheduleMessage.ScheduleMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmScheduleMessage.ScheduleMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScheduleMessage.ScheduleMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockScheduleMessageParams{ctx, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScheduleMessage.t.Errorf("ChatServiceMock.ScheduleMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmScheduleMessage.ScheduleMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScheduleMessage.ScheduleMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScheduleMessage.t.Fatal("No results are set for the ChatServiceMock.ScheduleMessage")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmScheduleMessage.funcScheduleMessage != nil {
+		return mmScheduleMessage.funcScheduleMessage(ctx, msg)
+	}
+	mmScheduleMessage.t.Fatalf("Unexpected call to ChatServiceMock.ScheduleMessage. %v %v", ctx, msg)
+	return
+}
+
+// ScheduleMessageAfterCounter returns a count of finished ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.afterScheduleMessageCounter)
+}
+
+// ScheduleMessageBeforeCounter returns a count of ChatServiceMock.ScheduleMessage invocations
+func (mmScheduleMessage *ChatServiceMock) ScheduleMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScheduleMessage.beforeScheduleMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.ScheduleMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScheduleMessage *mChatServiceMockScheduleMessage) Calls() []*ChatServiceMockScheduleMessageParams {
+	mmScheduleMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockScheduleMessageParams, len(mmScheduleMessage.callArgs))
+	copy(argCopy, mmScheduleMessage.callArgs)
+
+	mmScheduleMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScheduleMessageDone returns true if the count of the ScheduleMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockScheduleMessageDone() bool {
+	if m.ScheduleMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScheduleMessageMock.invocationsDone()
+}
+
+// MinimockScheduleMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockScheduleMessageInspect() {
+	for _, e := range m.ScheduleMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterScheduleMessageCounter := mm_atomic.LoadUint64(&m.afterScheduleMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScheduleMessageMock.defaultExpectation != nil && afterScheduleMessageCounter < 1 {
+		if m.ScheduleMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s", m.ScheduleMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s with params: %#v", m.ScheduleMessageMock.defaultExpectation.expectationOrigins.origin, *m.ScheduleMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScheduleMessage != nil && afterScheduleMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.ScheduleMessage at\n%s", m.funcScheduleMessageOrigin)
+	}
+
+	if !m.ScheduleMessageMock.invocationsDone() && afterScheduleMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.ScheduleMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ScheduleMessageMock.expectedInvocations), m.ScheduleMessageMock.expectedInvocationsOrigin, afterScheduleMessageCounter)
+	}
+}
+
+type mChatServiceMockSearchMessages struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSearchMessagesExpectation
+	expectations       []*ChatServiceMockSearchMessagesExpectation
+
+	callArgs []*ChatServiceMockSearchMessagesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSearchMessagesExpectation specifies expectation struct of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSearchMessagesParams
+	paramPtrs          *ChatServiceMockSearchMessagesParamPtrs
+	expectationOrigins ChatServiceMockSearchMessagesExpectationOrigins
+	results            *ChatServiceMockSearchMessagesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSearchMessagesParams contains parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParams struct {
+	ctx   context.Context
+	query *model.MessageSearchQuery
+}
+
+// ChatServiceMockSearchMessagesParamPtrs contains pointers to parameters of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesParamPtrs struct {
+	ctx   *context.Context
+	query **model.MessageSearchQuery
+}
+
+// ChatServiceMockSearchMessagesResults contains results of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesResults struct {
+	sp1 *model.SearchPage
+	err error
+}
+
+// ChatServiceMockSearchMessagesOrigins contains origins of expectations of the ChatService.SearchMessages
+type ChatServiceMockSearchMessagesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originQuery string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchMessages *mChatServiceMockSearchMessages) Optional() *mChatServiceMockSearchMessages {
+	mmSearchMessages.optional = true
+	return mmSearchMessages
+}
+
+// Expect sets up expected params for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Expect(ctx context.Context, query *model.MessageSearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by ExpectParams functions")
+	}
+
+	mmSearchMessages.defaultExpectation.params = &ChatServiceMockSearchMessagesParams{ctx, query}
+	mmSearchMessages.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchMessages.expectations {
+		if minimock.Equal(e.params, mmSearchMessages.defaultExpectation.params) {
+			mmSearchMessages.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchMessages.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchMessages
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchMessages.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// ExpectQueryParam2 sets up expected param query for ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) ExpectQueryParam2(query *model.MessageSearchQuery) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{}
+	}
+
+	if mmSearchMessages.defaultExpectation.params != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Expect")
+	}
+
+	if mmSearchMessages.defaultExpectation.paramPtrs == nil {
+		mmSearchMessages.defaultExpectation.paramPtrs = &ChatServiceMockSearchMessagesParamPtrs{}
+	}
+	mmSearchMessages.defaultExpectation.paramPtrs.query = &query
+	mmSearchMessages.defaultExpectation.expectationOrigins.originQuery = minimock.CallerInfo(1)
+
+	return mmSearchMessages
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Inspect(f func(ctx context.Context, query *model.MessageSearchQuery)) *mChatServiceMockSearchMessages {
+	if mmSearchMessages.mock.inspectFuncSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SearchMessages")
+	}
+
+	mmSearchMessages.mock.inspectFuncSearchMessages = f
+
+	return mmSearchMessages
+}
+
+// Return sets up results that will be returned by ChatService.SearchMessages
+func (mmSearchMessages *mChatServiceMockSearchMessages) Return(sp1 *model.SearchPage, err error) *ChatServiceMock {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	if mmSearchMessages.defaultExpectation == nil {
+		mmSearchMessages.defaultExpectation = &ChatServiceMockSearchMessagesExpectation{mock: mmSearchMessages.mock}
+	}
+	mmSearchMessages.defaultExpectation.results = &ChatServiceMockSearchMessagesResults{sp1, err}
+	mmSearchMessages.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// Set uses given function f to mock the ChatService.SearchMessages method
+func (mmSearchMessages *mChatServiceMockSearchMessages) Set(f func(ctx context.Context, query *model.MessageSearchQuery) (sp1 *model.SearchPage, err error)) *ChatServiceMock {
+	if mmSearchMessages.defaultExpectation != nil {
+		mmSearchMessages.mock.t.Fatalf("Default expectation is already set for the ChatService.SearchMessages method")
+	}
+
+	if len(mmSearchMessages.expectations) > 0 {
+		mmSearchMessages.mock.t.Fatalf("Some expectations are already set for the ChatService.SearchMessages method")
+	}
+
+	mmSearchMessages.mock.funcSearchMessages = f
+	mmSearchMessages.mock.funcSearchMessagesOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages.mock
+}
+
+// When sets expectation for the ChatService.SearchMessages which will trigger the result defined by the following
+// Then helper
+func (mmSearchMessages *mChatServiceMockSearchMessages) When(ctx context.Context, query *model.MessageSearchQuery) *ChatServiceMockSearchMessagesExpectation {
+	if mmSearchMessages.mock.funcSearchMessages != nil {
+		mmSearchMessages.mock.t.Fatalf("ChatServiceMock.SearchMessages mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSearchMessagesExpectation{
+		mock:               mmSearchMessages.mock,
+		params:             &ChatServiceMockSearchMessagesParams{ctx, query},
+		expectationOrigins: ChatServiceMockSearchMessagesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearchMessages.expectations = append(mmSearchMessages.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SearchMessages return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSearchMessagesExpectation) Then(sp1 *model.SearchPage, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSearchMessagesResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SearchMessages should be invoked
+func (mmSearchMessages *mChatServiceMockSearchMessages) Times(n uint64) *mChatServiceMockSearchMessages {
+	if n == 0 {
+		mmSearchMessages.mock.t.Fatalf("Times of ChatServiceMock.SearchMessages mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearchMessages.expectedInvocations, n)
+	mmSearchMessages.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchMessages
+}
+
+func (mmSearchMessages *mChatServiceMockSearchMessages) invocationsDone() bool {
+	if len(mmSearchMessages.expectations) == 0 && mmSearchMessages.defaultExpectation == nil && mmSearchMessages.mock.funcSearchMessages == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchMessages.mock.afterSearchMessagesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchMessages.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SearchMessages implements mm_service.ChatService
+func (mmSearchMessages *ChatServiceMock) SearchMessages(ctx context.Context, query *model.MessageSearchQuery) (sp1 *model.SearchPage, err error) {
+	mm_atomic.AddUint64(&mmSearchMessages.beforeSearchMessagesCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchMessages.afterSearchMessagesCounter, 1)
+
+	mmSearchMessages.t.Helper()
+
+	if mmSearchMessages.inspectFuncSearchMessages != nil {
+		mmSearchMessages.inspectFuncSearchMessages(ctx, query)
+	}
+
+	mm_params := ChatServiceMockSearchMessagesParams{ctx, query}
+
+	// Record call args
+	mmSearchMessages.SearchMessagesMock.mutex.Lock()
+	mmSearchMessages.SearchMessagesMock.callArgs = append(mmSearchMessages.SearchMessagesMock.callArgs, &mm_params)
+	mmSearchMessages.SearchMessagesMock.mutex.Unlock()
+
+	for _, e := range mmSearchMessages.SearchMessagesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmSearchMessages.SearchMessagesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchMessages.SearchMessagesMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchMessages.SearchMessagesMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchMessages.SearchMessagesMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSearchMessagesParams{ctx, query}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.query != nil && !minimock.Equal(*mm_want_ptrs.query, mm_got.query) {
+				mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameter query, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.originQuery, *mm_want_ptrs.query, mm_got.query, minimock.Diff(*mm_want_ptrs.query, mm_got.query))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSearchMessages.t.Errorf("ChatServiceMock.SearchMessages got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchMessages.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSearchMessages.SearchMessagesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSearchMessages.t.Fatal("No results are set for the ChatServiceMock.SearchMessages")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmSearchMessages.funcSearchMessages != nil {
+		return mmSearchMessages.funcSearchMessages(ctx, query)
+	}
+	mmSearchMessages.t.Fatalf("Unexpected call to ChatServiceMock.SearchMessages. %v %v", ctx, query)
+	return
+}
+
+// SearchMessagesAfterCounter returns a count of finished ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.afterSearchMessagesCounter)
+}
+
+// SearchMessagesBeforeCounter returns a count of ChatServiceMock.SearchMessages invocations
+func (mmSearchMessages *ChatServiceMock) SearchMessagesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchMessages.beforeSearchMessagesCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SearchMessages.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSearchMessages *mChatServiceMockSearchMessages) Calls() []*ChatServiceMockSearchMessagesParams {
+	mmSearchMessages.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSearchMessagesParams, len(mmSearchMessages.callArgs))
+	copy(argCopy, mmSearchMessages.callArgs)
+
+	mmSearchMessages.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSearchMessagesDone returns true if the count of the SearchMessages invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSearchMessagesDone() bool {
+	if m.SearchMessagesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SearchMessagesMock.invocationsDone()
+}
+
+// MinimockSearchMessagesInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSearchMessagesInspect() {
+	for _, e := range m.SearchMessagesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSearchMessagesCounter := mm_atomic.LoadUint64(&m.afterSearchMessagesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SearchMessagesMock.defaultExpectation != nil && afterSearchMessagesCounter < 1 {
+		if m.SearchMessagesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s", m.SearchMessagesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s with params: %#v", m.SearchMessagesMock.defaultExpectation.expectationOrigins.origin, *m.SearchMessagesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSearchMessages != nil && afterSearchMessagesCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SearchMessages at\n%s", m.funcSearchMessagesOrigin)
+	}
+
+	if !m.SearchMessagesMock.invocationsDone() && afterSearchMessagesCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SearchMessages at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMessagesMock.expectedInvocations), m.SearchMessagesMock.expectedInvocationsOrigin, afterSearchMessagesCounter)
+	}
+}
+
+type mChatServiceMockSendMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendMessageExpectation
+	expectations       []*ChatServiceMockSendMessageExpectation
+
+	callArgs []*ChatServiceMockSendMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSendMessageParams
+	paramPtrs          *ChatServiceMockSendMessageParamPtrs
+	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
+	results            *ChatServiceMockSendMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParams struct {
+	ctx context.Context
+	msg *model.Message
+}
+
+// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
+type ChatServiceMockSendMessageParamPtrs struct {
+	ctx *context.Context
+	msg **model.Message
+}
+
+// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
+type ChatServiceMockSendMessageResults struct {
+	mp1 *model.Message
+	err error
+}
+
+// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
+type ChatServiceMockSendMessageExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originMsg string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
+	mmSendMessage.optional = true
+	return mmSendMessage
+}
+
+// Expect sets up expected params for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, msg *model.Message) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	}
+
+	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, msg}
+	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendMessage.expectations {
+		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
+			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmSendMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// ExpectMsgParam2 sets up expected param msg for ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) ExpectMsgParam2(msg *model.Message) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	}
+
+	if mmSendMessage.defaultExpectation.params != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	}
+
+	if mmSendMessage.defaultExpectation.paramPtrs == nil {
+		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	}
+	mmSendMessage.defaultExpectation.paramPtrs.msg = &msg
+	mmSendMessage.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+
+	return mmSendMessage
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, msg *model.Message)) *mChatServiceMockSendMessage {
+	if mmSendMessage.mock.inspectFuncSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+	}
+
+	mmSendMessage.mock.inspectFuncSendMessage = f
+
+	return mmSendMessage
+}
+
+// Return sets up results that will be returned by ChatService.SendMessage
+func (mmSendMessage *mChatServiceMockSendMessage) Return(mp1 *model.Message, err error) *ChatServiceMock {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	if mmSendMessage.defaultExpectation == nil {
+		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	}
+	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{mp1, err}
+	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// Set uses given function f to mock the ChatService.SendMessage method
+func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error)) *ChatServiceMock {
+	if mmSendMessage.defaultExpectation != nil {
+		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+	}
+
+	if len(mmSendMessage.expectations) > 0 {
+		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	}
+
+	mmSendMessage.mock.funcSendMessage = f
+	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
+	return mmSendMessage.mock
+}
+
+// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// Then helper
+func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, msg *model.Message) *ChatServiceMockSendMessageExpectation {
+	if mmSendMessage.mock.funcSendMessage != nil {
+		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendMessageExpectation{
+		mock:               mmSendMessage.mock,
+		params:             &ChatServiceMockSendMessageParams{ctx, msg},
+		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendMessageExpectation) Then(mp1 *model.Message, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendMessageResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendMessage should be invoked
+func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+	if n == 0 {
+		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
+	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendMessage
+}
+
+func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
+	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendMessage implements mm_service.ChatService
+func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, msg *model.Message) (mp1 *model.Message, err error) {
+	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+
+	mmSendMessage.t.Helper()
+
+	if mmSendMessage.inspectFuncSendMessage != nil {
+		mmSendMessage.inspectFuncSendMessage(ctx, msg)
+	}
+
+	mm_params := ChatServiceMockSendMessageParams{ctx, msg}
+
+	// Record call args
+	mmSendMessage.SendMessageMock.mutex.Lock()
+	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
+	mmSendMessage.SendMessageMock.mutex.Unlock()
+
+	for _, e := range mmSendMessage.SendMessageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendMessageParams{ctx, msg}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
+				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmSendMessage.funcSendMessage != nil {
+		return mmSendMessage.funcSendMessage(ctx, msg)
+	}
+	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, msg)
+	return
+}
+
+// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+}
+
+// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
+func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
+	mmSendMessage.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
+	copy(argCopy, mmSendMessage.callArgs)
+
+	mmSendMessage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendMessageDone() bool {
+	if m.SendMessageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMessageMock.invocationsDone()
+}
+
+// MinimockSendMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendMessageInspect() {
+	for _, e := range m.SendMessageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
+		if m.SendMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	}
+
+	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	}
+}
+
+type mChatServiceMockSendTyping struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSendTypingExpectation
+	expectations       []*ChatServiceMockSendTypingExpectation
+
+	callArgs []*ChatServiceMockSendTypingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSendTypingExpectation specifies expectation struct of the ChatService.SendTyping
+type ChatServiceMockSendTypingExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSendTypingParams
+	paramPtrs          *ChatServiceMockSendTypingParamPtrs
+	expectationOrigins ChatServiceMockSendTypingExpectationOrigins
+	results            *ChatServiceMockSendTypingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSendTypingParams contains parameters of the ChatService.SendTyping
+type ChatServiceMockSendTypingParams struct {
+	ctx      context.Context
+	chatID   int64
+	username string
+}
+
+// ChatServiceMockSendTypingParamPtrs contains pointers to parameters of the ChatService.SendTyping
+type ChatServiceMockSendTypingParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	username *string
+}
+
+// ChatServiceMockSendTypingResults contains results of the ChatService.SendTyping
+type ChatServiceMockSendTypingResults struct {
+	err error
+}
+
+// ChatServiceMockSendTypingOrigins contains origins of expectations of the ChatService.SendTyping
+type ChatServiceMockSendTypingExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendTyping *mChatServiceMockSendTyping) Optional() *mChatServiceMockSendTyping {
+	mmSendTyping.optional = true
+	return mmSendTyping
+}
+
+// Expect sets up expected params for ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) Expect(ctx context.Context, chatID int64, username string) *mChatServiceMockSendTyping {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	if mmSendTyping.defaultExpectation == nil {
+		mmSendTyping.defaultExpectation = &ChatServiceMockSendTypingExpectation{}
+	}
+
+	if mmSendTyping.defaultExpectation.paramPtrs != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by ExpectParams functions")
+	}
+
+	mmSendTyping.defaultExpectation.params = &ChatServiceMockSendTypingParams{ctx, chatID, username}
+	mmSendTyping.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendTyping.expectations {
+		if minimock.Equal(e.params, mmSendTyping.defaultExpectation.params) {
+			mmSendTyping.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendTyping.defaultExpectation.params)
+		}
+	}
+
+	return mmSendTyping
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendTyping {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	if mmSendTyping.defaultExpectation == nil {
+		mmSendTyping.defaultExpectation = &ChatServiceMockSendTypingExpectation{}
+	}
+
+	if mmSendTyping.defaultExpectation.params != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Expect")
+	}
+
+	if mmSendTyping.defaultExpectation.paramPtrs == nil {
+		mmSendTyping.defaultExpectation.paramPtrs = &ChatServiceMockSendTypingParamPtrs{}
+	}
+	mmSendTyping.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSendTyping.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSendTyping
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) ExpectChatIDParam2(chatID int64) *mChatServiceMockSendTyping {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	if mmSendTyping.defaultExpectation == nil {
+		mmSendTyping.defaultExpectation = &ChatServiceMockSendTypingExpectation{}
+	}
+
+	if mmSendTyping.defaultExpectation.params != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Expect")
+	}
+
+	if mmSendTyping.defaultExpectation.paramPtrs == nil {
+		mmSendTyping.defaultExpectation.paramPtrs = &ChatServiceMockSendTypingParamPtrs{}
+	}
+	mmSendTyping.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSendTyping.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSendTyping
+}
+
+// ExpectUsernameParam3 sets up expected param username for ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) ExpectUsernameParam3(username string) *mChatServiceMockSendTyping {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	if mmSendTyping.defaultExpectation == nil {
+		mmSendTyping.defaultExpectation = &ChatServiceMockSendTypingExpectation{}
+	}
+
+	if mmSendTyping.defaultExpectation.params != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Expect")
+	}
+
+	if mmSendTyping.defaultExpectation.paramPtrs == nil {
+		mmSendTyping.defaultExpectation.paramPtrs = &ChatServiceMockSendTypingParamPtrs{}
+	}
+	mmSendTyping.defaultExpectation.paramPtrs.username = &username
+	mmSendTyping.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSendTyping
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) Inspect(f func(ctx context.Context, chatID int64, username string)) *mChatServiceMockSendTyping {
+	if mmSendTyping.mock.inspectFuncSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendTyping")
+	}
+
+	mmSendTyping.mock.inspectFuncSendTyping = f
+
+	return mmSendTyping
+}
+
+// Return sets up results that will be returned by ChatService.SendTyping
+func (mmSendTyping *mChatServiceMockSendTyping) Return(err error) *ChatServiceMock {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	if mmSendTyping.defaultExpectation == nil {
+		mmSendTyping.defaultExpectation = &ChatServiceMockSendTypingExpectation{mock: mmSendTyping.mock}
+	}
+	mmSendTyping.defaultExpectation.results = &ChatServiceMockSendTypingResults{err}
+	mmSendTyping.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendTyping.mock
+}
+
+// Set uses given function f to mock the ChatService.SendTyping method
+func (mmSendTyping *mChatServiceMockSendTyping) Set(f func(ctx context.Context, chatID int64, username string) (err error)) *ChatServiceMock {
+	if mmSendTyping.defaultExpectation != nil {
+		mmSendTyping.mock.t.Fatalf("Default expectation is already set for the ChatService.SendTyping method")
+	}
+
+	if len(mmSendTyping.expectations) > 0 {
+		mmSendTyping.mock.t.Fatalf("Some expectations are already set for the ChatService.SendTyping method")
+	}
+
+	mmSendTyping.mock.funcSendTyping = f
+	mmSendTyping.mock.funcSendTypingOrigin = minimock.CallerInfo(1)
+	return mmSendTyping.mock
+}
+
+// When sets expectation for the ChatService.SendTyping which will trigger the result defined by the following
+// Then helper
+func (mmSendTyping *mChatServiceMockSendTyping) When(ctx context.Context, chatID int64, username string) *ChatServiceMockSendTypingExpectation {
+	if mmSendTyping.mock.funcSendTyping != nil {
+		mmSendTyping.mock.t.Fatalf("ChatServiceMock.SendTyping mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSendTypingExpectation{
+		mock:               mmSendTyping.mock,
+		params:             &ChatServiceMockSendTypingParams{ctx, chatID, username},
+		expectationOrigins: ChatServiceMockSendTypingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendTyping.expectations = append(mmSendTyping.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SendTyping return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSendTypingExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSendTypingResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SendTyping should be invoked
+func (mmSendTyping *mChatServiceMockSendTyping) Times(n uint64) *mChatServiceMockSendTyping {
+	if n == 0 {
+		mmSendTyping.mock.t.Fatalf("Times of ChatServiceMock.SendTyping mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendTyping.expectedInvocations, n)
+	mmSendTyping.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendTyping
+}
+
+func (mmSendTyping *mChatServiceMockSendTyping) invocationsDone() bool {
+	if len(mmSendTyping.expectations) == 0 && mmSendTyping.defaultExpectation == nil && mmSendTyping.mock.funcSendTyping == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendTyping.mock.afterSendTypingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendTyping.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendTyping implements mm_service.ChatService
+func (mmSendTyping *ChatServiceMock) SendTyping(ctx context.Context, chatID int64, username string) (err error) {
+	mm_atomic.AddUint64(&mmSendTyping.beforeSendTypingCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendTyping.afterSendTypingCounter, 1)
+
+	mmSendTyping.t.Helper()
+
+	if mmSendTyping.inspectFuncSendTyping != nil {
+		mmSendTyping.inspectFuncSendTyping(ctx, chatID, username)
+	}
+
+	mm_params := ChatServiceMockSendTypingParams{ctx, chatID, username}
+
+	// Record call args
+	mmSendTyping.SendTypingMock.mutex.Lock()
+	mmSendTyping.SendTypingMock.callArgs = append(mmSendTyping.SendTypingMock.callArgs, &mm_params)
+	mmSendTyping.SendTypingMock.mutex.Unlock()
+
+	for _, e := range mmSendTyping.SendTypingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendTyping.SendTypingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendTyping.SendTypingMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendTyping.SendTypingMock.defaultExpectation.params
+		mm_want_ptrs := mmSendTyping.SendTypingMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSendTypingParams{ctx, chatID, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSendTyping.t.Errorf("ChatServiceMock.SendTyping got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendTyping.SendTypingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSendTyping.t.Errorf("ChatServiceMock.SendTyping got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendTyping.SendTypingMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSendTyping.t.Errorf("ChatServiceMock.SendTyping got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendTyping.SendTypingMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendTyping.t.Errorf("ChatServiceMock.SendTyping got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendTyping.SendTypingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendTyping.SendTypingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendTyping.t.Fatal("No results are set for the ChatServiceMock.SendTyping")
+		}
+		return (*mm_results).err
+	}
+	if mmSendTyping.funcSendTyping != nil {
+		return mmSendTyping.funcSendTyping(ctx, chatID, username)
+	}
+	mmSendTyping.t.Fatalf("Unexpected call to ChatServiceMock.SendTyping. %v %v %v", ctx, chatID, username)
+	return
+}
+
+// SendTypingAfterCounter returns a count of finished ChatServiceMock.SendTyping invocations
+func (mmSendTyping *ChatServiceMock) SendTypingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendTyping.afterSendTypingCounter)
+}
+
+// SendTypingBeforeCounter returns a count of ChatServiceMock.SendTyping invocations
+func (mmSendTyping *ChatServiceMock) SendTypingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendTyping.beforeSendTypingCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SendTyping.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendTyping *mChatServiceMockSendTyping) Calls() []*ChatServiceMockSendTypingParams {
+	mmSendTyping.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSendTypingParams, len(mmSendTyping.callArgs))
+	copy(argCopy, mmSendTyping.callArgs)
+
+	mmSendTyping.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendTypingDone returns true if the count of the SendTyping invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSendTypingDone() bool {
+	if m.SendTypingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendTypingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendTypingMock.invocationsDone()
+}
+
+// MinimockSendTypingInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSendTypingInspect() {
+	for _, e := range m.SendTypingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SendTyping at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendTypingCounter := mm_atomic.LoadUint64(&m.afterSendTypingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendTypingMock.defaultExpectation != nil && afterSendTypingCounter < 1 {
+		if m.SendTypingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SendTyping at\n%s", m.SendTypingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SendTyping at\n%s with params: %#v", m.SendTypingMock.defaultExpectation.expectationOrigins.origin, *m.SendTypingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendTyping != nil && afterSendTypingCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SendTyping at\n%s", m.funcSendTypingOrigin)
+	}
+
+	if !m.SendTypingMock.invocationsDone() && afterSendTypingCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SendTyping at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendTypingMock.expectedInvocations), m.SendTypingMock.expectedInvocationsOrigin, afterSendTypingCounter)
+	}
+}
+
+type mChatServiceMockSetMemberRole struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSetMemberRoleExpectation
+	expectations       []*ChatServiceMockSetMemberRoleExpectation
+
+	callArgs []*ChatServiceMockSetMemberRoleParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSetMemberRoleExpectation specifies expectation struct of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSetMemberRoleParams
+	paramPtrs          *ChatServiceMockSetMemberRoleParamPtrs
+	expectationOrigins ChatServiceMockSetMemberRoleExpectationOrigins
+	results            *ChatServiceMockSetMemberRoleResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSetMemberRoleParams contains parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParams struct {
+	ctx      context.Context
+	chatID   int64
+	actor    string
+	username string
+	role     model.Role
+}
+
+// ChatServiceMockSetMemberRoleParamPtrs contains pointers to parameters of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleParamPtrs struct {
+	ctx      *context.Context
+	chatID   *int64
+	actor    *string
+	username *string
+	role     *model.Role
+}
+
+// ChatServiceMockSetMemberRoleResults contains results of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleResults struct {
+	err error
+}
+
+// ChatServiceMockSetMemberRoleOrigins contains origins of expectations of the ChatService.SetMemberRole
+type ChatServiceMockSetMemberRoleExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originChatID   string
+	originActor    string
+	originUsername string
+	originRole     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Optional() *mChatServiceMockSetMemberRole {
+	mmSetMemberRole.optional = true
+	return mmSetMemberRole
+}
+
+// Expect sets up expected params for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Expect(ctx context.Context, chatID int64, actor string, username string, role model.Role) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by ExpectParams functions")
+	}
+
+	mmSetMemberRole.defaultExpectation.params = &ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, username, role}
+	mmSetMemberRole.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMemberRole.expectations {
+		if minimock.Equal(e.params, mmSetMemberRole.defaultExpectation.params) {
+			mmSetMemberRole.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMemberRole.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMemberRole
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectChatIDParam2 sets up expected param chatID for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectChatIDParam2(chatID int64) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.chatID = &chatID
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originChatID = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectActorParam3 sets up expected param actor for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectActorParam3(actor string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.actor = &actor
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectUsernameParam4 sets up expected param username for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectUsernameParam4(username string) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.username = &username
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// ExpectRoleParam5 sets up expected param role for ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) ExpectRoleParam5(role model.Role) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{}
+	}
+
+	if mmSetMemberRole.defaultExpectation.params != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Expect")
+	}
+
+	if mmSetMemberRole.defaultExpectation.paramPtrs == nil {
+		mmSetMemberRole.defaultExpectation.paramPtrs = &ChatServiceMockSetMemberRoleParamPtrs{}
+	}
+	mmSetMemberRole.defaultExpectation.paramPtrs.role = &role
+	mmSetMemberRole.defaultExpectation.expectationOrigins.originRole = minimock.CallerInfo(1)
+
+	return mmSetMemberRole
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Inspect(f func(ctx context.Context, chatID int64, actor string, username string, role model.Role)) *mChatServiceMockSetMemberRole {
+	if mmSetMemberRole.mock.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SetMemberRole")
+	}
+
+	mmSetMemberRole.mock.inspectFuncSetMemberRole = f
+
+	return mmSetMemberRole
+}
+
+// Return sets up results that will be returned by ChatService.SetMemberRole
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Return(err error) *ChatServiceMock {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	if mmSetMemberRole.defaultExpectation == nil {
+		mmSetMemberRole.defaultExpectation = &ChatServiceMockSetMemberRoleExpectation{mock: mmSetMemberRole.mock}
+	}
+	mmSetMemberRole.defaultExpectation.results = &ChatServiceMockSetMemberRoleResults{err}
+	mmSetMemberRole.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// Set uses given function f to mock the ChatService.SetMemberRole method
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Set(f func(ctx context.Context, chatID int64, actor string, username string, role model.Role) (err error)) *ChatServiceMock {
+	if mmSetMemberRole.defaultExpectation != nil {
+		mmSetMemberRole.mock.t.Fatalf("Default expectation is already set for the ChatService.SetMemberRole method")
+	}
+
+	if len(mmSetMemberRole.expectations) > 0 {
+		mmSetMemberRole.mock.t.Fatalf("Some expectations are already set for the ChatService.SetMemberRole method")
+	}
+
+	mmSetMemberRole.mock.funcSetMemberRole = f
+	mmSetMemberRole.mock.funcSetMemberRoleOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole.mock
+}
+
+// When sets expectation for the ChatService.SetMemberRole which will trigger the result defined by the following
+// Then helper
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) When(ctx context.Context, chatID int64, actor string, username string, role model.Role) *ChatServiceMockSetMemberRoleExpectation {
+	if mmSetMemberRole.mock.funcSetMemberRole != nil {
+		mmSetMemberRole.mock.t.Fatalf("ChatServiceMock.SetMemberRole mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSetMemberRoleExpectation{
+		mock:               mmSetMemberRole.mock,
+		params:             &ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, username, role},
+		expectationOrigins: ChatServiceMockSetMemberRoleExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMemberRole.expectations = append(mmSetMemberRole.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.SetMemberRole return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSetMemberRoleExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSetMemberRoleResults{err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.SetMemberRole should be invoked
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Times(n uint64) *mChatServiceMockSetMemberRole {
+	if n == 0 {
+		mmSetMemberRole.mock.t.Fatalf("Times of ChatServiceMock.SetMemberRole mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMemberRole.expectedInvocations, n)
+	mmSetMemberRole.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMemberRole
+}
+
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) invocationsDone() bool {
+	if len(mmSetMemberRole.expectations) == 0 && mmSetMemberRole.defaultExpectation == nil && mmSetMemberRole.mock.funcSetMemberRole == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.mock.afterSetMemberRoleCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMemberRole.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMemberRole implements mm_service.ChatService
+func (mmSetMemberRole *ChatServiceMock) SetMemberRole(ctx context.Context, chatID int64, actor string, username string, role model.Role) (err error) {
+	mm_atomic.AddUint64(&mmSetMemberRole.beforeSetMemberRoleCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMemberRole.afterSetMemberRoleCounter, 1)
+
+	mmSetMemberRole.t.Helper()
+
+	if mmSetMemberRole.inspectFuncSetMemberRole != nil {
+		mmSetMemberRole.inspectFuncSetMemberRole(ctx, chatID, actor, username, role)
+	}
+
+	mm_params := ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, username, role}
+
+	// Record call args
+	mmSetMemberRole.SetMemberRoleMock.mutex.Lock()
+	mmSetMemberRole.SetMemberRoleMock.callArgs = append(mmSetMemberRole.SetMemberRoleMock.callArgs, &mm_params)
+	mmSetMemberRole.SetMemberRoleMock.mutex.Unlock()
+
+	for _, e := range mmSetMemberRole.SetMemberRoleMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMemberRole.SetMemberRoleMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMemberRole.SetMemberRoleMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSetMemberRoleParams{ctx, chatID, actor, username, role}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.chatID != nil && !minimock.Equal(*mm_want_ptrs.chatID, mm_got.chatID) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter chatID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originChatID, *mm_want_ptrs.chatID, mm_got.chatID, minimock.Diff(*mm_want_ptrs.chatID, mm_got.chatID))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.role != nil && !minimock.Equal(*mm_want_ptrs.role, mm_got.role) {
+				mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameter role, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.originRole, *mm_want_ptrs.role, mm_got.role, minimock.Diff(*mm_want_ptrs.role, mm_got.role))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMemberRole.t.Errorf("ChatServiceMock.SetMemberRole got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMemberRole.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMemberRole.SetMemberRoleMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMemberRole.t.Fatal("No results are set for the ChatServiceMock.SetMemberRole")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMemberRole.funcSetMemberRole != nil {
+		return mmSetMemberRole.funcSetMemberRole(ctx, chatID, actor, username, role)
+	}
+	mmSetMemberRole.t.Fatalf("Unexpected call to ChatServiceMock.SetMemberRole. %v %v %v %v %v", ctx, chatID, actor, username, role)
+	return
+}
+
+// SetMemberRoleAfterCounter returns a count of finished ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.afterSetMemberRoleCounter)
+}
+
+// SetMemberRoleBeforeCounter returns a count of ChatServiceMock.SetMemberRole invocations
+func (mmSetMemberRole *ChatServiceMock) SetMemberRoleBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMemberRole.beforeSetMemberRoleCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.SetMemberRole.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMemberRole *mChatServiceMockSetMemberRole) Calls() []*ChatServiceMockSetMemberRoleParams {
+	mmSetMemberRole.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSetMemberRoleParams, len(mmSetMemberRole.callArgs))
+	copy(argCopy, mmSetMemberRole.callArgs)
+
+	mmSetMemberRole.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMemberRoleDone returns true if the count of the SetMemberRole invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSetMemberRoleDone() bool {
+	if m.SetMemberRoleMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMemberRoleMock.invocationsDone()
+}
+
+// MinimockSetMemberRoleInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSetMemberRoleInspect() {
+	for _, e := range m.SetMemberRoleMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMemberRoleCounter := mm_atomic.LoadUint64(&m.afterSetMemberRoleCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMemberRoleMock.defaultExpectation != nil && afterSetMemberRoleCounter < 1 {
+		if m.SetMemberRoleMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.SetMemberRoleMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s with params: %#v", m.SetMemberRoleMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberRoleMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMemberRole != nil && afterSetMemberRoleCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.SetMemberRole at\n%s", m.funcSetMemberRoleOrigin)
+	}
+
+	if !m.SetMemberRoleMock.invocationsDone() && afterSetMemberRoleCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.SetMemberRole at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberRoleMock.expectedInvocations), m.SetMemberRoleMock.expectedInvocationsOrigin, afterSetMemberRoleCounter)
+	}
+}
+
+type mChatServiceMockSync struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockSyncExpectation
+	expectations       []*ChatServiceMockSyncExpectation
+
+	callArgs []*ChatServiceMockSyncParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockSyncExpectation specifies expectation struct of the ChatService.Sync
+type ChatServiceMockSyncExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockSyncParams
+	paramPtrs          *ChatServiceMockSyncParamPtrs
+	expectationOrigins ChatServiceMockSyncExpectationOrigins
+	results            *ChatServiceMockSyncResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockSyncParams contains parameters of the ChatService.Sync
+type ChatServiceMockSyncParams struct {
+	ctx      context.Context
+	username string
+	token    *model.SyncToken
+	limit    int
+}
+
+// ChatServiceMockSyncParamPtrs contains pointers to parameters of the ChatService.Sync
+type ChatServiceMockSyncParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	token    **model.SyncToken
+	limit    *int
+}
+
+// ChatServiceMockSyncResults contains results of the ChatService.Sync
+type ChatServiceMockSyncResults struct {
+	sp1 *model.SyncResult
+	err error
+}
+
+// ChatServiceMockSyncOrigins contains origins of expectations of the ChatService.Sync
+type ChatServiceMockSyncExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originToken    string
+	originLimit    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSync *mChatServiceMockSync) Optional() *mChatServiceMockSync {
+	mmSync.optional = true
+	return mmSync
+}
+
+// Expect sets up expected params for ChatService.Sync
+func (mmSync *mChatServiceMockSync) Expect(ctx context.Context, username string, token *model.SyncToken, limit int) *mChatServiceMockSync {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{}
+	}
+
+	if mmSync.defaultExpectation.paramPtrs != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by ExpectParams functions")
+	}
+
+	mmSync.defaultExpectation.params = &ChatServiceMockSyncParams{ctx, username, token, limit}
+	mmSync.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSync.expectations {
+		if minimock.Equal(e.params, mmSync.defaultExpectation.params) {
+			mmSync.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSync.defaultExpectation.params)
+		}
+	}
+
+	return mmSync
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.Sync
+func (mmSync *mChatServiceMockSync) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSync {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{}
+	}
+
+	if mmSync.defaultExpectation.params != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Expect")
+	}
+
+	if mmSync.defaultExpectation.paramPtrs == nil {
+		mmSync.defaultExpectation.paramPtrs = &ChatServiceMockSyncParamPtrs{}
+	}
+	mmSync.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSync.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSync
+}
+
+// ExpectUsernameParam2 sets up expected param username for ChatService.Sync
+func (mmSync *mChatServiceMockSync) ExpectUsernameParam2(username string) *mChatServiceMockSync {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{}
+	}
+
+	if mmSync.defaultExpectation.params != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Expect")
+	}
+
+	if mmSync.defaultExpectation.paramPtrs == nil {
+		mmSync.defaultExpectation.paramPtrs = &ChatServiceMockSyncParamPtrs{}
+	}
+	mmSync.defaultExpectation.paramPtrs.username = &username
+	mmSync.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSync
+}
+
+// ExpectTokenParam3 sets up expected param token for ChatService.Sync
+func (mmSync *mChatServiceMockSync) ExpectTokenParam3(token *model.SyncToken) *mChatServiceMockSync {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{}
+	}
+
+	if mmSync.defaultExpectation.params != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Expect")
+	}
+
+	if mmSync.defaultExpectation.paramPtrs == nil {
+		mmSync.defaultExpectation.paramPtrs = &ChatServiceMockSyncParamPtrs{}
+	}
+	mmSync.defaultExpectation.paramPtrs.token = &token
+	mmSync.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
+
+	return mmSync
+}
+
+// ExpectLimitParam4 sets up expected param limit for ChatService.Sync
+func (mmSync *mChatServiceMockSync) ExpectLimitParam4(limit int) *mChatServiceMockSync {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{}
+	}
+
+	if mmSync.defaultExpectation.params != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Expect")
+	}
+
+	if mmSync.defaultExpectation.paramPtrs == nil {
+		mmSync.defaultExpectation.paramPtrs = &ChatServiceMockSyncParamPtrs{}
+	}
+	mmSync.defaultExpectation.paramPtrs.limit = &limit
+	mmSync.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmSync
+}
+
+// Inspect accepts an inspector function that has same arguments as the ChatService.Sync
+func (mmSync *mChatServiceMockSync) Inspect(f func(ctx context.Context, username string, token *model.SyncToken, limit int)) *mChatServiceMockSync {
+	if mmSync.mock.inspectFuncSync != nil {
+		mmSync.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Sync")
+	}
+
+	mmSync.mock.inspectFuncSync = f
+
+	return mmSync
+}
+
+// Return sets up results that will be returned by ChatService.Sync
+func (mmSync *mChatServiceMockSync) Return(sp1 *model.SyncResult, err error) *ChatServiceMock {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	if mmSync.defaultExpectation == nil {
+		mmSync.defaultExpectation = &ChatServiceMockSyncExpectation{mock: mmSync.mock}
+	}
+	mmSync.defaultExpectation.results = &ChatServiceMockSyncResults{sp1, err}
+	mmSync.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSync.mock
+}
+
+// Set uses given function f to mock the ChatService.Sync method
+func (mmSync *mChatServiceMockSync) Set(f func(ctx context.Context, username string, token *model.SyncToken, limit int) (sp1 *model.SyncResult, err error)) *ChatServiceMock {
+	if mmSync.defaultExpectation != nil {
+		mmSync.mock.t.Fatalf("Default expectation is already set for the ChatService.Sync method")
+	}
+
+	if len(mmSync.expectations) > 0 {
+		mmSync.mock.t.Fatalf("Some expectations are already set for the ChatService.Sync method")
+	}
+
+	mmSync.mock.funcSync = f
+	mmSync.mock.funcSyncOrigin = minimock.CallerInfo(1)
+	return mmSync.mock
+}
+
+// When sets expectation for the ChatService.Sync which will trigger the result defined by the following
+// Then helper
+func (mmSync *mChatServiceMockSync) When(ctx context.Context, username string, token *model.SyncToken, limit int) *ChatServiceMockSyncExpectation {
+	if mmSync.mock.funcSync != nil {
+		mmSync.mock.t.Fatalf("ChatServiceMock.Sync mock is already set by Set")
+	}
+
+	expectation := &ChatServiceMockSyncExpectation{
+		mock:               mmSync.mock,
+		params:             &ChatServiceMockSyncParams{ctx, username, token, limit},
+		expectationOrigins: ChatServiceMockSyncExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSync.expectations = append(mmSync.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ChatService.Sync return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockSyncExpectation) Then(sp1 *model.SyncResult, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockSyncResults{sp1, err}
+	return e.mock
+}
+
+// Times sets number of times ChatService.Sync should be invoked
+func (mmSync *mChatServiceMockSync) Times(n uint64) *mChatServiceMockSync {
+	if n == 0 {
+		mmSync.mock.t.Fatalf("Times of ChatServiceMock.Sync mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSync.expectedInvocations, n)
+	mmSync.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSync
+}
+
+func (mmSync *mChatServiceMockSync) invocationsDone() bool {
+	if len(mmSync.expectations) == 0 && mmSync.defaultExpectation == nil && mmSync.mock.funcSync == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSync.mock.afterSyncCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSync.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Sync implements mm_service.ChatService
+func (mmSync *ChatServiceMock) Sync(ctx context.Context, username string, token *model.SyncToken, limit int) (sp1 *model.SyncResult, err error) {
+	mm_atomic.AddUint64(&mmSync.beforeSyncCounter, 1)
+	defer mm_atomic.AddUint64(&mmSync.afterSyncCounter, 1)
+
+	mmSync.t.Helper()
+
+	if mmSync.inspectFuncSync != nil {
+		mmSync.inspectFuncSync(ctx, username, token, limit)
+	}
+
+	mm_params := ChatServiceMockSyncParams{ctx, username, token, limit}
+
+	// Record call args
+	mmSync.SyncMock.mutex.Lock()
+	mmSync.SyncMock.callArgs = append(mmSync.SyncMock.callArgs, &mm_params)
+	mmSync.SyncMock.mutex.Unlock()
+
+	for _, e := range mmSync.SyncMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sp1, e.results.err
+		}
+	}
+
+	if mmSync.SyncMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSync.SyncMock.defaultExpectation.Counter, 1)
+		mm_want := mmSync.SyncMock.defaultExpectation.params
+		mm_want_ptrs := mmSync.SyncMock.defaultExpectation.paramPtrs
+
+		mm_got := ChatServiceMockSyncParams{ctx, username, token, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSync.t.Errorf("ChatServiceMock.Sync got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSync.SyncMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSync.t.Errorf("ChatServiceMock.Sync got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSync.SyncMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmSync.t.Errorf("ChatServiceMock.Sync got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSync.SyncMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmSync.t.Errorf("ChatServiceMock.Sync got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSync.SyncMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSync.t.Errorf("ChatServiceMock.Sync got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSync.SyncMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSync.SyncMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSync.t.Fatal("No results are set for the ChatServiceMock.Sync")
+		}
+		return (*mm_results).sp1, (*mm_results).err
+	}
+	if mmSync.funcSync != nil {
+		return mmSync.funcSync(ctx, username, token, limit)
+	}
+	mmSync.t.Fatalf("Unexpected call to ChatServiceMock.Sync. %v %v %v %v", ctx, username, token, limit)
+	return
+}
+
+// SyncAfterCounter returns a count of finished ChatServiceMock.Sync invocations
+func (mmSync *ChatServiceMock) SyncAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSync.afterSyncCounter)
+}
+
+// SyncBeforeCounter returns a count of ChatServiceMock.Sync invocations
+func (mmSync *ChatServiceMock) SyncBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSync.beforeSyncCounter)
+}
+
+// Calls returns a list of arguments used in each call to ChatServiceMock.Sync.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSync *mChatServiceMockSync) Calls() []*ChatServiceMockSyncParams {
+	mmSync.mutex.RLock()
+
+	argCopy := make([]*ChatServiceMockSyncParams, len(mmSync.callArgs))
+	copy(argCopy, mmSync.callArgs)
+
+	mmSync.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSyncDone returns true if the count of the Sync invocations corresponds
+// the number of defined expectations
+func (m *ChatServiceMock) MinimockSyncDone() bool {
+	if m.SyncMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SyncMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SyncMock.invocationsDone()
+}
+
+// MinimockSyncInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockSyncInspect() {
+	for _, e := range m.SyncMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ChatServiceMock.Sync at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSyncCounter := mm_atomic.LoadUint64(&m.afterSyncCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SyncMock.defaultExpectation != nil && afterSyncCounter < 1 {
+		if m.SyncMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.Sync at\n%s", m.SyncMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ChatServiceMock.Sync at\n%s with params: %#v", m.SyncMock.defaultExpectation.expectationOrigins.origin, *m.SyncMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSync != nil && afterSyncCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.Sync at\n%s", m.funcSyncOrigin)
+	}
+
+	if !m.SyncMock.invocationsDone() && afterSyncCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.Sync at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SyncMock.expectedInvocations), m.SyncMock.expectedInvocationsOrigin, afterSyncCounter)
+	}
+}
+
+type mChatServiceMockUnpinMessage struct {
+	optional           bool
+	mock               *ChatServiceMock
+	defaultExpectation *ChatServiceMockUnpinMessageExpectation
+	expectations       []*ChatServiceMockUnpinMessageExpectation
+
+	callArgs []*ChatServiceMockUnpinMessageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ChatServiceMockUnpinMessageExpectation specifies expectation struct of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageExpectation struct {
+	mock               *ChatServiceMock
+	params             *ChatServiceMockUnpinMessageParams
+	paramPtrs          *ChatServiceMockUnpinMessageParamPtrs
+	expectationOrigins ChatServiceMockUnpinMessageExpectationOrigins
+	results            *ChatServiceMockUnpinMessageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ChatServiceMockUnpinMessageParams contains parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParams struct {
+	ctx       context.Context
+	actor     string
+	messageID int64
+}
+
+// ChatServiceMockUnpinMessageParamPtrs contains pointers to parameters of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageParamPtrs struct {
+	ctx       *context.Context
+	actor     *string
+	messageID *int64
+}
+
+// ChatServiceMockUnpinMessageResults contains results of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageResults struct {
+	err error
+}
+
+// ChatServiceMockUnpinMessageOrigins contains origins of expectations of the ChatService.UnpinMessage
+type ChatServiceMockUnpinMessageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originActor     string
+	originMessageID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Optional() *mChatServiceMockUnpinMessage {
+	mmUnpinMessage.optional = true
+	return mmUnpinMessage
+}
+
+// Expect sets up expected params for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Expect(ctx context.Context, actor string, messageID int64) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by ExpectParams functions")
+	}
+
+	mmUnpinMessage.defaultExpectation.params = &ChatServiceMockUnpinMessageParams{ctx, actor, messageID}
+	mmUnpinMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUnpinMessage.expectations {
+		if minimock.Equal(e.params, mmUnpinMessage.defaultExpectation.params) {
+			mmUnpinMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUnpinMessage.defaultExpectation.params)
+		}
+	}
+
+	return mmUnpinMessage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUnpinMessage
+}
+
+// ExpectActorParam2 sets up expected param actor for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectActorParam2(actor string) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
+	}
+
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
+	}
+
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
+	}
+	mmUnpinMessage.defaultExpectation.paramPtrs.actor = &actor
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmUnpinMessage
+}
+
+// ExpectMessageIDParam3 sets up expected param messageID for ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) ExpectMessageIDParam3(messageID int64) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
+	}
+
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{}
 	}
 
-	if mmCreate.defaultExpectation.params != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Expect")
+	if mmUnpinMessage.defaultExpectation.params != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Expect")
 	}
 
-	if mmCreate.defaultExpectation.paramPtrs == nil {
-		mmCreate.defaultExpectation.paramPtrs = &ChatServiceMockCreateParamPtrs{}
+	if mmUnpinMessage.defaultExpectation.paramPtrs == nil {
+		mmUnpinMessage.defaultExpectation.paramPtrs = &ChatServiceMockUnpinMessageParamPtrs{}
 	}
-	mmCreate.defaultExpectation.paramPtrs.req = &req
-	mmCreate.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmUnpinMessage.defaultExpectation.paramPtrs.messageID = &messageID
+	mmUnpinMessage.defaultExpectation.expectationOrigins.originMessageID = minimock.CallerInfo(1)
 
-	return mmCreate
+	return mmUnpinMessage
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Inspect(f func(ctx context.Context, req *model.ChatCreate)) *mChatServiceMockCreate {
-	if mmCreate.mock.inspectFuncCreate != nil {
-		mmCreate.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Create")
+// Inspect accepts an inspector function that has same arguments as the ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Inspect(f func(ctx context.Context, actor string, messageID int64)) *mChatServiceMockUnpinMessage {
+	if mmUnpinMessage.mock.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UnpinMessage")
 	}
 
-	mmCreate.mock.inspectFuncCreate = f
+	mmUnpinMessage.mock.inspectFuncUnpinMessage = f
 
-	return mmCreate
+	return mmUnpinMessage
 }
 
-// Return sets up results that will be returned by ChatService.Create
-func (mmCreate *mChatServiceMockCreate) Return(i1 int64, err error) *ChatServiceMock {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+// Return sets up results that will be returned by ChatService.UnpinMessage
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Return(err error) *ChatServiceMock {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	if mmCreate.defaultExpectation == nil {
-		mmCreate.defaultExpectation = &ChatServiceMockCreateExpectation{mock: mmCreate.mock}
+	if mmUnpinMessage.defaultExpectation == nil {
+		mmUnpinMessage.defaultExpectation = &ChatServiceMockUnpinMessageExpectation{mock: mmUnpinMessage.mock}
 	}
-	mmCreate.defaultExpectation.results = &ChatServiceMockCreateResults{i1, err}
-	mmCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmUnpinMessage.defaultExpectation.results = &ChatServiceMockUnpinMessageResults{err}
+	mmUnpinMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
 }
 
-// Set uses given function f to mock the ChatService.Create method
-func (mmCreate *mChatServiceMockCreate) Set(f func(ctx context.Context, req *model.ChatCreate) (i1 int64, err error)) *ChatServiceMock {
-	if mmCreate.defaultExpectation != nil {
-		mmCreate.mock.t.Fatalf("Default expectation is already set for the ChatService.Create method")
+// Set uses given function f to mock the ChatService.UnpinMessage method
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Set(f func(ctx context.Context, actor string, messageID int64) (err error)) *ChatServiceMock {
+	if mmUnpinMessage.defaultExpectation != nil {
+		mmUnpinMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.UnpinMessage method")
 	}
 
-	if len(mmCreate.expectations) > 0 {
-		mmCreate.mock.t.Fatalf("Some expectations are already set for the ChatService.Create method")
+	if len(mmUnpinMessage.expectations) > 0 {
+		mmUnpinMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.UnpinMessage method")
 	}
 
-	mmCreate.mock.funcCreate = f
-	mmCreate.mock.funcCreateOrigin = minimock.CallerInfo(1)
-	return mmCreate.mock
+	mmUnpinMessage.mock.funcUnpinMessage = f
+	mmUnpinMessage.mock.funcUnpinMessageOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage.mock
 }
 
-// When sets expectation for the ChatService.Create which will trigger the result defined by the following
+// When sets expectation for the ChatService.UnpinMessage which will trigger the result defined by the following
 // Then helper
-func (mmCreate *mChatServiceMockCreate) When(ctx context.Context, req *model.ChatCreate) *ChatServiceMockCreateExpectation {
-	if mmCreate.mock.funcCreate != nil {
-		mmCreate.mock.t.Fatalf("ChatServiceMock.Create mock is already set by Set")
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) When(ctx context.Context, actor string, messageID int64) *ChatServiceMockUnpinMessageExpectation {
+	if mmUnpinMessage.mock.funcUnpinMessage != nil {
+		mmUnpinMessage.mock.t.Fatalf("ChatServiceMock.UnpinMessage mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockCreateExpectation{
-		mock:               mmCreate.mock,
-		params:             &ChatServiceMockCreateParams{ctx, req},
-		expectationOrigins: ChatServiceMockCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockUnpinMessageExpectation{
+		mock:               mmUnpinMessage.mock,
+		params:             &ChatServiceMockUnpinMessageParams{ctx, actor, messageID},
+		expectationOrigins: ChatServiceMockUnpinMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreate.expectations = append(mmCreate.expectations, expectation)
+	mmUnpinMessage.expectations = append(mmUnpinMessage.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.Create return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockCreateExpectation) Then(i1 int64, err error) *ChatServiceMock {
-	e.results = &ChatServiceMockCreateResults{i1, err}
+// Then sets up ChatService.UnpinMessage return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUnpinMessageExpectation) Then(err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUnpinMessageResults{err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.Create should be invoked
-func (mmCreate *mChatServiceMockCreate) Times(n uint64) *mChatServiceMockCreate {
+// Times sets number of times ChatService.UnpinMessage should be invoked
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Times(n uint64) *mChatServiceMockUnpinMessage {
 	if n == 0 {
-		mmCreate.mock.t.Fatalf("Times of ChatServiceMock.Create mock can not be zero")
+		mmUnpinMessage.mock.t.Fatalf("Times of ChatServiceMock.UnpinMessage mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreate.expectedInvocations, n)
-	mmCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreate
+	mm_atomic.StoreUint64(&mmUnpinMessage.expectedInvocations, n)
+	mmUnpinMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUnpinMessage
 }
 
-func (mmCreate *mChatServiceMockCreate) invocationsDone() bool {
-	if len(mmCreate.expectations) == 0 && mmCreate.defaultExpectation == nil && mmCreate.mock.funcCreate == nil {
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) invocationsDone() bool {
+	if len(mmUnpinMessage.expectations) == 0 && mmUnpinMessage.defaultExpectation == nil && mmUnpinMessage.mock.funcUnpinMessage == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreate.mock.afterCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.mock.afterUnpinMessageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUnpinMessage.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Create implements mm_service.ChatService
-func (mmCreate *ChatServiceMock) Create(ctx context.Context, req *model.ChatCreate) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmCreate.beforeCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreate.afterCreateCounter, 1)
+// UnpinMessage implements mm_service.ChatService
+func (mmUnpinMessage *ChatServiceMock) UnpinMessage(ctx context.Context, actor string, messageID int64) (err error) {
+	mm_atomic.AddUint64(&mmUnpinMessage.beforeUnpinMessageCounter, 1)
+	defer mm_atomic.AddUint64(&mmUnpinMessage.afterUnpinMessageCounter, 1)
 
-	mmCreate.t.Helper()
+	mmUnpinMessage.t.Helper()
 
-	if mmCreate.inspectFuncCreate != nil {
-		mmCreate.inspectFuncCreate(ctx, req)
+	if mmUnpinMessage.inspectFuncUnpinMessage != nil {
+		mmUnpinMessage.inspectFuncUnpinMessage(ctx, actor, messageID)
 	}
 
-	mm_params := ChatServiceMockCreateParams{ctx, req}
+	mm_params := ChatServiceMockUnpinMessageParams{ctx, actor, messageID}
 
 	// Record call args
-	mmCreate.CreateMock.mutex.Lock()
-	mmCreate.CreateMock.callArgs = append(mmCreate.CreateMock.callArgs, &mm_params)
-	mmCreate.CreateMock.mutex.Unlock()
+	mmUnpinMessage.UnpinMessageMock.mutex.Lock()
+	mmUnpinMessage.UnpinMessageMock.callArgs = append(mmUnpinMessage.UnpinMessageMock.callArgs, &mm_params)
+	mmUnpinMessage.UnpinMessageMock.mutex.Unlock()
 
-	for _, e := range mmCreate.CreateMock.expectations {
+	for _, e := range mmUnpinMessage.UnpinMessageMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmCreate.CreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreate.CreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreate.CreateMock.defaultExpectation.params
-		mm_want_ptrs := mmCreate.CreateMock.defaultExpectation.paramPtrs
+	if mmUnpinMessage.UnpinMessageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUnpinMessage.UnpinMessageMock.defaultExpectation.Counter, 1)
+		mm_want := mmUnpinMessage.UnpinMessageMock.defaultExpectation.params
+		mm_want_ptrs := mmUnpinMessage.UnpinMessageMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockCreateParams{ctx, req}
+		mm_got := ChatServiceMockUnpinMessageParams{ctx, actor, messageID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreate.CreateMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.messageID != nil && !minimock.Equal(*mm_want_ptrs.messageID, mm_got.messageID) {
+				mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameter messageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.originMessageID, *mm_want_ptrs.messageID, mm_got.messageID, minimock.Diff(*mm_want_ptrs.messageID, mm_got.messageID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreate.t.Errorf("ChatServiceMock.Create got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreate.CreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUnpinMessage.t.Errorf("ChatServiceMock.UnpinMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUnpinMessage.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreate.CreateMock.defaultExpectation.results
+		mm_results := mmUnpinMessage.UnpinMessageMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreate.t.Fatal("No results are set for the ChatServiceMock.Create")
+			mmUnpinMessage.t.Fatal("No results are set for the ChatServiceMock.UnpinMessage")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmCreate.funcCreate != nil {
-		return mmCreate.funcCreate(ctx, req)
+	if mmUnpinMessage.funcUnpinMessage != nil {
+		return mmUnpinMessage.funcUnpinMessage(ctx, actor, messageID)
 	}
-	mmCreate.t.Fatalf("Unexpected call to ChatServiceMock.Create. %v %v", ctx, req)
+	mmUnpinMessage.t.Fatalf("Unexpected call to ChatServiceMock.UnpinMessage. %v %v %v", ctx, actor, messageID)
 	return
 }
 
-// CreateAfterCounter returns a count of finished ChatServiceMock.Create invocations
-func (mmCreate *ChatServiceMock) CreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.afterCreateCounter)
+// UnpinMessageAfterCounter returns a count of finished ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.afterUnpinMessageCounter)
 }
 
-// CreateBeforeCounter returns a count of ChatServiceMock.Create invocations
-func (mmCreate *ChatServiceMock) CreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreate.beforeCreateCounter)
+// UnpinMessageBeforeCounter returns a count of ChatServiceMock.UnpinMessage invocations
+func (mmUnpinMessage *ChatServiceMock) UnpinMessageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUnpinMessage.beforeUnpinMessageCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.Create.
+// Calls returns a list of arguments used in each call to ChatServiceMock.UnpinMessage.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreate *mChatServiceMockCreate) Calls() []*ChatServiceMockCreateParams {
-	mmCreate.mutex.RLock()
+func (mmUnpinMessage *mChatServiceMockUnpinMessage) Calls() []*ChatServiceMockUnpinMessageParams {
+	mmUnpinMessage.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockCreateParams, len(mmCreate.callArgs))
-	copy(argCopy, mmCreate.callArgs)
+	argCopy := make([]*ChatServiceMockUnpinMessageParams, len(mmUnpinMessage.callArgs))
+	copy(argCopy, mmUnpinMessage.callArgs)
 
-	mmCreate.mutex.RUnlock()
+	mmUnpinMessage.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateDone returns true if the count of the Create invocations corresponds
+// MinimockUnpinMessageDone returns true if the count of the UnpinMessage invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockCreateDone() bool {
-	if m.CreateMock.optional {
+func (m *ChatServiceMock) MinimockUnpinMessageDone() bool {
+	if m.UnpinMessageMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateMock.expectations {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateMock.invocationsDone()
+	return m.UnpinMessageMock.invocationsDone()
 }
 
-// MinimockCreateInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockCreateInspect() {
-	for _, e := range m.CreateMock.expectations {
+// MinimockUnpinMessageInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUnpinMessageInspect() {
+	for _, e := range m.UnpinMessageMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateCounter := mm_atomic.LoadUint64(&m.afterCreateCounter)
+	afterUnpinMessageCounter := mm_atomic.LoadUint64(&m.afterUnpinMessageCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateMock.defaultExpectation != nil && afterCreateCounter < 1 {
-		if m.CreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.CreateMock.defaultExpectation.returnOrigin)
+	if m.UnpinMessageMock.defaultExpectation != nil && afterUnpinMessageCounter < 1 {
+		if m.UnpinMessageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s", m.UnpinMessageMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s with params: %#v", m.CreateMock.defaultExpectation.expectationOrigins.origin, *m.CreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s with params: %#v", m.UnpinMessageMock.defaultExpectation.expectationOrigins.origin, *m.UnpinMessageMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreate != nil && afterCreateCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.Create at\n%s", m.funcCreateOrigin)
+	if m.funcUnpinMessage != nil && afterUnpinMessageCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.UnpinMessage at\n%s", m.funcUnpinMessageOrigin)
 	}
 
-	if !m.CreateMock.invocationsDone() && afterCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.Create at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateMock.expectedInvocations), m.CreateMock.expectedInvocationsOrigin, afterCreateCounter)
+	if !m.UnpinMessageMock.invocationsDone() && afterUnpinMessageCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UnpinMessage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UnpinMessageMock.expectedInvocations), m.UnpinMessageMock.expectedInvocationsOrigin, afterUnpinMessageCounter)
 	}
 }
 
-type mChatServiceMockDelete struct {
+type mChatServiceMockUpdateChat struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockDeleteExpectation
-	expectations       []*ChatServiceMockDeleteExpectation
+	defaultExpectation *ChatServiceMockUpdateChatExpectation
+	expectations       []*ChatServiceMockUpdateChatExpectation
 
-	callArgs []*ChatServiceMockDeleteParams
+	callArgs []*ChatServiceMockUpdateChatParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockDeleteExpectation specifies expectation struct of the ChatService.Delete
-type ChatServiceMockDeleteExpectation struct {
+// ChatServiceMockUpdateChatExpectation specifies expectation struct of the ChatService.UpdateChat
+type ChatServiceMockUpdateChatExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockDeleteParams
-	paramPtrs          *ChatServiceMockDeleteParamPtrs
-	expectationOrigins ChatServiceMockDeleteExpectationOrigins
-	results            *ChatServiceMockDeleteResults
+	params             *ChatServiceMockUpdateChatParams
+	paramPtrs          *ChatServiceMockUpdateChatParamPtrs
+	expectationOrigins ChatServiceMockUpdateChatExpectationOrigins
+	results            *ChatServiceMockUpdateChatResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockDeleteParams contains parameters of the ChatService.Delete
-type ChatServiceMockDeleteParams struct {
-	ctx context.Context
-	id  int64
+// ChatServiceMockUpdateChatParams contains parameters of the ChatService.UpdateChat
+type ChatServiceMockUpdateChatParams struct {
+	ctx    context.Context
+	actor  string
+	update *model.ChatUpdate
 }
 
-// ChatServiceMockDeleteParamPtrs contains pointers to parameters of the ChatService.Delete
-type ChatServiceMockDeleteParamPtrs struct {
-	ctx *context.Context
-	id  *int64
+// ChatServiceMockUpdateChatParamPtrs contains pointers to parameters of the ChatService.UpdateChat
+type ChatServiceMockUpdateChatParamPtrs struct {
+	ctx    *context.Context
+	actor  *string
+	update **model.ChatUpdate
 }
 
-// ChatServiceMockDeleteResults contains results of the ChatService.Delete
-type ChatServiceMockDeleteResults struct {
+// ChatServiceMockUpdateChatResults contains results of the ChatService.UpdateChat
+type ChatServiceMockUpdateChatResults struct {
+	cp1 *model.Chat
 	err error
 }
 
-// ChatServiceMockDeleteOrigins contains origins of expectations of the ChatService.Delete
-type ChatServiceMockDeleteExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originId  string
+// ChatServiceMockUpdateChatOrigins contains origins of expectations of the ChatService.UpdateChat
+type ChatServiceMockUpdateChatExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originActor  string
+	originUpdate string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -459,341 +14621,373 @@ type ChatServiceMockDeleteExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDelete *mChatServiceMockDelete) Optional() *mChatServiceMockDelete {
-	mmDelete.optional = true
-	return mmDelete
+func (mmUpdateChat *mChatServiceMockUpdateChat) Optional() *mChatServiceMockUpdateChat {
+	mmUpdateChat.optional = true
+	return mmUpdateChat
 }
 
-// Expect sets up expected params for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Expect(ctx context.Context, id int64) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// Expect sets up expected params for ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) Expect(ctx context.Context, actor string, update *model.ChatUpdate) *mChatServiceMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatServiceMockUpdateChatExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by ExpectParams functions")
+	if mmUpdateChat.defaultExpectation.paramPtrs != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by ExpectParams functions")
 	}
 
-	mmDelete.defaultExpectation.params = &ChatServiceMockDeleteParams{ctx, id}
-	mmDelete.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDelete.expectations {
-		if minimock.Equal(e.params, mmDelete.defaultExpectation.params) {
-			mmDelete.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDelete.defaultExpectation.params)
+	mmUpdateChat.defaultExpectation.params = &ChatServiceMockUpdateChatParams{ctx, actor, update}
+	mmUpdateChat.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdateChat.expectations {
+		if minimock.Equal(e.params, mmUpdateChat.defaultExpectation.params) {
+			mmUpdateChat.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdateChat.defaultExpectation.params)
 		}
 	}
 
-	return mmDelete
+	return mmUpdateChat
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) ExpectCtxParam1(ctx context.Context) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatServiceMockUpdateChatExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	if mmUpdateChat.defaultExpectation.params != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	if mmUpdateChat.defaultExpectation.paramPtrs == nil {
+		mmUpdateChat.defaultExpectation.paramPtrs = &ChatServiceMockUpdateChatParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDelete.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUpdateChat.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdateChat.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmUpdateChat
 }
 
-// ExpectIdParam2 sets up expected param id for ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) ExpectIdParam2(id int64) *mChatServiceMockDelete {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// ExpectActorParam2 sets up expected param actor for ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) ExpectActorParam2(actor string) *mChatServiceMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{}
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatServiceMockUpdateChatExpectation{}
 	}
 
-	if mmDelete.defaultExpectation.params != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Expect")
+	if mmUpdateChat.defaultExpectation.params != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Expect")
 	}
 
-	if mmDelete.defaultExpectation.paramPtrs == nil {
-		mmDelete.defaultExpectation.paramPtrs = &ChatServiceMockDeleteParamPtrs{}
+	if mmUpdateChat.defaultExpectation.paramPtrs == nil {
+		mmUpdateChat.defaultExpectation.paramPtrs = &ChatServiceMockUpdateChatParamPtrs{}
 	}
-	mmDelete.defaultExpectation.paramPtrs.id = &id
-	mmDelete.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+	mmUpdateChat.defaultExpectation.paramPtrs.actor = &actor
+	mmUpdateChat.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
 
-	return mmDelete
+	return mmUpdateChat
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Inspect(f func(ctx context.Context, id int64)) *mChatServiceMockDelete {
-	if mmDelete.mock.inspectFuncDelete != nil {
-		mmDelete.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.Delete")
+// ExpectUpdateParam3 sets up expected param update for ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) ExpectUpdateParam3(update *model.ChatUpdate) *mChatServiceMockUpdateChat {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
 
-	mmDelete.mock.inspectFuncDelete = f
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatServiceMockUpdateChatExpectation{}
+	}
 
-	return mmDelete
+	if mmUpdateChat.defaultExpectation.params != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Expect")
+	}
+
+	if mmUpdateChat.defaultExpectation.paramPtrs == nil {
+		mmUpdateChat.defaultExpectation.paramPtrs = &ChatServiceMockUpdateChatParamPtrs{}
+	}
+	mmUpdateChat.defaultExpectation.paramPtrs.update = &update
+	mmUpdateChat.defaultExpectation.expectationOrigins.originUpdate = minimock.CallerInfo(1)
+
+	return mmUpdateChat
 }
 
-// Return sets up results that will be returned by ChatService.Delete
-func (mmDelete *mChatServiceMockDelete) Return(err error) *ChatServiceMock {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) Inspect(f func(ctx context.Context, actor string, update *model.ChatUpdate)) *mChatServiceMockUpdateChat {
+	if mmUpdateChat.mock.inspectFuncUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UpdateChat")
 	}
 
-	if mmDelete.defaultExpectation == nil {
-		mmDelete.defaultExpectation = &ChatServiceMockDeleteExpectation{mock: mmDelete.mock}
+	mmUpdateChat.mock.inspectFuncUpdateChat = f
+
+	return mmUpdateChat
+}
+
+// Return sets up results that will be returned by ChatService.UpdateChat
+func (mmUpdateChat *mChatServiceMockUpdateChat) Return(cp1 *model.Chat, err error) *ChatServiceMock {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
-	mmDelete.defaultExpectation.results = &ChatServiceMockDeleteResults{err}
-	mmDelete.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+
+	if mmUpdateChat.defaultExpectation == nil {
+		mmUpdateChat.defaultExpectation = &ChatServiceMockUpdateChatExpectation{mock: mmUpdateChat.mock}
+	}
+	mmUpdateChat.defaultExpectation.results = &ChatServiceMockUpdateChatResults{cp1, err}
+	mmUpdateChat.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat.mock
 }
 
-// Set uses given function f to mock the ChatService.Delete method
-func (mmDelete *mChatServiceMockDelete) Set(f func(ctx context.Context, id int64) (err error)) *ChatServiceMock {
-	if mmDelete.defaultExpectation != nil {
-		mmDelete.mock.t.Fatalf("Default expectation is already set for the ChatService.Delete method")
+// Set uses given function f to mock the ChatService.UpdateChat method
+func (mmUpdateChat *mChatServiceMockUpdateChat) Set(f func(ctx context.Context, actor string, update *model.ChatUpdate) (cp1 *model.Chat, err error)) *ChatServiceMock {
+	if mmUpdateChat.defaultExpectation != nil {
+		mmUpdateChat.mock.t.Fatalf("Default expectation is already set for the ChatService.UpdateChat method")
 	}
 
-	if len(mmDelete.expectations) > 0 {
-		mmDelete.mock.t.Fatalf("Some expectations are already set for the ChatService.Delete method")
+	if len(mmUpdateChat.expectations) > 0 {
+		mmUpdateChat.mock.t.Fatalf("Some expectations are already set for the ChatService.UpdateChat method")
 	}
 
-	mmDelete.mock.funcDelete = f
-	mmDelete.mock.funcDeleteOrigin = minimock.CallerInfo(1)
-	return mmDelete.mock
+	mmUpdateChat.mock.funcUpdateChat = f
+	mmUpdateChat.mock.funcUpdateChatOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat.mock
 }
 
-// When sets expectation for the ChatService.Delete which will trigger the result defined by the following
+// When sets expectation for the ChatService.UpdateChat which will trigger the result defined by the following
 // Then helper
-func (mmDelete *mChatServiceMockDelete) When(ctx context.Context, id int64) *ChatServiceMockDeleteExpectation {
-	if mmDelete.mock.funcDelete != nil {
-		mmDelete.mock.t.Fatalf("ChatServiceMock.Delete mock is already set by Set")
+func (mmUpdateChat *mChatServiceMockUpdateChat) When(ctx context.Context, actor string, update *model.ChatUpdate) *ChatServiceMockUpdateChatExpectation {
+	if mmUpdateChat.mock.funcUpdateChat != nil {
+		mmUpdateChat.mock.t.Fatalf("ChatServiceMock.UpdateChat mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockDeleteExpectation{
-		mock:               mmDelete.mock,
-		params:             &ChatServiceMockDeleteParams{ctx, id},
-		expectationOrigins: ChatServiceMockDeleteExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockUpdateChatExpectation{
+		mock:               mmUpdateChat.mock,
+		params:             &ChatServiceMockUpdateChatParams{ctx, actor, update},
+		expectationOrigins: ChatServiceMockUpdateChatExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDelete.expectations = append(mmDelete.expectations, expectation)
+	mmUpdateChat.expectations = append(mmUpdateChat.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.Delete return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockDeleteExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockDeleteResults{err}
+// Then sets up ChatService.UpdateChat return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUpdateChatExpectation) Then(cp1 *model.Chat, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUpdateChatResults{cp1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.Delete should be invoked
-func (mmDelete *mChatServiceMockDelete) Times(n uint64) *mChatServiceMockDelete {
+// Times sets number of times ChatService.UpdateChat should be invoked
+func (mmUpdateChat *mChatServiceMockUpdateChat) Times(n uint64) *mChatServiceMockUpdateChat {
 	if n == 0 {
-		mmDelete.mock.t.Fatalf("Times of ChatServiceMock.Delete mock can not be zero")
+		mmUpdateChat.mock.t.Fatalf("Times of ChatServiceMock.UpdateChat mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDelete.expectedInvocations, n)
-	mmDelete.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDelete
+	mm_atomic.StoreUint64(&mmUpdateChat.expectedInvocations, n)
+	mmUpdateChat.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdateChat
 }
 
-func (mmDelete *mChatServiceMockDelete) invocationsDone() bool {
-	if len(mmDelete.expectations) == 0 && mmDelete.defaultExpectation == nil && mmDelete.mock.funcDelete == nil {
+func (mmUpdateChat *mChatServiceMockUpdateChat) invocationsDone() bool {
+	if len(mmUpdateChat.expectations) == 0 && mmUpdateChat.defaultExpectation == nil && mmUpdateChat.mock.funcUpdateChat == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDelete.mock.afterDeleteCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDelete.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdateChat.mock.afterUpdateChatCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdateChat.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Delete implements mm_service.ChatService
-func (mmDelete *ChatServiceMock) Delete(ctx context.Context, id int64) (err error) {
-	mm_atomic.AddUint64(&mmDelete.beforeDeleteCounter, 1)
-	defer mm_atomic.AddUint64(&mmDelete.afterDeleteCounter, 1)
+// UpdateChat implements mm_service.ChatService
+func (mmUpdateChat *ChatServiceMock) UpdateChat(ctx context.Context, actor string, update *model.ChatUpdate) (cp1 *model.Chat, err error) {
+	mm_atomic.AddUint64(&mmUpdateChat.beforeUpdateChatCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdateChat.afterUpdateChatCounter, 1)
 
-	mmDelete.t.Helper()
+	mmUpdateChat.t.Helper()
 
-	if mmDelete.inspectFuncDelete != nil {
-		mmDelete.inspectFuncDelete(ctx, id)
+	if mmUpdateChat.inspectFuncUpdateChat != nil {
+		mmUpdateChat.inspectFuncUpdateChat(ctx, actor, update)
 	}
 
-	mm_params := ChatServiceMockDeleteParams{ctx, id}
+	mm_params := ChatServiceMockUpdateChatParams{ctx, actor, update}
 
 	// Record call args
-	mmDelete.DeleteMock.mutex.Lock()
-	mmDelete.DeleteMock.callArgs = append(mmDelete.DeleteMock.callArgs, &mm_params)
-	mmDelete.DeleteMock.mutex.Unlock()
+	mmUpdateChat.UpdateChatMock.mutex.Lock()
+	mmUpdateChat.UpdateChatMock.callArgs = append(mmUpdateChat.UpdateChatMock.callArgs, &mm_params)
+	mmUpdateChat.UpdateChatMock.mutex.Unlock()
 
-	for _, e := range mmDelete.DeleteMock.expectations {
+	for _, e := range mmUpdateChat.UpdateChatMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.cp1, e.results.err
 		}
 	}
 
-	if mmDelete.DeleteMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDelete.DeleteMock.defaultExpectation.Counter, 1)
-		mm_want := mmDelete.DeleteMock.defaultExpectation.params
-		mm_want_ptrs := mmDelete.DeleteMock.defaultExpectation.paramPtrs
+	if mmUpdateChat.UpdateChatMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdateChat.UpdateChatMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdateChat.UpdateChatMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdateChat.UpdateChatMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockDeleteParams{ctx, id}
+		mm_got := ChatServiceMockUpdateChatParams{ctx, actor, update}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUpdateChat.t.Errorf("ChatServiceMock.UpdateChat got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
-				mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDelete.DeleteMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmUpdateChat.t.Errorf("ChatServiceMock.UpdateChat got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.update != nil && !minimock.Equal(*mm_want_ptrs.update, mm_got.update) {
+				mmUpdateChat.t.Errorf("ChatServiceMock.UpdateChat got unexpected parameter update, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.originUpdate, *mm_want_ptrs.update, mm_got.update, minimock.Diff(*mm_want_ptrs.update, mm_got.update))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDelete.t.Errorf("ChatServiceMock.Delete got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDelete.DeleteMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUpdateChat.t.Errorf("ChatServiceMock.UpdateChat got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdateChat.UpdateChatMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDelete.DeleteMock.defaultExpectation.results
+		mm_results := mmUpdateChat.UpdateChatMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDelete.t.Fatal("No results are set for the ChatServiceMock.Delete")
+			mmUpdateChat.t.Fatal("No results are set for the ChatServiceMock.UpdateChat")
 		}
-		return (*mm_results).err
+		return (*mm_results).cp1, (*mm_results).err
 	}
-	if mmDelete.funcDelete != nil {
-		return mmDelete.funcDelete(ctx, id)
+	if mmUpdateChat.funcUpdateChat != nil {
+		return mmUpdateChat.funcUpdateChat(ctx, actor, update)
 	}
-	mmDelete.t.Fatalf("Unexpected call to ChatServiceMock.Delete. %v %v", ctx, id)
+	mmUpdateChat.t.Fatalf("Unexpected call to ChatServiceMock.UpdateChat. %v %v %v", ctx, actor, update)
 	return
 }
 
-// DeleteAfterCounter returns a count of finished ChatServiceMock.Delete invocations
-func (mmDelete *ChatServiceMock) DeleteAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.afterDeleteCounter)
+// UpdateChatAfterCounter returns a count of finished ChatServiceMock.UpdateChat invocations
+func (mmUpdateChat *ChatServiceMock) UpdateChatAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChat.afterUpdateChatCounter)
 }
 
-// DeleteBeforeCounter returns a count of ChatServiceMock.Delete invocations
-func (mmDelete *ChatServiceMock) DeleteBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDelete.beforeDeleteCounter)
+// UpdateChatBeforeCounter returns a count of ChatServiceMock.UpdateChat invocations
+func (mmUpdateChat *ChatServiceMock) UpdateChatBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdateChat.beforeUpdateChatCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.Delete.
+// Calls returns a list of arguments used in each call to ChatServiceMock.UpdateChat.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDelete *mChatServiceMockDelete) Calls() []*ChatServiceMockDeleteParams {
-	mmDelete.mutex.RLock()
+func (mmUpdateChat *mChatServiceMockUpdateChat) Calls() []*ChatServiceMockUpdateChatParams {
+	mmUpdateChat.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockDeleteParams, len(mmDelete.callArgs))
-	copy(argCopy, mmDelete.callArgs)
+	argCopy := make([]*ChatServiceMockUpdateChatParams, len(mmUpdateChat.callArgs))
+	copy(argCopy, mmUpdateChat.callArgs)
 
-	mmDelete.mutex.RUnlock()
+	mmUpdateChat.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteDone returns true if the count of the Delete invocations corresponds
+// MinimockUpdateChatDone returns true if the count of the UpdateChat invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockDeleteDone() bool {
-	if m.DeleteMock.optional {
+func (m *ChatServiceMock) MinimockUpdateChatDone() bool {
+	if m.UpdateChatMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteMock.expectations {
+	for _, e := range m.UpdateChatMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteMock.invocationsDone()
+	return m.UpdateChatMock.invocationsDone()
 }
 
-// MinimockDeleteInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockDeleteInspect() {
-	for _, e := range m.DeleteMock.expectations {
+// MinimockUpdateChatInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUpdateChatInspect() {
+	for _, e := range m.UpdateChatMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UpdateChat at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCounter := mm_atomic.LoadUint64(&m.afterDeleteCounter)
+	afterUpdateChatCounter := mm_atomic.LoadUint64(&m.afterUpdateChatCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteMock.defaultExpectation != nil && afterDeleteCounter < 1 {
-		if m.DeleteMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.DeleteMock.defaultExpectation.returnOrigin)
+	if m.UpdateChatMock.defaultExpectation != nil && afterUpdateChatCounter < 1 {
+		if m.UpdateChatMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.UpdateChat at\n%s", m.UpdateChatMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s with params: %#v", m.DeleteMock.defaultExpectation.expectationOrigins.origin, *m.DeleteMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UpdateChat at\n%s with params: %#v", m.UpdateChatMock.defaultExpectation.expectationOrigins.origin, *m.UpdateChatMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDelete != nil && afterDeleteCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.Delete at\n%s", m.funcDeleteOrigin)
+	if m.funcUpdateChat != nil && afterUpdateChatCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.UpdateChat at\n%s", m.funcUpdateChatOrigin)
 	}
 
-	if !m.DeleteMock.invocationsDone() && afterDeleteCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.Delete at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteMock.expectedInvocations), m.DeleteMock.expectedInvocationsOrigin, afterDeleteCounter)
+	if !m.UpdateChatMock.invocationsDone() && afterUpdateChatCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UpdateChat at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdateChatMock.expectedInvocations), m.UpdateChatMock.expectedInvocationsOrigin, afterUpdateChatCounter)
 	}
 }
 
-type mChatServiceMockSendMessage struct {
+type mChatServiceMockUploadAttachment struct {
 	optional           bool
 	mock               *ChatServiceMock
-	defaultExpectation *ChatServiceMockSendMessageExpectation
-	expectations       []*ChatServiceMockSendMessageExpectation
+	defaultExpectation *ChatServiceMockUploadAttachmentExpectation
+	expectations       []*ChatServiceMockUploadAttachmentExpectation
 
-	callArgs []*ChatServiceMockSendMessageParams
+	callArgs []*ChatServiceMockUploadAttachmentParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ChatServiceMockSendMessageExpectation specifies expectation struct of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectation struct {
+// ChatServiceMockUploadAttachmentExpectation specifies expectation struct of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentExpectation struct {
 	mock               *ChatServiceMock
-	params             *ChatServiceMockSendMessageParams
-	paramPtrs          *ChatServiceMockSendMessageParamPtrs
-	expectationOrigins ChatServiceMockSendMessageExpectationOrigins
-	results            *ChatServiceMockSendMessageResults
+	params             *ChatServiceMockUploadAttachmentParams
+	paramPtrs          *ChatServiceMockUploadAttachmentParamPtrs
+	expectationOrigins ChatServiceMockUploadAttachmentExpectationOrigins
+	results            *ChatServiceMockUploadAttachmentResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ChatServiceMockSendMessageParams contains parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParams struct {
-	ctx context.Context
-	msg *model.Message
+// ChatServiceMockUploadAttachmentParams contains parameters of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentParams struct {
+	ctx    context.Context
+	upload *model.AttachmentUpload
+	r      io.Reader
 }
 
-// ChatServiceMockSendMessageParamPtrs contains pointers to parameters of the ChatService.SendMessage
-type ChatServiceMockSendMessageParamPtrs struct {
-	ctx *context.Context
-	msg **model.Message
+// ChatServiceMockUploadAttachmentParamPtrs contains pointers to parameters of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentParamPtrs struct {
+	ctx    *context.Context
+	upload **model.AttachmentUpload
+	r      *io.Reader
 }
 
-// ChatServiceMockSendMessageResults contains results of the ChatService.SendMessage
-type ChatServiceMockSendMessageResults struct {
+// ChatServiceMockUploadAttachmentResults contains results of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentResults struct {
+	ap1 *model.Attachment
 	err error
 }
 
-// ChatServiceMockSendMessageOrigins contains origins of expectations of the ChatService.SendMessage
-type ChatServiceMockSendMessageExpectationOrigins struct {
-	origin    string
-	originCtx string
-	originMsg string
+// ChatServiceMockUploadAttachmentOrigins contains origins of expectations of the ChatService.UploadAttachment
+type ChatServiceMockUploadAttachmentExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUpload string
+	originR      string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -801,292 +14995,320 @@ type ChatServiceMockSendMessageExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSendMessage *mChatServiceMockSendMessage) Optional() *mChatServiceMockSendMessage {
-	mmSendMessage.optional = true
-	return mmSendMessage
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Optional() *mChatServiceMockUploadAttachment {
+	mmUploadAttachment.optional = true
+	return mmUploadAttachment
 }
 
-// Expect sets up expected params for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Expect(ctx context.Context, msg *model.Message) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Expect sets up expected params for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Expect(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by ExpectParams functions")
+	if mmUploadAttachment.defaultExpectation.paramPtrs != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by ExpectParams functions")
 	}
 
-	mmSendMessage.defaultExpectation.params = &ChatServiceMockSendMessageParams{ctx, msg}
-	mmSendMessage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSendMessage.expectations {
-		if minimock.Equal(e.params, mmSendMessage.defaultExpectation.params) {
-			mmSendMessage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendMessage.defaultExpectation.params)
+	mmUploadAttachment.defaultExpectation.params = &ChatServiceMockUploadAttachmentParams{ctx, upload, r}
+	mmUploadAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUploadAttachment.expectations {
+		if minimock.Equal(e.params, mmUploadAttachment.defaultExpectation.params) {
+			mmUploadAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadAttachment.defaultExpectation.params)
 		}
 	}
 
-	return mmSendMessage
+	return mmUploadAttachment
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectCtxParam1(ctx context.Context) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectCtxParam1(ctx context.Context) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSendMessage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmUploadAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUploadAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUploadAttachment
 }
 
-// ExpectMsgParam2 sets up expected param msg for ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) ExpectMsgParam2(msg *model.Message) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// ExpectUploadParam2 sets up expected param upload for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectUploadParam2(upload *model.AttachmentUpload) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{}
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
 	}
 
-	if mmSendMessage.defaultExpectation.params != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Expect")
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
 	}
 
-	if mmSendMessage.defaultExpectation.paramPtrs == nil {
-		mmSendMessage.defaultExpectation.paramPtrs = &ChatServiceMockSendMessageParamPtrs{}
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
 	}
-	mmSendMessage.defaultExpectation.paramPtrs.msg = &msg
-	mmSendMessage.defaultExpectation.expectationOrigins.originMsg = minimock.CallerInfo(1)
+	mmUploadAttachment.defaultExpectation.paramPtrs.upload = &upload
+	mmUploadAttachment.defaultExpectation.expectationOrigins.originUpload = minimock.CallerInfo(1)
 
-	return mmSendMessage
+	return mmUploadAttachment
 }
 
-// Inspect accepts an inspector function that has same arguments as the ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Inspect(f func(ctx context.Context, msg *model.Message)) *mChatServiceMockSendMessage {
-	if mmSendMessage.mock.inspectFuncSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.SendMessage")
+// ExpectRParam3 sets up expected param r for ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) ExpectRParam3(r io.Reader) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
 
-	mmSendMessage.mock.inspectFuncSendMessage = f
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{}
+	}
 
-	return mmSendMessage
+	if mmUploadAttachment.defaultExpectation.params != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Expect")
+	}
+
+	if mmUploadAttachment.defaultExpectation.paramPtrs == nil {
+		mmUploadAttachment.defaultExpectation.paramPtrs = &ChatServiceMockUploadAttachmentParamPtrs{}
+	}
+	mmUploadAttachment.defaultExpectation.paramPtrs.r = &r
+	mmUploadAttachment.defaultExpectation.expectationOrigins.originR = minimock.CallerInfo(1)
+
+	return mmUploadAttachment
 }
 
-// Return sets up results that will be returned by ChatService.SendMessage
-func (mmSendMessage *mChatServiceMockSendMessage) Return(err error) *ChatServiceMock {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Inspect(f func(ctx context.Context, upload *model.AttachmentUpload, r io.Reader)) *mChatServiceMockUploadAttachment {
+	if mmUploadAttachment.mock.inspectFuncUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("Inspect function is already set for ChatServiceMock.UploadAttachment")
 	}
 
-	if mmSendMessage.defaultExpectation == nil {
-		mmSendMessage.defaultExpectation = &ChatServiceMockSendMessageExpectation{mock: mmSendMessage.mock}
+	mmUploadAttachment.mock.inspectFuncUploadAttachment = f
+
+	return mmUploadAttachment
+}
+
+// Return sets up results that will be returned by ChatService.UploadAttachment
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Return(ap1 *model.Attachment, err error) *ChatServiceMock {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
-	mmSendMessage.defaultExpectation.results = &ChatServiceMockSendMessageResults{err}
-	mmSendMessage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+
+	if mmUploadAttachment.defaultExpectation == nil {
+		mmUploadAttachment.defaultExpectation = &ChatServiceMockUploadAttachmentExpectation{mock: mmUploadAttachment.mock}
+	}
+	mmUploadAttachment.defaultExpectation.results = &ChatServiceMockUploadAttachmentResults{ap1, err}
+	mmUploadAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUploadAttachment.mock
 }
 
-// Set uses given function f to mock the ChatService.SendMessage method
-func (mmSendMessage *mChatServiceMockSendMessage) Set(f func(ctx context.Context, msg *model.Message) (err error)) *ChatServiceMock {
-	if mmSendMessage.defaultExpectation != nil {
-		mmSendMessage.mock.t.Fatalf("Default expectation is already set for the ChatService.SendMessage method")
+// Set uses given function f to mock the ChatService.UploadAttachment method
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Set(f func(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) (ap1 *model.Attachment, err error)) *ChatServiceMock {
+	if mmUploadAttachment.defaultExpectation != nil {
+		mmUploadAttachment.mock.t.Fatalf("Default expectation is already set for the ChatService.UploadAttachment method")
 	}
 
-	if len(mmSendMessage.expectations) > 0 {
-		mmSendMessage.mock.t.Fatalf("Some expectations are already set for the ChatService.SendMessage method")
+	if len(mmUploadAttachment.expectations) > 0 {
+		mmUploadAttachment.mock.t.Fatalf("Some expectations are already set for the ChatService.UploadAttachment method")
 	}
 
-	mmSendMessage.mock.funcSendMessage = f
-	mmSendMessage.mock.funcSendMessageOrigin = minimock.CallerInfo(1)
-	return mmSendMessage.mock
+	mmUploadAttachment.mock.funcUploadAttachment = f
+	mmUploadAttachment.mock.funcUploadAttachmentOrigin = minimock.CallerInfo(1)
+	return mmUploadAttachment.mock
 }
 
-// When sets expectation for the ChatService.SendMessage which will trigger the result defined by the following
+// When sets expectation for the ChatService.UploadAttachment which will trigger the result defined by the following
 // Then helper
-func (mmSendMessage *mChatServiceMockSendMessage) When(ctx context.Context, msg *model.Message) *ChatServiceMockSendMessageExpectation {
-	if mmSendMessage.mock.funcSendMessage != nil {
-		mmSendMessage.mock.t.Fatalf("ChatServiceMock.SendMessage mock is already set by Set")
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) When(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) *ChatServiceMockUploadAttachmentExpectation {
+	if mmUploadAttachment.mock.funcUploadAttachment != nil {
+		mmUploadAttachment.mock.t.Fatalf("ChatServiceMock.UploadAttachment mock is already set by Set")
 	}
 
-	expectation := &ChatServiceMockSendMessageExpectation{
-		mock:               mmSendMessage.mock,
-		params:             &ChatServiceMockSendMessageParams{ctx, msg},
-		expectationOrigins: ChatServiceMockSendMessageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ChatServiceMockUploadAttachmentExpectation{
+		mock:               mmUploadAttachment.mock,
+		params:             &ChatServiceMockUploadAttachmentParams{ctx, upload, r},
+		expectationOrigins: ChatServiceMockUploadAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSendMessage.expectations = append(mmSendMessage.expectations, expectation)
+	mmUploadAttachment.expectations = append(mmUploadAttachment.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ChatService.SendMessage return parameters for the expectation previously defined by the When method
-func (e *ChatServiceMockSendMessageExpectation) Then(err error) *ChatServiceMock {
-	e.results = &ChatServiceMockSendMessageResults{err}
+// Then sets up ChatService.UploadAttachment return parameters for the expectation previously defined by the When method
+func (e *ChatServiceMockUploadAttachmentExpectation) Then(ap1 *model.Attachment, err error) *ChatServiceMock {
+	e.results = &ChatServiceMockUploadAttachmentResults{ap1, err}
 	return e.mock
 }
 
-// Times sets number of times ChatService.SendMessage should be invoked
-func (mmSendMessage *mChatServiceMockSendMessage) Times(n uint64) *mChatServiceMockSendMessage {
+// Times sets number of times ChatService.UploadAttachment should be invoked
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Times(n uint64) *mChatServiceMockUploadAttachment {
 	if n == 0 {
-		mmSendMessage.mock.t.Fatalf("Times of ChatServiceMock.SendMessage mock can not be zero")
+		mmUploadAttachment.mock.t.Fatalf("Times of ChatServiceMock.UploadAttachment mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSendMessage.expectedInvocations, n)
-	mmSendMessage.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSendMessage
+	mm_atomic.StoreUint64(&mmUploadAttachment.expectedInvocations, n)
+	mmUploadAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUploadAttachment
 }
 
-func (mmSendMessage *mChatServiceMockSendMessage) invocationsDone() bool {
-	if len(mmSendMessage.expectations) == 0 && mmSendMessage.defaultExpectation == nil && mmSendMessage.mock.funcSendMessage == nil {
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) invocationsDone() bool {
+	if len(mmUploadAttachment.expectations) == 0 && mmUploadAttachment.defaultExpectation == nil && mmUploadAttachment.mock.funcUploadAttachment == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSendMessage.mock.afterSendMessageCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSendMessage.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadAttachment.mock.afterUploadAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadAttachment.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SendMessage implements mm_service.ChatService
-func (mmSendMessage *ChatServiceMock) SendMessage(ctx context.Context, msg *model.Message) (err error) {
-	mm_atomic.AddUint64(&mmSendMessage.beforeSendMessageCounter, 1)
-	defer mm_atomic.AddUint64(&mmSendMessage.afterSendMessageCounter, 1)
+// UploadAttachment implements mm_service.ChatService
+func (mmUploadAttachment *ChatServiceMock) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload, r io.Reader) (ap1 *model.Attachment, err error) {
+	mm_atomic.AddUint64(&mmUploadAttachment.beforeUploadAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadAttachment.afterUploadAttachmentCounter, 1)
 
-	mmSendMessage.t.Helper()
+	mmUploadAttachment.t.Helper()
 
-	if mmSendMessage.inspectFuncSendMessage != nil {
-		mmSendMessage.inspectFuncSendMessage(ctx, msg)
+	if mmUploadAttachment.inspectFuncUploadAttachment != nil {
+		mmUploadAttachment.inspectFuncUploadAttachment(ctx, upload, r)
 	}
 
-	mm_params := ChatServiceMockSendMessageParams{ctx, msg}
+	mm_params := ChatServiceMockUploadAttachmentParams{ctx, upload, r}
 
 	// Record call args
-	mmSendMessage.SendMessageMock.mutex.Lock()
-	mmSendMessage.SendMessageMock.callArgs = append(mmSendMessage.SendMessageMock.callArgs, &mm_params)
-	mmSendMessage.SendMessageMock.mutex.Unlock()
+	mmUploadAttachment.UploadAttachmentMock.mutex.Lock()
+	mmUploadAttachment.UploadAttachmentMock.callArgs = append(mmUploadAttachment.UploadAttachmentMock.callArgs, &mm_params)
+	mmUploadAttachment.UploadAttachmentMock.mutex.Unlock()
 
-	for _, e := range mmSendMessage.SendMessageMock.expectations {
+	for _, e := range mmUploadAttachment.UploadAttachmentMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ap1, e.results.err
 		}
 	}
 
-	if mmSendMessage.SendMessageMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSendMessage.SendMessageMock.defaultExpectation.Counter, 1)
-		mm_want := mmSendMessage.SendMessageMock.defaultExpectation.params
-		mm_want_ptrs := mmSendMessage.SendMessageMock.defaultExpectation.paramPtrs
+	if mmUploadAttachment.UploadAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadAttachment.UploadAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.paramPtrs
 
-		mm_got := ChatServiceMockSendMessageParams{ctx, msg}
+		mm_got := ChatServiceMockUploadAttachmentParams{ctx, upload, r}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAttachment.UploadAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.msg != nil && !minimock.Equal(*mm_want_ptrs.msg, mm_got.msg) {
-				mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameter msg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.originMsg, *mm_want_ptrs.msg, mm_got.msg, minimock.Diff(*mm_want_ptrs.msg, mm_got.msg))
+			if mm_want_ptrs.upload != nil && !minimock.Equal(*mm_want_ptrs.upload, mm_got.upload) {
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter upload, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAttachment.UploadAttachmentMock.defaultExpectation.expectationOrigins.originUpload, *mm_want_ptrs.upload, mm_got.upload, minimock.Diff(*mm_want_ptrs.upload, mm_got.upload))
+			}
+
+			if mm_want_ptrs.r != nil && !minimock.Equal(*mm_want_ptrs.r, mm_got.r) {
+				mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameter r, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadAttachment.UploadAttachmentMock.defaultExpectation.expectationOrigins.originR, *mm_want_ptrs.r, mm_got.r, minimock.Diff(*mm_want_ptrs.r, mm_got.r))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSendMessage.t.Errorf("ChatServiceMock.SendMessage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSendMessage.SendMessageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmUploadAttachment.t.Errorf("ChatServiceMock.UploadAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUploadAttachment.UploadAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSendMessage.SendMessageMock.defaultExpectation.results
+		mm_results := mmUploadAttachment.UploadAttachmentMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSendMessage.t.Fatal("No results are set for the ChatServiceMock.SendMessage")
+			mmUploadAttachment.t.Fatal("No results are set for the ChatServiceMock.UploadAttachment")
 		}
-		return (*mm_results).err
+		return (*mm_results).ap1, (*mm_results).err
 	}
-	if mmSendMessage.funcSendMessage != nil {
-		return mmSendMessage.funcSendMessage(ctx, msg)
+	if mmUploadAttachment.funcUploadAttachment != nil {
+		return mmUploadAttachment.funcUploadAttachment(ctx, upload, r)
 	}
-	mmSendMessage.t.Fatalf("Unexpected call to ChatServiceMock.SendMessage. %v %v", ctx, msg)
+	mmUploadAttachment.t.Fatalf("Unexpected call to ChatServiceMock.UploadAttachment. %v %v %v", ctx, upload, r)
 	return
 }
 
-// SendMessageAfterCounter returns a count of finished ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.afterSendMessageCounter)
+// UploadAttachmentAfterCounter returns a count of finished ChatServiceMock.UploadAttachment invocations
+func (mmUploadAttachment *ChatServiceMock) UploadAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAttachment.afterUploadAttachmentCounter)
 }
 
-// SendMessageBeforeCounter returns a count of ChatServiceMock.SendMessage invocations
-func (mmSendMessage *ChatServiceMock) SendMessageBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSendMessage.beforeSendMessageCounter)
+// UploadAttachmentBeforeCounter returns a count of ChatServiceMock.UploadAttachment invocations
+func (mmUploadAttachment *ChatServiceMock) UploadAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadAttachment.beforeUploadAttachmentCounter)
 }
 
-// Calls returns a list of arguments used in each call to ChatServiceMock.SendMessage.
+// Calls returns a list of arguments used in each call to ChatServiceMock.UploadAttachment.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSendMessage *mChatServiceMockSendMessage) Calls() []*ChatServiceMockSendMessageParams {
-	mmSendMessage.mutex.RLock()
+func (mmUploadAttachment *mChatServiceMockUploadAttachment) Calls() []*ChatServiceMockUploadAttachmentParams {
+	mmUploadAttachment.mutex.RLock()
 
-	argCopy := make([]*ChatServiceMockSendMessageParams, len(mmSendMessage.callArgs))
-	copy(argCopy, mmSendMessage.callArgs)
+	argCopy := make([]*ChatServiceMockUploadAttachmentParams, len(mmUploadAttachment.callArgs))
+	copy(argCopy, mmUploadAttachment.callArgs)
 
-	mmSendMessage.mutex.RUnlock()
+	mmUploadAttachment.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSendMessageDone returns true if the count of the SendMessage invocations corresponds
+// MinimockUploadAttachmentDone returns true if the count of the UploadAttachment invocations corresponds
 // the number of defined expectations
-func (m *ChatServiceMock) MinimockSendMessageDone() bool {
-	if m.SendMessageMock.optional {
+func (m *ChatServiceMock) MinimockUploadAttachmentDone() bool {
+	if m.UploadAttachmentMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SendMessageMock.expectations {
+	for _, e := range m.UploadAttachmentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SendMessageMock.invocationsDone()
+	return m.UploadAttachmentMock.invocationsDone()
 }
 
-// MinimockSendMessageInspect logs each unmet expectation
-func (m *ChatServiceMock) MinimockSendMessageInspect() {
-	for _, e := range m.SendMessageMock.expectations {
+// MinimockUploadAttachmentInspect logs each unmet expectation
+func (m *ChatServiceMock) MinimockUploadAttachmentInspect() {
+	for _, e := range m.UploadAttachmentMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSendMessageCounter := mm_atomic.LoadUint64(&m.afterSendMessageCounter)
+	afterUploadAttachmentCounter := mm_atomic.LoadUint64(&m.afterUploadAttachmentCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SendMessageMock.defaultExpectation != nil && afterSendMessageCounter < 1 {
-		if m.SendMessageMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.SendMessageMock.defaultExpectation.returnOrigin)
+	if m.UploadAttachmentMock.defaultExpectation != nil && afterUploadAttachmentCounter < 1 {
+		if m.UploadAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment at\n%s", m.UploadAttachmentMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s with params: %#v", m.SendMessageMock.defaultExpectation.expectationOrigins.origin, *m.SendMessageMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment at\n%s with params: %#v", m.UploadAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.UploadAttachmentMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSendMessage != nil && afterSendMessageCounter < 1 {
-		m.t.Errorf("Expected call to ChatServiceMock.SendMessage at\n%s", m.funcSendMessageOrigin)
+	if m.funcUploadAttachment != nil && afterUploadAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to ChatServiceMock.UploadAttachment at\n%s", m.funcUploadAttachmentOrigin)
 	}
 
-	if !m.SendMessageMock.invocationsDone() && afterSendMessageCounter > 0 {
-		m.t.Errorf("Expected %d calls to ChatServiceMock.SendMessage at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SendMessageMock.expectedInvocations), m.SendMessageMock.expectedInvocationsOrigin, afterSendMessageCounter)
+	if !m.UploadAttachmentMock.invocationsDone() && afterUploadAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ChatServiceMock.UploadAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadAttachmentMock.expectedInvocations), m.UploadAttachmentMock.expectedInvocationsOrigin, afterUploadAttachmentCounter)
 	}
 }
 
@@ -1094,11 +15316,85 @@ func (m *ChatServiceMock) MinimockSendMessageInspect() {
 func (m *ChatServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAckMessageInspect()
+
+			m.MinimockAddMembersInspect()
+
+			m.MinimockAddReactionInspect()
+
+			m.MinimockCancelScheduledMessageInspect()
+
+			m.MinimockConnectChatInspect()
+
 			m.MinimockCreateInspect()
 
 			m.MinimockDeleteInspect()
 
+			m.MinimockDeleteExpiredMessagesInspect()
+
+			m.MinimockDeleteMessageInspect()
+
+			m.MinimockDeleteStaleUploadsInspect()
+
+			m.MinimockDeliverScheduledMessageInspect()
+
+			m.MinimockEditMessageInspect()
+
+			m.MinimockGetChatInspect()
+
+			m.MinimockGetOrCreateDirectChatInspect()
+
+			m.MinimockGetPresenceInspect()
+
+			m.MinimockGetReadStateInspect()
+
+			m.MinimockHeartbeatInspect()
+
+			m.MinimockLeaveChatInspect()
+
+			m.MinimockListChatsInspect()
+
+			m.MinimockListMentionsInspect()
+
+			m.MinimockListMessageRangeInspect()
+
+			m.MinimockListMessagesInspect()
+
+			m.MinimockListPinnedInspect()
+
+			m.MinimockListScheduledMessagesInspect()
+
+			m.MinimockListThreadInspect()
+
+			m.MinimockMarkReadInspect()
+
+			m.MinimockOpenAttachmentInspect()
+
+			m.MinimockPinMessageInspect()
+
+			m.MinimockPublishPresenceChangesInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockRemoveReactionInspect()
+
+			m.MinimockScheduleMessageInspect()
+
+			m.MinimockSearchMessagesInspect()
+
 			m.MinimockSendMessageInspect()
+
+			m.MinimockSendTypingInspect()
+
+			m.MinimockSetMemberRoleInspect()
+
+			m.MinimockSyncInspect()
+
+			m.MinimockUnpinMessageInspect()
+
+			m.MinimockUpdateChatInspect()
+
+			m.MinimockUploadAttachmentInspect()
 		}
 	})
 }
@@ -1122,7 +15418,44 @@ func (m *ChatServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ChatServiceMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAckMessageDone() &&
+		m.MinimockAddMembersDone() &&
+		m.MinimockAddReactionDone() &&
+		m.MinimockCancelScheduledMessageDone() &&
+		m.MinimockConnectChatDone() &&
 		m.MinimockCreateDone() &&
 		m.MinimockDeleteDone() &&
-		m.MinimockSendMessageDone()
+		m.MinimockDeleteExpiredMessagesDone() &&
+		m.MinimockDeleteMessageDone() &&
+		m.MinimockDeleteStaleUploadsDone() &&
+		m.MinimockDeliverScheduledMessageDone() &&
+		m.MinimockEditMessageDone() &&
+		m.MinimockGetChatDone() &&
+		m.MinimockGetOrCreateDirectChatDone() &&
+		m.MinimockGetPresenceDone() &&
+		m.MinimockGetReadStateDone() &&
+		m.MinimockHeartbeatDone() &&
+		m.MinimockLeaveChatDone() &&
+		m.MinimockListChatsDone() &&
+		m.MinimockListMentionsDone() &&
+		m.MinimockListMessageRangeDone() &&
+		m.MinimockListMessagesDone() &&
+		m.MinimockListPinnedDone() &&
+		m.MinimockListScheduledMessagesDone() &&
+		m.MinimockListThreadDone() &&
+		m.MinimockMarkReadDone() &&
+		m.MinimockOpenAttachmentDone() &&
+		m.MinimockPinMessageDone() &&
+		m.MinimockPublishPresenceChangesDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockRemoveReactionDone() &&
+		m.MinimockScheduleMessageDone() &&
+		m.MinimockSearchMessagesDone() &&
+		m.MinimockSendMessageDone() &&
+		m.MinimockSendTypingDone() &&
+		m.MinimockSetMemberRoleDone() &&
+		m.MinimockSyncDone() &&
+		m.MinimockUnpinMessageDone() &&
+		m.MinimockUpdateChatDone() &&
+		m.MinimockUploadAttachmentDone()
 }
@@ -0,0 +1,122 @@
+package thumbnail
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif" // registers the GIF decoder
+	"image/jpeg"
+	"image/png"
+
+	xdraw "golang.org/x/image/draw"
+	_ "golang.org/x/image/webp" // registers the WebP decoder
+)
+
+// Sizes are the bounding boxes, in pixels, thumbnails are rendered into.
+var Sizes = []int{160, 480, 1024}
+
+// maxPixels guards against decompression bombs: tiny files that claim
+// enormous dimensions. A decoded image takes up to 4 bytes per pixel, so this
+// bounds a single decode at about 64 MiB.
+const maxPixels = 16_000_000
+
+const jpegQuality = 80
+
+// ErrUnsupported is returned for content that is not a decodable image.
+var ErrUnsupported = errors.New("unsupported image")
+
+// Thumbnail is an encoded, scaled down copy of an image.
+type Thumbnail struct {
+	Width    int
+	Height   int
+	MimeType string
+	Data     []byte
+}
+
+// Result holds the dimensions of the original image and its thumbnails.
+type Result struct {
+	Width      int
+	Height     int
+	Thumbnails []*Thumbnail
+}
+
+// Supported reports whether thumbnails can be made for the MIME type.
+func Supported(mimeType string) bool {
+	switch mimeType {
+	case "image/jpeg", "image/png", "image/gif", "image/webp":
+		return true
+	}
+	return false
+}
+
+// Make decodes an image and renders a thumbnail for every size smaller than
+// the image itself. The longer side of each thumbnail is its size, which
+// tells the thumbnails apart; the shorter side may repeat for narrow images.
+// Images with transparency are encoded as PNG, all others as JPEG.
+func Make(data []byte) (*Result, error) {
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return nil, fmt.Errorf("%w: %dx%d pixels", ErrUnsupported, cfg.Width, cfg.Height)
+	}
+
+	src, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+
+	res := &Result{Width: cfg.Width, Height: cfg.Height}
+	transparent := format == "png" || format == "gif"
+
+	for _, size := range Sizes {
+		if cfg.Width <= size && cfg.Height <= size {
+			break
+		}
+
+		thumb, err := render(src, size, transparent)
+		if err != nil {
+			return nil, err
+		}
+		res.Thumbnails = append(res.Thumbnails, thumb)
+	}
+
+	return res, nil
+}
+
+func render(src image.Image, size int, transparent bool) (*Thumbnail, error) {
+	width, height := fit(src.Bounds().Dx(), src.Bounds().Dy(), size)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	if !transparent {
+		// JPEG has no alpha channel: flatten onto white.
+		draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	}
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), src, src.Bounds(), draw.Over, nil)
+
+	var buf bytes.Buffer
+	mimeType := "image/jpeg"
+	if transparent {
+		mimeType = "image/png"
+		if err := png.Encode(&buf, dst); err != nil {
+			return nil, fmt.Errorf("encode thumbnail: %w", err)
+		}
+	} else if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return nil, fmt.Errorf("encode thumbnail: %w", err)
+	}
+
+	return &Thumbnail{Width: width, Height: height, MimeType: mimeType, Data: buf.Bytes()}, nil
+}
+
+// fit scales width and height down to fit a size x size box, keeping the
+// aspect ratio.
+func fit(width, height, size int) (int, int) {
+	if width >= height {
+		return size, max(1, height*size/width)
+	}
+	return max(1, width*size/height), size
+}
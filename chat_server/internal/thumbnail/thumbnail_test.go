@@ -0,0 +1,157 @@
+package thumbnail
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func encodeJPEG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)), nil))
+	return buf.Bytes()
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 128})
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// withPNGSize rewrites the dimensions in the header of a PNG without touching
+// its pixel data, the way a decompression bomb would.
+func withPNGSize(data []byte, width, height uint32) []byte {
+	res := bytes.Clone(data)
+	// Signature (8), chunk length (4), "IHDR" (4), then width and height.
+	binary.BigEndian.PutUint32(res[16:], width)
+	binary.BigEndian.PutUint32(res[20:], height)
+	// The CRC covers the chunk type and its 13 data bytes.
+	binary.BigEndian.PutUint32(res[29:], crc32.ChecksumIEEE(res[12:29]))
+	return res
+}
+
+func TestFit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		width, height, size int
+		wantW, wantH        int
+	}{
+		{2000, 1000, 160, 160, 80},
+		{1000, 2000, 160, 80, 160},
+		{500, 500, 480, 480, 480},
+		{10000, 1, 160, 160, 1},
+		{1, 10000, 160, 1, 160},
+	}
+
+	for _, tt := range tests {
+		w, h := fit(tt.width, tt.height, tt.size)
+		require.Equal(t, [2]int{tt.wantW, tt.wantH}, [2]int{w, h}, "%dx%d into %d", tt.width, tt.height, tt.size)
+	}
+}
+
+func TestMake(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		data     []byte
+		wantSize [2]int
+		wantMime string
+		want     [][2]int
+	}{
+		{
+			name:     "smaller than every size",
+			data:     encodeJPEG(t, 100, 100),
+			wantSize: [2]int{100, 100},
+		},
+		{
+			name:     "only smaller sizes are rendered",
+			data:     encodeJPEG(t, 300, 200),
+			wantSize: [2]int{300, 200},
+			wantMime: "image/jpeg",
+			want:     [][2]int{{160, 106}},
+		},
+		{
+			name:     "every size",
+			data:     encodeJPEG(t, 2000, 1000),
+			wantSize: [2]int{2000, 1000},
+			wantMime: "image/jpeg",
+			want:     [][2]int{{160, 80}, {480, 240}, {1024, 512}},
+		},
+		{
+			name:     "narrow image",
+			data:     encodeJPEG(t, 3, 3000),
+			wantSize: [2]int{3, 3000},
+			wantMime: "image/jpeg",
+			want:     [][2]int{{1, 160}, {1, 480}, {1, 1024}},
+		},
+		{
+			name:     "transparency is kept",
+			data:     encodePNG(t, 200, 400),
+			wantSize: [2]int{200, 400},
+			wantMime: "image/png",
+			want:     [][2]int{{80, 160}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Make(tt.data)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSize, [2]int{res.Width, res.Height})
+			require.Len(t, res.Thumbnails, len(tt.want))
+
+			for i, thumb := range res.Thumbnails {
+				require.Equal(t, tt.want[i], [2]int{thumb.Width, thumb.Height})
+				require.Equal(t, tt.wantMime, thumb.MimeType)
+
+				cfg, _, err := image.DecodeConfig(bytes.NewReader(thumb.Data))
+				require.NoError(t, err)
+				require.Equal(t, tt.want[i], [2]int{cfg.Width, cfg.Height})
+			}
+		})
+	}
+}
+
+func TestMakeRejects(t *testing.T) {
+	t.Parallel()
+
+	small := encodePNG(t, 1, 1)
+
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not an image", data: []byte("hello")},
+		{name: "truncated", data: small[:len(small)/2]},
+		{name: "too many pixels", data: withPNGSize(small, 4000, 4001)},
+		{name: "too wide", data: withPNGSize(small, 1<<30, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := Make(tt.data)
+			require.ErrorIs(t, err, ErrUnsupported)
+			require.Nil(t, res)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+-- +goose Up
+CREATE INDEX messages_chat_id_id_idx ON messages (chat_id, id);
+CREATE INDEX chat_users_chat_id_username_idx ON chat_users (chat_id, username);
+
+-- +goose Down
+DROP INDEX chat_users_chat_id_username_idx;
+DROP INDEX messages_chat_id_id_idx;
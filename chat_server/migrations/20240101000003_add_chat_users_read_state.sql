@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE chat_users ADD COLUMN last_read_message_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX chat_users_username_idx ON chat_users (username);
+
+-- +goose Down
+DROP INDEX chat_users_username_idx;
+
+ALTER TABLE chat_users DROP COLUMN last_read_message_id;
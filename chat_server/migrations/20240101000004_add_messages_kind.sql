@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE messages ADD COLUMN kind VARCHAR(16) NOT NULL DEFAULT 'user';
+
+-- +goose Down
+ALTER TABLE messages DROP COLUMN kind;
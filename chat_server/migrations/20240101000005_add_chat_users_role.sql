@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE chat_users ADD COLUMN role VARCHAR(16) NOT NULL DEFAULT 'member';
+
+-- Chats created before roles existed get their first member as the owner.
+UPDATE chat_users cu
+SET role = 'owner'
+WHERE cu.id = (SELECT MIN(id) FROM chat_users WHERE chat_id = cu.chat_id);
+
+-- +goose Down
+ALTER TABLE chat_users DROP COLUMN role;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE chats
+    ADD COLUMN title VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN avatar_url TEXT NOT NULL DEFAULT '',
+    ADD COLUMN type VARCHAR(16) NOT NULL DEFAULT 'group';
+
+-- +goose Down
+ALTER TABLE chats
+    DROP COLUMN type,
+    DROP COLUMN avatar_url,
+    DROP COLUMN description,
+    DROP COLUMN title;
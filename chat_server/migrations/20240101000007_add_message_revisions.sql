@@ -0,0 +1,20 @@
+-- +goose Up
+ALTER TABLE messages
+    ADD COLUMN edited_at TIMESTAMP,
+    ADD COLUMN deleted_at TIMESTAMP;
+
+CREATE TABLE message_revisions (
+    id SERIAL PRIMARY KEY,
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    text TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX message_revisions_message_id_idx ON message_revisions (message_id);
+
+-- +goose Down
+DROP TABLE message_revisions;
+
+ALTER TABLE messages
+    DROP COLUMN deleted_at,
+    DROP COLUMN edited_at;
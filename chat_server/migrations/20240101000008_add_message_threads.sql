@@ -0,0 +1,16 @@
+-- +goose Up
+ALTER TABLE messages
+    ADD COLUMN reply_to_message_id INTEGER REFERENCES messages(id) ON DELETE CASCADE,
+    ADD COLUMN reply_count INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN last_reply_at TIMESTAMP;
+
+CREATE INDEX messages_reply_to_message_id_id_idx ON messages (reply_to_message_id, id)
+    WHERE reply_to_message_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX messages_reply_to_message_id_id_idx;
+
+ALTER TABLE messages
+    DROP COLUMN last_reply_at,
+    DROP COLUMN reply_count,
+    DROP COLUMN reply_to_message_id;
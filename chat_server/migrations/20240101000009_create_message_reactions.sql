@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE message_reactions (
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    username VARCHAR(255) NOT NULL,
+    emoji VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, username, emoji)
+);
+
+-- +goose Down
+DROP TABLE message_reactions;
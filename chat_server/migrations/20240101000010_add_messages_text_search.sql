@@ -0,0 +1,12 @@
+-- +goose Up
+-- The simple configuration does no stemming, so search works the same for
+-- every language people write in.
+ALTER TABLE messages
+    ADD COLUMN text_search tsvector GENERATED ALWAYS AS (to_tsvector('simple', text)) STORED;
+
+CREATE INDEX messages_text_search_idx ON messages USING GIN (text_search);
+
+-- +goose Down
+DROP INDEX messages_text_search_idx;
+
+ALTER TABLE messages DROP COLUMN text_search;
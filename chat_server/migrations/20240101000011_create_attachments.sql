@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE attachments (
+    id SERIAL PRIMARY KEY,
+    chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    -- message_id is NULL until the attachment is sent with a message.
+    message_id INTEGER REFERENCES messages(id) ON DELETE CASCADE,
+    uploaded_by VARCHAR(255) NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    mime_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL,
+    sha256 CHAR(64) NOT NULL,
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX attachments_message_id_idx ON attachments (message_id);
+
+-- +goose Down
+DROP TABLE attachments;
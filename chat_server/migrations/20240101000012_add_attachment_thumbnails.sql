@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE attachments
+    ADD COLUMN width INTEGER,
+    ADD COLUMN height INTEGER,
+    ADD COLUMN thumbnails JSONB NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE attachments
+    DROP COLUMN thumbnails,
+    DROP COLUMN height,
+    DROP COLUMN width;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Clients may tag a new message or chat with a key of their own so that a
+-- retried request finds the row stored by the first attempt.
+ALTER TABLE messages ADD COLUMN client_message_id VARCHAR(64);
+
+CREATE UNIQUE INDEX messages_chat_id_from_user_client_message_id_idx ON messages (chat_id, from_user, client_message_id)
+    WHERE client_message_id IS NOT NULL;
+
+ALTER TABLE chats
+    ADD COLUMN created_by VARCHAR(255),
+    ADD COLUMN client_request_id VARCHAR(64);
+
+CREATE UNIQUE INDEX chats_created_by_client_request_id_idx ON chats (created_by, client_request_id)
+    WHERE client_request_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX chats_created_by_client_request_id_idx;
+
+ALTER TABLE chats
+    DROP COLUMN client_request_id,
+    DROP COLUMN created_by;
+
+DROP INDEX messages_chat_id_from_user_client_message_id_idx;
+
+ALTER TABLE messages DROP COLUMN client_message_id;
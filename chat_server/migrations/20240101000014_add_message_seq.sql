@@ -0,0 +1,26 @@
+-- +goose Up
+-- chats.last_seq is the counter messages.seq is taken from. Incrementing it
+-- in the insert transaction locks the chat row, so sequence numbers of a chat
+-- are handed out one at a time and a rolled back insert leaves no gap.
+ALTER TABLE chats ADD COLUMN last_seq BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE messages ADD COLUMN seq BIGINT;
+
+UPDATE messages
+SET seq = numbered.seq
+FROM (SELECT id, ROW_NUMBER() OVER (PARTITION BY chat_id ORDER BY id) AS seq FROM messages) AS numbered
+WHERE messages.id = numbered.id;
+
+UPDATE chats
+SET last_seq = COALESCE((SELECT MAX(seq) FROM messages WHERE messages.chat_id = chats.id), 0);
+
+ALTER TABLE messages ALTER COLUMN seq SET NOT NULL;
+
+CREATE UNIQUE INDEX messages_chat_id_seq_idx ON messages (chat_id, seq);
+
+-- +goose Down
+DROP INDEX messages_chat_id_seq_idx;
+
+ALTER TABLE messages DROP COLUMN seq;
+
+ALTER TABLE chats DROP COLUMN last_seq;
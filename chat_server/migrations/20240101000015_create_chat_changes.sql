@@ -0,0 +1,20 @@
+-- +goose Up
+-- chat_changes is the log Sync reads. tx_id is the id of the transaction that
+-- wrote the row: ids of transactions below the xmin of a snapshot are all
+-- finished, so a sync window ending at that xmin never misses a change that
+-- commits later.
+CREATE TABLE chat_changes (
+    id BIGSERIAL PRIMARY KEY,
+    tx_id BIGINT NOT NULL DEFAULT (pg_current_xact_id()::text::bigint),
+    chat_id INTEGER NOT NULL,
+    kind VARCHAR(32) NOT NULL,
+    message_id BIGINT,
+    username VARCHAR(255),
+    role VARCHAR(16),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX chat_changes_tx_id_idx ON chat_changes (tx_id);
+
+-- +goose Down
+DROP TABLE chat_changes;
@@ -0,0 +1,34 @@
+-- +goose Up
+-- A direct chat is keyed by its two members in sorted order, so that the same
+-- pair can never end up with two direct chats.
+ALTER TABLE chats
+    ADD COLUMN direct_user_low VARCHAR(255),
+    ADD COLUMN direct_user_high VARCHAR(255);
+
+-- Existing duplicates keep working as plain chats; the oldest direct chat of
+-- each pair becomes the one that is found from now on.
+UPDATE chats
+SET direct_user_low = pairs.low, direct_user_high = pairs.high
+FROM (
+    SELECT DISTINCT ON (low, high) chat_id, low, high
+    FROM (
+        SELECT cu.chat_id, MIN(cu.username) AS low, MAX(cu.username) AS high
+        FROM chat_users cu
+        JOIN chats c ON c.id = cu.chat_id
+        WHERE c.type = 'direct'
+        GROUP BY cu.chat_id
+        HAVING COUNT(*) = 2
+    ) AS direct_members
+    ORDER BY low, high, chat_id
+) AS pairs
+WHERE chats.id = pairs.chat_id;
+
+CREATE UNIQUE INDEX chats_direct_pair_idx ON chats (direct_user_low, direct_user_high)
+    WHERE direct_user_low IS NOT NULL;
+
+-- +goose Down
+DROP INDEX chats_direct_pair_idx;
+
+ALTER TABLE chats
+    DROP COLUMN direct_user_high,
+    DROP COLUMN direct_user_low;
@@ -0,0 +1,13 @@
+-- +goose Up
+CREATE TABLE message_mentions (
+    message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+    chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    username VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (message_id, username)
+);
+
+CREATE INDEX message_mentions_username_message_id_idx ON message_mentions (username, message_id);
+
+-- +goose Down
+DROP TABLE message_mentions;
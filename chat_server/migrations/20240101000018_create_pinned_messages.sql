@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE pinned_messages (
+    message_id INTEGER PRIMARY KEY REFERENCES messages(id) ON DELETE CASCADE,
+    chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    pinned_by VARCHAR(255) NOT NULL,
+    pinned_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX pinned_messages_chat_id_pinned_at_idx ON pinned_messages (chat_id, pinned_at);
+
+-- +goose Down
+DROP TABLE pinned_messages;
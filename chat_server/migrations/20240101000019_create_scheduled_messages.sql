@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE scheduled_messages (
+    id BIGSERIAL PRIMARY KEY,
+    chat_id INTEGER NOT NULL REFERENCES chats(id) ON DELETE CASCADE,
+    from_user VARCHAR(255) NOT NULL,
+    text TEXT NOT NULL,
+    send_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX scheduled_messages_send_at_idx ON scheduled_messages (send_at, id);
+CREATE INDEX scheduled_messages_from_user_idx ON scheduled_messages (from_user, send_at);
+
+-- +goose Down
+DROP TABLE scheduled_messages;
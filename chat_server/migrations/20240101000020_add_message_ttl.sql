@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE chats ADD COLUMN message_ttl_seconds INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE messages ADD COLUMN expires_at TIMESTAMP;
+
+CREATE INDEX messages_expires_at_idx ON messages (expires_at, id) WHERE expires_at IS NOT NULL;
+
+-- +goose Down
+DROP INDEX messages_expires_at_idx;
+
+ALTER TABLE messages DROP COLUMN expires_at;
+
+ALTER TABLE chats DROP COLUMN message_ttl_seconds;
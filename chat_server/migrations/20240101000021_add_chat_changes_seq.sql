@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE chat_changes ADD COLUMN seq BIGINT;
+
+-- +goose Down
+ALTER TABLE chat_changes DROP COLUMN seq;
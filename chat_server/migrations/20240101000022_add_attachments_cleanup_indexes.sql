@@ -0,0 +1,8 @@
+-- +goose Up
+CREATE INDEX attachments_chat_id_idx ON attachments (chat_id);
+-- Unsent uploads are cleaned up once they are old enough.
+CREATE INDEX attachments_unsent_created_at_idx ON attachments (created_at) WHERE message_id IS NULL;
+
+-- +goose Down
+DROP INDEX attachments_unsent_created_at_idx;
+DROP INDEX attachments_chat_id_idx;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE scheduled_messages
+    ADD COLUMN attempts INTEGER NOT NULL DEFAULT 0,
+    -- next_attempt_at is set after a failed delivery and holds the message
+    -- back until then.
+    ADD COLUMN next_attempt_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE scheduled_messages
+    DROP COLUMN next_attempt_at,
+    DROP COLUMN attempts;